@@ -0,0 +1,438 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The mock API server is a local stand-in for an Apsara Stack region. It verifies the
+// RPC (HMAC-SHA1 query signature) and ROA (Authorization header) signing used by the
+// provider clients and replays recorded responses per Action, so that resources can be
+// exercised in CI without any cloud credentials.
+
+const (
+	mockApiAccessKey     = "MockAccessKeyId"
+	mockApiSecretKey     = "MockAccessKeySecret"
+	mockApiRegion        = "cn-qingdao-env66-d01"
+	mockApiDepartment    = "11"
+	mockApiResourceGroup = "27"
+	mockApiFixturePath   = "testdata/mockapi"
+)
+
+// mockApiEnvironments are the provider environment variables which would send the
+// requests somewhere other than the mock server or change the way they are signed.
+var mockApiEnvironments = []string{
+	"ALIBABACLOUDSTACK_DOMAIN",
+	"ALIBABACLOUDSTACK_PROXY",
+	"ALIBABACLOUDSTACK_PROFILE",
+	"ALIBABACLOUDSTACK_SHARED_CREDENTIALS_FILE",
+	"ALIBABACLOUDSTACK_ASSUME_ROLE_ARN",
+	"ALIBABACLOUDSTACK_SECURITY_TOKEN",
+	"ALIBABACLOUDSTACK_ECS_ROLE_NAME",
+	"ALIBABACLOUDSTACK_OSSSERVICE_DOMAIN",
+	"ALIBABACLOUDSTACK_STS_ENDPOINT",
+	"ALIBABACLOUDSTACK_SOURCE_IP",
+	"ALIBABACLOUDSTACK_SECURITY_TRANSPORT",
+	"ALIBABACLOUDSTACK_SECURE_TRANSPORT",
+	connectivity.EnvAccessKeyId,
+	connectivity.EnvAccessKeySecret,
+	connectivity.EnvSecurityToken,
+	connectivity.EnvOIDCProviderArn,
+	connectivity.EnvOIDCTokenFile,
+	connectivity.EnvRoleArn,
+	connectivity.EnvRoleSessionName,
+	connectivity.EnvProfile,
+}
+
+// mockApiEndpoints are the keys of the provider endpoints block which point at the mock server.
+var mockApiEndpoints = []string{
+	"ecs", "vpc", "slb", "rds", "ascm", "ess", "kms", "cms", "cr", "cs", "dns", "kvstore", "gpdb", "dds", "ons", "ros", "nas", "sts", "alikafka", "polardb", "cbn",
+}
+
+// mockApiResponse is a recorded response. Responses of the same Action are replayed in
+// the order they were recorded and the last one is replayed for all remaining calls.
+// A response with After is only replayed once the After action has been called and then
+// takes precedence over the ones without it, e.g. Describe* returning NotFound after Delete*.
+type mockApiResponse struct {
+	Action string                 `json:"action"`
+	After  string                 `json:"after,omitempty"`
+	Status int                    `json:"status,omitempty"`
+	Body   map[string]interface{} `json:"body"`
+
+	served bool
+}
+
+// mockApiCall is a request received by the mock server.
+type mockApiCall struct {
+	Action string
+	Method string
+	Path   string
+	Params map[string]string
+}
+
+type mockApiServer struct {
+	t         *testing.T
+	server    *httptest.Server
+	mutex     sync.Mutex
+	responses map[string][]*mockApiResponse
+	calls     []mockApiCall
+	failures  []string
+}
+
+func newMockApiServer(t *testing.T) *mockApiServer {
+	s := &mockApiServer{
+		t:         t,
+		responses: make(map[string][]*mockApiResponse),
+	}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(func() {
+		s.server.Close()
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		for _, failure := range s.failures {
+			t.Error(failure)
+		}
+	})
+	return s
+}
+
+// endpoint returns the host:port the provider endpoints should point at.
+func (s *mockApiServer) endpoint() string {
+	return strings.TrimPrefix(s.server.URL, "http://")
+}
+
+// on records the response body for the action. The key of a ROA API is "METHOD /path".
+func (s *mockApiServer) on(action string, body map[string]interface{}) *mockApiServer {
+	return s.add(&mockApiResponse{Action: action, Body: body})
+}
+
+// onError records an error response for the action.
+func (s *mockApiServer) onError(action string, status int, code string) *mockApiServer {
+	return s.add(&mockApiResponse{Action: action, Status: status, Body: mockApiErrorBody(code)})
+}
+
+// after records the response body for the action which is replayed once the trigger has been called.
+func (s *mockApiServer) after(trigger, action string, body map[string]interface{}) *mockApiServer {
+	return s.add(&mockApiResponse{Action: action, After: trigger, Body: body})
+}
+
+// afterError records an error response for the action which is replayed once the trigger has been called.
+func (s *mockApiServer) afterError(trigger, action string, status int, code string) *mockApiServer {
+	return s.add(&mockApiResponse{Action: action, After: trigger, Status: status, Body: mockApiErrorBody(code)})
+}
+
+func (s *mockApiServer) add(response *mockApiResponse) *mockApiServer {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.responses[response.Action] = append(s.responses[response.Action], response)
+	return s
+}
+
+// loadFixture records all of the responses in testdata/mockapi/<name>.json.
+func (s *mockApiServer) loadFixture(name string) *mockApiServer {
+	data, err := ioutil.ReadFile(filepath.Join(mockApiFixturePath, name+".json"))
+	if err != nil {
+		s.t.Fatalf("reading mock api fixture %s got an error: %#v", name, err)
+	}
+	var responses []*mockApiResponse
+	if err := json.Unmarshal(data, &responses); err != nil {
+		s.t.Fatalf("parsing mock api fixture %s got an error: %#v", name, err)
+	}
+	for _, response := range responses {
+		if response.Action == "" {
+			s.t.Fatalf("mock api fixture %s has a response without action", name)
+		}
+		s.add(response)
+	}
+	return s
+}
+
+// callCount returns how many times the action has been called.
+func (s *mockApiServer) callCount(action string) int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.countLocked(action)
+}
+
+func (s *mockApiServer) countLocked(action string) int {
+	count := 0
+	for _, call := range s.calls {
+		if call.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// lastCall returns the latest request of the action.
+func (s *mockApiServer) lastCall(action string) (mockApiCall, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for i := len(s.calls) - 1; i >= 0; i-- {
+		if s.calls[i].Action == action {
+			return s.calls[i], true
+		}
+	}
+	return mockApiCall{}, false
+}
+
+// providerConfig returns the raw provider configuration which sends every request to the mock server.
+func (s *mockApiServer) providerConfig() map[string]interface{} {
+	endpoints := make(map[string]interface{})
+	for _, key := range mockApiEndpoints {
+		endpoints[key] = s.endpoint()
+	}
+	return map[string]interface{}{
+		"access_key":              mockApiAccessKey,
+		"secret_key":              mockApiSecretKey,
+		"region":                  mockApiRegion,
+		"protocol":                "HTTP",
+		"department":              mockApiDepartment,
+		"resource_group":          mockApiResourceGroup,
+		"resource_group_set_name": "mock",
+		"kafkaopenapi_domain":     s.endpoint(),
+		"endpoints":               []interface{}{endpoints},
+	}
+}
+
+// providerBlock returns the HCL provider block which sends every request to the mock server.
+func (s *mockApiServer) providerBlock() string {
+	var endpoints []string
+	for _, key := range mockApiEndpoints {
+		endpoints = append(endpoints, fmt.Sprintf("    %s = %q", key, s.endpoint()))
+	}
+	return fmt.Sprintf(`
+provider "alibabacloudstack" {
+  access_key              = %q
+  secret_key              = %q
+  region                  = %q
+  protocol                = "HTTP"
+  department              = %q
+  resource_group          = %q
+  resource_group_set_name = "mock"
+  kafkaopenapi_domain     = %q
+  endpoints {
+%s
+  }
+}
+`, mockApiAccessKey, mockApiSecretKey, mockApiRegion, mockApiDepartment, mockApiResourceGroup, s.endpoint(), strings.Join(endpoints, "\n"))
+}
+
+// client configures the provider against the mock server and returns its client.
+func (s *mockApiServer) client() *connectivity.AlibabacloudStackClient {
+	unsetMockApiEnvironments(s.t)
+	p := Provider()
+	diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(s.providerConfig()))
+	if diags.HasError() {
+		s.t.Fatalf("configuring the provider against the mock api got an error: %#v", diags)
+	}
+	return p.Meta().(*connectivity.AlibabacloudStackClient)
+}
+
+func (s *mockApiServer) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	call, failure := parseMockApiCall(r)
+	if failure != "" {
+		s.fail(failure)
+		writeMockApiResponse(w, http.StatusBadRequest, mockApiErrorBody("SignatureDoesNotMatch"))
+		return
+	}
+
+	s.mutex.Lock()
+	response := s.matchLocked(call.Action)
+	s.calls = append(s.calls, call)
+	s.mutex.Unlock()
+	// The endpoint catalog is listed through the ascm endpoint whenever the provider is configured, it is empty
+	// unless a test records one
+	if response == nil && call.Action == "ListEndpoints" {
+		response = &mockApiResponse{Body: map[string]interface{}{"Success": true}}
+	}
+
+	if response == nil {
+		s.fail(fmt.Sprintf("mock api: there is no recorded response for %s, params: %v", call.Action, call.Params))
+		writeMockApiResponse(w, http.StatusNotFound, mockApiErrorBody("InvalidAction.NotFound"))
+		return
+	}
+	status := response.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	writeMockApiResponse(w, status, response.Body)
+}
+
+func (s *mockApiServer) matchLocked(action string) *mockApiResponse {
+	var triggered, others []*mockApiResponse
+	for _, response := range s.responses[action] {
+		if response.After == "" {
+			others = append(others, response)
+		} else if s.countLocked(response.After) > 0 {
+			triggered = append(triggered, response)
+		}
+	}
+	candidates := others
+	if len(triggered) > 0 {
+		candidates = triggered
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	for _, response := range candidates {
+		if !response.served {
+			response.served = true
+			return response
+		}
+	}
+	return candidates[len(candidates)-1]
+}
+
+func (s *mockApiServer) fail(failure string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failures = append(s.failures, failure)
+}
+
+// parseMockApiCall reads the request and verifies its signature.
+func parseMockApiCall(r *http.Request) (mockApiCall, string) {
+	call := mockApiCall{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Params: make(map[string]string),
+	}
+	if err := r.ParseForm(); err != nil {
+		return call, fmt.Sprintf("mock api: parsing the request %s got an error: %#v", r.URL, err)
+	}
+	for key, values := range r.Form {
+		if len(values) > 0 {
+			call.Params[key] = values[0]
+		}
+	}
+	// The secret is only used to sign the requests, it must never be sent along with them
+	for key, value := range call.Params {
+		if key == "AccessKeySecret" || value == mockApiSecretKey {
+			return call, fmt.Sprintf("mock api: %s %s sends the AccessKeySecret as the parameter %s", r.Method, r.URL.Path, key)
+		}
+	}
+
+	if action := call.Params["Action"]; action != "" {
+		call.Action = action
+		// AssumeRoleWithOIDC is not signed, the OIDC token is the proof of the identity
+		if action == "AssumeRoleWithOIDC" {
+			if call.Params["OIDCToken"] == "" || call.Params["Signature"] != "" {
+				return call, fmt.Sprintf("mock api: %s is expected to be sent anonymously with an OIDCToken", action)
+			}
+			return call, ""
+		}
+		signature := call.Params["Signature"]
+		if call.Params["AccessKeyId"] != mockApiAccessKey {
+			return call, fmt.Sprintf("mock api: %s is signed with the unexpected AccessKeyId %q", action, call.Params["AccessKeyId"])
+		}
+		if expected := mockApiRpcSignature(r.Method, call.Params, mockApiSecretKey); signature != expected {
+			return call, fmt.Sprintf("mock api: the signature of %s does not match, got %q, expected %q", action, signature, expected)
+		}
+		return call, ""
+	}
+
+	call.Action = fmt.Sprintf("%s %s", r.Method, r.URL.Path)
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, fmt.Sprintf("acs %s:", mockApiAccessKey)) || strings.HasSuffix(authorization, ":") {
+		return call, fmt.Sprintf("mock api: %s has an unexpected Authorization header %q", call.Action, authorization)
+	}
+	return call, ""
+}
+
+// mockApiRpcSignature computes the RPC signature in the same way as the SDK and tea rpc clients.
+func mockApiRpcSignature(method string, params map[string]string, secret string) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		if key != "Signature" {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	var pairs []string
+	for _, key := range keys {
+		pairs = append(pairs, url.QueryEscape(key)+"="+url.QueryEscape(params[key]))
+	}
+	canonicalized := strings.Join(pairs, "&")
+	canonicalized = strings.Replace(canonicalized, "+", "%20", -1)
+	canonicalized = strings.Replace(canonicalized, "*", "%2A", -1)
+	canonicalized = strings.Replace(canonicalized, "%7E", "~", -1)
+	stringToSign := method + "&%2F&" + url.QueryEscape(canonicalized)
+
+	mac := hmac.New(sha1.New, []byte(secret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func mockApiErrorBody(code string) map[string]interface{} {
+	return map[string]interface{}{
+		"Code":    code,
+		"Message": fmt.Sprintf("The mock api returned %s.", code),
+	}
+}
+
+func writeMockApiResponse(w http.ResponseWriter, status int, body map[string]interface{}) {
+	if body == nil {
+		body = make(map[string]interface{})
+	}
+	if _, ok := body["RequestId"]; !ok {
+		body["RequestId"] = "00000000-0000-0000-0000-000000000000"
+	}
+	data, _ := json.Marshal(body)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(data)
+}
+
+// unsetMockApiEnvironments clears the environments which would bypass the mock server until the test ends.
+func unsetMockApiEnvironments(t *testing.T) {
+	for _, key := range mockApiEnvironments {
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			restore := value
+			name := key
+			t.Cleanup(func() {
+				os.Setenv(name, restore)
+			})
+		}
+	}
+}
+
+// newMockApiResourceData builds the resource data of a resource which is about to be created, as the SDK does before calling Create.
+func newMockApiResourceData(t *testing.T, r *schema.Resource, raw map[string]interface{}) *schema.ResourceData {
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.MarkNewResource()
+	return d
+}
+
+// testAccPreCheckWithTerraform skips the test when the terraform binary used by resource.UnitTest is unavailable,
+// unless it runs in CI, which must provide the binary rather than skip the tests silently.
+func testAccPreCheckWithTerraform(t *testing.T) {
+	if os.Getenv("TF_ACC_TERRAFORM_PATH") != "" {
+		return
+	}
+	if _, err := exec.LookPath("terraform"); err != nil {
+		if os.Getenv("CI") != "" {
+			t.Fatal("The terraform binary was not found in PATH and TF_ACC_TERRAFORM_PATH is not set, it is required to run the tests in CI.")
+		}
+		t.Skip("Skipping the test because the terraform binary was not found in PATH and TF_ACC_TERRAFORM_PATH is not set.")
+	}
+}
@@ -0,0 +1,154 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	util "github.com/alibabacloud-go/tea-utils/service"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackClientRetry_throttling(t *testing.T) {
+	server := newMockApiServer(t).onError("CreateVpc", http.StatusBadRequest, "Throttling.User").loadFixture("vpc")
+	client := server.client()
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the vpc after a throttled request got an error: %#v", diags)
+	}
+	if count := server.callCount("CreateVpc"); count != 2 {
+		t.Errorf("expected the throttled CreateVpc to be sent again once, it was sent %d times", count)
+	}
+}
+
+func TestUnitAlibabacloudStackClientRetry_idempotency(t *testing.T) {
+	server := newMockApiServer(t).
+		onError("DescribeFileSystems", http.StatusInternalServerError, "InternalError").
+		onError("CreateFileSystem", http.StatusInternalServerError, "InternalError").
+		loadFixture("nas_file_system")
+	client := server.client()
+
+	nasService := NasService{client, context.Background()}
+	if _, err := nasService.DescribeNasFileSystem("nas-mock0001"); err != nil {
+		t.Fatalf("describing the file system after a server error got an error: %#v", err)
+	}
+	if count := server.callCount("DescribeFileSystems"); count != 2 {
+		t.Errorf("expected the read only DescribeFileSystems to be sent again once, it was sent %d times", count)
+	}
+
+	conn, err := client.NewNasClient()
+	if err != nil {
+		t.Fatalf("creating the nas client got an error: %#v", err)
+	}
+	request := map[string]interface{}{"RegionId": client.RegionId, "ProtocolType": "NFS"}
+	if _, err := conn.DoRequest(StringPointer("CreateFileSystem"), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{}); err == nil {
+		t.Fatalf("expected the CreateFileSystem without a client token to fail on the server error")
+	}
+	if count := server.callCount("CreateFileSystem"); count != 1 {
+		t.Errorf("expected the CreateFileSystem without a client token not to be sent again, it was sent %d times", count)
+	}
+	request["ClientToken"] = buildClientToken("CreateFileSystem")
+	if _, err := conn.DoRequest(StringPointer("CreateFileSystem"), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{}); err != nil {
+		t.Fatalf("creating the file system with a client token got an error: %#v", err)
+	}
+}
+
+func TestUnitAlibabacloudStackClientRetry_busyResource(t *testing.T) {
+	server := newMockApiServer(t).add(&mockApiResponse{
+		Action: "CreateFileSystem",
+		Status: http.StatusBadRequest,
+		Body: map[string]interface{}{
+			"Code":    "IncorrectStatus",
+			"Message": "The current status of the resource does not support this operation, please retry again.",
+		},
+	}).loadFixture("nas_file_system")
+	client := server.client()
+
+	conn, err := client.NewNasClient()
+	if err != nil {
+		t.Fatalf("creating the nas client got an error: %#v", err)
+	}
+	request := map[string]interface{}{"RegionId": client.RegionId, "ProtocolType": "NFS"}
+	if _, err := conn.DoRequest(StringPointer("CreateFileSystem"), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{}); err != nil {
+		t.Fatalf("creating the file system once the resource is no longer busy got an error: %#v", err)
+	}
+	if count := server.callCount("CreateFileSystem"); count != 2 {
+		t.Errorf("expected the CreateFileSystem rejected while the resource is busy to be sent again once, it was sent %d times", count)
+	}
+}
+
+func TestUnitAlibabacloudStackClientRetry_maxRetries(t *testing.T) {
+	server := newMockApiServer(t).onError("CreateVpc", http.StatusServiceUnavailable, "ServiceUnavailable")
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["max_retries"] = 1
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with max_retries got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); !diags.HasError() {
+		t.Fatalf("expected the create to fail once the retries are exhausted")
+	}
+	if count := server.callCount("CreateVpc"); count != 2 {
+		t.Errorf("expected CreateVpc to be sent max_retries + 1 times, it was sent %d times", count)
+	}
+}
+
+func TestUnitAlibabacloudStackClientRetry_sdkIdempotency(t *testing.T) {
+	server := newMockApiServer(t).
+		onError("CreateSecurityGroup", http.StatusInternalServerError, "InternalError").
+		onError("DescribeSecurityGroupAttribute", http.StatusInternalServerError, "InternalError").
+		loadFixture("security_group")
+	client := server.client()
+
+	r := resourceAlibabacloudStackSecurityGroup()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"name":   "tf-testAccSecurityGroupMock",
+		"vpc_id": "vpc-mock0001",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the security group after server errors got an error: %#v", diags)
+	}
+	if count := server.callCount("CreateSecurityGroup"); count != 2 {
+		t.Errorf("expected the CreateSecurityGroup with a client token to be sent again once, it was sent %d times", count)
+	}
+	if count := server.callCount("DescribeSecurityGroupAttribute"); count < 2 {
+		t.Errorf("expected the read only DescribeSecurityGroupAttribute to be sent again, it was sent %d times", count)
+	}
+}
+
+func TestUnitAlibabacloudStackClientRetry_cancel(t *testing.T) {
+	server := newMockApiServer(t).onError("CreateVpc", http.StatusServiceUnavailable, "ServiceUnavailable")
+	client := server.client()
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	client.SetStopContext(ctx)
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	start := time.Now()
+	if diags := r.CreateContext(ctx, d, client); !diags.HasError() {
+		t.Fatalf("expected the create to fail once it has been interrupted")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected the retries to stop once interrupted, the create took %s", elapsed)
+	}
+}
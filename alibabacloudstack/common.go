@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -20,7 +21,10 @@ import (
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 type InstanceNetWork string
@@ -348,7 +352,7 @@ func addDebug(action, content interface{}, requestInfo ...interface{}) {
 		}
 
 		//fmt.Printf(DefaultDebugMsg, action, content, trace)
-		log.Printf(DefaultDebugMsg, action, content, trace)
+		log.Print(connectivity.Redact(fmt.Sprintf(DefaultDebugMsg, action, content, trace)))
 	}
 }
 
@@ -470,24 +474,6 @@ func IntMin(x, y int) int {
 	return y
 }
 
-type Catcher struct {
-	Reason           string
-	RetryCount       int
-	RetryWaitSeconds int
-}
-
-var ClientErrorCatcher = Catcher{AlibabacloudStackGoClientFailure, 10, 5}
-var ServiceBusyCatcher = Catcher{"ServiceUnavailable", 10, 5}
-var ThrottlingCatcher = Catcher{Throttling, 50, 2}
-
-func NewInvoker() Invoker {
-	i := Invoker{}
-	i.AddCatcher(ClientErrorCatcher)
-	i.AddCatcher(ServiceBusyCatcher)
-	i.AddCatcher(ThrottlingCatcher)
-	return i
-}
-
 func userDataHashSum(user_data string) string {
 	// Check whether the user_data is not Base64 encoded.
 	// Always calculate hash of base64 decoded value since we
@@ -532,6 +518,10 @@ const (
 	TagResourceTopic         = TagResourceType("topic")
 	TagResourceConsumerGroup = TagResourceType("consumergroup")
 	TagResourceCluster       = TagResourceType("cluster")
+	TagResourceDBInstance    = TagResourceType("INSTANCE")
+	TagResourceScalingGroup  = TagResourceType("scalinggroup")
+	TagResourceFileSystem    = TagResourceType("filesystem")
+	TagResourceKey           = TagResourceType("key")
 )
 
 type KubernetesNodeType string
@@ -584,35 +574,6 @@ func writeToFile(filePath string, data interface{}) error {
 	return ioutil.WriteFile(filePath, []byte(out), 422)
 }
 
-type Invoker struct {
-	catchers []*Catcher
-}
-
-func (a *Invoker) AddCatcher(catcher Catcher) {
-	a.catchers = append(a.catchers, &catcher)
-}
-
-func (a *Invoker) Run(f func() error) error {
-	err := f()
-
-	if err == nil {
-		return nil
-	}
-
-	for _, catcher := range a.catchers {
-		if IsExpectedErrors(err, []string{catcher.Reason}) {
-			catcher.RetryCount--
-
-			if catcher.RetryCount <= 0 {
-				return fmt.Errorf("Retry timeout and got an error: %#v.", err)
-			} else {
-				time.Sleep(time.Duration(catcher.RetryWaitSeconds) * time.Second)
-				return a.Run(f)
-			}
-		}
-	}
-	return err
-}
 func Trim(v string) string {
 	if len(v) < 1 {
 		return v
@@ -842,3 +803,26 @@ func convertMapFloat64ToJsonString(m map[string]interface{}) (string, error) {
 		return string(result), nil
 	}
 }
+
+// withDiagnostics adapts a context-aware CRUD function which returns an error to the signature of the
+// schema.Resource CreateContext, ReadContext, UpdateContext and DeleteContext fields.
+// The context only bounds the waits of the function and of its services, RetryContext, WaitForStateContext and
+// sleepContext. The requests are not bound to it: their retries stop once terraform is interrupted, through the
+// stop context of the client, and a request in flight runs until the client times it out.
+func withDiagnostics(f func(context.Context, *schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		return errorToDiagnostics(f(ctx, d, meta), d.Id())
+	}
+}
+
+// sleepContext pauses the current goroutine for the duration and returns early with the context error once it is done.
+func sleepContext(ctx context.Context, duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
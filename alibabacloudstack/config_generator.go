@@ -0,0 +1,308 @@
+package alibabacloudstack
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+// The config generator walks the data sources of the existing resources and writes the
+// Terraform 1.5 import blocks together with the configuration of the resources, which is
+// read in the same way as "terraform import" does: the importer of the resource followed
+// by its read. The resources are scoped by the department and resource group of the provider.
+
+// configGeneratorSource is the data source which lists the resources of a type.
+type configGeneratorSource struct {
+	dataSource string
+	// importIds returns the import ids of the resources from the state of the data source.
+	importIds func(d *schema.ResourceData) []string
+}
+
+var configGeneratorSources = map[string]configGeneratorSource{
+	"alibabacloudstack_instance":            {"alibabacloudstack_instances", configGeneratorListIds("ids")},
+	"alibabacloudstack_vpc":                 {"alibabacloudstack_vpcs", configGeneratorListIds("ids")},
+	"alibabacloudstack_vswitch":             {"alibabacloudstack_vswitches", configGeneratorListIds("ids")},
+	"alibabacloudstack_security_group":      {"alibabacloudstack_security_groups", configGeneratorListIds("ids")},
+	"alibabacloudstack_slb":                 {"alibabacloudstack_slbs", configGeneratorListIds("ids")},
+	"alibabacloudstack_db_instance":         {"alibabacloudstack_db_instances", configGeneratorListIds("ids")},
+	"alibabacloudstack_oss_bucket":          {"alibabacloudstack_oss_buckets", configGeneratorListIds("names")},
+	"alibabacloudstack_ascm_organization":   {"alibabacloudstack_ascm_organizations", configGeneratorAscmOrganizationIds},
+	"alibabacloudstack_alikafka_instance":   {"alibabacloudstack_alikafka_instances", configGeneratorListIds("ids")},
+	"alibabacloudstack_ascm_resource_group": {"alibabacloudstack_ascm_resource_groups", configGeneratorAscmResourceGroupIds},
+	"alibabacloudstack_fc_service":          {"alibabacloudstack_fc_services", configGeneratorListIds("names")},
+	"alibabacloudstack_polardb_cluster":     {"alibabacloudstack_polardb_clusters", configGeneratorListIds("ids")},
+	"alibabacloudstack_cen_instance":        {"alibabacloudstack_cen_instances", configGeneratorListIds("ids")},
+}
+
+// ConfigGeneratorResourceTypes returns the resource types the config generator supports.
+func ConfigGeneratorResourceTypes() []string {
+	var types []string
+	for resourceType := range configGeneratorSources {
+		types = append(types, resourceType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// GenerateConfig writes the import blocks and the resources of the given types to w, all of
+// the supported types when resourceTypes is empty. The meta is the one of a configured provider.
+func GenerateConfig(ctx context.Context, meta interface{}, w io.Writer, resourceTypes []string) error {
+	if len(resourceTypes) == 0 {
+		resourceTypes = ConfigGeneratorResourceTypes()
+	}
+	provider := Provider()
+	for _, resourceType := range resourceTypes {
+		source, ok := configGeneratorSources[resourceType]
+		if !ok {
+			return WrapError(Error("the resource type %s is not supported, expected one of %s", resourceType, strings.Join(ConfigGeneratorResourceTypes(), ", ")))
+		}
+		ids, err := configGeneratorListResources(ctx, provider.DataSourcesMap[source.dataSource], source, meta)
+		if err != nil {
+			return WrapError(Error("listing the %s failed: %s", source.dataSource, err))
+		}
+		r := provider.ResourcesMap[resourceType]
+		labels := make(map[string]bool)
+		for _, id := range ids {
+			d, err := configGeneratorImportResource(ctx, r, id, meta)
+			if err != nil {
+				return WrapError(Error("importing the %s %s failed: %s", resourceType, id, err))
+			}
+			if d == nil {
+				continue
+			}
+			label := configGeneratorLabel(id, labels)
+			var buf bytes.Buffer
+			fmt.Fprintf(&buf, "import {\n  to = %s.%s\n  id = %s\n}\n\n", resourceType, label, configGeneratorString(id))
+			fmt.Fprintf(&buf, "resource %q %q {\n", resourceType, label)
+			writeConfigGeneratorAttributes(&buf, r.Schema, d.Get, 1)
+			buf.WriteString("}\n\n")
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+	return nil
+}
+
+func configGeneratorListResources(ctx context.Context, ds *schema.Resource, source configGeneratorSource, meta interface{}) ([]string, error) {
+	diff, err := ds.Diff(ctx, nil, terraform.NewResourceConfigRaw(map[string]interface{}{}), meta)
+	if err != nil {
+		return nil, err
+	}
+	state, diags := ds.ReadDataApply(ctx, diff, meta)
+	if diags.HasError() {
+		return nil, configGeneratorDiagnosticsError(diags)
+	}
+	if state == nil {
+		return nil, nil
+	}
+	return source.importIds(ds.Data(state)), nil
+}
+
+// configGeneratorImportResource imports the resource like "terraform import" does and returns nil
+// when it does not exist anymore.
+func configGeneratorImportResource(ctx context.Context, r *schema.Resource, id string, meta interface{}) (*schema.ResourceData, error) {
+	d := r.Data(nil)
+	d.SetId(id)
+	imported := []*schema.ResourceData{d}
+	if r.Importer != nil && r.Importer.StateContext != nil {
+		var err error
+		if imported, err = r.Importer.StateContext(ctx, d, meta); err != nil {
+			return nil, err
+		}
+	}
+	if len(imported) == 0 {
+		return nil, nil
+	}
+	state, diags := r.RefreshWithoutUpgrade(ctx, imported[0].State(), meta)
+	if diags.HasError() {
+		return nil, configGeneratorDiagnosticsError(diags)
+	}
+	if state == nil || state.ID == "" {
+		return nil, nil
+	}
+	return r.Data(state), nil
+}
+
+// writeConfigGeneratorAttributes writes the configurable attributes which are set, leaving out the
+// computed, deprecated and default ones. The sensitive attributes are left as a comment to fill in.
+func writeConfigGeneratorAttributes(buf *bytes.Buffer, schemas map[string]*schema.Schema, get func(string) interface{}, depth int) {
+	indent := strings.Repeat("  ", depth)
+	var keys []string
+	for key := range schemas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	// The attributes between two blocks are aligned like "terraform fmt" does.
+	var pending [][2]string
+	flush := func() {
+		width := 0
+		for _, attribute := range pending {
+			if len(attribute[0]) > width {
+				width = len(attribute[0])
+			}
+		}
+		for _, attribute := range pending {
+			fmt.Fprintf(buf, "%s%-*s = %s\n", indent, width, attribute[0], attribute[1])
+		}
+		pending = nil
+	}
+	for _, key := range keys {
+		s := schemas[key]
+		if (!s.Required && !s.Optional) || s.Deprecated != "" || key == "tags_all" {
+			continue
+		}
+		value := get(key)
+		if set, ok := value.(*schema.Set); ok {
+			value = set.List()
+		}
+		if s.Sensitive {
+			if s.Required {
+				flush()
+				fmt.Fprintf(buf, "%s# %s is sensitive and has to be set\n", indent, key)
+			}
+			continue
+		}
+		// An attribute is left out when the configuration would set it to the value it has anyway: its Default,
+		// or the zero value of an optional attribute without one. The required attributes are always written.
+		if value == nil {
+			continue
+		}
+		if !s.Required {
+			if s.Default != nil {
+				if reflect.DeepEqual(value, s.Default) {
+					continue
+				}
+			} else if configGeneratorIsZero(value) {
+				continue
+			}
+		}
+		if elem, ok := s.Elem.(*schema.Resource); ok && (s.Type == schema.TypeList || s.Type == schema.TypeSet) {
+			flush()
+			for _, item := range value.([]interface{}) {
+				block, ok := item.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				fmt.Fprintf(buf, "%s%s {\n", indent, key)
+				writeConfigGeneratorAttributes(buf, elem.Schema, func(k string) interface{} { return block[k] }, depth+1)
+				fmt.Fprintf(buf, "%s}\n", indent)
+			}
+			continue
+		}
+		pending = append(pending, [2]string{key, configGeneratorValue(value)})
+	}
+	flush()
+}
+
+func configGeneratorValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return configGeneratorString(v)
+	case []interface{}:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, configGeneratorValue(item))
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case map[string]interface{}:
+		var keys []string
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		items := make([]string, 0, len(keys))
+		for _, key := range keys {
+			items = append(items, configGeneratorString(key)+" = "+configGeneratorValue(v[key]))
+		}
+		return "{ " + strings.Join(items, ", ") + " }"
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// configGeneratorString quotes the string and escapes the template sequences of HCL.
+func configGeneratorString(value string) string {
+	quoted := strconv.Quote(value)
+	quoted = strings.Replace(quoted, "${", "$${", -1)
+	return strings.Replace(quoted, "%{", "%%{", -1)
+}
+
+func configGeneratorIsZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	switch v := value.(type) {
+	case []interface{}:
+		return len(v) == 0
+	case map[string]interface{}:
+		return len(v) == 0
+	}
+	return reflect.DeepEqual(value, reflect.Zero(reflect.TypeOf(value)).Interface())
+}
+
+var configGeneratorLabelInvalid = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// configGeneratorLabel returns a resource name for the id which is unique within its type.
+func configGeneratorLabel(id string, labels map[string]bool) string {
+	label := strings.Trim(configGeneratorLabelInvalid.ReplaceAllString(id, "_"), "_")
+	if label == "" || (label[0] >= '0' && label[0] <= '9') || label[0] == '-' {
+		label = "r_" + label
+	}
+	unique := label
+	for i := 2; labels[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", label, i)
+	}
+	labels[unique] = true
+	return unique
+}
+
+func configGeneratorListIds(key string) func(d *schema.ResourceData) []string {
+	return func(d *schema.ResourceData) []string {
+		var ids []string
+		for _, id := range d.Get(key).([]interface{}) {
+			ids = append(ids, fmt.Sprint(id))
+		}
+		return ids
+	}
+}
+
+// configGeneratorAscmOrganizationIds returns the ids of the organizations, which are formulated as <name>:<id>.
+func configGeneratorAscmOrganizationIds(d *schema.ResourceData) []string {
+	var ids []string
+	for _, item := range d.Get("organizations").([]interface{}) {
+		organization := item.(map[string]interface{})
+		ids = append(ids, fmt.Sprint(organization["name"])+COLON_SEPARATED+fmt.Sprint(organization["id"]))
+	}
+	return ids
+}
+
+// configGeneratorAscmResourceGroupIds returns the ids of the resource groups, which are formulated as <name>:<id>.
+func configGeneratorAscmResourceGroupIds(d *schema.ResourceData) []string {
+	var ids []string
+	for _, item := range d.Get("groups").([]interface{}) {
+		group := item.(map[string]interface{})
+		ids = append(ids, fmt.Sprint(group["name"])+COLON_SEPARATED+fmt.Sprint(group["id"]))
+	}
+	return ids
+}
+
+func configGeneratorDiagnosticsError(diags diag.Diagnostics) error {
+	var messages []string
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			messages = append(messages, strings.TrimSpace(d.Summary+" "+d.Detail))
+		}
+	}
+	return fmt.Errorf("%s", strings.Join(messages, "; "))
+}
@@ -0,0 +1,110 @@
+package alibabacloudstack
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitAlibabacloudStackGenerateConfig_mock(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeVpcs", map[string]interface{}{
+		"TotalCount": 1,
+		"PageNumber": 1,
+		"PageSize":   50,
+		"Vpcs": map[string]interface{}{
+			"Vpc": []interface{}{
+				map[string]interface{}{
+					"VpcId":       "vpc-mock0001",
+					"VpcName":     "tf-testAccVpcMock",
+					"CidrBlock":   "10.0.0.0/8",
+					"Description": "mock ${vpc}",
+					"VRouterId":   "vrt-mock0001",
+					"Status":      "Available",
+				},
+			},
+		},
+	}).on("DescribeVRouters", map[string]interface{}{
+		"VRouters": map[string]interface{}{
+			"VRouter": []interface{}{
+				map[string]interface{}{"VRouterId": "vrt-mock0001", "RouteTableIds": map[string]interface{}{"RouteTableId": []interface{}{"vtb-mock0001"}}},
+			},
+		},
+	}).on("DescribeRouteTables", map[string]interface{}{
+		"TotalCount": 1,
+		"RouteTables": map[string]interface{}{
+			"RouteTable": []interface{}{
+				map[string]interface{}{"RouteTableId": "vtb-mock0001", "VRouterId": "vrt-mock0001", "RouteTableType": "System"},
+			},
+		},
+	})
+
+	var buf bytes.Buffer
+	if err := GenerateConfig(context.Background(), server.client(), &buf, []string{"alibabacloudstack_vpc"}); err != nil {
+		t.Fatalf("generating the config got an error: %#v", err)
+	}
+	expected := `import {
+  to = alibabacloudstack_vpc.vpc-mock0001
+  id = "vpc-mock0001"
+}
+
+resource "alibabacloudstack_vpc" "vpc-mock0001" {
+  cidr_block  = "10.0.0.0/8"
+  description = "mock $${vpc}"
+  vpc_name    = "tf-testAccVpcMock"
+}
+
+`
+	if buf.String() != expected {
+		t.Fatalf("expected the config\n%s\ngot\n%s", expected, buf.String())
+	}
+	if call, ok := server.lastCall("DescribeVpcs"); !ok || call.Params["Department"] != mockApiDepartment || call.Params["ResourceGroup"] != mockApiResourceGroup {
+		t.Errorf("DescribeVpcs was not scoped by the department and resource group: %v", call.Params)
+	}
+
+	if err := GenerateConfig(context.Background(), server.client(), &buf, []string{"alibabacloudstack_unknown"}); err == nil {
+		t.Errorf("expected an unsupported resource type to fail")
+	}
+}
+
+func TestUnitAlibabacloudStackGenerateConfig_label(t *testing.T) {
+	labels := make(map[string]bool)
+	for id, expected := range map[string]string{
+		"tf-testorg:35": "tf-testorg_35",
+		"35:tf-testorg": "r_35_tf-testorg",
+	} {
+		if label := configGeneratorLabel(id, labels); label != expected {
+			t.Errorf("expected the label of %s to be %s, got %s", id, expected, label)
+		}
+	}
+	if label := configGeneratorLabel("tf-testorg:35", labels); label != "tf-testorg_35_2" {
+		t.Errorf("expected a duplicated label to be suffixed, got %s", label)
+	}
+}
+
+func TestUnitAlibabacloudStackGenerateConfig_defaults(t *testing.T) {
+	schemas := map[string]*schema.Schema{
+		"name":                 {Type: schema.TypeString, Required: true},
+		"size":                 {Type: schema.TypeInt, Required: true},
+		"category":             {Type: schema.TypeString, Optional: true, Default: "cloud_efficiency"},
+		"encrypted":            {Type: schema.TypeBool, Optional: true},
+		"delete_with_instance": {Type: schema.TypeBool, Optional: true, Default: true},
+	}
+	values := map[string]interface{}{
+		"name":                 "",
+		"size":                 0,
+		"category":             "cloud_efficiency",
+		"encrypted":            false,
+		"delete_with_instance": false,
+	}
+	var buf bytes.Buffer
+	writeConfigGeneratorAttributes(&buf, schemas, func(key string) interface{} { return values[key] }, 0)
+	expected := `delete_with_instance = false
+name                 = ""
+size                 = 0
+`
+	if buf.String() != expected {
+		t.Fatalf("expected the zero values which differ from the default and the required ones to be written\n%s\ngot\n%s", expected, buf.String())
+	}
+}
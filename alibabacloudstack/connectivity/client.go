@@ -1,6 +1,7 @@
 package connectivity
 
 import (
+	"context"
 	"encoding/json"
 	roaCS "github.com/alibabacloud-go/cs-20151215/v2/client"
 	openapi "github.com/alibabacloud-go/darabonba-openapi/client"
@@ -26,7 +27,6 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/gpdb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/hbase"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/location"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/maxcompute"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ons"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
@@ -63,22 +63,41 @@ import (
 )
 
 type AlibabacloudStackClient struct {
-	SourceIp                     string
-	SecureTransport              string
-	Region                       Region
-	RegionId                     string
-	Domain                       string
-	AccessKey                    string
-	SecretKey                    string
-	Department                   string
-	ResourceGroup                string
-	Config                       *Config
-	teaSdkConfig                 rpc.Config
-	accountId                    string
-	roleId                       int
+	SourceIp        string
+	SecureTransport string
+	Region          Region
+	RegionId        string
+	Domain          string
+	AccessKey       string
+	SecretKey       string
+	Department      string
+	ResourceGroup   string
+	Config          *Config
+	teaSdkConfig    rpc.Config
+	accountId       string
+	roleId          int
+	accountIdMutex  sync.RWMutex
+	roleIdMutex     sync.RWMutex
+	clientConns
+	OtsInstanceName string
+	// credentialsGeneration is the generation of the credentials the clientConns were created with
+	credentialsGeneration uint64
+	connsMutex            sync.Mutex
+	// retry sends the requests of every client, it is shared by the scoped clients
+	retry *retryEngine
+	// stopContext is done once terraform is interrupted, the scoped clients use the one of their base
+	stopContext context.Context
+	// base is the client of the provider a client scoped to another department or resource group is derived from
+	base               *AlibabacloudStackClient
+	scopedClients      map[string]*AlibabacloudStackClient
+	scopedClientsMutex sync.Mutex
+}
+
+// clientConns are the clients of the products which sign their requests with the credentials they were created
+// with. They are created again with the current credentials once the credentials of the provider have been refreshed.
+type clientConns struct {
+	credentials                  Credentials
 	ecsconn                      *ecs.Client
-	accountIdMutex               sync.RWMutex
-	roleIdMutex                  sync.RWMutex
 	vpcconn                      *vpc.Client
 	slbconn                      *slb.Client
 	csconn                       *cs.Client
@@ -110,7 +129,6 @@ type AlibabacloudStackClient struct {
 	maxcomputeconn               *maxcompute.Client
 	alikafkaconn                 *alikafka.Client
 	otsconn                      *ots.Client
-	OtsInstanceName              string
 	tablestoreconnByInstanceName map[string]*tablestore.TableStoreClient
 	dhconn                       datahub.DataHubApi
 	cloudapiconn                 *cloudapi.Client
@@ -123,8 +141,6 @@ const (
 	ApiVersion20190510 = ApiVersion("2019-05-10")
 )
 
-const DefaultClientRetryCountSmall = 5
-
 const Terraform = "HashiCorp-Terraform"
 
 const Provider = "Terraform-Provider"
@@ -159,22 +175,120 @@ func (c *Config) Client() (*AlibabacloudStackClient, error) {
 		return nil, err
 	}
 
+	// The credentials are masked in the debug output, whichever request or log line they end up in, the ones
+	// of the credentials chain are registered as they are resolved and refreshed
+	RegisterSensitiveValues(c.AccessKey, c.SecretKey, c.SecurityToken, c.OrganizationAccessKey, c.OrganizationSecretKey)
+	RedactLogOutput()
+
+	if err := c.LoadEndpoints(); err != nil {
+		return nil, err
+	}
+
+	client := newAlibabacloudStackClient(c, teaSdkConfig, c.Department, c.ResourceGroup)
+	client.retry = newRetryEngine(c.MaxRetries, c.MaxRetryTimeout)
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+func newAlibabacloudStackClient(c *Config, teaSdkConfig rpc.Config, department, resourceGroup string) *AlibabacloudStackClient {
 	return &AlibabacloudStackClient{
-		Config:                       c,
-		teaSdkConfig:                 teaSdkConfig,
-		Region:                       c.Region,
-		RegionId:                     c.RegionId,
-		AccessKey:                    c.AccessKey,
-		SecretKey:                    c.SecretKey,
-		Department:                   c.Department,
-		ResourceGroup:                c.ResourceGroup,
-		Domain:                       c.Domain,
-		OtsInstanceName:              c.OtsInstanceName,
-		tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
-	}, nil
+		Config:          c,
+		teaSdkConfig:    teaSdkConfig,
+		Region:          c.Region,
+		RegionId:        c.RegionId,
+		AccessKey:       c.AccessKey,
+		SecretKey:       c.SecretKey,
+		Department:      department,
+		ResourceGroup:   resourceGroup,
+		Domain:          c.Domain,
+		OtsInstanceName: c.OtsInstanceName,
+	}
+}
+
+// renewConns creates the clients of the products again once the credentials of the provider have been refreshed.
+// The requests in flight go on with the former credentials, which are refreshed a while before they expire.
+func (client *AlibabacloudStackClient) renewConns() error {
+	credentials, generation, err := client.Config.currentCredentials()
+	if err != nil {
+		return err
+	}
+	client.connsMutex.Lock()
+	defer client.connsMutex.Unlock()
+	if generation != client.credentialsGeneration {
+		client.clientConns = clientConns{
+			credentials:                  credentials,
+			tablestoreconnByInstanceName: make(map[string]*tablestore.TableStoreClient),
+		}
+		client.AccessKey, client.SecretKey = credentials.AccessKey, credentials.SecretKey
+		client.credentialsGeneration = generation
+	}
+	return nil
+}
+
+// WithScope returns the client which sends the requests to the department and resource group instead of the
+// ones of the provider. Both of them may be an id or a name of the provider name_mapping, and an empty one
+// keeps the one of the provider, or the department of the resource group when only the latter is given. A department
+// which is not the one of a resource group of the provider name_mapping is rejected.
+// Every request reads the department and resource group from the client, so the common requests, the sdk
+// clients and the tea rpc clients of the returned client are all scoped. The clients are cached per scope.
+func (client *AlibabacloudStackClient) WithScope(department, resourceGroup string) (*AlibabacloudStackClient, error) {
+	base := client
+	if client.base != nil {
+		base = client.base
+	}
+	if department != "" {
+		if id, ok := base.Config.DepartmentIds[department]; ok {
+			department = id
+		} else if _, err := strconv.Atoi(department); err != nil {
+			return nil, fmt.Errorf("the department %q is neither an id nor a department of the provider name_mapping", department)
+		}
+	}
+	if resourceGroup != "" {
+		if id, ok := base.Config.ResourceGroupIds[resourceGroup]; ok {
+			resourceGroup = id
+		} else if _, err := strconv.Atoi(resourceGroup); err != nil {
+			return nil, fmt.Errorf("the resource group %q is neither an id nor a resource group of the provider name_mapping", resourceGroup)
+		}
+		if resourceGroupDepartment, ok := base.Config.ResourceGroupDepartments[resourceGroup]; ok {
+			if department == "" {
+				department = resourceGroupDepartment
+			} else if department != resourceGroupDepartment {
+				return nil, fmt.Errorf("the resource group %s belongs to the department %s, not to the department %s", resourceGroup, resourceGroupDepartment, department)
+			}
+		}
+	}
+	if department == "" {
+		department = base.Department
+	}
+	if resourceGroup == "" {
+		resourceGroup = base.ResourceGroup
+	}
+	if department == base.Department && resourceGroup == base.ResourceGroup {
+		return base, nil
+	}
+
+	base.scopedClientsMutex.Lock()
+	defer base.scopedClientsMutex.Unlock()
+	if base.scopedClients == nil {
+		base.scopedClients = make(map[string]*AlibabacloudStackClient)
+	}
+	key := department + ":" + resourceGroup
+	scoped, ok := base.scopedClients[key]
+	if !ok {
+		scoped = newAlibabacloudStackClient(base.Config, base.teaSdkConfig, department, resourceGroup)
+		scoped.base = base
+		scoped.retry = base.retry
+		base.scopedClients[key] = scoped
+	}
+	return scoped, nil
 }
 
 func (client *AlibabacloudStackClient) WithEcsClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the ECS client if necessary
 	if client.ecsconn == nil {
 		endpoint := client.Config.EcsEndpoint
@@ -204,10 +318,16 @@ func (client *AlibabacloudStackClient) WithEcsClient(do func(*ecs.Client) (inter
 		client.ecsconn = ecsconn
 	}
 
-	return do(client.ecsconn)
+	conn := client.ecsconn
+	return client.invoke("ecs", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithPolarDBClient(do func(*polardb.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the PolarDB client if necessary
 	if client.polarDBconn == nil {
 		endpoint := client.Config.PolarDBEndpoint
@@ -232,9 +352,15 @@ func (client *AlibabacloudStackClient) WithPolarDBClient(do func(*polardb.Client
 		client.polarDBconn = polarDBconn
 	}
 
-	return do(client.polarDBconn)
+	conn := client.polarDBconn
+	return client.invoke("polardb", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithElasticsearchClient(do func(*elasticsearch.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the Elasticsearch client if necessary
 	if client.elasticsearchconn == nil {
 		endpoint := client.Config.ElasticsearchEndpoint
@@ -259,15 +385,21 @@ func (client *AlibabacloudStackClient) WithElasticsearchClient(do func(*elastics
 		client.elasticsearchconn = elasticsearchconn
 	}
 
-	return do(client.elasticsearchconn)
+	conn := client.elasticsearchconn
+	return client.invoke("elasticsearch", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCloudApiClient(do func(*cloudapi.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the Cloud API client if necessary
 	if client.cloudapiconn == nil {
 		endpoint := client.Config.ApigatewayEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, CLOUDAPICode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(CLOUDAPICode)))
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.RegionId, "CLOUDAPI", endpoint)
@@ -286,10 +418,16 @@ func (client *AlibabacloudStackClient) WithCloudApiClient(do func(*cloudapi.Clie
 		client.cloudapiconn = cloudapiconn
 	}
 
-	return do(client.cloudapiconn)
+	conn := client.cloudapiconn
+	return client.invoke("apigateway", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithEssClient(do func(*ess.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the ESS client if necessary
 	if client.essconn == nil {
 		endpoint := client.Config.EssEndpoint
@@ -318,10 +456,16 @@ func (client *AlibabacloudStackClient) WithEssClient(do func(*ess.Client) (inter
 		client.essconn = essconn
 	}
 
-	return do(client.essconn)
+	conn := client.essconn
+	return client.invoke("ess", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRkvClient(do func(*r_kvstore.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the RKV client if necessary
 	if client.rkvconn == nil {
 		endpoint := client.Config.KVStoreEndpoint
@@ -349,10 +493,16 @@ func (client *AlibabacloudStackClient) WithRkvClient(do func(*r_kvstore.Client)
 		client.rkvconn = rkvconn
 	}
 
-	return do(client.rkvconn)
+	conn := client.rkvconn
+	return client.invoke("r-kvstore", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithGpdbClient(do func(*gpdb.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the GPDB client if necessary
 	if client.gpdbconn == nil {
 		endpoint := client.Config.GpdbEndpoint
@@ -375,9 +525,15 @@ func (client *AlibabacloudStackClient) WithGpdbClient(do func(*gpdb.Client) (int
 		client.gpdbconn = gpdbconn
 	}
 
-	return do(client.gpdbconn)
+	conn := client.gpdbconn
+	return client.invoke("gpdb", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithAdbClient(do func(*adb.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the adb client if necessary
 	if client.adbconn == nil {
 		endpoint := client.Config.AdbEndpoint
@@ -400,9 +556,15 @@ func (client *AlibabacloudStackClient) WithAdbClient(do func(*adb.Client) (inter
 		client.adbconn = adbconn
 	}
 
-	return do(client.adbconn)
+	conn := client.adbconn
+	return client.invoke("adb", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithHbaseClient(do func(*hbase.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the HBase client if necessary
 	if client.hbaseconn == nil {
 		endpoint := client.Config.HBaseEndpoint
@@ -427,9 +589,15 @@ func (client *AlibabacloudStackClient) WithHbaseClient(do func(*hbase.Client) (i
 		client.hbaseconn = hbaseconn
 	}
 
-	return do(client.hbaseconn)
+	conn := client.hbaseconn
+	return client.invoke("hbase", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithFcClient(do func(*fc.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -448,21 +616,27 @@ func (client *AlibabacloudStackClient) WithFcClient(do func(*fc.Client) (interfa
 		}
 
 		config := client.getSdkConfig()
-		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.Config.SecurityToken), fc.WithTransport(config.HttpTransport),
-			fc.WithTimeout(30), fc.WithRetryCount(DefaultClientRetryCountSmall)}
-		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
+		clientOptions := []fc.ClientOption{fc.WithSecurityToken(client.credentials.SecurityToken), fc.WithTransport(config.HttpTransport),
+			fc.WithTimeout(30), fc.WithRetryCount(0)}
+		fcconn, err := fc.NewClient(fmt.Sprintf("https://%s.%s", accountId, endpoint), string(ApiVersion20160815), client.credentials.AccessKey, client.credentials.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the FC client: %#v", err)
 		}
 
 		fcconn.Config.UserAgent = client.getUserAgent()
-		fcconn.Config.SecurityToken = client.Config.SecurityToken
+		fcconn.Config.SecurityToken = client.credentials.SecurityToken
 		client.fcconn = fcconn
 	}
 
-	return do(client.fcconn)
+	conn := client.fcconn
+	return client.invoke("fc", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithVpcClient(do func(*vpc.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the VPC client if necessary
 	if client.vpcconn == nil {
 		endpoint := client.Config.VpcEndpoint
@@ -491,10 +665,16 @@ func (client *AlibabacloudStackClient) WithVpcClient(do func(*vpc.Client) (inter
 		client.vpcconn = vpcconn
 	}
 
-	return do(client.vpcconn)
+	conn := client.vpcconn
+	return client.invoke("vpc", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithSlbClient(do func(*slb.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the SLB client if necessary
 	if client.slbconn == nil {
 		endpoint := client.Config.SlbEndpoint
@@ -521,9 +701,15 @@ func (client *AlibabacloudStackClient) WithSlbClient(do func(*slb.Client) (inter
 		client.slbconn = slbconn
 	}
 
-	return do(client.slbconn)
+	conn := client.slbconn
+	return client.invoke("slb", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDdsClient(do func(*dds.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the DDS client if necessary
 	if client.ddsconn == nil {
 		endpoint := client.Config.DdsEndpoint
@@ -548,10 +734,16 @@ func (client *AlibabacloudStackClient) WithDdsClient(do func(*dds.Client) (inter
 		client.ddsconn = ddsconn
 	}
 
-	return do(client.ddsconn)
+	conn := client.ddsconn
+	return client.invoke("dds", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithOssNewClient(do func(*ecs.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the ECS client if necessary
 	if client.ecsconn == nil {
 		endpoint := client.Config.OssEndpoint
@@ -581,44 +773,10 @@ func (client *AlibabacloudStackClient) WithOssNewClient(do func(*ecs.Client) (in
 		client.ecsconn = ecsconn
 	}
 
-	return do(client.ecsconn)
-}
-
-func (client *AlibabacloudStackClient) describeEndpointForService(serviceCode string) (*location.Endpoint, error) {
-	args := location.CreateDescribeEndpointsRequest()
-	args.ServiceCode = serviceCode
-	args.Id = client.Config.RegionId
-	args.Domain = client.Config.LocationEndpoint
-
-	if args.Domain == "" {
-		args.Domain = "location-readonly.aliyuncs.com"
-	}
-
-	locationClient, err := location.NewClientWithOptions(client.Config.RegionId, client.getSdkConfig(), client.Config.getAuthCredential(true))
-	if err != nil {
-		return nil, fmt.Errorf("Unable to initialize the location client: %#v", err)
-
-	}
-	locationClient.AppendUserAgent(Terraform, TerraformVersion)
-	locationClient.AppendUserAgent(Provider, ProviderVersion)
-	locationClient.AppendUserAgent(Module, client.Config.ConfigurationSource)
-	locationClient.SetHTTPSInsecure(client.Config.Insecure)
-	if client.Config.Proxy != "" {
-		locationClient.SetHttpProxy(client.Config.Proxy)
-		locationClient.SetHttpsProxy(client.Config.Proxy)
-	}
-	endpointsResponse, err := locationClient.DescribeEndpoints(args)
-	if err != nil {
-		return nil, fmt.Errorf("Describe %s endpoint using region: %#v got an error: %#v.", serviceCode, client.RegionId, err)
-	}
-	if endpointsResponse != nil && len(endpointsResponse.Endpoints.Endpoint) > 0 {
-		for _, e := range endpointsResponse.Endpoints.Endpoint {
-			if e.Type == "openAPI" {
-				return &e, nil
-			}
-		}
-	}
-	return nil, fmt.Errorf("There is no any available endpoint for %s in region %s.", serviceCode, client.RegionId)
+	conn := client.ecsconn
+	return client.invoke("ecs", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) NewCommonRequest(product, serviceCode, schema string, apiVersion ApiVersion) (*requests.CommonRequest, error) {
@@ -644,33 +802,24 @@ func (client *AlibabacloudStackClient) NewCommonRequest(product, serviceCode, sc
 	}
 
 	if endpoint == "" {
-		endpointItem, err := client.describeEndpointForService(serviceCode)
-		if err != nil {
-			return nil, fmt.Errorf("describeEndpointForService got an error: %#v.", err)
-		}
-		if endpointItem != nil {
-			endpoint = endpointItem.Endpoint
-		}
+		endpoint = client.Config.resolveEndpoint(strings.ToLower(serviceCode))
 	}
-	// Use product code to find product domain
-	if endpoint != "" {
-		request.Domain = endpoint
-	} else {
-		// When getting endpoint failed by location, using custom endpoint instead
-		request.Domain = fmt.Sprintf("%s.%s.aliyuncs.com", strings.ToLower(serviceCode), client.RegionId)
+	if endpoint == "" {
+		return nil, client.Config.missingEndpointError(serviceCode)
 	}
+	request.Domain = endpoint
 	request.Version = string(apiVersion)
 	request.RegionId = client.RegionId
 	request.Product = product
 
 	if strings.ToUpper(product) == "SLB" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
 	}
 	if strings.ToUpper(product) == "ECS" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
 	}
 	if strings.ToUpper(product) == "ASCM" {
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ascm", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
+		request.QueryParams = map[string]string{"Product": "ascm", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": string(apiVersion)}
 	}
 
 	request.AppendUserAgent(Terraform, TerraformVersion)
@@ -682,8 +831,9 @@ func (client *AlibabacloudStackClient) NewCommonRequest(product, serviceCode, sc
 
 func (client *AlibabacloudStackClient) getSdkConfig() *sdk.Config {
 	log.Printf("Protocol is set to %s", client.Config.Protocol)
+	// The requests are retried by the retry engine of the client instead of the sdk
 	return sdk.NewConfig().
-		WithMaxRetryTime(DefaultClientRetryCountSmall).
+		WithAutoRetry(false).
 		WithTimeout(time.Duration(30) * time.Second).
 		WithEnableAsync(true).
 		WithGoRoutinePoolSize(100).
@@ -778,6 +928,9 @@ func (client *AlibabacloudStackClient) skipProxy(endpoint string) (bool, error)
 	return false, nil
 }
 func (client *AlibabacloudStackClient) WithKmsClient(do func(*kms.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the KMS client if necessary
 	if client.kmsconn == nil {
 
@@ -802,10 +955,15 @@ func (client *AlibabacloudStackClient) WithKmsClient(do func(*kms.Client) (inter
 		}
 		client.kmsconn = kmsconn
 	}
-	return do(client.kmsconn)
+	conn := client.kmsconn
+	return client.invoke("kms", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse, error) {
-
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	endpoint := client.Config.AscmEndpoint
 	if endpoint == "" {
 		return nil, fmt.Errorf("unable to initialize the ascm client: endpoint or domain is not provided for ascm service")
@@ -813,7 +971,13 @@ func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse,
 	if endpoint != "" {
 		endpoints.AddEndpointMapping(client.Config.RegionId, string(ASCMCode), endpoint)
 	}
-	ascmClient, err := sdk.NewClientWithAccessKey(client.Config.RegionId, client.Config.AccessKey, client.Config.SecretKey)
+	var ascmClient *sdk.Client
+	var err error
+	if client.credentials.SecurityToken != "" {
+		ascmClient, err = sdk.NewClientWithStsToken(client.Config.RegionId, client.credentials.AccessKey, client.credentials.SecretKey, client.credentials.SecurityToken)
+	} else {
+		ascmClient, err = sdk.NewClientWithAccessKey(client.Config.RegionId, client.credentials.AccessKey, client.credentials.SecretKey)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the ascm client: %#v", err)
 	}
@@ -844,8 +1008,7 @@ func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse,
 	request.Version = "2019-05-10" // Specify product version
 	request.ApiName = "GetUserInfo"
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  client.Config.SecretKey,
-		"SecurityToken":    client.Config.SecurityToken,
+		"SecurityToken":    client.credentials.SecurityToken,
 		"Product":          "ascm",
 		"Department":       client.Config.Department,
 		"ResourceGroup":    client.Config.ResourceGroup,
@@ -862,50 +1025,71 @@ func (client *AlibabacloudStackClient) GetCallerInfo() (*responses.BaseResponse,
 	}
 	return &resp, nil
 }
-func (client *AlibabacloudStackClient) GetCallerIdentity() (string, error) {
 
+// CallerUser is the ASCM user of the credentials of the provider.
+type CallerUser struct {
+	// PrimaryKey is the id of the account of the user
+	PrimaryKey  string   `json:"primaryKey"`
+	Id          int      `json:"id"`
+	LoginName   string   `json:"loginName"`
+	DisplayName string   `json:"displayName"`
+	RoleIdList  []string `json:"roleIdList"`
+	DefaultRole struct {
+		Id       int    `json:"id"`
+		RoleName string `json:"roleName"`
+	} `json:"defaultRole"`
+	Roles []struct {
+		Id       int    `json:"id"`
+		RoleName string `json:"roleName"`
+		Default  bool   `json:"default"`
+	} `json:"roles"`
+	Organization struct {
+		Id   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"organization"`
+}
+
+// GetCallerUser returns the ASCM user of the credentials of the provider.
+func (client *AlibabacloudStackClient) GetCallerUser() (*CallerUser, error) {
 	resp, err := client.GetCallerInfo()
-	response := &AccountId{}
-	err = json.Unmarshal(resp.GetHttpContentBytes(), response)
-	ownerId := response.Data.PrimaryKey
+	if err != nil {
+		return nil, err
+	}
+	response := &struct {
+		Data CallerUser `json:"data"`
+	}{}
+	if err := json.Unmarshal(resp.GetHttpContentBytes(), response); err != nil {
+		return nil, fmt.Errorf("parsing the caller info failed: %s", err)
+	}
+	return &response.Data, nil
+}
 
-	if ownerId == "" {
+func (client *AlibabacloudStackClient) GetCallerIdentity() (string, error) {
+	user, err := client.GetCallerUser()
+	if err != nil {
+		return "", err
+	}
+	if user.PrimaryKey == "" {
 		return "", fmt.Errorf("ownerId not found")
 	}
-	return ownerId, err
+	return user.PrimaryKey, nil
 }
 
 func (client *AlibabacloudStackClient) GetCallerDefaultRole() (int, error) {
-
-	resp, err := client.GetCallerInfo()
+	user, err := client.GetCallerUser()
 	if err != nil {
 		return 1, err
 	}
-	response := &RoleId{}
-	err = json.Unmarshal(resp.GetHttpContentBytes(), response)
-	roleId := response.Data.DefaultRole.Id
-
-	if roleId == 0 {
+	if user.DefaultRole.Id == 0 {
 		return 0, fmt.Errorf("default roleId not found")
 	}
-	return roleId, err
-}
-
-type AccountId struct {
-	Data struct {
-		PrimaryKey string `json:"primaryKey"`
-	} `json:"data"`
-}
-
-type RoleId struct {
-	Data struct {
-		DefaultRole struct {
-			Id int `json:"id"`
-		} `json:"defaultRole"`
-	} `json:"data"`
+	return user.DefaultRole.Id, nil
 }
 
 func (client *AlibabacloudStackClient) WithBssopenapiClient(do func(*bssopenapi.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the bssopenapi client if necessary
 	if client.bssopenapiconn == nil {
 		endpoint := client.Config.BssOpenApiEndpoint
@@ -930,10 +1114,13 @@ func (client *AlibabacloudStackClient) WithBssopenapiClient(do func(*bssopenapi.
 		client.bssopenapiconn = bssopenapiconn
 	}
 
-	return do(client.bssopenapiconn)
+	conn := client.bssopenapiconn
+	return client.invoke("bssopenapi", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
-func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewNasClient() (*RpcClient, error) {
 	productCode := "nas"
 	endpoint := client.Config.NasEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -945,7 +1132,57 @@ func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
+	}
+	return client.newRpcClient(productCode, conn), nil
+}
+
+func (client *AlibabacloudStackClient) NewEssClient() (*RpcClient, error) {
+	productCode := "ess"
+	endpoint := client.Config.EssEndpoint
+	if endpoint == "" {
+		if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
+			if err := client.loadEndpoint(productCode); err != nil {
+				return nil, err
+			}
+		}
+		if v, ok := client.Config.Endpoints[productCode]; ok && v.(string) != "" {
+			endpoint = v.(string)
+		}
+	}
+	if endpoint == "" {
+		return nil, client.Config.missingEndpointError(productCode)
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
+	}
+	return client.newRpcClient(productCode, conn), nil
+}
+
+func (client *AlibabacloudStackClient) NewKVStoreClient() (*RpcClient, error) {
+	productCode := "r-kvstore"
+	endpoint := client.Config.KVStoreEndpoint
+	if endpoint == "" {
+		if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
+			if err := client.loadEndpoint(productCode); err != nil {
+				return nil, err
+			}
+		}
+		if v, ok := client.Config.Endpoints[productCode]; ok && v.(string) != "" {
+			endpoint = v.(string)
+		}
+	}
+	if endpoint == "" {
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -953,10 +1190,38 @@ func (client *AlibabacloudStackClient) NewNasClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
+}
+
+func (client *AlibabacloudStackClient) NewDdsClient() (*RpcClient, error) {
+	productCode := "dds"
+	endpoint := client.Config.DdsEndpoint
+	if endpoint == "" {
+		if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
+			if err := client.loadEndpoint(productCode); err != nil {
+				return nil, err
+			}
+		}
+		if v, ok := client.Config.Endpoints[productCode]; ok && v.(string) != "" {
+			endpoint = v.(string)
+		}
+	}
+	if endpoint == "" {
+		return nil, client.Config.missingEndpointError(productCode)
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
+	}
+	return client.newRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -967,35 +1232,19 @@ func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Clien
 		if endpoint == "" {
 			return nil, fmt.Errorf("unable to initialize the oss client: endpoint or domain is not provided for OSS service")
 		}
-		if endpoint == "" {
-			endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
-			if endpointItem != nil {
-				if len(endpointItem.Protocols.Protocols) > 0 {
-					// HTTP or HTTPS
-					schma = strings.ToLower(endpointItem.Protocols.Protocols[0])
-					for _, p := range endpointItem.Protocols.Protocols {
-						if strings.ToLower(p) == "http" {
-							schma = strings.ToLower(p)
-							break
-						}
-					}
-				}
-				endpoint = endpointItem.Endpoint
-			}
-		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
 		}
 
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.Config.SecurityToken)}
+			oss.SecurityToken(client.credentials.SecurityToken)}
 		if client.Config.Proxy != "" {
 			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
 		}
 
 		clientOptions = append(clientOptions, oss.UseCname(false))
 
-		ossconn, err := oss.New(endpoint, client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
+		ossconn, err := oss.New(endpoint, client.credentials.AccessKey, client.credentials.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
 		}
@@ -1003,10 +1252,16 @@ func (client *AlibabacloudStackClient) WithOssClientPutObject(do func(*oss.Clien
 		client.ossconn = ossconn
 	}
 
-	return do(client.ossconn)
+	conn := client.ossconn
+	return client.invoke("oss", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1017,35 +1272,19 @@ func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (inter
 		if endpoint == "" {
 			return nil, fmt.Errorf("unable to initialize the oss client: endpoint or domain is not provided for OSS service")
 		}
-		if endpoint == "" {
-			endpointItem, _ := client.describeEndpointForService(strings.ToLower(string(OSSCode)))
-			if endpointItem != nil {
-				if len(endpointItem.Protocols.Protocols) > 0 {
-					// HTTP or HTTPS
-					schma = strings.ToLower(endpointItem.Protocols.Protocols[0])
-					for _, p := range endpointItem.Protocols.Protocols {
-						if strings.ToLower(p) == "http" {
-							schma = strings.ToLower(p)
-							break
-						}
-					}
-				}
-				endpoint = endpointItem.Endpoint
-			}
-		}
 		if !strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("%s://%s", schma, endpoint)
 		}
 
 		clientOptions := []oss.ClientOption{oss.UserAgent(client.getUserAgent()),
-			oss.SecurityToken(client.Config.SecurityToken)}
+			oss.SecurityToken(client.credentials.SecurityToken)}
 		if client.Config.Proxy != "" {
 			clientOptions = append(clientOptions, oss.Proxy(client.Config.Proxy))
 		}
 
 		clientOptions = append(clientOptions, oss.UseCname(false))
 
-		ossconn, err := oss.New(endpoint, client.Config.AccessKey, client.Config.SecretKey, clientOptions...)
+		ossconn, err := oss.New(endpoint, client.credentials.AccessKey, client.credentials.SecretKey, clientOptions...)
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the OSS client: %#v", err)
 		}
@@ -1053,10 +1292,16 @@ func (client *AlibabacloudStackClient) WithOssClient(do func(*oss.Client) (inter
 		client.ossconn = ossconn
 	}
 
-	return do(client.ossconn)
+	conn := client.ossconn
+	return client.invoke("oss", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRamClient(do func(*ram.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the RAM client if necessary
 	if client.ramconn == nil {
 		endpoint := client.Config.RamEndpoint
@@ -1084,10 +1329,16 @@ func (client *AlibabacloudStackClient) WithRamClient(do func(*ram.Client) (inter
 		client.ramconn = ramconn
 	}
 
-	return do(client.ramconn)
+	conn := client.ramconn
+	return client.invoke("ram", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithRdsClient(do func(*rds.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the RDS client if necessary
 	if client.rdsconn == nil {
 		endpoint := client.Config.RdsEndpoint
@@ -1114,10 +1365,16 @@ func (client *AlibabacloudStackClient) WithRdsClient(do func(*rds.Client) (inter
 		client.rdsconn = rdsconn
 	}
 
-	return do(client.rdsconn)
+	conn := client.rdsconn
+	return client.invoke("rds", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCdnClient_new(do func(*cdn_new.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the CDN client if necessary
 	if client.cdnconn_new == nil {
 		endpoint := client.Config.CdnEndpoint
@@ -1142,18 +1399,24 @@ func (client *AlibabacloudStackClient) WithCdnClient_new(do func(*cdn_new.Client
 		client.cdnconn_new = cdnconn
 	}
 
-	return do(client.cdnconn_new)
+	conn := client.cdnconn_new
+	return client.invoke("cdn", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) getUserAgent() string {
 	return fmt.Sprintf("%s/%s %s/%s %s/%s", Terraform, TerraformVersion, Provider, ProviderVersion, Module, client.Config.ConfigurationSource)
 }
 func (client *AlibabacloudStackClient) WithCsClient(do func(*cs.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
 	// Initialize the CS client if necessary
 	if client.csconn == nil {
-		csconn := cs.NewClientForAussumeRole(client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
+		csconn := cs.NewClientForAussumeRole(client.credentials.AccessKey, client.credentials.SecretKey, client.credentials.SecurityToken)
 		csconn.SetUserAgent(client.getUserAgent())
 		endpoint := client.Config.CsEndpoint
 		if endpoint == "" {
@@ -1176,7 +1439,10 @@ func (client *AlibabacloudStackClient) WithCsClient(do func(*cs.Client) (interfa
 		client.csconn = csconn
 	}
 
-	return do(client.csconn)
+	conn := client.csconn
+	return client.invoke("cs", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) getHttpProxyUrl() *url.URL {
@@ -1198,7 +1464,7 @@ func (client *AlibabacloudStackClient) getHttpProxyUrl() *url.URL {
 
 func (client *AlibabacloudStackClient) WithOssBucketByName(bucketName string, do func(*oss.Bucket) (interface{}, error)) (interface{}, error) {
 	return client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
-		bucket, err := client.ossconn.Bucket(bucketName)
+		bucket, err := ossClient.Bucket(bucketName)
 
 		if err != nil {
 			return nil, fmt.Errorf("unable to get the bucket %s: %#v", bucketName, err)
@@ -1208,6 +1474,9 @@ func (client *AlibabacloudStackClient) WithOssBucketByName(bucketName string, do
 }
 
 func (client *AlibabacloudStackClient) WithOnsClient(do func(*ons.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the ons client if necessary
 	if client.onsconn == nil {
 		endpoint := client.Config.OnsEndpoint
@@ -1220,7 +1489,13 @@ func (client *AlibabacloudStackClient) WithOnsClient(do func(*ons.Client) (inter
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = strings.TrimPrefix(strings.TrimPrefix(endpoint, "http://"), "https://")
 		}
-		onsconn, err := ons.NewClientWithAccessKey(client.RegionId, client.AccessKey, client.SecretKey)
+		var onsconn *ons.Client
+		var err error
+		if client.credentials.SecurityToken != "" {
+			onsconn, err = ons.NewClientWithStsToken(client.RegionId, client.credentials.AccessKey, client.credentials.SecretKey, client.credentials.SecurityToken)
+		} else {
+			onsconn, err = ons.NewClientWithAccessKey(client.RegionId, client.credentials.AccessKey, client.credentials.SecretKey)
+		}
 		if err != nil {
 			return nil, fmt.Errorf("unable to initialize the ONS client: %#v", err)
 		}
@@ -1237,10 +1512,16 @@ func (client *AlibabacloudStackClient) WithOnsClient(do func(*ons.Client) (inter
 		client.onsconn = onsconn
 	}
 
-	return do(client.onsconn)
+	conn := client.onsconn
+	return client.invoke("ons", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithLogClient(do func(*sls.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1260,14 +1541,20 @@ func (client *AlibabacloudStackClient) WithLogClient(do func(*sls.Client) (inter
 			AccessKeyID:     client.Config.OrganizationAccessKey,
 			AccessKeySecret: client.Config.OrganizationSecretKey,
 			Endpoint:        client.Config.SLSOpenAPIEndpoint,
-			SecurityToken:   client.Config.SecurityToken,
+			SecurityToken:   client.credentials.SecurityToken,
 			UserAgent:       client.getUserAgent(),
 		}
 	}
 
-	return do(client.logconn)
+	conn := client.logconn
+	return client.invoke("sls", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithLogPopClient(do func(*slsPop.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the HBase client if necessary
 	if client.logpopconn == nil {
 		endpoint := client.Config.LogEndpoint
@@ -1289,15 +1576,21 @@ func (client *AlibabacloudStackClient) WithLogPopClient(do func(*slsPop.Client)
 		client.logpopconn = logpopconn
 	}
 
-	return do(client.logpopconn)
+	conn := client.logpopconn
+	return client.invoke("sls", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithAlikafkaClient(do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the alikafka client if necessary
 	if client.alikafkaconn == nil {
 		endpoint := client.Config.AlikafkaEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.Config.RegionId, ALIKAFKACode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(ALIKAFKACode)))
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.Config.RegionId, string(ALIKAFKACode), endpoint)
@@ -1316,15 +1609,21 @@ func (client *AlibabacloudStackClient) WithAlikafkaClient(do func(*alikafka.Clie
 		client.alikafkaconn = alikafkaconn
 	}
 
-	return do(client.alikafkaconn)
+	conn := client.alikafkaconn
+	return client.invoke("alikafka", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithEdasClient(do func(*edas.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the edas client if necessary
 	if client.edasconn == nil {
 		endpoint := client.Config.EdasEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.Config.RegionId, EDASCode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(EDASCode)))
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.Config.RegionId, string(EDASCode), endpoint)
@@ -1347,10 +1646,16 @@ func (client *AlibabacloudStackClient) WithEdasClient(do func(*edas.Client) (int
 		client.edasconn = edasconn
 	}
 
-	return do(client.edasconn)
+	conn := client.edasconn
+	return client.invoke("edas", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCrEEClient(do func(*cr_ee.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the CR EE client if necessary
 	if client.creeconn == nil {
 		endpoint := client.Config.CrEndpoint
@@ -1373,10 +1678,16 @@ func (client *AlibabacloudStackClient) WithCrEEClient(do func(*cr_ee.Client) (in
 		client.creeconn = creeconn
 	}
 
-	return do(client.creeconn)
+	conn := client.creeconn
+	return client.invoke("cr-ee", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
 func (client *AlibabacloudStackClient) WithCrClient(do func(*cr.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the CR client if necessary
 	if client.crconn == nil {
 		endpoint := client.Config.CrEndpoint
@@ -1402,9 +1713,15 @@ func (client *AlibabacloudStackClient) WithCrClient(do func(*cr.Client) (interfa
 		client.crconn = crconn
 	}
 
-	return do(client.crconn)
+	conn := client.crconn
+	return client.invoke("cr", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDnsClient(do func(*alidns.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the DNS client if necessary
 	if client.dnsconn == nil {
 		endpoint := client.Config.DnsEndpoint
@@ -1426,14 +1743,20 @@ func (client *AlibabacloudStackClient) WithDnsClient(do func(*alidns.Client) (in
 		client.dnsconn = dnsconn
 	}
 
-	return do(client.dnsconn)
+	conn := client.dnsconn
+	return client.invoke("dns", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithCmsClient(do func(*cms.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the CMS client if necessary
 	if client.cmsconn == nil {
 		endpoint := client.Config.CmsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.Config.RegionId, CMSCode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(CMSCode)))
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.Config.RegionId, string(CMSCode), endpoint)
@@ -1452,9 +1775,15 @@ func (client *AlibabacloudStackClient) WithCmsClient(do func(*cms.Client) (inter
 		}
 	}
 
-	return do(client.cmsconn)
+	conn := client.cmsconn
+	return client.invoke("cms", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithMaxComputeClient(do func(*maxcompute.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1462,7 +1791,7 @@ func (client *AlibabacloudStackClient) WithMaxComputeClient(do func(*maxcompute.
 	if client.maxcomputeconn == nil {
 		endpoint := client.Config.MaxComputeEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.Config.RegionId, MAXCOMPUTECode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(MAXCOMPUTECode)))
 		}
 		if strings.HasPrefix(endpoint, "http") {
 			endpoint = fmt.Sprintf("https://%s", strings.TrimPrefix(endpoint, "http://"))
@@ -1485,10 +1814,13 @@ func (client *AlibabacloudStackClient) WithMaxComputeClient(do func(*maxcompute.
 		client.maxcomputeconn = maxcomputeconn
 	}
 
-	return do(client.maxcomputeconn)
+	conn := client.maxcomputeconn
+	return client.invoke("odps", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 
-func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewHitsdbClient() (*RpcClient, error) {
 	productCode := "hitsdb"
 	endpoint := client.Config.HitsdbEndpoint
 	if endpoint == "" {
@@ -1502,7 +1834,7 @@ func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
 		}
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1510,10 +1842,10 @@ func (client *AlibabacloudStackClient) NewHitsdbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewOdpsClient() (*RpcClient, error) {
 	productCode := "odps"
 	endpoint := client.Config.MaxComputeEndpoint
 	if endpoint == "" {
@@ -1527,7 +1859,7 @@ func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
 		}
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1535,9 +1867,9 @@ func (client *AlibabacloudStackClient) NewOdpsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewKmsClient() (*RpcClient, error) {
 	productCode := "kms"
 	endpoint := client.Config.KmsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1551,7 +1883,7 @@ func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1559,10 +1891,10 @@ func (client *AlibabacloudStackClient) NewKmsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewAscmClient() (*RpcClient, error) {
 	productCode := "ascm"
 	endpoint := client.Config.AscmEndpoint
 	if endpoint == "" {
@@ -1577,7 +1909,7 @@ func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
 			endpoint = v.(string)
 		}
 		if endpoint == "" {
-			return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+			return nil, client.Config.missingEndpointError(productCode)
 		}
 	}
 	sdkConfig := client.teaSdkConfig
@@ -1586,9 +1918,9 @@ func (client *AlibabacloudStackClient) NewAscmClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCloudApiClient() (*RpcClient, error) {
 	productCode := "apigateway"
 	endpoint := client.Config.ApigatewayEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1600,7 +1932,7 @@ func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error)
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 
 	sdkConfig := client.teaSdkConfig
@@ -1611,9 +1943,9 @@ func (client *AlibabacloudStackClient) NewCloudApiClient() (*rpc.Client, error)
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewAdsClient() (*RpcClient, error) {
 	productCode := "ads"
 	endpoint := client.Config.AdbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1625,7 +1957,7 @@ func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1633,9 +1965,9 @@ func (client *AlibabacloudStackClient) NewAdsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*RpcClient, error) {
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
 
@@ -1644,9 +1976,12 @@ func (client *AlibabacloudStackClient) NewTeaCommonClient(endpoint string) (*rpc
 		return nil, fmt.Errorf("unable to initialize the tea client: %#v", err)
 	}
 
-	return conn, nil
+	return client.newRpcClient("tea", conn), nil
 }
 func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string, do func(*tablestore.TableStoreClient) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1655,7 +1990,7 @@ func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string,
 	if !ok {
 		endpoint := client.Config.OtsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, OTSCode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(OTSCode)))
 		}
 		if endpoint == "" {
 			endpoint = fmt.Sprintf("%s.%s.ots.aliyuncs.com", instanceName, client.RegionId)
@@ -1664,18 +1999,23 @@ func (client *AlibabacloudStackClient) WithTableStoreClient(instanceName string,
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
 
-		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken, tablestore.NewDefaultTableStoreConfig())
+		tableStoreClient = tablestore.NewClientWithConfig(endpoint, instanceName, client.credentials.AccessKey, client.credentials.SecretKey, client.credentials.SecurityToken, tablestore.NewDefaultTableStoreConfig())
 		client.tablestoreconnByInstanceName[instanceName] = tableStoreClient
 	}
 
-	return do(tableStoreClient)
+	return client.invoke("ots", func() (interface{}, error) {
+		return do(tableStoreClient)
+	})
 }
 func (client *AlibabacloudStackClient) WithOtsClient(do func(*ots.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the OTS client if necessary
 	if client.otsconn == nil {
 		endpoint := client.Config.OtsEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.Config.RegionId, OTSCode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(OTSCode)))
 		}
 		if endpoint != "" {
 			endpoints.AddEndpointMapping(client.Config.RegionId, string(OTSCode), endpoint)
@@ -1695,9 +2035,15 @@ func (client *AlibabacloudStackClient) WithOtsClient(do func(*ots.Client) (inter
 		client.otsconn = otsconn
 	}
 
-	return do(client.otsconn)
+	conn := client.otsconn
+	return client.invoke("ots", func() (interface{}, error) {
+		return do(conn)
+	})
 }
 func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.DataHubApi) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	goSdkMutex.Lock()
 	defer goSdkMutex.Unlock()
 
@@ -1705,7 +2051,7 @@ func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.Dat
 	if client.dhconn == nil {
 		endpoint := client.Config.DatahubEndpoint
 		if endpoint == "" {
-			endpoint = loadEndpoint(client.RegionId, DATAHUBCode)
+			endpoint = client.Config.resolveEndpoint(strings.ToLower(string(DATAHUBCode)))
 		}
 		if endpoint == "" {
 			if client.RegionId == string(APSouthEast1) {
@@ -1718,7 +2064,7 @@ func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.Dat
 			endpoint = fmt.Sprintf("https://%s", endpoint)
 		}
 
-		account := datahub.NewStsCredential(client.Config.AccessKey, client.Config.SecretKey, client.Config.SecurityToken)
+		account := datahub.NewStsCredential(client.credentials.AccessKey, client.credentials.SecretKey, client.credentials.SecurityToken)
 		config := &datahub.Config{
 			UserAgent: client.getUserAgent(),
 		}
@@ -1726,9 +2072,12 @@ func (client *AlibabacloudStackClient) WithDataHubClient(do func(api datahub.Dat
 		client.dhconn = datahub.NewClientWithConfig(endpoint, config, account)
 	}
 
-	return do(client.dhconn)
+	conn := client.dhconn
+	return client.invoke("datahub", func() (interface{}, error) {
+		return do(conn)
+	})
 }
-func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewVpcClient() (*RpcClient, error) {
 	productCode := "vpc"
 	endpoint := client.Config.VpcEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1740,7 +2089,7 @@ func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 
 	sdkConfig := client.teaSdkConfig
@@ -1751,9 +2100,9 @@ func (client *AlibabacloudStackClient) NewVpcClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewEcsClient() (*RpcClient, error) {
 	productCode := "ecs"
 	endpoint := client.Config.EcsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1765,7 +2114,7 @@ func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 
 	sdkConfig := client.teaSdkConfig
@@ -1776,9 +2125,9 @@ func (client *AlibabacloudStackClient) NewEcsClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewElasticsearchClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewElasticsearchClient() (*RpcClient, error) {
 	productCode := "elasticsearch"
 	endpoint := client.Config.ElasticsearchEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1799,10 +2148,10 @@ func (client *AlibabacloudStackClient) NewElasticsearchClient() (*rpc.Client, er
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, err
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewRosClient() (*RpcClient, error) {
 	productCode := "ros"
 	endpoint := client.Config.RosEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1814,7 +2163,7 @@ func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1822,12 +2171,12 @@ func (client *AlibabacloudStackClient) NewRosClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewRdsClient() (*RpcClient, error) {
 	productCode := "rds"
-	endpoint := ""
+	endpoint := client.Config.RdsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
 			return nil, err
@@ -1837,7 +2186,7 @@ func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1845,25 +2194,27 @@ func (client *AlibabacloudStackClient) NewRdsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) NewRoaCsClient() (*roaCS.Client, error) {
 	productCode := "ros"
 	endpoint := client.Config.CsEndpoint
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
+	}
+	// The CS client is created for every operation, it signs its requests with the credentials of the provider when it is created
+	credential, err := newTeaCredential(client.Config)
+	if err != nil {
+		return nil, err
 	}
-	// Initialize the CS client if necessary
 	roaCSConn, err := roaCS.NewClient(&openapi.Config{
-		AccessKeyId:     tea.String(client.Config.AccessKey),
-		AccessKeySecret: tea.String(client.Config.SecretKey),
-		SecurityToken:   tea.String(client.Config.SecurityToken),
-		RegionId:        tea.String(client.Config.RegionId),
-		UserAgent:       tea.String(client.getUserAgent()),
-		Endpoint:        tea.String(endpoint),
-		ReadTimeout:     tea.Int(client.Config.ClientReadTimeout),
-		ConnectTimeout:  tea.Int(client.Config.ClientConnectTimeout),
+		Credential:     credential,
+		RegionId:       tea.String(client.Config.RegionId),
+		UserAgent:      tea.String(client.getUserAgent()),
+		Endpoint:       tea.String(endpoint),
+		ReadTimeout:    tea.Int(client.Config.ClientReadTimeout),
+		ConnectTimeout: tea.Int(client.Config.ClientConnectTimeout),
 	})
 	if err != nil {
 		return nil, err
@@ -1872,7 +2223,7 @@ func (client *AlibabacloudStackClient) NewRoaCsClient() (*roaCS.Client, error) {
 	return roaCSConn, nil
 }
 
-func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDtsClient() (*RpcClient, error) {
 	productCode := "dts"
 	endpoint := client.Config.DtsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1886,7 +2237,7 @@ func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1894,10 +2245,10 @@ func (client *AlibabacloudStackClient) NewDtsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*RpcClient, error) {
 	productCode := "dmsenterprise"
 	endpoint := client.Config.DmsEnterpriseEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -1911,7 +2262,7 @@ func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, er
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1919,12 +2270,12 @@ func (client *AlibabacloudStackClient) NewDmsenterpriseClient() (*rpc.Client, er
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewHbaseClient() (*RpcClient, error) {
 	productCode := "hbase"
-	endpoint := ""
+	endpoint := client.Config.HBaseEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
 		if err := client.loadEndpoint(productCode); err != nil {
 			return nil, err
@@ -1934,7 +2285,7 @@ func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -1942,10 +2293,13 @@ func (client *AlibabacloudStackClient) NewHbaseClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
 func (client *AlibabacloudStackClient) WithDrdsClient(do func(*drds.Client) (interface{}, error)) (interface{}, error) {
+	if err := client.renewConns(); err != nil {
+		return nil, err
+	}
 	// Initialize the DRDS client if necessary
 	if client.drdsconn == nil {
 		endpoint := client.Config.DrdsEndpoint
@@ -1977,9 +2331,12 @@ func (client *AlibabacloudStackClient) WithDrdsClient(do func(*drds.Client) (int
 		client.drdsconn = drdsconn
 	}
 
-	return do(client.drdsconn)
+	conn := client.drdsconn
+	return client.invoke("drds", func() (interface{}, error) {
+		return do(conn)
+	})
 }
-func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewGpdbClient() (*RpcClient, error) {
 	productCode := "gpdb"
 	endpoint := client.Config.GpdbEndpoint
 
@@ -1992,7 +2349,7 @@ func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 
 	sdkConfig := client.teaSdkConfig
@@ -2003,10 +2360,10 @@ func (client *AlibabacloudStackClient) NewGpdbClient() (*rpc.Client, error) {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
 
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewQuickbiClient() (*RpcClient, error) {
 	productCode := "quickbi"
 	endpoint := client.Config.QuickbiEndpoint
 	//endpoint := "quickbi-public.inter.env202.shuguang.com"
@@ -2021,7 +2378,7 @@ func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2029,9 +2386,9 @@ func (client *AlibabacloudStackClient) NewQuickbiClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCsbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCsbClient() (*RpcClient, error) {
 	productCode := "csb"
 	endpoint := client.Config.CsbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2045,7 +2402,7 @@ func (client *AlibabacloudStackClient) NewCsbClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2053,9 +2410,9 @@ func (client *AlibabacloudStackClient) NewCsbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewGdbClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewGdbClient() (*RpcClient, error) {
 	productCode := "gdb"
 	endpoint := client.Config.GdbEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2069,7 +2426,7 @@ func (client *AlibabacloudStackClient) NewGdbClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2077,9 +2434,9 @@ func (client *AlibabacloudStackClient) NewGdbClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*RpcClient, error) {
 	productCode := "dataworkspublic"
 	endpoint := client.Config.DataworkspublicEndpoint
 	//endpoint := "dataworks-public.cloud.ste3.com"
@@ -2094,7 +2451,7 @@ func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client,
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2102,10 +2459,10 @@ func (client *AlibabacloudStackClient) NewDataworkspublicClient() (*rpc.Client,
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*RpcClient, error) {
 	productCode := "dataworks-private-cloud"
 	endpoint := client.Config.DataworkspublicEndpoint
 	//endpoint := "dataworks.inter.env66.shuguang.com"
@@ -2120,7 +2477,7 @@ func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client,
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	index := strings.Index(endpoint, ".")
@@ -2130,10 +2487,10 @@ func (client *AlibabacloudStackClient) NewDataworksPrivateClient() (*rpc.Client,
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewDbsClient() (*RpcClient, error) {
 	productCode := "dbs"
 	endpoint := client.Config.DbsEndpoint
 	//endpoint := "dbs.inter.env66.shuguang.com"
@@ -2148,7 +2505,7 @@ func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2156,9 +2513,9 @@ func (client *AlibabacloudStackClient) NewDbsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewArmsClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewArmsClient() (*RpcClient, error) {
 	productCode := "arms"
 	endpoint := client.Config.ArmsEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2172,7 +2529,7 @@ func (client *AlibabacloudStackClient) NewArmsClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2180,10 +2537,10 @@ func (client *AlibabacloudStackClient) NewArmsClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
 
-func (client *AlibabacloudStackClient) NewOosClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewOosClient() (*RpcClient, error) {
 	productCode := "oos"
 	endpoint := client.Config.OosEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2195,7 +2552,35 @@ func (client *AlibabacloudStackClient) NewOosClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
+	}
+	sdkConfig := client.teaSdkConfig
+	sdkConfig.SetEndpoint(endpoint)
+	conn, err := rpc.NewClient(&sdkConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
+	}
+	return client.newRpcClient(productCode, conn), nil
+}
+func (client *AlibabacloudStackClient) NewCbnClient() (*RpcClient, error) {
+	productCode := "cbn"
+	endpoint := client.Config.CbnEndpoint
+	if endpoint == "" {
+		// cen is the former key of the cbn endpoint
+		endpoint = client.Config.CenEndpoint
+	}
+	if endpoint == "" {
+		if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
+			if err := client.loadEndpoint(productCode); err != nil {
+				return nil, err
+			}
+		}
+		if v, ok := client.Config.Endpoints[productCode]; ok && v.(string) != "" {
+			endpoint = v.(string)
+		}
+	}
+	if endpoint == "" {
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2203,9 +2588,9 @@ func (client *AlibabacloudStackClient) NewOosClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
-func (client *AlibabacloudStackClient) NewCloudfwClient() (*rpc.Client, error) {
+func (client *AlibabacloudStackClient) NewCloudfwClient() (*RpcClient, error) {
 	productCode := "cloudfw"
 	endpoint := client.Config.CloudfwEndpoint
 	if v, ok := client.Config.Endpoints[productCode]; !ok || v.(string) == "" {
@@ -2217,7 +2602,7 @@ func (client *AlibabacloudStackClient) NewCloudfwClient() (*rpc.Client, error) {
 		endpoint = v.(string)
 	}
 	if endpoint == "" {
-		return nil, fmt.Errorf("[ERROR] missing the product %s endpoint.", productCode)
+		return nil, client.Config.missingEndpointError(productCode)
 	}
 	sdkConfig := client.teaSdkConfig
 	sdkConfig.SetEndpoint(endpoint)
@@ -2225,5 +2610,5 @@ func (client *AlibabacloudStackClient) NewCloudfwClient() (*rpc.Client, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to initialize the %s client: %#v", productCode, err)
 	}
-	return conn, nil
+	return client.newRpcClient(productCode, conn), nil
 }
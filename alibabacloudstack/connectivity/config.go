@@ -4,17 +4,11 @@ import (
 	"fmt"
 	"log"
 
-	"encoding/json"
-	"net/http"
 	"strings"
 
 	rpc "github.com/alibabacloud-go/tea-rpc/client"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/auth/credentials"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
-	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
-	credential "github.com/aliyun/credentials-go/credentials"
-	"github.com/jmespath/go-jmespath"
 )
 
 var securityCredURL = "http://100.100.100.200/latest/meta-data/ram/security-credentials/"
@@ -42,6 +36,45 @@ type Config struct {
 	RamRolePolicy            string
 	RamRoleSessionExpiration int
 
+	// The RAM role assumed with the OIDC token of a kubernetes service account, see credentials.go
+	OIDCProviderArn       string
+	OIDCRoleArn           string
+	OIDCTokenFile         string
+	OIDCSessionName       string
+	OIDCSessionExpiration int
+	// The profile of the config.json of the Alibaba Cloud CLI, see profile.go
+	Profile     string
+	ProfileFile string
+	// CredentialsSource is the source of the chain the credentials were resolved from, and ResolvedProfile the
+	// profile when it is the profile one
+	CredentialsSource string
+	ResolvedProfile   *ResolvedProfile
+	// credentials are the current credentials of the provider, resolved by LoadCredentials
+	credentials *credentialsCache
+
+	// DefaultTags are merged into the tags of every taggable resource
+	DefaultTags map[string]string
+	// The tags matching IgnoreTagsKeys or IgnoreTagsKeyPrefixes are neither read nor managed
+	IgnoreTagsKeys        []string
+	IgnoreTagsKeyPrefixes []string
+
+	// DepartmentIds and ResourceGroupIds map the names of the provider name_mapping to their ids,
+	// ResourceGroupDepartments maps the id of a resource group to the id of its department
+	DepartmentIds            map[string]string
+	ResourceGroupIds         map[string]string
+	ResourceGroupDepartments map[string]string
+
+	// MaxRetries and MaxRetryTimeout, in seconds, bound the retries of the throttled and failed requests
+	MaxRetries      int
+	MaxRetryTimeout int
+
+	// EndpointTemplate is the endpoint of the products which have none, with the {product} and {region} placeholders
+	EndpointTemplate string
+	// endpointCatalog maps the product codes to the endpoints listed by the location service
+	endpointCatalog map[string]string
+	// endpointCatalogErr is the error the catalog could not be listed through the ascm endpoint with
+	endpointCatalogErr error
+
 	Endpoints               map[string]interface{}
 	EcsEndpoint             string
 	RdsEndpoint             string
@@ -143,114 +176,26 @@ func (c *Config) validateRegion() error {
 	return fmt.Errorf("Invalid AlibabacloudStack Cloud region: %s", c.RegionId)
 }
 
+// getAuthCredential returns the current credentials of the provider for the sdk clients, which sign their requests
+// with the ones they were created with, so they are renewed when the credentials are refreshed.
 func (c *Config) getAuthCredential(stsSupported bool) auth.Credential {
-	if c.AccessKey != "" && c.SecretKey != "" {
-		if stsSupported && c.SecurityToken != "" {
-			return credentials.NewStsTokenCredential(c.AccessKey, c.SecretKey, c.SecurityToken)
-		}
-		if c.RamRoleArn != "" {
-			log.Printf("[INFO] Assume RAM Role specified in provider block assume_role { ... }")
-			return credentials.NewRamRoleArnWithPolicyCredential(
-				c.AccessKey, c.SecretKey, c.RamRoleArn,
-				c.RamRoleSessionName, c.RamRolePolicy, c.RamRoleSessionExpiration)
-		}
-		return credentials.NewAccessKeyCredential(c.AccessKey, c.SecretKey)
-	}
-	if c.EcsRoleName != "" {
-		return credentials.NewEcsRamRoleCredential(c.EcsRoleName)
-	}
-
-	return credentials.NewAccessKeyCredential(c.AccessKey, c.SecretKey)
-}
-
-// getAuthCredentialByEcsRoleName aims to access meta to get sts credential
-// Actually, the job should be done by sdk, but currently not all resources and products support alibaba-cloud-sdk-go,
-// and their go sdk does support ecs role name.
-// This method is a temporary solution and it should be removed after all go sdk support ecs role name
-
-func (c *Config) getAuthCredentialByEcsRoleName() (accessKey, secretKey, token string, err error) {
-	if c.AccessKey != "" {
-		return c.AccessKey, c.SecretKey, c.SecurityToken, nil
-	}
-	if c.EcsRoleName == "" {
-		return
-	}
-	requestUrl := securityCredURL + c.EcsRoleName
-	httpRequest, err := http.NewRequest(requests.GET, requestUrl, strings.NewReader(""))
+	credential, err := c.Credentials()
 	if err != nil {
-		err = fmt.Errorf("build sts requests err: %s", err.Error())
-		return
+		log.Printf("[ERROR] Getting the credentials of the provider failed: %s", err)
 	}
-	httpClient := &http.Client{}
-	httpResponse, err := httpClient.Do(httpRequest)
-	if err != nil {
-		err = fmt.Errorf("get Ecs sts token err : %s", err.Error())
-		return
+	if stsSupported && credential.SecurityToken != "" {
+		return credentials.NewStsTokenCredential(credential.AccessKey, credential.SecretKey, credential.SecurityToken)
 	}
-
-	response := responses.NewCommonResponse()
-	err = responses.Unmarshal(response, httpResponse, "")
-	if err != nil {
-		err = fmt.Errorf("Unmarshal Ecs sts token response err : %s", err.Error())
-		return
-	}
-
-	if response.GetHttpStatus() != http.StatusOK {
-		err = fmt.Errorf("get Ecs sts token err, httpStatus: %d, message = %s", response.GetHttpStatus(), response.GetHttpContentString())
-		return
-	}
-	var data interface{}
-	err = json.Unmarshal(response.GetHttpContentBytes(), &data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, json.Unmarshal fail: %s", err.Error())
-		return
-	}
-	code, err := jmespath.Search("Code", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get Code: %s", err.Error())
-		return
-	}
-	if code.(string) != "Success" {
-		err = fmt.Errorf("refresh Ecs sts token err, Code is not Success")
-		return
-	}
-	accessKeyId, err := jmespath.Search("AccessKeyId", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get AccessKeyId: %s", err.Error())
-		return
-	}
-	accessKeySecret, err := jmespath.Search("AccessKeySecret", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get AccessKeySecret: %s", err.Error())
-		return
-	}
-	securityToken, err := jmespath.Search("SecurityToken", data)
-	if err != nil {
-		err = fmt.Errorf("refresh Ecs sts token err, fail to get SecurityToken: %s", err.Error())
-		return
-	}
-
-	if accessKeyId == nil || accessKeySecret == nil || securityToken == nil {
-		err = fmt.Errorf("there is no any available accesskey, secret and security token for Ecs role %s", c.EcsRoleName)
-		return
-	}
-
-	return accessKeyId.(string), accessKeySecret.(string), securityToken.(string), nil
-}
-
-func (c *Config) MakeConfigByEcsRoleName() error {
-	accessKey, secretKey, token, err := c.getAuthCredentialByEcsRoleName()
-	if err != nil {
-		return err
-	}
-	c.AccessKey, c.SecretKey, c.SecurityToken = accessKey, secretKey, token
-	return nil
+	return credentials.NewAccessKeyCredential(credential.AccessKey, credential.SecretKey)
 }
 
 func (c *Config) getTeaDslSdkConfig(stsSupported bool) (config rpc.Config, err error) {
 	config.SetRegionId(c.RegionId)
 	config.SetUserAgent(fmt.Sprintf("%s/%s %s/%s %s/%s", Terraform, terraformVersion, Provider, providerVersion, Module, c.ConfigurationSource))
-	credential, err := credential.NewCredential(c.getCredentialConfig(stsSupported))
+	credential, err := newTeaCredential(c)
+	if err != nil {
+		return config, err
+	}
 	config.SetCredential(credential).
 		SetRegionId(c.RegionId).
 		SetProtocol(c.Protocol).
@@ -276,31 +221,3 @@ func (c *Config) getTeaDslSdkConfig(stsSupported bool) (config rpc.Config, err e
 
 	return
 }
-
-func (c *Config) getCredentialConfig(stsSupported bool) *credential.Config {
-	credentialType := ""
-	credentialConfig := &credential.Config{}
-	if c.AccessKey != "" && c.SecretKey != "" {
-		credentialType = "access_key"
-		credentialConfig.AccessKeyId = &c.AccessKey     // AccessKeyId
-		credentialConfig.AccessKeySecret = &c.SecretKey // AccessKeySecret
-
-		if stsSupported && c.SecurityToken != "" {
-			credentialType = "sts"
-			credentialConfig.SecurityToken = &c.SecurityToken // STS Token
-		} else if c.RamRoleArn != "" {
-			log.Printf("[INFO] Assume RAM Role specified in provider block assume_role { ... }")
-			credentialType = "ram_role_arn"
-			credentialConfig.RoleArn = &c.RamRoleArn
-			credentialConfig.RoleSessionName = &c.RamRoleSessionName
-			credentialConfig.RoleSessionExpiration = &c.RamRoleSessionExpiration
-			credentialConfig.Policy = &c.RamRolePolicy
-		}
-	} else if c.EcsRoleName != "" {
-		credentialType = "ecs_ram_role"
-		credentialConfig.RoleName = &c.EcsRoleName
-	}
-
-	credentialConfig.Type = &credentialType
-	return credentialConfig
-}
@@ -0,0 +1,479 @@
+package connectivity
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
+	"github.com/google/uuid"
+)
+
+// The credentials of the provider are resolved by a chain of sources, the first source which has credentials
+// configured is used: the access key of the provider block or its environment variables, the profile of the
+// config.json of the Alibaba Cloud CLI, the ALIBABA_CLOUD_* environment variables, the RAM role of the ECS instance the provider runs on, and the OIDC
+// token of the RAM role of the service account of the ACK or EDAS pod the provider runs in. The role of the
+// provider assume_role is then assumed with the resolved credentials. The temporary credentials are refreshed
+// before they expire, so that an apply which outlasts them, e.g. the creation of a kubernetes cluster, goes on
+// with the new ones: the tea rpc clients read the current credentials at every request, and the other clients
+// are created again with them.
+
+// credentialsRefreshWindow is how long before they expire the temporary credentials are refreshed.
+const credentialsRefreshWindow = 5 * time.Minute
+
+const (
+	EnvAccessKeyId     = "ALIBABA_CLOUD_ACCESS_KEY_ID"
+	EnvAccessKeySecret = "ALIBABA_CLOUD_ACCESS_KEY_SECRET"
+	EnvSecurityToken   = "ALIBABA_CLOUD_SECURITY_TOKEN"
+	EnvOIDCProviderArn = "ALIBABA_CLOUD_OIDC_PROVIDER_ARN"
+	EnvOIDCTokenFile   = "ALIBABA_CLOUD_OIDC_TOKEN_FILE"
+	EnvRoleArn         = "ALIBABA_CLOUD_ROLE_ARN"
+	EnvRoleSessionName = "ALIBABA_CLOUD_ROLE_SESSION_NAME"
+	defaultSessionName = "terraform"
+	stsApiVersion      = "2015-04-01"
+	stsTimeFormat      = "2006-01-02T15:04:05Z"
+)
+
+// Credentials are the access key, and the security token of temporary credentials, the requests are signed with.
+type Credentials struct {
+	AccessKey     string
+	SecretKey     string
+	SecurityToken string
+	// Expiration is when the temporary credentials expire, it is zero for the ones which do not
+	Expiration time.Time
+	// Source is the source of the chain the credentials come from
+	Source string
+}
+
+func (c Credentials) expiring() bool {
+	return !c.Expiration.IsZero() && time.Now().Add(credentialsRefreshWindow).After(c.Expiration)
+}
+
+// credentialsSource is a source of the credentials chain. Retrieve returns nil when the source is not configured.
+type credentialsSource interface {
+	Name() string
+	Retrieve() (*Credentials, error)
+}
+
+// credentialsCache holds the current credentials of a source and retrieves them again when they are about to expire.
+// generation changes every time they are retrieved, so that the clients created with the former ones are renewed.
+type credentialsCache struct {
+	source     credentialsSource
+	mutex      sync.Mutex
+	current    Credentials
+	generation uint64
+}
+
+func newCredentialsCache(source credentialsSource, current Credentials) *credentialsCache {
+	return &credentialsCache{source: source, current: current, generation: 1}
+}
+
+func (cache *credentialsCache) get() (Credentials, uint64, error) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	if cache.current.AccessKey != "" && !cache.current.expiring() {
+		return cache.current, cache.generation, nil
+	}
+	credentials, err := cache.source.Retrieve()
+	if err == nil && credentials == nil {
+		err = fmt.Errorf("the %s credentials are no longer available", cache.source.Name())
+	}
+	if err != nil {
+		if time.Now().Before(cache.current.Expiration) {
+			log.Printf("[WARN] Refreshing the %s credentials failed, the current ones are used until they expire at %s: %s", cache.source.Name(), cache.current.Expiration.Format(time.RFC3339), err)
+			return cache.current, cache.generation, nil
+		}
+		return Credentials{}, cache.generation, fmt.Errorf("refreshing the expired %s credentials failed: %s", cache.source.Name(), err)
+	}
+	log.Printf("[DEBUG] Refreshed the %s credentials, they expire at %s", cache.source.Name(), credentials.Expiration.Format(time.RFC3339))
+	RegisterSensitiveValues(credentials.AccessKey, credentials.SecretKey, credentials.SecurityToken)
+	cache.current = *credentials
+	cache.generation++
+	return cache.current, cache.generation, nil
+}
+
+// LoadCredentials resolves the credentials of the provider from the first source of the chain which has credentials.
+func (c *Config) LoadCredentials() error {
+	sources := []credentialsSource{
+		&staticCredentialsSource{name: "provider", accessKey: c.AccessKey, secretKey: c.SecretKey, securityToken: c.SecurityToken},
+	}
+	profile, err := c.profileCredentialsSource()
+	if err != nil {
+		return err
+	}
+	if profile != nil {
+		sources = append(sources, profile)
+	}
+	sources = append(sources,
+		&staticCredentialsSource{name: "environment", accessKey: os.Getenv(EnvAccessKeyId), secretKey: os.Getenv(EnvAccessKeySecret), securityToken: os.Getenv(EnvSecurityToken)},
+		&ecsRamRoleCredentialsSource{roleName: c.EcsRoleName},
+		c.oidcCredentialsSource(),
+	)
+	for _, source := range sources {
+		credentials, err := source.Retrieve()
+		if err != nil {
+			return fmt.Errorf("getting the %s credentials failed: %s", source.Name(), err)
+		}
+		if credentials == nil {
+			continue
+		}
+		log.Printf("[INFO] Using the %s credentials", source.Name())
+		RegisterSensitiveValues(credentials.AccessKey, credentials.SecretKey, credentials.SecurityToken)
+		c.credentials = newCredentialsCache(source, *credentials)
+		c.CredentialsSource = source.Name()
+		switch source := source.(type) {
+		case *profileCredentialsSource:
+			c.ResolvedProfile = &source.profile
+		case *oidcCredentialsSource:
+			c.OIDCRoleArn, c.OIDCSessionName = source.roleArn, source.sessionName
+		}
+		return nil
+	}
+	return fmt.Errorf("no credentials found: set the access_key and secret_key or the profile of the provider, the %s and %s environment variables, the ecs_role_name or the assume_role_with_oidc of the provider", EnvAccessKeyId, EnvAccessKeySecret)
+}
+
+// AssumeRole assumes the role of the provider assume_role with the loaded credentials. The role is assumed again with
+// the current ones, which may have been refreshed themselves, whenever its credentials are about to expire.
+func (c *Config) AssumeRole() error {
+	if c.credentials == nil {
+		if err := c.LoadCredentials(); err != nil {
+			return err
+		}
+	}
+	if c.RamRoleSessionName == "" {
+		c.RamRoleSessionName = defaultSessionName
+	}
+	source := &assumeRoleCredentialsSource{
+		config:      c,
+		base:        c.credentials,
+		roleArn:     c.RamRoleArn,
+		sessionName: c.RamRoleSessionName,
+		policy:      c.RamRolePolicy,
+	}
+	credentials, err := source.Retrieve()
+	if err != nil {
+		return err
+	}
+	RegisterSensitiveValues(credentials.AccessKey, credentials.SecretKey, credentials.SecurityToken)
+	c.credentials = newCredentialsCache(source, *credentials)
+	return nil
+}
+
+// Credentials returns the current credentials of the provider, they are refreshed when they are about to expire.
+func (c *Config) Credentials() (Credentials, error) {
+	credentials, _, err := c.currentCredentials()
+	return credentials, err
+}
+
+func (c *Config) currentCredentials() (Credentials, uint64, error) {
+	if c.credentials == nil {
+		if err := c.LoadCredentials(); err != nil {
+			return Credentials{}, 0, err
+		}
+	}
+	return c.credentials.get()
+}
+
+// staticCredentialsSource is an access key, with the security token of temporary credentials which cannot be refreshed.
+type staticCredentialsSource struct {
+	name          string
+	accessKey     string
+	secretKey     string
+	securityToken string
+}
+
+func (s *staticCredentialsSource) Name() string {
+	return s.name
+}
+
+func (s *staticCredentialsSource) Retrieve() (*Credentials, error) {
+	if s.accessKey == "" || s.secretKey == "" {
+		return nil, nil
+	}
+	return &Credentials{AccessKey: s.accessKey, SecretKey: s.secretKey, SecurityToken: s.securityToken, Source: s.name}, nil
+}
+
+// ecsRamRoleCredentialsSource gets the credentials of the RAM role of the ECS instance from its metadata service.
+type ecsRamRoleCredentialsSource struct {
+	roleName string
+}
+
+func (s *ecsRamRoleCredentialsSource) Name() string {
+	return "ecs_ram_role"
+}
+
+func (s *ecsRamRoleCredentialsSource) Retrieve() (*Credentials, error) {
+	if s.roleName == "" {
+		return nil, nil
+	}
+	request, err := http.NewRequest(http.MethodGet, securityCredURL+s.roleName, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building the metadata request failed: %s", err)
+	}
+	httpClient := &http.Client{Timeout: time.Minute}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("requesting the credentials of the ECS role %s failed: %s", s.roleName, err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the credentials of the ECS role %s failed: %s", s.roleName, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("requesting the credentials of the ECS role %s failed, httpStatus: %d, message = %s", s.roleName, response.StatusCode, body)
+	}
+	var result struct {
+		Code            string
+		AccessKeyId     string
+		AccessKeySecret string
+		SecurityToken   string
+		Expiration      string
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("parsing the credentials of the ECS role %s failed: %s", s.roleName, err)
+	}
+	if result.Code != "Success" {
+		return nil, fmt.Errorf("requesting the credentials of the ECS role %s failed, Code is %s", s.roleName, result.Code)
+	}
+	if result.AccessKeyId == "" || result.AccessKeySecret == "" || result.SecurityToken == "" {
+		return nil, fmt.Errorf("there is no any available accesskey, secret and security token for Ecs role %s", s.roleName)
+	}
+	return newTemporaryCredentials(s.Name(), result.AccessKeyId, result.AccessKeySecret, result.SecurityToken, result.Expiration)
+}
+
+// oidcCredentialsSource assumes a RAM role with the OIDC token of a kubernetes service account, the token file is
+// projected into the pod and rotated by the kubelet, so it is read again every time the role is assumed.
+type oidcCredentialsSource struct {
+	config            *Config
+	providerArn       string
+	roleArn           string
+	tokenFile         string
+	sessionName       string
+	sessionExpiration int
+}
+
+// oidcCredentialsSource takes the settings of the provider assume_role_with_oidc, or the environment variables
+// the ACK and EDAS clusters set in the pods of the service accounts which have a RAM role.
+func (c *Config) oidcCredentialsSource() *oidcCredentialsSource {
+	source := &oidcCredentialsSource{
+		config:            c,
+		providerArn:       c.OIDCProviderArn,
+		roleArn:           c.OIDCRoleArn,
+		tokenFile:         c.OIDCTokenFile,
+		sessionName:       c.OIDCSessionName,
+		sessionExpiration: c.OIDCSessionExpiration,
+	}
+	if source.providerArn == "" {
+		source.providerArn = os.Getenv(EnvOIDCProviderArn)
+	}
+	if source.roleArn == "" {
+		source.roleArn = os.Getenv(EnvRoleArn)
+	}
+	if source.tokenFile == "" {
+		source.tokenFile = os.Getenv(EnvOIDCTokenFile)
+	}
+	if source.sessionName == "" {
+		source.sessionName = os.Getenv(EnvRoleSessionName)
+	}
+	if source.sessionName == "" {
+		source.sessionName = defaultSessionName
+	}
+	return source
+}
+
+func (s *oidcCredentialsSource) Name() string {
+	return "oidc"
+}
+
+func (s *oidcCredentialsSource) Retrieve() (*Credentials, error) {
+	if s.providerArn == "" || s.roleArn == "" || s.tokenFile == "" {
+		return nil, nil
+	}
+	token, err := ioutil.ReadFile(s.tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading the OIDC token file %s failed: %s", s.tokenFile, err)
+	}
+	endpoint := s.config.StsEndpoint
+	if endpoint == "" {
+		endpoint = s.config.resolveEndpoint("sts")
+	}
+	if endpoint == "" {
+		return nil, fmt.Errorf("the sts endpoint is required to assume the role %s with the OIDC token", s.roleArn)
+	}
+	if !strings.HasPrefix(endpoint, "http") {
+		endpoint = fmt.Sprintf("%s://%s", strings.ToLower(s.config.Protocol), endpoint)
+	}
+
+	// AssumeRoleWithOIDC is called anonymously, the OIDC token is the proof of the identity
+	params := url.Values{}
+	params.Set("Action", "AssumeRoleWithOIDC")
+	params.Set("Format", "JSON")
+	params.Set("Version", stsApiVersion)
+	params.Set("Timestamp", time.Now().UTC().Format(stsTimeFormat))
+	params.Set("SignatureNonce", uuid.New().String())
+	params.Set("RoleArn", s.roleArn)
+	params.Set("OIDCProviderArn", s.providerArn)
+	params.Set("RoleSessionName", s.sessionName)
+	if s.sessionExpiration > 0 {
+		params.Set("DurationSeconds", strconv.Itoa(s.sessionExpiration))
+	}
+	form := url.Values{}
+	form.Set("OIDCToken", strings.TrimSpace(string(token)))
+	request, err := http.NewRequest(http.MethodPost, strings.TrimSuffix(endpoint, "/")+"/?"+params.Encode(), strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("building the AssumeRoleWithOIDC request failed: %s", err)
+	}
+	request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	request.Header.Set("x-ascm-product-name", "sts")
+	request.Header.Set("x-acs-organizationId", s.config.Department)
+	request.Header.Set("User-Agent", fmt.Sprintf("%s/%s %s/%s %s/%s", Terraform, TerraformVersion, Provider, ProviderVersion, Module, s.config.ConfigurationSource))
+
+	transport := &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: s.config.Insecure}}
+	if s.config.Proxy != "" {
+		if proxy, err := url.Parse(s.config.Proxy); err == nil {
+			transport.Proxy = http.ProxyURL(proxy)
+		}
+	}
+	httpClient := &http.Client{Transport: transport, Timeout: time.Minute}
+	response, err := httpClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("assuming the role %s with the OIDC token failed: %s", s.roleArn, err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the AssumeRoleWithOIDC response failed: %s", err)
+	}
+	var result struct {
+		Code        string
+		Message     string
+		RequestId   string
+		Credentials sts.Credentials
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("parsing the AssumeRoleWithOIDC response failed, httpStatus: %d, body: %s", response.StatusCode, body)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("assuming the role %s with the OIDC token failed, httpStatus: %d, Code: %s, Message: %s, RequestId: %s", s.roleArn, response.StatusCode, result.Code, result.Message, result.RequestId)
+	}
+	return newTemporaryCredentials(s.Name(), result.Credentials.AccessKeyId, result.Credentials.AccessKeySecret, result.Credentials.SecurityToken, result.Credentials.Expiration)
+}
+
+// assumeRoleCredentialsSource assumes a role, the one of the provider assume_role or of a profile, with the
+// credentials of the base cache.
+type assumeRoleCredentialsSource struct {
+	config      *Config
+	base        *credentialsCache
+	roleArn     string
+	sessionName string
+	policy      string
+	// durationSeconds is left to the default of the STS service when it is zero
+	durationSeconds int
+}
+
+func (s *assumeRoleCredentialsSource) Name() string {
+	return "assume_role"
+}
+
+func (s *assumeRoleCredentialsSource) Retrieve() (*Credentials, error) {
+	c := s.config
+	base, _, err := s.base.get()
+	if err != nil {
+		return nil, err
+	}
+	request := sts.CreateAssumeRoleRequest()
+	request.RoleArn = s.roleArn
+	request.RoleSessionName = s.sessionName
+	request.Policy = s.policy
+	if s.durationSeconds > 0 {
+		request.DurationSeconds = requests.NewInteger(s.durationSeconds)
+	}
+	request.Scheme = "https"
+	request.SetHTTPSInsecure(c.Insecure)
+	request.Domain = c.StsEndpoint
+	request.Headers["x-ascm-product-name"] = "sts"
+	request.Headers["x-acs-organizationId"] = c.Department
+
+	var client *sts.Client
+	if base.SecurityToken == "" {
+		client, err = sts.NewClientWithAccessKey(c.RegionId, base.AccessKey, base.SecretKey)
+	} else {
+		client, err = sts.NewClientWithStsToken(c.RegionId, base.AccessKey, base.SecretKey, base.SecurityToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+	client.Domain = c.StsEndpoint
+	client.AppendUserAgent(Terraform, TerraformVersion)
+	client.AppendUserAgent(Provider, ProviderVersion)
+	client.AppendUserAgent(Module, c.ConfigurationSource)
+	client.SetHTTPSInsecure(c.Insecure)
+	if c.Proxy != "" {
+		client.SetHttpProxy(c.Proxy)
+	}
+	response, err := client.AssumeRole(request)
+	if err != nil {
+		return nil, fmt.Errorf("assuming the role %s with the %s credentials failed: %s", s.roleArn, base.Source, err)
+	}
+	return newTemporaryCredentials(s.Name(), response.Credentials.AccessKeyId, response.Credentials.AccessKeySecret, response.Credentials.SecurityToken, response.Credentials.Expiration)
+}
+
+func newTemporaryCredentials(source, accessKey, secretKey, securityToken, expiration string) (*Credentials, error) {
+	credentials := &Credentials{AccessKey: accessKey, SecretKey: secretKey, SecurityToken: securityToken, Source: source}
+	if expiration != "" {
+		t, err := time.Parse(stsTimeFormat, expiration)
+		if err != nil {
+			return nil, fmt.Errorf("parsing the expiration %q of the %s credentials failed: %s", expiration, source, err)
+		}
+		credentials.Expiration = t
+	}
+	return credentials, nil
+}
+
+// teaCredential is the credential of the tea clients, a snapshot of the credentials of the provider. The tea clients
+// read the access key, the secret and the security token of a request one after the other, so they are taken from a
+// single snapshot, which the RpcClient takes again at every request, instead of signing a request with a mismatched
+// key, secret and token when the credentials are refreshed in between.
+type teaCredential struct {
+	credentials Credentials
+}
+
+func newTeaCredential(c *Config) (*teaCredential, error) {
+	credentials, err := c.Credentials()
+	if err != nil {
+		return nil, err
+	}
+	return &teaCredential{credentials: credentials}, nil
+}
+
+func (t *teaCredential) GetAccessKeyId() (*string, error) {
+	return tea.String(t.credentials.AccessKey), nil
+}
+
+func (t *teaCredential) GetAccessKeySecret() (*string, error) {
+	return tea.String(t.credentials.SecretKey), nil
+}
+
+func (t *teaCredential) GetSecurityToken() (*string, error) {
+	return tea.String(t.credentials.SecurityToken), nil
+}
+
+func (t *teaCredential) GetBearerToken() *string {
+	return tea.String("")
+}
+
+func (t *teaCredential) GetType() *string {
+	return tea.String("sts")
+}
@@ -1,14 +1,14 @@
 package connectivity
 
 import (
-	"encoding/xml"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/location"
 )
 
-// ServiceCode Load endpoints from endpoints.xml or environment variables to meet specified application scenario, like private cloud.
+// ServiceCode is the code of a product, whose endpoint can be set by the <ServiceCode>_ENDPOINT environment variable.
 type ServiceCode string
 
 const (
@@ -77,116 +77,241 @@ const (
 	STSCode             = ServiceCode("STS")
 )
 
-type Endpoints struct {
-	Endpoint []Endpoint `xml:"Endpoint"`
-}
+// The endpoint of a product is resolved in the following order:
+//  1. the endpoint of the product in the provider endpoints block, the domain or the other provider settings,
+//  2. the <PRODUCT>_ENDPOINT environment variable,
+//  3. the service endpoint catalog of the platform, which is listed once through the location service of the
+//     endpoints block, or of the ascm endpoint when it has none, and cached for the lifetime of the provider,
+//  4. the endpoint_template of the provider.
+// The {product} and {region} placeholders of an endpoint are replaced by the product code and the region.
 
-type Endpoint struct {
-	Name      string    `xml:"name,attr"`
-	RegionIds RegionIds `xml:"RegionIds"`
-	Products  Products  `xml:"Products"`
+// productEndpoints are the products whose endpoint is a field of the config, keyed by their product code.
+var productEndpoints = map[string]func(c *Config) *string{
+	"adb":             func(c *Config) *string { return &c.AdbEndpoint },
+	"alikafka":        func(c *Config) *string { return &c.AlikafkaEndpoint },
+	"apigateway":      func(c *Config) *string { return &c.ApigatewayEndpoint },
+	"arms":            func(c *Config) *string { return &c.ArmsEndpoint },
+	"ascm":            func(c *Config) *string { return &c.AscmEndpoint },
+	"bssopenapi":      func(c *Config) *string { return &c.BssOpenApiEndpoint },
+	"cas":             func(c *Config) *string { return &c.CasEndpoint },
+	"cbn":             func(c *Config) *string { return &c.CbnEndpoint },
+	"cdn":             func(c *Config) *string { return &c.CdnEndpoint },
+	"cen":             func(c *Config) *string { return &c.CenEndpoint },
+	"cloudfw":         func(c *Config) *string { return &c.CloudfwEndpoint },
+	"cms":             func(c *Config) *string { return &c.CmsEndpoint },
+	"cr":              func(c *Config) *string { return &c.CrEndpoint },
+	"cs":              func(c *Config) *string { return &c.CsEndpoint },
+	"csb":             func(c *Config) *string { return &c.CsbEndpoint },
+	"datahub":         func(c *Config) *string { return &c.DatahubEndpoint },
+	"dataworkspublic": func(c *Config) *string { return &c.DataworkspublicEndpoint },
+	"dbs":             func(c *Config) *string { return &c.DbsEndpoint },
+	"ddosbgp":         func(c *Config) *string { return &c.DdosbgpEndpoint },
+	"ddoscoo":         func(c *Config) *string { return &c.DdoscooEndpoint },
+	"dds":             func(c *Config) *string { return &c.DdsEndpoint },
+	"dms-enterprise":  func(c *Config) *string { return &c.DmsEnterpriseEndpoint },
+	"dns":             func(c *Config) *string { return &c.DnsEndpoint },
+	"drds":            func(c *Config) *string { return &c.DrdsEndpoint },
+	"dts":             func(c *Config) *string { return &c.DtsEndpoint },
+	"ecs":             func(c *Config) *string { return &c.EcsEndpoint },
+	"edas":            func(c *Config) *string { return &c.EdasEndpoint },
+	"elasticsearch":   func(c *Config) *string { return &c.ElasticsearchEndpoint },
+	"emr":             func(c *Config) *string { return &c.EmrEndpoint },
+	"ess":             func(c *Config) *string { return &c.EssEndpoint },
+	"fc":              func(c *Config) *string { return &c.FcEndpoint },
+	"gdb":             func(c *Config) *string { return &c.GdbEndpoint },
+	"gpdb":            func(c *Config) *string { return &c.GpdbEndpoint },
+	"hbase":           func(c *Config) *string { return &c.HBaseEndpoint },
+	"hitsdb":          func(c *Config) *string { return &c.HitsdbEndpoint },
+	"kms":             func(c *Config) *string { return &c.KmsEndpoint },
+	"kvstore":         func(c *Config) *string { return &c.KVStoreEndpoint },
+	"log":             func(c *Config) *string { return &c.LogEndpoint },
+	"market":          func(c *Config) *string { return &c.MarketEndpoint },
+	"maxcompute":      func(c *Config) *string { return &c.MaxComputeEndpoint },
+	"mns":             func(c *Config) *string { return &c.MnsEndpoint },
+	"nas":             func(c *Config) *string { return &c.NasEndpoint },
+	"ons":             func(c *Config) *string { return &c.OnsEndpoint },
+	"oos":             func(c *Config) *string { return &c.OosEndpoint },
+	"oss":             func(c *Config) *string { return &c.OssEndpoint },
+	"ots":             func(c *Config) *string { return &c.OtsEndpoint },
+	"polardb":         func(c *Config) *string { return &c.PolarDBEndpoint },
+	"pvtz":            func(c *Config) *string { return &c.PvtzEndpoint },
+	"quickbi":         func(c *Config) *string { return &c.QuickbiEndpoint },
+	"ram":             func(c *Config) *string { return &c.RamEndpoint },
+	"rds":             func(c *Config) *string { return &c.RdsEndpoint },
+	"ros":             func(c *Config) *string { return &c.RosEndpoint },
+	"sag":             func(c *Config) *string { return &c.SagEndpoint },
+	"slb":             func(c *Config) *string { return &c.SlbEndpoint },
+	"sts":             func(c *Config) *string { return &c.StsEndpoint },
+	"vpc":             func(c *Config) *string { return &c.VpcEndpoint },
 }
 
-type RegionIds struct {
-	RegionId string `xml:"RegionId"`
+// productCodeAliases map the other codes a product is known by to the one of productEndpoints.
+var productCodeAliases = map[string]string{
+	"ads":            "adb",
+	"cloudapi":       "apigateway",
+	"r-kvstore":      "kvstore",
+	"sls":            "log",
+	"odps":           "maxcompute",
+	"dms_enterprise": "dms-enterprise",
+	"dmsenterprise":  "dms-enterprise",
 }
 
-type Products struct {
-	Product []Product `xml:"Product"`
+// CanonicalProductCode returns the code of productEndpoints the product is known by.
+func CanonicalProductCode(product string) string {
+	product = strings.ToLower(strings.TrimSpace(product))
+	if alias, ok := productCodeAliases[product]; ok {
+		return alias
+	}
+	return product
 }
 
-type Product struct {
-	ProductName string `xml:"ProductName"`
-	DomainName  string `xml:"DomainName"`
+// expandEndpointTemplate replaces the {product} and {region} placeholders of the endpoint.
+func expandEndpointTemplate(endpoint, product, region string) string {
+	endpoint = strings.Replace(endpoint, "{product}", product, -1)
+	return strings.Replace(endpoint, "{region}", region, -1)
 }
 
-var localEndpointPath = "./endpoints.xml"
-var localEndpointPathEnv = "TF_ENDPOINT_PATH"
-var loadLocalEndpoint = false
+// resolveEndpoint returns the endpoint of the product from the environment, the endpoint catalog or the
+// endpoint template, or an empty string when none of them has one.
+func (c *Config) resolveEndpoint(product string) string {
+	product = CanonicalProductCode(product)
+	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", strings.ToUpper(strings.Replace(product, "-", "_", -1)))))
+	if endpoint == "" {
+		endpoint = c.endpointCatalog[product]
+	}
+	if endpoint == "" {
+		endpoint = c.EndpointTemplate
+	}
+	return expandEndpointTemplate(endpoint, product, c.RegionId)
+}
 
-var serviceCodeMapping = map[string]string{
-	"cloudapi": "apigateway",
+// resolveEndpoints sets the endpoint of every product which has not been set explicitly.
+func (c *Config) resolveEndpoints() {
+	for product, field := range productEndpoints {
+		endpoint := field(c)
+		if *endpoint == "" {
+			*endpoint = c.resolveEndpoint(product)
+		} else {
+			*endpoint = expandEndpointTemplate(*endpoint, product, c.RegionId)
+		}
+		if *endpoint != "" {
+			c.Endpoints[product] = *endpoint
+		}
+	}
 }
 
-func hasLocalEndpoint() bool {
-	data, err := ioutil.ReadFile(localEndpointPath)
-	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv(localEndpointPathEnv))
-		if e != nil {
-			return false
+// ResolvedEndpoints returns the endpoints of the products which have one, keyed by their product code.
+func (c *Config) ResolvedEndpoints() map[string]string {
+	endpoints := make(map[string]string)
+	for product, field := range productEndpoints {
+		if endpoint := *field(c); endpoint != "" {
+			endpoints[product] = endpoint
+		}
+	}
+	for product, endpoint := range c.Endpoints {
+		product = CanonicalProductCode(product)
+		if v, ok := endpoint.(string); ok && v != "" && endpoints[product] == "" {
+			endpoints[product] = v
 		}
-		data = d
 	}
-	return len(data) > 0
+	return endpoints
 }
 
-func loadEndpoint(region string, serviceCode ServiceCode) string {
-	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", string(serviceCode))))
-	if endpoint != "" {
-		return endpoint
+// LoadEndpoints lists the endpoint catalog and resolves the endpoint of every product. The catalog is only
+// listed once, the later calls return the endpoints resolved by the first one.
+func (c *Config) LoadEndpoints() error {
+	if c.endpointCatalog != nil {
+		return nil
 	}
+	// loadEndpoint caches the endpoints it resolves, so the map must not be nil
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]interface{})
+	}
+	catalog, err := c.listEndpointCatalog()
+	if err != nil {
+		return err
+	}
+	c.endpointCatalog = catalog
+	c.resolveEndpoints()
+	return nil
+}
 
-	// Load current path endpoint file endpoints.xml, if failed, it will load from environment variables TF_ENDPOINT_PATH
-	if !loadLocalEndpoint {
-		return ""
+// listEndpointCatalog lists the endpoints of all of the products of the region through the location service
+// of the platform. The location service is reached through its own endpoint when it is set, and otherwise
+// through the ascm endpoint, which routes the request by its product. A platform whose ascm does not serve
+// the catalog leaves it empty rather than failing, since its products may be resolved from the other sources,
+// and the error is returned for the products none of them resolves.
+func (c *Config) listEndpointCatalog() (map[string]string, error) {
+	catalog := make(map[string]string)
+	locationEndpoint := expandEndpointTemplate(c.LocationEndpoint, "location", c.RegionId)
+	throughAscm := locationEndpoint == ""
+	if throughAscm {
+		locationEndpoint = c.AscmEndpoint
 	}
-	data, err := ioutil.ReadFile(localEndpointPath)
-	if err != nil || len(data) <= 0 {
-		d, e := ioutil.ReadFile(os.Getenv(localEndpointPathEnv))
-		if e != nil {
-			return ""
-		}
-		data = d
+	if locationEndpoint == "" {
+		return catalog, nil
+	}
+	client := &AlibabacloudStackClient{Config: c, retry: newRetryEngine(c.MaxRetries, c.MaxRetryTimeout)}
+	locationClient, err := location.NewClientWithOptions(c.RegionId, client.getSdkConfig(), c.getAuthCredential(true))
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize the location client: %#v", err)
+	}
+	locationClient.Domain = strings.TrimPrefix(strings.TrimPrefix(locationEndpoint, "http://"), "https://")
+	locationClient.AppendUserAgent(Terraform, TerraformVersion)
+	locationClient.AppendUserAgent(Provider, ProviderVersion)
+	locationClient.AppendUserAgent(Module, c.ConfigurationSource)
+	locationClient.SetHTTPSInsecure(c.Insecure)
+	if c.Proxy != "" {
+		locationClient.SetHttpProxy(c.Proxy)
+		locationClient.SetHttpsProxy(c.Proxy)
+	}
+	request := location.CreateListEndpointsRequest()
+	request.Id = c.RegionId
+	request.QueryParams["Product"] = "location"
+	request.QueryParams["Department"] = c.Department
+	request.QueryParams["ResourceGroup"] = c.ResourceGroup
+	raw, err := client.invoke("location", func() (interface{}, error) {
+		return locationClient.ListEndpoints(request)
+	})
+	if err != nil && throughAscm {
+		c.endpointCatalogErr = fmt.Errorf("listing the endpoints of the region %s through the ascm endpoint %s got an error: %#v", c.RegionId, locationEndpoint, err)
+		return catalog, nil
 	}
-	var endpoints Endpoints
-	err = xml.Unmarshal(data, &endpoints)
 	if err != nil {
-		return ""
-	}
-	for _, endpoint := range endpoints.Endpoint {
-		if endpoint.RegionIds.RegionId == string(region) {
-			for _, product := range endpoint.Products.Product {
-				if strings.ToLower(product.ProductName) == strings.ToLower(string(serviceCode)) {
-					return strings.TrimSpace(product.DomainName)
-				}
-			}
+		return nil, fmt.Errorf("listing the endpoints of the region %s from the location service %s got an error: %#v", c.RegionId, locationEndpoint, err)
+	}
+	for _, item := range raw.(*location.ListEndpointsResponse).EndpointList.ItemEndpoint {
+		product := CanonicalProductCode(item.Product)
+		if item.Endpoint == "" || (item.Type != "" && item.Type != "openAPI") || catalog[product] != "" {
+			continue
 		}
+		catalog[product] = item.Endpoint
 	}
+	return catalog, nil
+}
 
-	return ""
+// missingEndpointError returns the error of a product none of the sources has an endpoint for, which is the
+// error the endpoint catalog could not be listed with when it could not.
+func (c *Config) missingEndpointError(product string) error {
+	if c.endpointCatalogErr != nil {
+		return fmt.Errorf("[ERROR] missing the product %s endpoint, which is neither set nor resolved by the endpoint_template, and %s", product, c.endpointCatalogErr)
+	}
+	return fmt.Errorf("[ERROR] missing the product %s endpoint.", product)
 }
 
+// loadEndpoint caches the endpoint of the product under its product code. The endpoint set explicitly for the
+// product takes precedence over the resolved one, whichever code the product is known by.
 func (client *AlibabacloudStackClient) loadEndpoint(productCode string) error {
 	loadSdkEndpointMutex.Lock()
 	defer loadSdkEndpointMutex.Unlock()
-	// Firstly, load endpoint from environment variables
-	endpoint := strings.TrimSpace(os.Getenv(fmt.Sprintf("%s_ENDPOINT", strings.ToUpper(productCode))))
-	if endpoint != "" {
-		client.Config.Endpoints[productCode] = endpoint
+	if field, ok := productEndpoints[CanonicalProductCode(productCode)]; ok && *field(client.Config) != "" {
+		client.Config.Endpoints[productCode] = *field(client.Config)
 		return nil
 	}
-
-	// Secondly, load endpoint from known rules
-	// Currently, this way is not pass.
-	// if _, ok := irregularProductCode[productCode]; !ok {
-	// 	client.config.Endpoints[productCode] = regularEndpoint
-	// 	return nil
-	// }
-
-	// Thirdly, load endpoint from location
-	serviceCode := serviceCodeMapping[productCode]
-	if serviceCode == "" {
-		serviceCode = productCode
-	}
-	Endpoint, err := client.describeEndpointForService(serviceCode)
-	if Endpoint == nil {
-		return nil
-	}
-	endpoint = Endpoint.Endpoint
-	if err == nil {
-		client.Config.Endpoints[strings.ToLower(serviceCode)] = endpoint
+	if endpoint := client.Config.resolveEndpoint(productCode); endpoint != "" {
+		client.Config.Endpoints[productCode] = endpoint
 	}
-	return err
+	return nil
 }
 
 const (
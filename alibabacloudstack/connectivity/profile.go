@@ -0,0 +1,325 @@
+package connectivity
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// The profiles are read from the config.json of the Alibaba Cloud CLI, either the one of the provider
+// shared_credentials_file, ~/.alibabacloudstack/config.json or ~/.aliyun/config.json. All of the credential modes
+// of the CLI are supported, a RamRoleArn or ChainableRamRoleArn profile is refreshed like the other temporary
+// credentials, and a ChainableRamRoleArn profile assumes its role with the credentials of its source_profile.
+
+const (
+	EnvProfile = "ALIBABA_CLOUD_PROFILE"
+
+	ProfileModeAK                  = "AK"
+	ProfileModeStsToken            = "StsToken"
+	ProfileModeRamRoleArn          = "RamRoleArn"
+	ProfileModeEcsRamRole          = "EcsRamRole"
+	ProfileModeChainableRamRoleArn = "ChainableRamRoleArn"
+	ProfileModeExternal            = "External"
+	ProfileModeCredentialsURI      = "CredentialsURI"
+
+	// profileProcessTimeout is how long the process_command of an External profile may run
+	profileProcessTimeout = time.Minute
+)
+
+// Profile is a profile of the config.json of the Alibaba Cloud CLI.
+type Profile struct {
+	Name            string `json:"name"`
+	Mode            string `json:"mode"`
+	AccessKeyId     string `json:"access_key_id"`
+	AccessKeySecret string `json:"access_key_secret"`
+	StsToken        string `json:"sts_token"`
+	RamRoleName     string `json:"ram_role_name"`
+	RamRoleArn      string `json:"ram_role_arn"`
+	RamSessionName  string `json:"ram_session_name"`
+	ExpiredSeconds  int    `json:"expired_seconds"`
+	SourceProfile   string `json:"source_profile"`
+	ProcessCommand  string `json:"process_command"`
+	CredentialsURI  string `json:"credentials_uri"`
+	RegionId        string `json:"region_id"`
+}
+
+// ProfileFile is the config.json of the Alibaba Cloud CLI.
+type ProfileFile struct {
+	Path     string    `json:"-"`
+	Current  string    `json:"current"`
+	Profiles []Profile `json:"profiles"`
+}
+
+// ResolvedProfile is the profile the credentials of the provider come from, with the source profiles it is chained to.
+type ResolvedProfile struct {
+	Name           string
+	Mode           string
+	Path           string
+	SourceProfiles []string
+	// RoleArn and SessionName are the role a RamRoleArn or ChainableRamRoleArn profile assumes
+	RoleArn     string
+	SessionName string
+}
+
+// DefaultProfilePath returns ~/.alibabacloudstack/config.json when it exists, ~/.aliyun/config.json otherwise.
+func DefaultProfilePath() string {
+	home := os.Getenv("HOME")
+	if runtime.GOOS == "windows" {
+		home = os.Getenv("USERPROFILE")
+	}
+	path := filepath.Join(home, ".alibabacloudstack", "config.json")
+	if _, err := os.Stat(path); err == nil {
+		return path
+	}
+	return filepath.Join(home, ".aliyun", "config.json")
+}
+
+// LoadProfileFile reads the profiles of the file, or of the default one when the path is empty. A file which does
+// not exist has no profiles.
+func LoadProfileFile(path string) (*ProfileFile, error) {
+	path, err := homedir.Expand(path)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		path = DefaultProfilePath()
+	}
+	file := &ProfileFile{Path: path}
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return file, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading the profiles of %s failed: %s", path, err)
+	}
+	if err := json.Unmarshal(data, file); err != nil {
+		return nil, fmt.Errorf("parsing the profiles of %s failed: %s", path, err)
+	}
+	return file, nil
+}
+
+// Get returns the profile of the name, or the current one of the file when the name is empty.
+func (f *ProfileFile) Get(name string) (*Profile, error) {
+	if name == "" {
+		name = f.Current
+	}
+	for i := range f.Profiles {
+		if f.Profiles[i].Name == name {
+			profile := f.Profiles[i]
+			// The profiles written before the CLI had modes only have an access key
+			if profile.Mode == "" && profile.AccessKeyId != "" {
+				profile.Mode = ProfileModeAK
+			}
+			return &profile, nil
+		}
+	}
+	return nil, fmt.Errorf("the profile %q is not found in %s", name, f.Path)
+}
+
+// profileCredentialsSource gets the credentials of a profile of the config.json of the Alibaba Cloud CLI.
+type profileCredentialsSource struct {
+	profile  ResolvedProfile
+	delegate credentialsSource
+}
+
+func (s *profileCredentialsSource) Name() string {
+	return "profile"
+}
+
+func (s *profileCredentialsSource) Retrieve() (*Credentials, error) {
+	credentials, err := s.delegate.Retrieve()
+	if err != nil {
+		return nil, fmt.Errorf("getting the credentials of the %s profile %s failed: %s", s.profile.Mode, s.profile.Name, err)
+	}
+	if credentials == nil {
+		return nil, fmt.Errorf("the %s profile %s has no credentials", s.profile.Mode, s.profile.Name)
+	}
+	credentials.Source = s.Name()
+	return credentials, nil
+}
+
+// profileCredentialsSource returns the source of the provider profile, the ALIBABA_CLOUD_PROFILE environment variable
+// or, when the provider shared_credentials_file is set, the current profile of the file. It returns nil when no
+// profile is configured.
+func (c *Config) profileCredentialsSource() (credentialsSource, error) {
+	name := c.Profile
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	if name == "" && c.ProfileFile == "" {
+		return nil, nil
+	}
+	file, err := LoadProfileFile(c.ProfileFile)
+	if err != nil {
+		return nil, err
+	}
+	profile, err := file.Get(name)
+	if err != nil {
+		return nil, err
+	}
+	resolved := ResolvedProfile{Name: profile.Name, Mode: profile.Mode, Path: file.Path}
+	if profile.Mode == ProfileModeRamRoleArn || profile.Mode == ProfileModeChainableRamRoleArn {
+		resolved.RoleArn, resolved.SessionName = profile.RamRoleArn, profile.RamSessionName
+		if resolved.SessionName == "" {
+			resolved.SessionName = defaultSessionName
+		}
+	}
+	delegate, err := c.newProfileSource(file, profile, &resolved)
+	if err != nil {
+		return nil, err
+	}
+	return &profileCredentialsSource{profile: resolved, delegate: delegate}, nil
+}
+
+func (c *Config) newProfileSource(file *ProfileFile, profile *Profile, resolved *ResolvedProfile) (credentialsSource, error) {
+	static := &staticCredentialsSource{name: profile.Name, accessKey: profile.AccessKeyId, secretKey: profile.AccessKeySecret}
+	switch profile.Mode {
+	case ProfileModeAK:
+		return static, nil
+	case ProfileModeStsToken:
+		static.securityToken = profile.StsToken
+		return static, nil
+	case ProfileModeEcsRamRole:
+		return &ecsRamRoleCredentialsSource{roleName: profile.RamRoleName}, nil
+	case ProfileModeRamRoleArn:
+		return c.newProfileAssumeRoleSource(profile, static), nil
+	case ProfileModeChainableRamRoleArn:
+		if profile.SourceProfile == "" {
+			return nil, fmt.Errorf("the ChainableRamRoleArn profile %s has no source_profile", profile.Name)
+		}
+		for _, name := range append([]string{resolved.Name}, resolved.SourceProfiles...) {
+			if name == profile.SourceProfile {
+				return nil, fmt.Errorf("the source_profile %s of the profile %s is chained to itself", profile.SourceProfile, profile.Name)
+			}
+		}
+		source, err := file.Get(profile.SourceProfile)
+		if err != nil {
+			return nil, err
+		}
+		resolved.SourceProfiles = append(resolved.SourceProfiles, source.Name)
+		base, err := c.newProfileSource(file, source, resolved)
+		if err != nil {
+			return nil, err
+		}
+		return c.newProfileAssumeRoleSource(profile, base), nil
+	case ProfileModeExternal:
+		return &processCredentialsSource{command: profile.ProcessCommand}, nil
+	case ProfileModeCredentialsURI:
+		return &uriCredentialsSource{uri: profile.CredentialsURI}, nil
+	}
+	return nil, fmt.Errorf("the mode %q of the profile %s is not supported, supported modes: %s", profile.Mode, profile.Name,
+		strings.Join([]string{ProfileModeAK, ProfileModeStsToken, ProfileModeRamRoleArn, ProfileModeEcsRamRole, ProfileModeChainableRamRoleArn, ProfileModeExternal, ProfileModeCredentialsURI}, ", "))
+}
+
+func (c *Config) newProfileAssumeRoleSource(profile *Profile, base credentialsSource) credentialsSource {
+	sessionName := profile.RamSessionName
+	if sessionName == "" {
+		sessionName = defaultSessionName
+	}
+	return &assumeRoleCredentialsSource{
+		config:          c,
+		base:            newCredentialsCache(base, Credentials{}),
+		roleArn:         profile.RamRoleArn,
+		sessionName:     sessionName,
+		durationSeconds: profile.ExpiredSeconds,
+	}
+}
+
+// processCredentialsSource runs the process_command of an External profile, which prints the credentials as a profile
+// of the AK or StsToken mode, with an optional expiration.
+type processCredentialsSource struct {
+	command string
+}
+
+func (s *processCredentialsSource) Name() string {
+	return "external"
+}
+
+func (s *processCredentialsSource) Retrieve() (*Credentials, error) {
+	args := strings.Fields(s.command)
+	if len(args) == 0 {
+		return nil, fmt.Errorf("the process_command is empty")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), profileProcessTimeout)
+	defer cancel()
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("running the process_command %s failed: %s, stderr: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	var result struct {
+		Profile
+		Expiration string `json:"expiration"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &result); err != nil {
+		return nil, fmt.Errorf("parsing the output of the process_command %s failed: %s", args[0], err)
+	}
+	switch result.Mode {
+	case ProfileModeAK:
+		result.StsToken = ""
+	case ProfileModeStsToken:
+	default:
+		return nil, fmt.Errorf("the process_command %s printed the mode %q, expected %s or %s", args[0], result.Mode, ProfileModeAK, ProfileModeStsToken)
+	}
+	if result.AccessKeyId == "" || result.AccessKeySecret == "" {
+		return nil, fmt.Errorf("the process_command %s printed no access_key_id or access_key_secret", args[0])
+	}
+	return newTemporaryCredentials(s.Name(), result.AccessKeyId, result.AccessKeySecret, result.StsToken, result.Expiration)
+}
+
+// uriCredentialsSource gets the credentials of a CredentialsURI profile from its credentials_uri.
+type uriCredentialsSource struct {
+	uri string
+}
+
+func (s *uriCredentialsSource) Name() string {
+	return "credentials_uri"
+}
+
+func (s *uriCredentialsSource) Retrieve() (*Credentials, error) {
+	httpClient := &http.Client{Timeout: time.Minute}
+	response, err := httpClient.Get(s.uri)
+	if err != nil {
+		return nil, fmt.Errorf("requesting the credentials of %s failed: %s", s.uri, err)
+	}
+	defer response.Body.Close()
+	body, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading the credentials of %s failed: %s", s.uri, err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("requesting the credentials of %s failed, httpStatus: %d, message = %s", s.uri, response.StatusCode, body)
+	}
+	var result struct {
+		Code            string
+		AccessKeyId     string
+		AccessKeySecret string
+		SecurityToken   string
+		Expiration      string
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return nil, fmt.Errorf("parsing the credentials of %s failed: %s", s.uri, err)
+	}
+	if result.Code != "Success" {
+		return nil, fmt.Errorf("requesting the credentials of %s failed, Code is %s", s.uri, result.Code)
+	}
+	if result.AccessKeyId == "" || result.AccessKeySecret == "" {
+		return nil, fmt.Errorf("there is no any available accesskey and secret in the credentials of %s", s.uri)
+	}
+	log.Printf("[DEBUG] Got the credentials of %s, they expire at %q", s.uri, result.Expiration)
+	return newTemporaryCredentials(s.Name(), result.AccessKeyId, result.AccessKeySecret, result.SecurityToken, result.Expiration)
+}
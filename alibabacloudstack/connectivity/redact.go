@@ -0,0 +1,95 @@
+package connectivity
+
+import (
+	"io"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// RedactedValue replaces the credentials, security tokens and passwords in the debug output.
+const RedactedValue = "******"
+
+// The sensitive parameters are matched by name in the formats the debug output uses:
+// "Key":"value" and Key:"value" from %#v, Key=value from query strings and key: value from headers.
+var sensitiveParamPattern = regexp.MustCompile(`(?i)(\b(?:access_?key(?:_?id|_?secret)?|secret_?key|security_?token|sts_?token|[a-z_]*password)"?\s*[:=]\s*)("(?:[^"\\]|\\.)*"|[^\s"&,;})\]]+)`)
+
+var authorizationPattern = regexp.MustCompile(`(?i)(\bauthorization"?\s*[:=]\s*"?)([^"\r\n]+)`)
+
+// sensitiveValues holds the configured credentials, which are masked wherever they show up,
+// whatever the parameter they are sent with.
+var sensitiveValues = struct {
+	sync.RWMutex
+	values   map[string]bool
+	replacer *strings.Replacer
+}{values: make(map[string]bool)}
+
+var redactLogOnce sync.Once
+
+// RegisterSensitiveValues records the values which must never show up in the debug output.
+// Values shorter than six characters are ignored, masking them would garble the output.
+func RegisterSensitiveValues(values ...string) {
+	sensitiveValues.Lock()
+	defer sensitiveValues.Unlock()
+	changed := false
+	for _, value := range values {
+		if len(value) < 6 || sensitiveValues.values[value] {
+			continue
+		}
+		sensitiveValues.values[value] = true
+		changed = true
+	}
+	if !changed {
+		return
+	}
+	// Replace the longest values first, so that a value containing another one is masked as a whole
+	var sorted []string
+	for value := range sensitiveValues.values {
+		sorted = append(sorted, value)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return len(sorted[i]) > len(sorted[j]) })
+	var pairs []string
+	for _, value := range sorted {
+		pairs = append(pairs, value, RedactedValue)
+	}
+	sensitiveValues.replacer = strings.NewReplacer(pairs...)
+}
+
+// Redact masks the credentials, security tokens and passwords in the content.
+func Redact(content string) string {
+	sensitiveValues.RLock()
+	replacer := sensitiveValues.replacer
+	sensitiveValues.RUnlock()
+	if replacer != nil {
+		content = replacer.Replace(content)
+	}
+	content = authorizationPattern.ReplaceAllString(content, "${1}"+RedactedValue)
+	return sensitiveParamPattern.ReplaceAllStringFunc(content, func(match string) string {
+		parts := sensitiveParamPattern.FindStringSubmatch(match)
+		if strings.HasPrefix(parts[2], `"`) {
+			return parts[1] + `"` + RedactedValue + `"`
+		}
+		return parts[1] + RedactedValue
+	})
+}
+
+type redactWriter struct {
+	writer io.Writer
+}
+
+func (w redactWriter) Write(p []byte) (int, error) {
+	if _, err := w.writer.Write([]byte(Redact(string(p)))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// RedactLogOutput makes the standard logger redact everything it writes, which covers
+// the provider debug traces as well as the logs of the sdks built on it.
+func RedactLogOutput() {
+	redactLogOnce.Do(func() {
+		log.SetOutput(redactWriter{writer: log.Writer()})
+	})
+}
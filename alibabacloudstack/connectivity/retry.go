@@ -0,0 +1,376 @@
+package connectivity
+
+import (
+	"context"
+	"io"
+	"log"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	rpc "github.com/alibabacloud-go/tea-rpc/client"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
+	"github.com/aliyun/aliyun-oss-go-sdk/oss"
+	"github.com/aliyun/fc-go-sdk"
+)
+
+// The retry engine sends the requests of every sdk, tea rpc and oss client of the provider. It retries the
+// requests which were throttled, hit an unavailable service or were rejected while the resource is busy with
+// another operation, with an exponential backoff and jitter, until max_retries or max_retry_timeout of the
+// provider is reached, and limits how many requests of a product are in flight at once. The requests which
+// failed on the network or with a server error are only retried when they are idempotent, that is read only
+// or carrying a ClientToken, since they may have been processed. The backoff between two attempts ends early
+// once the context of the client is done, that is once terraform has been interrupted.
+
+const (
+	DefaultMaxRetries      = 10
+	DefaultMaxRetryTimeout = 300
+
+	retryBaseInterval         = time.Second
+	retryMaxInterval          = 30 * time.Second
+	defaultProductConcurrency = 10
+)
+
+// productConcurrencyLimits are the numbers of requests of a product which are in flight at once,
+// defaultProductConcurrency for the products which are not listed.
+var productConcurrencyLimits = map[string]int{
+	"ecs":  20,
+	"vpc":  20,
+	"slb":  15,
+	"rds":  15,
+	"oss":  20,
+	"ascm": 5,
+	"ram":  5,
+	"sts":  5,
+}
+
+// throttlingErrorCodes are the codes, or their prefixes, of the requests which were rejected before being processed.
+var throttlingErrorCodes = []string{
+	"Throttling",
+	"Rejected.Throttling",
+	"ServiceUnavailable",
+	"SystemBusy",
+	"ONS_SYSTEM_FLOW_CONTROL",
+	"SlowDown",
+}
+
+// rejectedErrorMessages are the messages of the requests which were rejected before being processed, by the services
+// which report it without a throttling code, or because the resource is busy with another operation.
+var rejectedErrorMessages = []string{
+	"QPS Limit Exceeded",
+	"The current status of the resource does not support this operation",
+}
+
+// idempotentActionPrefixes are the prefixes of the read only actions.
+var idempotentActionPrefixes = []string{"Describe", "List", "Get", "Query", "Check"}
+
+type retryEngine struct {
+	maxRetries      int
+	maxRetryTimeout time.Duration
+	mutex           sync.Mutex
+	limits          map[string]chan struct{}
+}
+
+func newRetryEngine(maxRetries, maxRetryTimeout int) *retryEngine {
+	return &retryEngine{
+		maxRetries:      maxRetries,
+		maxRetryTimeout: time.Duration(maxRetryTimeout) * time.Second,
+		limits:          make(map[string]chan struct{}),
+	}
+}
+
+func (e *retryEngine) limit(product string) chan struct{} {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	limit, ok := e.limits[product]
+	if !ok {
+		size, ok := productConcurrencyLimits[product]
+		if !ok {
+			size = defaultProductConcurrency
+		}
+		limit = make(chan struct{}, size)
+		e.limits[product] = limit
+	}
+	return limit
+}
+
+// invoke calls do until it succeeds, fails with an error which is not retried, or the retries are exhausted,
+// in which case the error of the last attempt is returned. The idempotent func is called with the result of the
+// failed attempt, since the sdk requests are only known once they have been sent.
+func (e *retryEngine) invoke(ctx context.Context, product string, idempotent func() bool, do func() error) error {
+	if e == nil {
+		return do()
+	}
+	limit := e.limit(product)
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		select {
+		case limit <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		err := do()
+		<-limit
+		if err == nil || attempt >= e.maxRetries || !IsRetryableError(err, idempotent()) {
+			return err
+		}
+		wait := retryBackoff(attempt)
+		if e.maxRetryTimeout > 0 && time.Since(start)+wait > e.maxRetryTimeout {
+			return err
+		}
+		log.Printf("[DEBUG] Retrying the %s request in %s after the attempt %d failed: %s", product, wait, attempt+1, err)
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryBackoff doubles the interval at every attempt up to retryMaxInterval, and waits a random time
+// between the half and the whole of it so that the throttled requests do not come back all at once.
+func retryBackoff(attempt int) time.Duration {
+	interval := retryMaxInterval
+	if attempt < 5 {
+		interval = retryBaseInterval << uint(attempt)
+	}
+	if interval > retryMaxInterval {
+		interval = retryMaxInterval
+	}
+	return interval/2 + time.Duration(rand.Int63n(int64(interval/2)+1))
+}
+
+// IsRetryableError reports whether the request failed with an error the retry engine retries.
+func IsRetryableError(err error, idempotent bool) bool {
+	code, status := retryErrorCode(err)
+	for _, prefix := range throttlingErrorCodes {
+		if strings.HasPrefix(code, prefix) {
+			return true
+		}
+	}
+	if message := retryErrorMessage(err); message != "" {
+		for _, rejected := range rejectedErrorMessages {
+			if strings.Contains(message, rejected) {
+				return true
+			}
+		}
+	}
+	if status == 429 || status == 503 || isDialError(err) {
+		return true
+	}
+	if !idempotent {
+		return false
+	}
+	return status >= 500 || isNetworkError(err)
+}
+
+func retryErrorCode(err error) (string, int) {
+	switch e := err.(type) {
+	case *errors.ServerError:
+		return e.ErrorCode(), e.HttpStatus()
+	case *tea.SDKError:
+		return tea.StringValue(e.Code), tea.IntValue(e.StatusCode)
+	case oss.ServiceError:
+		return e.Code, e.StatusCode
+	case *fc.ServiceError:
+		return e.ErrorCode, e.HTTPStatus
+	}
+	return "", 0
+}
+
+func retryErrorMessage(err error) string {
+	switch e := err.(type) {
+	case *errors.ServerError:
+		return e.Message()
+	case *tea.SDKError:
+		return tea.StringValue(e.Message)
+	}
+	return ""
+}
+
+// isDialError reports whether the connection could not even be opened, so the request has not been sent.
+func isDialError(err error) bool {
+	if e, ok := err.(*errors.ClientError); ok {
+		err = e.OriginError()
+	}
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+	e, ok := err.(*net.OpError)
+	return ok && e.Op == "dial"
+}
+
+func isNetworkError(err error) bool {
+	if e, ok := err.(*errors.ClientError); ok {
+		if e.ErrorCode() == errors.TimeoutErrorCode {
+			return true
+		}
+		err = e.OriginError()
+	}
+	if e, ok := err.(*url.Error); ok {
+		err = e.Err
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	_, ok := err.(net.Error)
+	return ok
+}
+
+// isIdempotentRequest reports whether the request can be sent twice: the read only actions,
+// and the requests carrying a ClientToken, which the services process only once.
+func isIdempotentRequest(action string, params ...map[string]interface{}) bool {
+	for _, prefix := range idempotentActionPrefixes {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	for _, param := range params {
+		if token, ok := param["ClientToken"]; ok && token != nil && token != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// isIdempotentSdkRequest reports whether the request of an sdk client is idempotent, from the response the sdk
+// returns along with the error. The action and the ClientToken are read from the request which got the response,
+// and the action is the name of the response type when the request failed before getting one. The roa requests
+// have no action, only their GET requests are read only.
+func isIdempotentSdkRequest(raw interface{}) bool {
+	value := reflect.ValueOf(raw)
+	if !value.IsValid() || value.Kind() == reflect.Ptr && value.IsNil() {
+		return false
+	}
+	var action string
+	params := make(map[string]interface{})
+	if response, ok := raw.(interface{ GetOriginHttpResponse() *http.Response }); ok && !isNilBaseResponse(value) {
+		if httpResponse := response.GetOriginHttpResponse(); httpResponse != nil && httpResponse.Request != nil {
+			query := httpResponse.Request.URL.Query()
+			action = query.Get("Action")
+			params["ClientToken"] = query.Get("ClientToken")
+			if action == "" {
+				return httpResponse.Request.Method == http.MethodGet || isIdempotentRequest("", params)
+			}
+		}
+	}
+	if action == "" {
+		action = strings.TrimSuffix(reflect.Indirect(value).Type().Name(), "Response")
+	}
+	return isIdempotentRequest(action, params)
+}
+
+// isNilBaseResponse reports whether the response embeds a nil *responses.BaseResponse, whose methods would panic.
+func isNilBaseResponse(value reflect.Value) bool {
+	value = reflect.Indirect(value)
+	if value.Kind() != reflect.Struct {
+		return false
+	}
+	base := value.FieldByName("BaseResponse")
+	return base.IsValid() && base.Kind() == reflect.Ptr && base.IsNil()
+}
+
+// invoke sends the request of the product through the retry engine of the client.
+func (client *AlibabacloudStackClient) invoke(product string, do func() (interface{}, error)) (interface{}, error) {
+	var raw interface{}
+	err := client.retry.invoke(client.context(), product, func() bool { return isIdempotentSdkRequest(raw) }, func() (err error) {
+		raw, err = do()
+		return err
+	})
+	return raw, err
+}
+
+// context returns the context the requests of the client are sent with, which is done once terraform is interrupted.
+func (client *AlibabacloudStackClient) context() context.Context {
+	if client.base != nil {
+		return client.base.context()
+	}
+	if client.stopContext == nil {
+		return context.Background()
+	}
+	return client.stopContext
+}
+
+// SetStopContext sets the context which is done once terraform is interrupted, the retries of the requests of the
+// client and of its scoped clients stop waiting once it is done.
+func (client *AlibabacloudStackClient) SetStopContext(ctx context.Context) {
+	client.stopContext = ctx
+}
+
+// RpcClient is the tea rpc client of a product whose requests go through the retry engine of the client.
+type RpcClient struct {
+	*rpc.Client
+	product string
+	retry   *retryEngine
+	ctx     context.Context
+	config  *Config
+}
+
+func (client *AlibabacloudStackClient) newRpcClient(product string, conn *rpc.Client) *RpcClient {
+	return &RpcClient{Client: conn, product: product, retry: client.retry, ctx: client.context(), config: client.Config}
+}
+
+func (c *RpcClient) do(action *string, query, body map[string]interface{}, do func(*rpc.Client) (map[string]interface{}, error)) (response map[string]interface{}, err error) {
+	idempotent := isIdempotentRequest(tea.StringValue(action), query, body)
+	err = c.retry.invoke(c.ctx, c.product, func() bool { return idempotent }, func() error {
+		conn, err := c.withCurrentCredentials()
+		if err != nil {
+			return err
+		}
+		response, err = do(conn)
+		return err
+	})
+	return response, err
+}
+
+// withCurrentCredentials returns a copy of the tea rpc client which signs a request with a snapshot of the current
+// credentials of the provider, so that the requests use the refreshed credentials once the previous ones expired.
+func (c *RpcClient) withCurrentCredentials() (*rpc.Client, error) {
+	if c.config == nil {
+		return c.Client, nil
+	}
+	credential, err := newTeaCredential(c.config)
+	if err != nil {
+		return nil, err
+	}
+	conn := *c.Client
+	conn.Credential = credential
+	return &conn, nil
+}
+
+// runtime turns off the retries of the tea runtime, the requests are retried by the retry engine instead.
+func (c *RpcClient) runtime(runtime *util.RuntimeOptions) *util.RuntimeOptions {
+	if runtime == nil {
+		return nil
+	}
+	options := *runtime
+	options.Autoretry = tea.Bool(false)
+	return &options
+}
+
+func (c *RpcClient) DoRequest(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return c.do(action, query, body, func(conn *rpc.Client) (map[string]interface{}, error) {
+		return conn.DoRequest(action, protocol, method, version, authType, query, body, c.runtime(runtime))
+	})
+}
+
+func (c *RpcClient) DoRequestWithOrg(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return c.do(action, query, body, func(conn *rpc.Client) (map[string]interface{}, error) {
+		return conn.DoRequestWithOrg(action, protocol, method, version, authType, query, body, c.runtime(runtime))
+	})
+}
+
+func (c *RpcClient) DoRequesttowpoint1(action *string, protocol *string, method *string, version *string, authType *string, query map[string]interface{}, body map[string]interface{}, runtime *util.RuntimeOptions) (map[string]interface{}, error) {
+	return c.do(action, query, body, func(conn *rpc.Client) (map[string]interface{}, error) {
+		return conn.DoRequesttowpoint1(action, protocol, method, version, authType, query, body, c.runtime(runtime))
+	})
+}
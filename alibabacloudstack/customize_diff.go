@@ -0,0 +1,153 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceDiffCondition reports whether a plan time check or a conditional ForceNew applies to the diff.
+type resourceDiffCondition func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool
+
+// customizeDiffAll runs all of the checks and reports every failure at once,
+// so that a plan lists all of the offending attributes instead of the first one.
+func customizeDiffAll(funcs ...schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		var messages []string
+		for _, f := range funcs {
+			if err := f(ctx, d, meta); err != nil {
+				messages = append(messages, err.Error())
+			}
+		}
+		if len(messages) > 0 {
+			return fmt.Errorf("%s", strings.Join(messages, "\n"))
+		}
+		return nil
+	}
+}
+
+// customizeDiffIf runs the check only when the condition holds.
+func customizeDiffIf(cond resourceDiffCondition, f schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if cond(ctx, d, meta) {
+			return f(ctx, d, meta)
+		}
+		return nil
+	}
+}
+
+// forceNewIf replaces the resource when the attribute changes and the condition holds,
+// for the attributes which the api can only modify in some cases. The recomputed attributes
+// are derived from the attribute and only known once the resource has been replaced.
+func forceNewIf(key string, cond resourceDiffCondition, recomputed ...string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange(key) || !cond(ctx, d, meta) {
+			return nil
+		}
+		if err := d.ForceNew(key); err != nil {
+			return err
+		}
+		for _, k := range recomputed {
+			if err := d.SetNewComputed(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+// rejectChangeIf fails the plan when the attribute of an existing resource changes and the condition holds,
+// for the changes which the api does not support and which would lose data if the resource was replaced.
+func rejectChangeIf(key string, cond resourceDiffCondition, reason string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange(key) || !cond(ctx, d, meta) {
+			return nil
+		}
+		o, n := d.GetChange(key)
+		return fmt.Errorf("%q can not be changed from %v to %v: %s", key, o, n, reason)
+	}
+}
+
+// diffValueDecreased holds when the new value of the numeric attribute is lower than the old one.
+func diffValueDecreased(key string) resourceDiffCondition {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+		o, n := d.GetChange(key)
+		return d.NewValueKnown(key) && n.(int) < o.(int)
+	}
+}
+
+// diffValueChangedTo holds when the attribute changes to the value.
+func diffValueChangedTo(key string, value interface{}) resourceDiffCondition {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+		return d.HasChange(key) && d.NewValueKnown(key) && d.Get(key) == value
+	}
+}
+
+// cannotUnsetDiff fails when the attribute is removed from the configuration of an existing resource,
+// for the attributes which the api can modify but not unset.
+func cannotUnsetDiff(key string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if d.Id() == "" || !d.HasChange(key) || !d.NewValueKnown(key) {
+			return nil
+		}
+		if _, ok := d.GetOk(key); !ok {
+			return fmt.Errorf("%q can not be removed once it has been set", key)
+		}
+		return nil
+	}
+}
+
+// vswitchZoneDiff fails when the vswitch is not in the zone given by the zone attribute.
+// The zone attribute is usually Computed, so it is only compared when the configuration sets or changes it.
+func vswitchZoneDiff(vswitchKey, zoneKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.NewValueKnown(vswitchKey) || !d.NewValueKnown(zoneKey) || d.Id() != "" && !d.HasChange(zoneKey) {
+			return nil
+		}
+		vswitchId, zoneId := d.Get(vswitchKey).(string), d.Get(zoneKey).(string)
+		if vswitchId == "" || zoneId == "" {
+			return nil
+		}
+		zone, err := vswitchZone(ctx, vswitchId, meta)
+		if err != nil {
+			return err
+		}
+		if zone != "" && zone != zoneId {
+			return fmt.Errorf("%q %s is in the zone %s, which does not match the %q %s", vswitchKey, vswitchId, zone, zoneKey, zoneId)
+		}
+		return nil
+	}
+}
+
+// vswitchZoneChanged holds when the new vswitch is in another zone than the current one,
+// which ModifyInstanceVpcAttribute does not support.
+func vswitchZoneChanged(vswitchKey, zoneKey string) resourceDiffCondition {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+		vswitchId := d.Get(vswitchKey).(string)
+		if !d.NewValueKnown(vswitchKey) || vswitchId == "" {
+			return false
+		}
+		zoneId, _ := d.GetChange(zoneKey)
+		zone, err := vswitchZone(ctx, vswitchId, meta)
+		return err == nil && zone != "" && zone != zoneId.(string)
+	}
+}
+
+func vswitchZone(ctx context.Context, vswitchId string, meta interface{}) (string, error) {
+	client, ok := meta.(*connectivity.AlibabacloudStackClient)
+	if !ok {
+		return "", nil
+	}
+	vpcService := VpcService{client, ctx}
+	vswitch, err := vpcService.DescribeVSwitch(vswitchId)
+	if err != nil {
+		if NotFoundError(err) {
+			return "", WrapError(Error("the vswitch %s does not exist", vswitchId))
+		}
+		return "", WrapError(err)
+	}
+	return vswitch.ZoneId, nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceAlibabacloudStackAccount() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAccountRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAccountRead),
 
 		Schema: map[string]*schema.Schema{
 			// Computed values
@@ -21,7 +22,7 @@ func dataSourceAlibabacloudStackAccount() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAccountRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	accountId, err := meta.(*connectivity.AlibabacloudStackClient).AccountId()
 
 	if err != nil {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAdbDbClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAdbDbClustersRead),
 		Schema: map[string]*schema.Schema{
 			"description_regex": {
 				Type:         schema.TypeString,
@@ -241,7 +242,7 @@ func dataSourceAlibabacloudStackAdbDbClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAdbDbClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDBClusters"
@@ -388,7 +389,7 @@ func dataSourceAlibabacloudStackAdbDbClustersRead(d *schema.ResourceData, meta i
 			continue
 		}
 
-		adbService := AdbService{client}
+		adbService := AdbService{client, ctx}
 		id := fmt.Sprint(object["DBClusterId"])
 		// 预付费 专有云没有细分 没有 DescribeAutoRenewAttribute 接口
 		//if object["PayType"].(string) == string(Prepaid) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackAdbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAdbZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAdbZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -51,7 +52,7 @@ func dataSourceAlibabacloudStackAdbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAdbZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAdbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -0,0 +1,129 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAlikafkaConsumerGroupsRead),
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"consumer_id_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"groups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consumer_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaConsumerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	instanceId := d.Get("instance_id").(string)
+	objects, err := alikafkaService.DescribeAlikafkaConsumerGroups(instanceId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var consumerIdRegex *regexp.Regexp
+	if v, ok := d.GetOk("consumer_id_regex"); ok {
+		consumerIdRegex = regexp.MustCompile(v.(string))
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, item := range objects {
+		if consumerIdRegex != nil && !consumerIdRegex.MatchString(item.ConsumerId) {
+			continue
+		}
+		tags := make(map[string]string)
+		for _, tag := range item.Tags.TagVO {
+			if !ignoredTags(tag.Key, tag.Value) {
+				tags[tag.Key] = tag.Value
+			}
+		}
+		id := instanceId + ":" + item.ConsumerId
+		mapping := map[string]interface{}{
+			"id":          id,
+			"consumer_id": item.ConsumerId,
+			"instance_id": instanceId,
+			"description": item.Remark,
+			"tags":        tags,
+		}
+		ids = append(ids, id)
+		names = append(names, item.ConsumerId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("groups", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAlikafkaInstancesRead),
+
+		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vswitch_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"end_point": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"deploy_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"disk_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"io_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"eip_max": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"topic_quota": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"spec_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"paid_type": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"msg_retain": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"security_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"tags": tagsSchemaComputed(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	objects, err := alikafkaService.DescribeAlikafkaInstances()
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, vv := range v.([]interface{}) {
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	var instances []alikafka.InstanceVO
+	for _, item := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(item.Name) {
+			continue
+		}
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[item.InstanceId]; !ok {
+				continue
+			}
+		}
+		instances = append(instances, item)
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, item := range instances {
+		tags := make(map[string]string)
+		for _, tag := range item.Tags.TagVO {
+			if !ignoredTags(tag.Key, tag.Value) {
+				tags[tag.Key] = tag.Value
+			}
+		}
+		mapping := map[string]interface{}{
+			"id":              item.InstanceId,
+			"name":            item.Name,
+			"service_status":  item.ServiceStatus,
+			"vpc_id":          item.VpcId,
+			"vswitch_id":      item.VSwitchId,
+			"zone_id":         item.ZoneId,
+			"end_point":       item.EndPoint,
+			"deploy_type":     item.DeployType,
+			"disk_type":       item.DiskType,
+			"disk_size":       item.DiskSize,
+			"io_max":          item.IoMax,
+			"eip_max":         item.EipMax,
+			"topic_quota":     item.TopicNumLimit,
+			"spec_type":       item.SpecType,
+			"paid_type":       item.PaidType,
+			"msg_retain":      item.MsgRetain,
+			"security_group":  item.SecurityGroup,
+			"service_version": item.UpgradeServiceDetailInfo.Current2OpenSourceVersion,
+			"tags":            tags,
+		}
+		ids = append(ids, item.InstanceId)
+		names = append(names, item.Name)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("instances", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackAlikafkaTopics() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAlikafkaTopicsRead),
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"topics": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"topic": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"local_topic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"compact_topic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"partition_num": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"remark": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"status_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackAlikafkaTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	instanceId := d.Get("instance_id").(string)
+	objects, err := alikafkaService.DescribeAlikafkaTopics(instanceId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, item := range objects {
+		if nameRegex != nil && !nameRegex.MatchString(item.Topic) {
+			continue
+		}
+		id := instanceId + ":" + item.Topic
+		mapping := map[string]interface{}{
+			"id":            id,
+			"topic":         item.Topic,
+			"instance_id":   instanceId,
+			"local_topic":   item.LocalTopic,
+			"compact_topic": item.InstanceDo.CompactTopic,
+			"partition_num": item.InstanceDo.PartitionNum,
+			"remark":        item.InstanceDo.Remark,
+			"status":        item.Status,
+			"status_name":   item.StatusName,
+		}
+		ids = append(ids, id)
+		names = append(names, item.Topic)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("topics", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayApisRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayApisRead),
 
 		Schema: map[string]*schema.Schema{
 			"group_id": {
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackApiGatewayApis() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayApisRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateDescribeApisRequest()
 	request.RegionId = client.RegionId
@@ -91,14 +92,13 @@ func dataSourceAlibabacloudStackApigatewayApisRead(d *schema.ResourceData, meta
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeApis",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DescribeApis",
+		"Version":       "2016-07-14",
 	}
 	if groupId, ok := d.GetOk("group_id"); ok {
 		request.GroupId = groupId.(string)
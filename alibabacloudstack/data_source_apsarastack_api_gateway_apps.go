@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strconv"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayAppsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayAppsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,9 +75,9 @@ func dataSourceAlibabacloudStackApiGatewayApps() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayAppsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	request := cloudapi.CreateDescribeAppAttributesRequest()
 	request.RegionId = client.RegionId
@@ -86,14 +87,13 @@ func dataSourceAlibabacloudStackApigatewayAppsRead(d *schema.ResourceData, meta
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeAppAttributes",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DescribeAppAttributes",
+		"Version":       "2016-07-14",
 	}
 	var apps []cloudapi.AppAttribute
 
@@ -179,14 +179,13 @@ func apigatewayAppsDecriptionAttributes(d *schema.ResourceData, apps []cloudapi.
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "DescribeAppSecurity",
-			"Version":         "2016-07-14",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "CloudAPI",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "DescribeAppSecurity",
+			"Version":       "2016-07-14",
 		}
 		request.AppId = requests.NewInteger64(app.AppId)
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackApiGatewayGroups() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateDescribeApiGroupsRequest()
@@ -99,14 +100,13 @@ func dataSourceAlibabacloudStackApigatewayGroupsRead(d *schema.ResourceData, met
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeApiGroups",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DescribeApiGroups",
+		"Version":       "2016-07-14",
 	}
 	var allGroups []cloudapi.ApiGroupAttribute
 
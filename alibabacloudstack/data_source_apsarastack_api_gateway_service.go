@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackApiGatewayService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackApigatewayServiceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackApigatewayServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -28,7 +29,7 @@ func dataSourceAlibabacloudStackApiGatewayService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackApigatewayServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackApigatewayServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
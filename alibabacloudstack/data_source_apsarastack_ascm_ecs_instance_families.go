@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsInstanceFamiliesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsInstanceFamiliesRead),
 		Schema: map[string]*schema.Schema{
 
 			"ids": {
@@ -52,7 +53,7 @@ func dataSourceAlibabacloudStackEcsInstanceFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -70,14 +71,13 @@ func dataSourceAlibabacloudStackEcsInstanceFamiliesRead(d *schema.ResourceData,
 	request.ApiName = "DescribeInstanceTypeFamilies"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DescribeInstanceTypeFamilies",
-		"Version":         "2019-05-10",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DescribeInstanceTypeFamilies",
+		"Version":       "2019-05-10",
 	}
 	response := EcsInstanceFamily{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackInstanceFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceFamiliesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstanceFamiliesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -81,7 +82,7 @@ func dataSourceAlibabacloudStackInstanceFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceFamiliesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstanceFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -100,14 +101,13 @@ func dataSourceAlibabacloudStackInstanceFamiliesRead(d *schema.ResourceData, met
 	request.ApiName = "DescribeSeriesIdFamilies"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeSeriesIdFamilies",
-		"Version":         "2019-05-10",
+		"AccessKeyId":   client.AccessKey,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Action":        "DescribeSeriesIdFamilies",
+		"Version":       "2019-05-10",
 	}
 	response := InstanceFamily{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmLogonPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmLogonPoliciesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmLogonPoliciesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -98,7 +99,7 @@ func dataSourceAlibabacloudStackAscmLogonPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmLogonPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmLogonPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	name := d.Get("name_regex").(string)
 	request := requests.NewCommonRequest()
@@ -118,13 +119,12 @@ func dataSourceAlibabacloudStackAscmLogonPoliciesRead(d *schema.ResourceData, me
 	request.ApiName = "ListLoginPolicies"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListLoginPolicies",
-		"Version":         "2019-05-10",
-		"name":            name,
+		"AccessKeyId": client.AccessKey,
+		"Product":     "ascm",
+		"RegionId":    client.RegionId,
+		"Action":      "ListLoginPolicies",
+		"Version":     "2019-05-10",
+		"name":        name,
 	}
 	response := LoginPolicy{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmOrganizations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmOrganizationsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmOrganizationsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -84,7 +85,7 @@ func dataSourceAlibabacloudStackAscmOrganizations() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmOrganizationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmOrganizationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -109,13 +110,12 @@ func dataSourceAlibabacloudStackAscmOrganizationsRead(d *schema.ResourceData, me
 	request.ApiName = "GetOrganizationList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "GetOrganizationList",
-		"Version":         "2019-05-10",
-		"id":              parentId}
+		"AccessKeyId": client.AccessKey,
+		"Product":     "ascm",
+		"RegionId":    client.RegionId,
+		"Action":      "GetOrganizationList",
+		"Version":     "2019-05-10",
+		"id":          parentId}
 	response := Organization{}
 
 	for {
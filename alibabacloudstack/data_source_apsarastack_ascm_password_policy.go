@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmPasswordPoliciesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmPasswordPoliciesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -119,7 +120,7 @@ func dataSourceAlibabacloudStackAscmPasswordPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -136,7 +137,7 @@ func dataSourceAlibabacloudStackAscmPasswordPoliciesRead(d *schema.ResourceData,
 	request.RegionId = client.RegionId
 	request.ApiName = "GetPasswordPolicy"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Action": "GetPasswordPolicy", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": "2019-05-10"}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "ascm", "RegionId": client.RegionId, "Action": "GetPasswordPolicy", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Version": "2019-05-10"}
 	response := PasswordPolicy{}
 
 	for {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackQuotas() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackQuotasRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackQuotasRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -165,7 +166,7 @@ func dataSourceAlibabacloudStackQuotas() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackQuotasRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackQuotasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -186,8 +187,7 @@ func dataSourceAlibabacloudStackQuotasRead(d *schema.ResourceData, meta interfac
 	quotaTypeId := d.Get("quota_type_id").(string)
 	targetType := d.Get("target_type").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey,
-		"Product":       "ascm",
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "ascm",
 		"RegionId":      client.RegionId,
 		"Department":    client.Department,
 		"ResourceGroup": client.ResourceGroup,
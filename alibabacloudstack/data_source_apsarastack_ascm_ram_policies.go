@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmRamPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRamPoliciesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRamPoliciesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -80,7 +81,7 @@ func dataSourceAlibabacloudStackAscmRamPolicies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRamPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmRamPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	request.Product = "ascm"
@@ -94,15 +95,14 @@ func dataSourceAlibabacloudStackAscmRamPoliciesRead(d *schema.ResourceData, meta
 	request.ApiName = "ListRamPolicies"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListRamPolicies",
-		"Version":         "2019-05-10",
-		"pageSize":        "1000",
+		"AccessKeyId":   client.AccessKey,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Action":        "ListRamPolicies",
+		"Version":       "2019-05-10",
+		"pageSize":      "1000",
 		//"policyName":name,
 	}
 	response := RamPolicies{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmRamPoliciesForUser() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRamPoliciesForUserRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRamPoliciesForUserRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -70,7 +71,7 @@ func dataSourceAlibabacloudStackAscmRamPoliciesForUser() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	lname := d.Get("login_name").(string)
 	request := requests.NewCommonRequest()
@@ -85,15 +86,14 @@ func dataSourceAlibabacloudStackAscmRamPoliciesForUserRead(d *schema.ResourceDat
 	request.ApiName = "ListRAMPoliciesForUser"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListRAMPoliciesForUser",
-		"Version":         "2019-05-10",
-		"LoginName":       lname,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "ascm",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListRAMPoliciesForUser",
+		"Version":       "2019-05-10",
+		"LoginName":     lname,
 	}
 	response := RamPolicyUser{}
 
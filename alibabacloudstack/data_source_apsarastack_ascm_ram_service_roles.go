@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmRamServiceRoles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRamServiceRolesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRamServiceRolesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -85,7 +86,7 @@ func dataSourceAlibabacloudStackAscmRamServiceRoles() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRamServiceRolesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmRamServiceRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -102,15 +103,14 @@ func dataSourceAlibabacloudStackAscmRamServiceRolesRead(d *schema.ResourceData,
 	request.ApiName = "ListRAMServiceRoles"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListRAMServiceRoles",
-		"Version":         "2019-05-10",
-		"roleType":        "ROLETYPE_RAM",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "ascm",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListRAMServiceRoles",
+		"Version":       "2019-05-10",
+		"roleType":      "ROLETYPE_RAM",
 	}
 	response := RamRole{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackRegionsByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRegionsByProductRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRegionsByProductRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -55,7 +56,7 @@ func dataSourceAlibabacloudStackRegionsByProduct() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRegionsByProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRegionsByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -72,7 +73,7 @@ func dataSourceAlibabacloudStackRegionsByProductRead(d *schema.ResourceData, met
 	request.RegionId = client.RegionId
 	request.ApiName = "GetRegionsByProduct"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GetRegionsByProduct", "Version": "2019-05-10"}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GetRegionsByProduct", "Version": "2019-05-10"}
 	response := RegionsByProduct{}
 
 	for {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmRoles() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmRolesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmRolesRead),
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeInt,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackAscmRoles() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmRolesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmRolesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	id := d.Get("id").(int)
 	roleType := d.Get("role_type").(string)
@@ -127,15 +128,14 @@ func dataSourceAlibabacloudStackAscmRolesRead(d *schema.ResourceData, meta inter
 	request.ApiName = "ListRoles"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListRoles",
-		"Version":         "2019-05-10",
-		"pageSize":        "100000",
+		"AccessKeyId":   client.AccessKey,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Action":        "ListRoles",
+		"Version":       "2019-05-10",
+		"pageSize":      "100000",
 		//"roleType":        roleType,
 	}
 	response := AscmRoles{}
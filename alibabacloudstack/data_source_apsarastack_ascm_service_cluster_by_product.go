@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackServiceClusterByProductRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackServiceClusterByProductRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -48,7 +49,7 @@ func dataSourceAlibabacloudStackServiceClusterByProduct() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackServiceClusterByProductRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -66,7 +67,7 @@ func dataSourceAlibabacloudStackServiceClusterByProductRead(d *schema.ResourceDa
 	request.ApiName = "GetClustersByProduct"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	productName := d.Get("product_name").(string)
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "productName": productName, "Action": "GetClustersByProduct", "Version": "2019-05-10"}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "productName": productName, "Action": "GetClustersByProduct", "Version": "2019-05-10"}
 	response := ClustersByProduct1{}
 
 	for {
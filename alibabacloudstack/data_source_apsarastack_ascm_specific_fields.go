@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackSpecificFields() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSpecificFieldsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSpecificFieldsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -53,7 +54,7 @@ func dataSourceAlibabacloudStackSpecificFields() *schema.Resource {
 
 }
 
-func dataSourceAlibabacloudStackSpecificFieldsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSpecificFieldsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -72,7 +73,7 @@ func dataSourceAlibabacloudStackSpecificFieldsRead(d *schema.ResourceData, meta
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	resourceType := d.Get("resource_type").(string)
 	groupFiled := d.Get("group_filed").(string)
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GroupCommonSpec", "Version": "2019-05-10", "resourceType": resourceType, "groupFiled": groupFiled}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "ascm", "RegionId": client.RegionId, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Action": "GroupCommonSpec", "Version": "2019-05-10", "resourceType": resourceType, "groupFiled": groupFiled}
 	response := SpecificField{}
 
 	for {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmUserGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmUserGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmUserGroupsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackAscmUserGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmUserGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -109,15 +110,14 @@ func dataSourceAlibabacloudStackAscmUserGroupsRead(d *schema.ResourceData, meta
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	response := UserGroup{}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListUserGroups",
-		"Version":         "2019-05-10",
-		"userGroupName":   userGroupName,
+		"AccessKeyId":   client.AccessKey,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Action":        "ListUserGroups",
+		"Version":       "2019-05-10",
+		"userGroupName": userGroupName,
 	}
 
 	for {
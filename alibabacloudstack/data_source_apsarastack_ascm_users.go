@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackAscmUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAscmUsersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAscmUsersRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -107,7 +108,7 @@ func dataSourceAlibabacloudStackAscmUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackAscmUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAscmUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -128,15 +129,14 @@ func dataSourceAlibabacloudStackAscmUsersRead(d *schema.ResourceData, meta inter
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	response := User{}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       loginName,
+		"AccessKeyId":   client.AccessKey,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"RegionId":      client.RegionId,
+		"Action":        "ListUsers",
+		"Version":       "2019-05-10",
+		"loginName":     loginName,
 	}
 
 	for {
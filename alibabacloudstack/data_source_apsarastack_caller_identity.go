@@ -0,0 +1,132 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackCallerIdentity() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCallerIdentityRead),
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"identity_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"login_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_role_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"role_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"department": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_group_set_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCallerIdentityRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	user, err := client.GetCallerUser()
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_caller_identity", "GetUserInfo", AlibabacloudStackSdkGoERROR)
+	}
+	if user.PrimaryKey == "" {
+		return WrapErrorf(Error(GetNotFoundMessage("ASCM User", "primaryKey")), NotFoundMsg, ProviderERROR)
+	}
+
+	// The ASCM user info has no arn, so it is derived from the account and the login name of the user, or from
+	// the role the credentials of the provider were assumed from, instead of being returned by the platform
+	identityType, arn := "User", fmt.Sprintf("acs:ram::%s:user/%s", user.PrimaryKey, user.LoginName)
+	if roleArn, sessionName := callerRoleArn(client.Config); roleArn != "" {
+		identityType, arn = "AssumedRoleUser", assumedRoleArn(roleArn, sessionName)
+	}
+	roleIds := user.RoleIdList
+	if len(roleIds) == 0 {
+		for _, role := range user.Roles {
+			roleIds = append(roleIds, strconv.Itoa(role.Id))
+		}
+	}
+
+	d.SetId(user.PrimaryKey)
+	d.Set("account_id", user.PrimaryKey)
+	d.Set("arn", arn)
+	d.Set("identity_type", identityType)
+	d.Set("user_id", strconv.Itoa(user.Id))
+	d.Set("login_name", user.LoginName)
+	d.Set("display_name", user.DisplayName)
+	d.Set("default_role_id", user.DefaultRole.Id)
+	if err := d.Set("role_ids", roleIds); err != nil {
+		return WrapError(err)
+	}
+	d.Set("department", client.Department)
+	d.Set("resource_group", client.ResourceGroup)
+	d.Set("resource_group_set_name", client.Config.ResourceSetName)
+	return nil
+}
+
+// callerRoleArn returns the RAM role the credentials of the provider were assumed from, and its session name.
+func callerRoleArn(config *connectivity.Config) (string, string) {
+	if config.RamRoleArn != "" {
+		return config.RamRoleArn, config.RamRoleSessionName
+	}
+	if config.CredentialsSource == "oidc" && config.OIDCRoleArn != "" {
+		return config.OIDCRoleArn, config.OIDCSessionName
+	}
+	if profile := config.ResolvedProfile; profile != nil && profile.RoleArn != "" {
+		return profile.RoleArn, profile.SessionName
+	}
+	return "", ""
+}
+
+// assumedRoleArn turns acs:ram::<account>:role/<name> into acs:ram::<account>:assumed-role/<name>/<session>.
+func assumedRoleArn(roleArn, sessionName string) string {
+	if sessionName == "" {
+		sessionName = "terraform"
+	}
+	parts := strings.SplitN(roleArn, ":role/", 2)
+	if len(parts) != 2 {
+		return roleArn
+	}
+	return fmt.Sprintf("%s:assumed-role/%s/%s", parts[0], parts[1], sessionName)
+}
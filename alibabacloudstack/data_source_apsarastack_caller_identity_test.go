@@ -0,0 +1,88 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestUnitAlibabacloudStackCallerIdentityDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("caller_identity")
+	client := server.client()
+
+	ds := dataSourceAlibabacloudStackCallerIdentity()
+	d := ds.TestResourceData()
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_caller_identity got an error: %#v", diags)
+	}
+	for key, expected := range map[string]interface{}{
+		"account_id":      "1234567890",
+		"arn":             "acs:ram::1234567890:user/terraform",
+		"identity_type":   "User",
+		"user_id":         "42",
+		"login_name":      "terraform",
+		"default_role_id": 2,
+		"role_ids":        []interface{}{"2", "7"},
+		"department":      mockApiDepartment,
+		"resource_group":  mockApiResourceGroup,
+	} {
+		if actual := d.Get(key); !reflect.DeepEqual(actual, expected) {
+			t.Errorf("expected the %s of the caller identity to be %#v, got %#v", key, expected, actual)
+		}
+	}
+	if d.Id() != "1234567890" {
+		t.Errorf("expected the id of the caller identity to be the account id, got %q", d.Id())
+	}
+
+	// The arn of the credentials assumed from a role is the one of the role session
+	client.Config.RamRoleArn = "acs:ram::1234567890:role/admin"
+	client.Config.RamRoleSessionName = "ci"
+	d = ds.TestResourceData()
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_caller_identity got an error: %#v", diags)
+	}
+	if arn := d.Get("arn").(string); arn != "acs:ram::1234567890:assumed-role/admin/ci" {
+		t.Errorf("expected the arn of the assumed role session, got %q", arn)
+	}
+
+	ds = dataSourceAlibabacloudStackProviderConfig()
+	d = ds.TestResourceData()
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_provider_config got an error: %#v", diags)
+	}
+	if region := d.Get("region").(string); region != mockApiRegion {
+		t.Errorf("expected the region of the provider config to be %q, got %q", mockApiRegion, region)
+	}
+	if protocol := d.Get("protocol").(string); protocol != "HTTP" {
+		t.Errorf("expected the protocol of the provider config to be HTTP, got %q", protocol)
+	}
+	if endpoint := d.Get("endpoints.vpc"); endpoint != server.endpoint() {
+		t.Errorf("expected the vpc endpoint of the provider config to be %q, got %v", server.endpoint(), endpoint)
+	}
+	if source := d.Get("credentials_source").(string); source != "provider" {
+		t.Errorf("expected the credentials of the provider config to come from the provider block, got %q", source)
+	}
+}
+
+func TestUnitAlibabacloudStackCallerIdentityDataSource_temporaryCredentials(t *testing.T) {
+	server := newMockApiServer(t).
+		on("AssumeRoleWithOIDC", mockApiOIDCCredentials("MockSecurityToken", time.Now().Add(time.Hour))).
+		loadFixture("caller_identity")
+	client := server.oidcClient(t)
+
+	ds := dataSourceAlibabacloudStackCallerIdentity()
+	d := ds.TestResourceData()
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_caller_identity with temporary credentials got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("GetUserInfo"); !ok || call.Params["SecurityToken"] != "MockSecurityToken" {
+		t.Errorf("expected GetUserInfo to be sent with the security token of the temporary credentials, got %v", call.Params)
+	}
+	if identityType := d.Get("identity_type").(string); identityType != "AssumedRoleUser" {
+		t.Errorf("expected the identity type of the OIDC credentials to be AssumedRoleUser, got %q", identityType)
+	}
+	if arn := d.Get("arn").(string); arn != "acs:ram::1234567890:assumed-role/mock/terraform" {
+		t.Errorf("expected the arn of the OIDC role session, got %q", arn)
+	}
+}
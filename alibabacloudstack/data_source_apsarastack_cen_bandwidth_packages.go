@@ -0,0 +1,159 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenBandwidthPackages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenBandwidthPackagesRead),
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				ForceNew:     true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"packages": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"geographic_region_a_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"geographic_region_b_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	action := "DescribeCenBandwidthPackages"
+	request := make(map[string]interface{})
+	filter := 1
+	if v, ok := d.GetOk("instance_id"); ok {
+		request[fmt.Sprintf("Filter.%d.Key", filter)] = "CenId"
+		request[fmt.Sprintf("Filter.%d.Value.1", filter)] = v
+		filter++
+	}
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		request[fmt.Sprintf("Filter.%d.Key", filter)] = "CenBandwidthPackageId"
+		for i, id := range v.([]interface{}) {
+			request[fmt.Sprintf("Filter.%d.Value.%d", filter, i+1)] = id
+		}
+	}
+	packages, err := cenService.describeAll(action, request, "$.CenBandwidthPackages.CenBandwidthPackage")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_bandwidth_packages", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		nameRegex = r
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, v := range packages {
+		object := v.(map[string]interface{})
+		if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(object["Name"])) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":                     fmt.Sprint(object["CenBandwidthPackageId"]),
+			"name":                   fmt.Sprint(object["Name"]),
+			"description":            fmt.Sprint(object["Description"]),
+			"bandwidth":              formatInt(object["Bandwidth"]),
+			"geographic_region_a_id": fmt.Sprint(object["GeographicRegionAId"]),
+			"geographic_region_b_id": fmt.Sprint(object["GeographicRegionBId"]),
+			"status":                 fmt.Sprint(object["Status"]),
+			"instance_ids":           cenStringList(object, "$.CenIds.CenId"),
+		}
+		ids = append(ids, fmt.Sprint(object["CenBandwidthPackageId"]))
+		names = append(names, fmt.Sprint(object["Name"]))
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("packages", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenInstancesRead),
+
+		Schema: map[string]*schema.Schema{
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				ForceNew:     true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"protection_level": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bandwidth_package_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	action := "DescribeCens"
+	request := make(map[string]interface{})
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		request["Filter.1.Key"] = "CenId"
+		for i, id := range v.([]interface{}) {
+			request[fmt.Sprintf("Filter.1.Value.%d", i+1)] = id
+		}
+	}
+	cens, err := cenService.describeAll(action, request, "$.Cens.Cen")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_instances", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		nameRegex = r
+	}
+
+	var ids []string
+	var names []string
+	var s []map[string]interface{}
+	for _, v := range cens {
+		object := v.(map[string]interface{})
+		if nameRegex != nil && !nameRegex.MatchString(fmt.Sprint(object["Name"])) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":                    fmt.Sprint(object["CenId"]),
+			"name":                  fmt.Sprint(object["Name"]),
+			"description":           fmt.Sprint(object["Description"]),
+			"protection_level":      fmt.Sprint(object["ProtectionLevel"]),
+			"status":                fmt.Sprint(object["Status"]),
+			"bandwidth_package_ids": cenStringList(object, "$.CenBandwidthPackageIds.CenBandwidthPackageId"),
+		}
+		ids = append(ids, fmt.Sprint(object["CenId"]))
+		names = append(names, fmt.Sprint(object["Name"]))
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("instances", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackCenRouteEntries() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenRouteEntriesRead),
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"entries": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cidr_block": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_hop_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"publish_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operational_mode": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackCenRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("instance_id").(string)
+	childId := d.Get("child_instance_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+	childType, err := GetCenChildInstanceType(childId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "DescribePublishedRouteEntries"
+	request := map[string]interface{}{
+		"CenId":                     cenId,
+		"ChildInstanceId":           childId,
+		"ChildInstanceType":         childType,
+		"ChildInstanceRegionId":     client.RegionId,
+		"ChildInstanceRouteTableId": routeTableId,
+	}
+	if v, ok := d.GetOk("cidr_block"); ok {
+		request["DestinationCidrBlock"] = v
+	}
+	entries, err := cenService.describeAll(action, request, "$.PublishedRouteEntries.PublishedRouteEntry")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_route_entries", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, v := range entries {
+		object := v.(map[string]interface{})
+		cidrBlock := fmt.Sprint(object["DestinationCidrBlock"])
+		id := strings.Join([]string{cenId, childId, routeTableId, cidrBlock}, COLON_SEPARATED)
+		mapping := map[string]interface{}{
+			"id":               id,
+			"route_table_id":   routeTableId,
+			"cidr_block":       cidrBlock,
+			"next_hop_type":    fmt.Sprint(object["NextHopType"]),
+			"next_hop_id":      fmt.Sprint(object["NextHopId"]),
+			"route_type":       fmt.Sprint(object["RouteType"]),
+			"publish_status":   fmt.Sprint(object["PublishStatus"]),
+			"operational_mode": object["OperationalMode"] == true,
+		}
+		ids = append(ids, id)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("entries", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
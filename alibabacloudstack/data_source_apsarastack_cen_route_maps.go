@@ -0,0 +1,193 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackCenRouteMaps() *schema.Resource {
+	r := &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCenRouteMapsRead),
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cen_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"transmit_direction": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RegionIn", "RegionOut"}, false),
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"description_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				ForceNew:     true,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"maps": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"route_map_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cen_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"cen_region_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"transmit_direction": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"map_result": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"next_priority": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+	maps := r.Schema["maps"].Elem.(*schema.Resource)
+	for key := range cenRouteMapMatchConditions {
+		maps.Schema[key] = &schema.Schema{
+			Type:     schema.TypeList,
+			Computed: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	return r
+}
+
+func dataSourceAlibabacloudStackCenRouteMapsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("cen_id").(string)
+	action := "DescribeCenRouteMaps"
+	request := map[string]interface{}{
+		"CenId": cenId,
+	}
+	if v, ok := d.GetOk("cen_region_id"); ok {
+		request["CenRegionId"] = v
+	}
+	if v, ok := d.GetOk("transmit_direction"); ok {
+		request["TransmitDirection"] = v
+	}
+	routeMaps, err := cenService.describeAll(action, request, "$.RouteMaps.RouteMap")
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_cen_route_maps", action, AlibabacloudStackSdkGoERROR)
+	}
+
+	idsMap := make(map[string]string)
+	if v, ok := d.GetOk("ids"); ok {
+		for _, id := range v.([]interface{}) {
+			idsMap[fmt.Sprint(id)] = fmt.Sprint(id)
+		}
+	}
+	var descriptionRegex *regexp.Regexp
+	if v, ok := d.GetOk("description_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		descriptionRegex = r
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, v := range routeMaps {
+		object := v.(map[string]interface{})
+		routeMapId := fmt.Sprint(object["RouteMapId"])
+		if len(idsMap) > 0 {
+			if _, ok := idsMap[routeMapId]; !ok {
+				continue
+			}
+		}
+		if descriptionRegex != nil && !descriptionRegex.MatchString(fmt.Sprint(object["Description"])) {
+			continue
+		}
+		id := fmt.Sprintf("%s%s%s", cenId, COLON_SEPARATED, routeMapId)
+		mapping := map[string]interface{}{
+			"id":                 id,
+			"route_map_id":       routeMapId,
+			"cen_id":             cenId,
+			"cen_region_id":      fmt.Sprint(object["CenRegionId"]),
+			"transmit_direction": fmt.Sprint(object["TransmitDirection"]),
+			"priority":           formatInt(object["Priority"]),
+			"map_result":         fmt.Sprint(object["MapResult"]),
+			"description":        fmt.Sprint(object["Description"]),
+			"status":             fmt.Sprint(object["Status"]),
+		}
+		if v, ok := object["NextPriority"]; ok {
+			mapping["next_priority"] = formatInt(v)
+		}
+		for key, condition := range cenRouteMapMatchConditions {
+			mapping[key] = cenStringList(object, condition[1])
+		}
+		ids = append(ids, routeMapId)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("maps", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -1,20 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackCloudFirewallControlPolicies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead),
 		Schema: map[string]*schema.Schema{
 			"acl_action": {
 				Type:         schema.TypeString,
@@ -194,7 +193,7 @@ func dataSourceAlibabacloudStackCloudFirewallControlPolicies() *schema.Resource
 	}
 }
 
-func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeControlPolicy"
@@ -241,18 +240,7 @@ func dataSourceAlibabacloudStackCloudFirewallControlPoliciesRead(d *schema.Resou
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudStack_cloud_firewall_control_policies", action, AlibabacloudStackSdkGoERROR)
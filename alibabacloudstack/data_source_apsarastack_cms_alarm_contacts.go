@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudstackCmsAlarmContacts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmContactsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackCmsAlarmContactsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -69,7 +70,7 @@ func dataSourceAlibabacloudstackCmsAlarmContacts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsAlarmContactsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsAlarmContactsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudstackCmsAlarmContactsRead(d *schema.ResourceData, met
 	request.RegionId = client.RegionId
 	request.ApiName = "ListCmsContacts"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Product": "ascm", "RegionId": client.RegionId, "Action": "ListCmsContacts", "Version": string(connectivity.ApiVersion20190510)}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Product": "ascm", "RegionId": client.RegionId, "Action": "ListCmsContacts", "Version": string(connectivity.ApiVersion20190510)}
 	response := CmsContact{}
 
 	for {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudstackCmsAlarms() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsAlarmsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackCmsAlarmsRead),
 		Schema: map[string]*schema.Schema{
 			"rule_id": {
 				Type:         schema.TypeString,
@@ -179,7 +180,7 @@ func dataSourceAlibabacloudstackCmsAlarms() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudstackCmsAlarmsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsAlarmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -197,12 +198,11 @@ func dataSourceAlibabacloudstackCmsAlarmsRead(d *schema.ResourceData, meta inter
 	request.ApiName = "DescribeMetricRuleList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeMetricRuleList",
-		"Version":         "2019-01-01",
+		"AccessKeyId": client.AccessKey,
+		"Product":     "Cms",
+		"RegionId":    client.RegionId,
+		"Action":      "DescribeMetricRuleList",
+		"Version":     "2019-01-01",
 	}
 	response := AlarmsData{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudstackCmsMetricMetalist() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackCmsMetricMetalistRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackCmsMetricMetalistRead),
 		Schema: map[string]*schema.Schema{
 			"namespace": {
 				Type:         schema.TypeString,
@@ -75,7 +76,7 @@ func dataSourceAlibabacloudstackCmsMetricMetalist() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackCmsMetricMetalistRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackCmsMetricMetalistRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	Namespace := d.Get("namespace").(string)
 	request := requests.NewCommonRequest()
@@ -95,14 +96,13 @@ func dataSourceAlibabacloudstackCmsMetricMetalistRead(d *schema.ResourceData, me
 	request.ApiName = "DescribeMetricMetaList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Cms",
-		"RegionId":        client.RegionId,
-		"Action":          "DescribeMetricMetaList",
-		"Version":         "2019-01-01",
-		"Namespace":       Namespace,
-		"ProductName":     "cms",
+		"AccessKeyId": client.AccessKey,
+		"Product":     "Cms",
+		"RegionId":    client.RegionId,
+		"Action":      "DescribeMetricMetaList",
+		"Version":     "2019-01-01",
+		"Namespace":   Namespace,
+		"ProductName": "cms",
 	}
 	response := MetaList{}
 
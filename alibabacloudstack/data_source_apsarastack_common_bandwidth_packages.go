@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCommonBandwidthPackagesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCommonBandwidthPackagesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -105,7 +106,7 @@ func dataSourceAlibabacloudStackCommonBandwidthPackages() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeCommonBandwidthPackagesRequest()
@@ -116,7 +117,7 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -137,16 +138,11 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 			WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		if err := invoker.Run(func() error {
-			response, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeCommonBandwidthPackages(request)
-			})
-			raw = response
-			return err
-		}); err != nil {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeCommonBandwidthPackages(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_common_bandwidth_packages", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -180,12 +176,12 @@ func dataSourceAlibabacloudStackCommonBandwidthPackagesRead(d *schema.ResourceDa
 		}
 	}
 
-	return CommonBandwidthPackagesDecriptionAttributes(d, allCommonBandwidthPackages, meta)
+	return CommonBandwidthPackagesDecriptionAttributes(ctx, d, allCommonBandwidthPackages, meta)
 }
 
-func CommonBandwidthPackagesDecriptionAttributes(d *schema.ResourceData, cbwps []vpc.CommonBandwidthPackage, meta interface{}) error {
+func CommonBandwidthPackagesDecriptionAttributes(ctx context.Context, d *schema.ResourceData, cbwps []vpc.CommonBandwidthPackage, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEEInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEEInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEEInstancesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -90,9 +91,9 @@ func dataSourceAlibabacloudStackCrEEInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEEInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEEInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	pageNo := 1
 	pageSize := 50
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEEReposRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEEReposRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,9 +127,9 @@ func dataSourceAlibabacloudStackCrEERepos() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEEReposRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEEReposRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	pageNo := 1
 	pageSize := 100
 	instanceId := d.Get("instance_id").(string)
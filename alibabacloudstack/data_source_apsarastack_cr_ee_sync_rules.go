@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"sort"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCrEESyncRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCrEESyncRulesRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -126,9 +127,9 @@ func dataSourceAlibabacloudStackCrEESyncRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCrEESyncRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 
 	var (
@@ -166,7 +167,7 @@ func dataSourceAlibabacloudStackCrEESyncRulesRead(d *schema.ResourceData, meta i
 		response := &cr_ee.ListRepoSyncRuleResponse{}
 		request := cr_ee.CreateListRepoSyncRuleRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.RegionId = crService.client.RegionId
 		request.InstanceId = instanceId
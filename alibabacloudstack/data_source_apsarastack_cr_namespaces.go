@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCRNamespacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCRNamespacesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -62,9 +63,9 @@ func dataSourceAlibabacloudStackCRNamespaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCRNamespacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := CrService{client}
+	crService := CrService{client, ctx}
 	//invoker := NewInvoker()
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -79,14 +80,13 @@ func dataSourceAlibabacloudStackCRNamespacesRead(d *schema.ResourceData, meta in
 	request.ApiName = "GetNamespaceList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetNamespaceList",
-		"Version":         "2016-06-07",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "GetNamespaceList",
+		"Version":       "2016-06-07",
 	}
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackCRRepos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCRReposRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCRReposRead),
 
 		Schema: map[string]*schema.Schema{
 			"namespace": {
@@ -100,7 +101,7 @@ func dataSourceAlibabacloudStackCRRepos() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackCRReposRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCRReposRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -115,14 +116,13 @@ func dataSourceAlibabacloudStackCRReposRead(d *schema.ResourceData, meta interfa
 	request.ApiName = "GetRepoList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetRepoList",
-		"Version":         "2016-06-07",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "GetRepoList",
+		"Version":       "2016-06-07",
 	}
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -20,7 +21,7 @@ import (
 
 func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackCSKubernetesClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackCSKubernetesClustersRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -301,7 +302,7 @@ func dataSourceAlibabacloudStackCSKubernetesClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackCSKubernetesClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -321,7 +322,7 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 	request.ServiceCode = "cs"
 	request.ApiName = "DescribeClustersV1"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "Cs", "RegionId": client.RegionId, "Action": "DescribeClustersV1", "Version": cs.CSAPIVersion, "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "Cs", "RegionId": client.RegionId, "Action": "DescribeClustersV1", "Version": cs.CSAPIVersion, "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	Cresponse := ClustersV1{}
 	Clusterresponse := ClustersV1{}
@@ -433,7 +434,6 @@ func dataSourceAlibabacloudStackCSKubernetesClustersRead(d *schema.ResourceData,
 			request.RegionId = client.RegionId
 			request.QueryParams = map[string]string{
 				"AccessKeyId":      client.AccessKey,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "Cs",
 				"RegionId":         client.RegionId,
 				"Action":           "DescribeClustersV1",
@@ -1,15 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 
 	"strings"
-	"time"
 
 	_ "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDatahubServiceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDatahubServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -33,7 +32,7 @@ func dataSourceAlibabacloudStackDatahubService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDatahubServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
 		d.SetId("DatahubServiceHasNotBeenOpened")
@@ -55,30 +54,19 @@ func dataSourceAlibabacloudStackDatahubServiceRead(d *schema.ResourceData, meta
 	request.ApiName = "OpenDataHubService"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Action":          "OpenDataHubService",
-		"Version":         "2019-11-20",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Action":        "OpenDataHubService",
+		"Version":       "2019-11-20",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
-			return dataHubClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if IsExpectedErrors(err, []string{"QPS Limit Exceeded"}) || NeedRetry(err) {
-				return resource.RetryableError(err)
-			}
-			addDebug(action, response, nil)
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, nil)
-		return nil
+	response, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
+		return dataHubClient.ProcessCommonRequest(request)
 	})
+	addDebug(action, response, nil)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"ORDER.OPEND"}) {
 			d.SetId("DatahubServiceHasBeenOpened")
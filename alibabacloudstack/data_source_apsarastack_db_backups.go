@@ -0,0 +1,174 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackDBBackups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBBackupsRead),
+
+		Schema: map[string]*schema.Schema{
+			"db_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"start_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"backup_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Success", "Failed"}, false),
+			},
+			"backup_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Automated", "Manual"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"recovery_begin_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recovery_end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"backups": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_instance_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_mode": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_start_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backup_end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"consistent_time": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"backup_db_names": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"available": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDBBackupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rdsService := RdsService{client, ctx}
+	instanceId := d.Get("db_instance_id").(string)
+
+	backups, err := rdsService.DescribeDBBackups(instanceId, d.Get("start_time").(string), d.Get("end_time").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, backup := range backups {
+		if v, ok := d.GetOk("backup_status"); ok && backup.BackupStatus != v.(string) {
+			continue
+		}
+		if v, ok := d.GetOk("backup_mode"); ok && backup.BackupMode != v.(string) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"id":                backup.BackupId,
+			"db_instance_id":    backup.DBInstanceId,
+			"backup_status":     backup.BackupStatus,
+			"backup_mode":       backup.BackupMode,
+			"backup_method":     backup.BackupMethod,
+			"backup_type":       backup.BackupType,
+			"backup_size":       int(backup.BackupSize),
+			"backup_start_time": backup.BackupStartTime,
+			"backup_end_time":   backup.BackupEndTime,
+			"consistent_time":   int(backup.ConsistentTime),
+			"backup_db_names":   backup.BackupDBNames,
+			"available":         backup.IsAvail == 1 && backup.BackupStatus == "Success",
+		}
+		ids = append(ids, backup.BackupId)
+		s = append(s, mapping)
+	}
+
+	recovery, err := rdsService.DescribeDBRecoveryTime(instanceId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{instanceId}, ids...)))
+	if err := d.Set("backups", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	d.Set("recovery_begin_time", recovery.RecoveryBeginTime)
+	d.Set("recovery_end_time", recovery.RecoveryEndTime)
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackDBBackupsDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeBackups", map[string]interface{}{
+		"TotalRecordCount": "3",
+		"Items": map[string]interface{}{
+			"Backup": []interface{}{
+				map[string]interface{}{
+					"BackupId":        "101",
+					"DBInstanceId":    "rm-mock0001",
+					"BackupStatus":    "Success",
+					"BackupMode":      "Automated",
+					"BackupMethod":    "Physical",
+					"BackupType":      "FullBackup",
+					"BackupSize":      2048,
+					"BackupStartTime": "2026-10-16T18:00:00Z",
+					"BackupEndTime":   "2026-10-16T18:10:00Z",
+					"ConsistentTime":  1792173000,
+					"IsAvail":         1,
+				},
+				map[string]interface{}{
+					"BackupId":     "102",
+					"DBInstanceId": "rm-mock0001",
+					"BackupStatus": "Failed",
+					"BackupMode":   "Manual",
+					"IsAvail":      0,
+				},
+				map[string]interface{}{
+					"BackupId":     "103",
+					"DBInstanceId": "rm-mock0001",
+					"BackupStatus": "Success",
+					"BackupMode":   "Manual",
+					"IsAvail":      0,
+				},
+			},
+		},
+	}).on("DescribeLocalAvailableRecoveryTime", map[string]interface{}{
+		"DBInstanceId":      "rm-mock0001",
+		"RecoveryBeginTime": "2026-10-10T18:10:00Z",
+		"RecoveryEndTime":   "2026-10-18T06:00:00Z",
+	})
+	client := server.client()
+
+	ds := dataSourceAlibabacloudStackDBBackups()
+	d := ds.TestResourceData()
+	d.Set("db_instance_id", "rm-mock0001")
+	d.Set("backup_status", "Success")
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_db_backups got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("DescribeBackups"); !ok || call.Params["DBInstanceId"] != "rm-mock0001" {
+		t.Errorf("expected the backups of the instance to be described, got %v", call.Params)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 2 || ids[0] != "101" || ids[1] != "103" {
+		t.Errorf("expected the successful backups, got %v", ids)
+	}
+	if d.Get("backups.0.available") != true || d.Get("backups.0.backup_size") != 2048 || d.Get("backups.0.consistent_time") != 1792173000 ||
+		d.Get("backups.1.available") != false {
+		t.Errorf("expected the backups to be flattened, got %v", d.Get("backups"))
+	}
+	if d.Get("recovery_begin_time") != "2026-10-10T18:10:00Z" || d.Get("recovery_end_time") != "2026-10-18T06:00:00Z" {
+		t.Errorf("expected the recovery time window, got %v - %v", d.Get("recovery_begin_time"), d.Get("recovery_end_time"))
+	}
+}
@@ -0,0 +1,140 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackDBInstanceClasses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBInstanceClassesRead),
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(MySQL), string(PostgreSQL)}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"local_ssd", "cloud_ssd", "cloud_pperf", "cloud_sperf"}, false),
+			},
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      PostPaid,
+				ValidateFunc: validation.StringInSlice([]string{"PrePaid", "PostPaid"}, false),
+			},
+			"category": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "HighAvailability",
+				ValidateFunc: validation.StringInSlice([]string{"Basic", "HighAvailability", "AlwaysOn", "Finance"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"instance_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"storage_range": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"min": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"max": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"step": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDBInstanceClassesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rdsService := RdsService{client, ctx}
+
+	request := rds.CreateDescribeAvailableClassesRequest()
+	request.ZoneId = d.Get("zone_id").(string)
+	request.Engine = d.Get("engine").(string)
+	request.EngineVersion = d.Get("engine_version").(string)
+	request.DBInstanceStorageType = d.Get("storage_type").(string)
+	request.InstanceChargeType = d.Get("instance_charge_type").(string)
+	request.Category = d.Get("category").(string)
+	classes, err := rdsService.DescribeAvailableDBInstanceClasses(request)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, class := range classes {
+		mapping := map[string]interface{}{
+			"instance_class": class.DBInstanceClass,
+			"storage_range": []map[string]interface{}{
+				{
+					"min":  class.DBInstanceStorageRange.Min,
+					"max":  class.DBInstanceStorageRange.Max,
+					"step": class.DBInstanceStorageRange.Step,
+				},
+			},
+		}
+		ids = append(ids, class.DBInstanceClass)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{request.ZoneId, request.Engine, request.EngineVersion}, ids...)))
+	if err := d.Set("instance_classes", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackDBInstanceClassesDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeAvailableClasses", map[string]interface{}{
+		"DBInstanceClasses": []interface{}{
+			map[string]interface{}{
+				"DBInstanceClass":        "rds.mysql.s2.large",
+				"DBInstanceStorageRange": map[string]interface{}{"Min": 5, "Max": 2000, "Step": 5},
+			},
+			map[string]interface{}{
+				"DBInstanceClass":        "rds.mysql.m1.medium",
+				"DBInstanceStorageRange": map[string]interface{}{"Min": 5, "Max": 3000, "Step": 5},
+			},
+		},
+	})
+	client := server.client()
+
+	ds := dataSourceAlibabacloudStackDBInstanceClasses()
+	d := newMockApiResourceData(t, ds, map[string]interface{}{
+		"zone_id":        "cn-qingdao-env66-d01-a",
+		"engine":         "MySQL",
+		"engine_version": "5.7",
+		"storage_type":   "local_ssd",
+	})
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_db_instance_classes got an error: %#v", diags)
+	}
+	call, ok := server.lastCall("DescribeAvailableClasses")
+	if !ok || call.Params["ZoneId"] != "cn-qingdao-env66-d01-a" || call.Params["DBInstanceStorageType"] != "local_ssd" ||
+		call.Params["InstanceChargeType"] != "PostPaid" || call.Params["Category"] != "HighAvailability" {
+		t.Errorf("DescribeAvailableClasses was not called with the expected parameters: %v", call.Params)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 2 || ids[0] != "rds.mysql.s2.large" {
+		t.Errorf("expected the available instance classes, got %v", ids)
+	}
+	if d.Get("instance_classes.1.storage_range.0.max") != 3000 || d.Get("instance_classes.1.storage_range.0.step") != 5 {
+		t.Errorf("expected the storage range to be flattened, got %v", d.Get("instance_classes"))
+	}
+
+	if diags := ds.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"zone_id":        "cn-qingdao-env66-d01-a",
+		"engine":         "MySQL",
+		"engine_version": "5.7",
+		"storage_type":   "local_ssd",
+		"category":       "Serverless",
+	})); !diags.HasError() {
+		t.Errorf("expected an unknown category to fail the validation")
+	}
+}
@@ -0,0 +1,133 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackDBInstanceParameters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBInstanceParametersRead),
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(MySQL), string(PostgreSQL)}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parameters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"default_value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"checking_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"force_modify": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"force_restart": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackDBInstanceParametersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rdsService := RdsService{client, ctx}
+	engine := d.Get("engine").(string)
+	engineVersion := d.Get("engine_version").(string)
+
+	records, err := rdsService.DescribeDBParameterTemplates(d.Get("db_instance_id").(string), engine, engineVersion)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		nameRegex = r
+	}
+
+	var names []string
+	var s []map[string]interface{}
+	for _, record := range records {
+		if nameRegex != nil && !nameRegex.MatchString(record.ParameterName) {
+			continue
+		}
+		mapping := map[string]interface{}{
+			"name":          record.ParameterName,
+			"default_value": record.ParameterValue,
+			"checking_code": record.CheckingCode,
+			"force_modify":  record.ForceModify == "true",
+			"force_restart": record.ForceRestart == "true",
+			"description":   record.ParameterDescription,
+		}
+		names = append(names, record.ParameterName)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{engine, engineVersion}, names...)))
+	if err := d.Set("parameters", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackDBInstanceParametersDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeParameterTemplates", map[string]interface{}{
+		"Engine":        "MySQL",
+		"EngineVersion": "5.7",
+		"Parameters": map[string]interface{}{
+			"TemplateRecord": []interface{}{
+				map[string]interface{}{
+					"ParameterName":        "back_log",
+					"ParameterValue":       "3000",
+					"CheckingCode":         "[0-65535]",
+					"ForceModify":          "true",
+					"ForceRestart":         "true",
+					"ParameterDescription": "The number of outstanding connection requests.",
+				},
+				map[string]interface{}{
+					"ParameterName":  "wait_timeout",
+					"ParameterValue": "86400",
+					"CheckingCode":   "[1-31536000]",
+					"ForceModify":    "true",
+					"ForceRestart":   "false",
+				},
+				map[string]interface{}{
+					"ParameterName":  "innodb_buffer_pool_size",
+					"ParameterValue": "{DBInstanceClassMemory*3/4}",
+					"ForceModify":    "false",
+					"ForceRestart":   "true",
+				},
+			},
+		},
+	})
+	client := server.client()
+
+	ds := dataSourceAlibabacloudStackDBInstanceParameters()
+	d := ds.TestResourceData()
+	d.Set("engine", "MySQL")
+	d.Set("engine_version", "5.7")
+	d.Set("name_regex", "^(back_log|wait_timeout)$")
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alibabacloudstack_db_instance_parameters got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("DescribeParameterTemplates"); !ok || call.Params["Engine"] != "MySQL" || call.Params["EngineVersion"] != "5.7" {
+		t.Errorf("expected the parameter templates of the engine to be described, got %v", call.Params)
+	}
+	if names := d.Get("names").([]interface{}); len(names) != 2 || names[0] != "back_log" || names[1] != "wait_timeout" {
+		t.Errorf("expected the parameters matching the name_regex, got %v", names)
+	}
+	if d.Get("parameters.0.default_value") != "3000" || d.Get("parameters.0.checking_code") != "[0-65535]" ||
+		d.Get("parameters.0.force_restart") != true || d.Get("parameters.1.force_restart") != false {
+		t.Errorf("expected the parameters to be flattened, got %v", d.Get("parameters"))
+	}
+}
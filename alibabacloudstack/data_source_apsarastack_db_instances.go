@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strings"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -187,7 +188,7 @@ func dataSourceAlibabacloudStackDBInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := rds.CreateDescribeDBInstancesRequest()
@@ -198,7 +199,7 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Engine = d.Get("engine").(string)
 	request.DBInstanceStatus = d.Get("status").(string)
 	request.DBInstanceType = d.Get("db_type").(string)
@@ -274,12 +275,12 @@ func dataSourceAlibabacloudStackDBInstancesRead(d *schema.ResourceData, meta int
 		}
 		request.PageNumber = page
 	}
-	return rdsInstancesDescription(d, meta, dbi)
+	return rdsInstancesDescription(ctx, d, meta, dbi)
 }
 
-func rdsInstancesDescription(d *schema.ResourceData, meta interface{}, dbi []rds.DBInstance) error {
+func rdsInstancesDescription(ctx context.Context, d *schema.ResourceData, meta interface{}, dbi []rds.DBInstance) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	var ids []string
 	var names []string
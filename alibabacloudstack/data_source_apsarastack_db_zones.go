@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDBZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDBZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -60,7 +61,7 @@ func dataSourceAlibabacloudStackDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	multi := d.Get("multi").(bool)
@@ -73,17 +74,13 @@ func dataSourceAlibabacloudStackDBZonesRead(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	var response = &rds.DescribeRegionsResponse{}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (i interface{}, err error) {
 			return rdsClient.DescribeRegions(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling}) {
-				time.Sleep(time.Duration(3) * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"strings"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDisksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDisksRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -145,7 +146,7 @@ func dataSourceAlibabacloudStackDisks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDisksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDescribeDisksRequest()
@@ -156,7 +157,7 @@ func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
 		request.DiskIds = convertListToJsonString(v.([]interface{}))
 	}
@@ -243,12 +244,12 @@ func dataSourceAlibabacloudStackDisksRead(d *schema.ResourceData, meta interface
 	} else {
 		filteredDisksTemp = allDisks
 	}
-	return disksDescriptionAttributes(d, filteredDisksTemp, meta)
+	return disksDescriptionAttributes(ctx, d, filteredDisksTemp, meta)
 }
 
-func disksDescriptionAttributes(d *schema.ResourceData, disks []ecs.Disk, meta interface{}) error {
+func disksDescriptionAttributes(ctx context.Context, d *schema.ResourceData, disks []ecs.Disk, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	var ids []string
 	var s []map[string]interface{}
 	for _, disk := range disks {
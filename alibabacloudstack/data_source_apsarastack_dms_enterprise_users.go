@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackDmsEnterpriseUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDmsEnterpriseUsersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDmsEnterpriseUsersRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -112,7 +113,7 @@ func dataSourceAlibabacloudStackDmsEnterpriseUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDmsEnterpriseUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListUsers"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsDomainsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsDomainsRead),
 
 		Schema: map[string]*schema.Schema{
 			"domain_name": {
@@ -114,7 +115,7 @@ func dataSourceAlibabacloudStackDnsDomains() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsDomainsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -132,7 +133,6 @@ func dataSourceAlibabacloudStackDnsDomainsRead(d *schema.ResourceData, meta inte
 	request.ApiName = "DescribeGlobalZones"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
 		"AccessKeyId":       client.AccessKey,
 		"Product":           "CloudDns",
 		"RegionId":          client.RegionId,
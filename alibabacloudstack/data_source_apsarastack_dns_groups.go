@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -56,12 +57,12 @@ func dataSourceAlibabacloudStackDnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := alidns.CreateDescribeDomainGroupsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns", "product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns", "product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	var allGroups []alidns.DomainGroup
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDnsRecordsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDnsRecordsRead),
 
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
@@ -88,7 +89,7 @@ func dataSourceAlibabacloudStackDnsRecords() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDnsRecordsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ZoneId := d.Get("zone_id").(string)
 	request := requests.NewCommonRequest()
@@ -106,13 +107,12 @@ func dataSourceAlibabacloudStackDnsRecordsRead(d *schema.ResourceData, meta inte
 	request.ApiName = "DescribeGlobalZoneRecords"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"RegionId":        client.RegionId,
-		"Product":         "CloudDns",
-		"Action":          "DescribeGlobalZoneRecords",
-		"Version":         "2021-06-24",
-		"ZoneId":          ZoneId,
+		"AccessKeyId": client.AccessKey,
+		"RegionId":    client.RegionId,
+		"Product":     "CloudDns",
+		"Action":      "DescribeGlobalZoneRecords",
+		"Version":     "2021-06-24",
+		"ZoneId":      ZoneId,
 	}
 
 	response := DnsRecord{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/drds"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackDRDSInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackDRDSInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackDRDSInstancesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -84,7 +85,7 @@ func dataSourceAlibabacloudStackDRDSInstances() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackDRDSInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackDRDSInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := drds.CreateDescribeDrdsInstancesRequest()
 	request.RegionId = client.RegionId
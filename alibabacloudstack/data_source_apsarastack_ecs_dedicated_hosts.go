@@ -1,21 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDedicatedHostsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsDedicatedHostsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -268,7 +267,7 @@ func dataSourceAlibabacloudStackEcsDedicatedHosts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsDedicatedHostsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDedicatedHosts"
@@ -342,19 +341,8 @@ func dataSourceAlibabacloudStackEcsDedicatedHostsRead(d *schema.ResourceData, me
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_dedicated_hosts", action, AlibabacloudStackSdkGoERROR)
 		}
@@ -1,21 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsDeploymentSetsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsDeploymentSetsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -103,7 +102,7 @@ func dataSourceAlibabacloudStackEcsDeploymentSets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsDeploymentSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeDeploymentSets"
@@ -149,18 +148,7 @@ func dataSourceAlibabacloudStackEcsDeploymentSetsRead(d *schema.ResourceData, me
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ecs_deployment_sets", action, AlibabacloudStackSdkGoERROR)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsEbsStorageSetsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsEbsStorageSetsRead),
 		Schema: map[string]*schema.Schema{
 			"storage_set_name": {
 				Type:     schema.TypeString,
@@ -72,7 +73,7 @@ func dataSourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var addDomains = &datahub.EcsDescribeEcsEbsStorageSetsResult{}
 	action := "DescribeStorageSets"
@@ -92,15 +93,14 @@ func dataSourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, me
 	request.Headers["x-acs-organizationId"] = client.Department
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"RegionId":        client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"PageNumber":      "1",
+		"AccessKeyId":   client.AccessKey,
+		"RegionId":      client.RegionId,
+		"Product":       "Ecs",
+		"Version":       "2014-05-26",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        action,
+		"PageNumber":    "1",
 	}
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
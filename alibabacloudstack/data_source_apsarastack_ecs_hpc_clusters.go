@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEcsHpcClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEcsHpcClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEcsHpcClustersRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -65,7 +66,7 @@ func dataSourceAlibabacloudStackEcsHpcClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEcsHpcClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEcsHpcClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeHpcClusters"
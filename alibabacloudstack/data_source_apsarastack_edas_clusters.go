@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackEdasClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEdasClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEdasClustersRead),
 
 		Schema: map[string]*schema.Schema{
 			"logical_region_id": {
@@ -105,9 +106,9 @@ func dataSourceAlibabacloudStackEdasClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEdasClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEdasClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	logicalRegionId := d.Get("logical_region_id").(string)
 	request := edas.CreateListClusterRequest()
@@ -1,19 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEhpcJobTemplatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEhpcJobTemplatesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -114,7 +113,7 @@ func dataSourceAlibabacloudStackEhpcJobTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEhpcJobTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListJobTemplates"
@@ -141,18 +140,7 @@ func dataSourceAlibabacloudStackEhpcJobTemplatesRead(d *schema.ResourceData, met
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ehpc_job_templates", action, AlibabacloudStackSdkGoERROR)
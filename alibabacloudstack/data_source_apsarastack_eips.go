@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackEips() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEipsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEipsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -75,7 +76,7 @@ func dataSourceAlibabacloudStackEips() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEipsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeEipAddressesRequest()
@@ -86,7 +87,7 @@ func dataSourceAlibabacloudStackEipsRead(d *schema.ResourceData, meta interface{
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackElasticsearch() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackElasticsearchRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackElasticsearchRead),
 
 		Schema: map[string]*schema.Schema{
 			"description_regex": {
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackElasticsearch() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackElasticsearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := elasticsearch.CreateListInstanceRequest()
@@ -120,7 +121,7 @@ func dataSourceAlibabacloudStackElasticsearchRead(d *schema.ResourceData, meta i
 	request.Size = requests.NewInteger(PageSizeLarge)
 	request.Page = requests.NewInteger(1)
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("tags"); ok {
 		var reqTags []map[string]string
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackElaticsearchZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackElaticsearchZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackElaticsearchZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -49,7 +50,7 @@ func dataSourceAlibabacloudStackElaticsearchZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackElaticsearchZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackElaticsearchZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -57,7 +58,7 @@ func dataSourceAlibabacloudStackElaticsearchZonesRead(d *schema.ResourceData, me
 	request := elasticsearch.CreateGetRegionConfigurationRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 		return elasticsearchClient.GetRegionConfiguration(request)
@@ -0,0 +1,65 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEndpointsRead),
+
+		Schema: map[string]*schema.Schema{
+			"products": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	resolved := client.Config.ResolvedEndpoints()
+
+	endpoints := make(map[string]interface{})
+	var ids []string
+	if v, ok := d.GetOk("products"); ok && len(v.([]interface{})) > 0 {
+		// The products are returned under the codes they were asked by, which may be an alias, e.g. sls for log
+		for _, product := range expandStringList(v.([]interface{})) {
+			if endpoint, ok := resolved[connectivity.CanonicalProductCode(product)]; ok {
+				endpoints[product] = endpoint
+				ids = append(ids, product)
+			}
+		}
+	} else {
+		for product, endpoint := range resolved {
+			endpoints[product] = endpoint
+			ids = append(ids, product)
+		}
+	}
+	sort.Strings(ids)
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), endpoints)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackEndpointsDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t)
+	server.on("ListEndpoints", map[string]interface{}{
+		"Success": true,
+		"EndpointList": map[string]interface{}{
+			"ItemEndpoint": []interface{}{
+				map[string]interface{}{"Product": "Vpc", "Endpoint": server.endpoint(), "Type": "openAPI"},
+				map[string]interface{}{"Product": "R-kvstore", "Endpoint": "kvstore.{region}.catalog.example.com", "Type": "openAPI"},
+				map[string]interface{}{"Product": "Slb", "Endpoint": "slb.inner.example.com", "Type": "innerAPI"},
+			},
+		},
+	})
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["endpoints"] = []interface{}{
+		map[string]interface{}{
+			"ecs":      server.endpoint(),
+			"ascm":     server.endpoint(),
+			"location": server.endpoint(),
+		},
+	}
+	config["endpoint_template"] = "{product}.{region}.intra.example.com"
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with the endpoint catalog got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+	if count := server.callCount("ListEndpoints"); count != 1 {
+		t.Errorf("expected the endpoint catalog to be listed once, it was listed %d times", count)
+	}
+	if call, ok := server.lastCall("ListEndpoints"); !ok || call.Params["Id"] != mockApiRegion {
+		t.Errorf("expected the endpoint catalog of the region %s to be listed, got %v", mockApiRegion, call.Params)
+	}
+
+	for _, c := range []struct {
+		product, actual, expected string
+	}{
+		{"ecs", client.Config.EcsEndpoint, server.endpoint()},
+		{"vpc", client.Config.VpcEndpoint, server.endpoint()},
+		{"kvstore", client.Config.KVStoreEndpoint, "kvstore." + mockApiRegion + ".catalog.example.com"},
+		{"slb", client.Config.SlbEndpoint, "slb." + mockApiRegion + ".intra.example.com"},
+		{"rds", client.Config.RdsEndpoint, "rds." + mockApiRegion + ".intra.example.com"},
+	} {
+		if c.actual != c.expected {
+			t.Errorf("expected the endpoint of %s to be %q, got %q", c.product, c.expected, c.actual)
+		}
+	}
+
+	ds := dataSourceAlibabacloudStackEndpoints()
+	d := newMockApiResourceData(t, ds, map[string]interface{}{
+		"products": []interface{}{"vpc", "r-kvstore", "unknown"},
+	})
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the endpoints got an error: %#v", diags)
+	}
+	endpoints := d.Get("endpoints").(map[string]interface{})
+	if len(endpoints) != 2 || endpoints["vpc"] != server.endpoint() || endpoints["r-kvstore"] != "kvstore."+mockApiRegion+".catalog.example.com" {
+		t.Errorf("expected the endpoints of vpc and r-kvstore, got %v", endpoints)
+	}
+}
+
+func TestUnitAlibabacloudStackEndpointsDataSource_ascm(t *testing.T) {
+	server := newMockApiServer(t)
+	server.on("ListEndpoints", map[string]interface{}{
+		"Success": true,
+		"EndpointList": map[string]interface{}{
+			"ItemEndpoint": []interface{}{
+				map[string]interface{}{"Product": "Sls", "Endpoint": "sls.{region}.catalog.example.com", "Type": "openAPI"},
+			},
+		},
+	})
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["endpoints"] = []interface{}{
+		map[string]interface{}{
+			"ecs":  server.endpoint(),
+			"ascm": server.endpoint(),
+		},
+	}
+	config["endpoint_template"] = "{product}.{region}.intra.example.com"
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with the endpoint catalog of ascm got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+	call, ok := server.lastCall("ListEndpoints")
+	if !ok || call.Params["Product"] != "location" || call.Params["Department"] != mockApiDepartment {
+		t.Errorf("expected the endpoint catalog to be listed through the ascm endpoint, got %v", call.Params)
+	}
+
+	ds := dataSourceAlibabacloudStackEndpoints()
+	d := newMockApiResourceData(t, ds, map[string]interface{}{
+		"products": []interface{}{"sls"},
+	})
+	if diags := ds.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the endpoints got an error: %#v", diags)
+	}
+	endpoints := d.Get("endpoints").(map[string]interface{})
+	if len(endpoints) != 1 || endpoints["sls"] != "sls."+mockApiRegion+".catalog.example.com" {
+		t.Errorf("expected the endpoint of sls from the catalog, got %v", endpoints)
+	}
+}
+
+func TestUnitAlibabacloudStackEndpointsDataSource_ascmError(t *testing.T) {
+	server := newMockApiServer(t).onError("ListEndpoints", http.StatusForbidden, "Forbidden.RAM")
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["endpoints"] = []interface{}{
+		map[string]interface{}{
+			"ecs": server.endpoint(),
+		},
+	}
+	config["ascm_endpoint"] = server.endpoint()
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider without the endpoint catalog of ascm got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+	if _, err := client.NewEcsClient(); err != nil {
+		t.Errorf("expected the ecs client of the explicit endpoint, got an error: %#v", err)
+	}
+	_, err := client.NewNasClient()
+	if err == nil || !strings.Contains(err.Error(), "Forbidden.RAM") {
+		t.Errorf("expected the error of the endpoint catalog for the unresolved nas endpoint, got %v", err)
+	}
+}
+
+func TestUnitAlibabacloudStackEndpointsDataSource_explicit(t *testing.T) {
+	server := newMockApiServer(t)
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["endpoints"] = []interface{}{
+		map[string]interface{}{
+			"ecs":  server.endpoint(),
+			"ascm": server.endpoint(),
+			"adb":  "adb.explicit.example.com",
+		},
+	}
+	config["endpoint_template"] = "{product}.{region}.intra.example.com"
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with the explicit endpoints got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+	if _, err := client.NewAdsClient(); err != nil {
+		t.Fatalf("creating the ads client got an error: %#v", err)
+	}
+	if endpoint := client.Config.Endpoints["ads"]; endpoint != "adb.explicit.example.com" {
+		t.Errorf("expected the explicit adb endpoint to take precedence over the endpoint_template, got %v", endpoint)
+	}
+}
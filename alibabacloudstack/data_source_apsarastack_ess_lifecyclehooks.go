@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssLifecycleHooks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssLifecycleHooksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssLifecycleHooksRead),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssLifecycleHooksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeLifecycleHooksRequest()
 	request.RegionId = client.RegionId
@@ -94,7 +95,7 @@ func dataSourceAlibabacloudStackEssLifecycleHooksRead(d *schema.ResourceData, me
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
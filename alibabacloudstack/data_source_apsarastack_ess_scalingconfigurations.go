@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScalingConfigurationsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScalingConfigurationsRead),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
@@ -131,12 +132,12 @@ func dataSourceAlibabacloudStackEssScalingConfigurations() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScalingConfigurationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScalingConfigurationsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.RegionId = client.RegionId
@@ -204,15 +205,15 @@ func dataSourceAlibabacloudStackEssScalingConfigurationsRead(d *schema.ResourceD
 		filteredScalingConfigurations = allScalingConfigurations
 	}
 
-	return scalingConfigurationsDescriptionAttribute(d, filteredScalingConfigurations, meta)
+	return scalingConfigurationsDescriptionAttribute(ctx, d, filteredScalingConfigurations, meta)
 }
 
-func scalingConfigurationsDescriptionAttribute(d *schema.ResourceData, scalingConfigurations []ess.ScalingConfiguration, meta interface{}) error {
+func scalingConfigurationsDescriptionAttribute(ctx context.Context, d *schema.ResourceData, scalingConfigurations []ess.ScalingConfiguration, meta interface{}) error {
 	var ids []string
 	var names []string
 	var s = make([]map[string]interface{}, 0)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	for _, scalingConfiguration := range scalingConfigurations {
 		mapping := map[string]interface{}{
 			"id":                         scalingConfiguration.ScalingConfigurationId,
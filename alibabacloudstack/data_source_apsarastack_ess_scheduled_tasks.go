@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackEssScheduledTasksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackEssScheduledTasksRead),
 		Schema: map[string]*schema.Schema{
 			"scheduled_task_id": {
 				Type:     schema.TypeString,
@@ -103,12 +104,12 @@ func dataSourceAlibabacloudStackEssScheduledTasks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackEssScheduledTasksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackEssScheduledTasksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateDescribeScheduledTasksRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -1,21 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackExpressConnectAccessPoints() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackExpressConnectAccessPointsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackExpressConnectAccessPointsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -93,7 +92,7 @@ func dataSourceAlibabacloudStackExpressConnectAccessPoints() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeAccessPoints"
@@ -132,18 +131,7 @@ func dataSourceAlibabacloudStackExpressConnectAccessPointsRead(d *schema.Resourc
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_express_connect_access_points", action, AlibabacloudStackSdkGoERROR)
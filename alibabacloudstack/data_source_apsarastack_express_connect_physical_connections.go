@@ -1,21 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackExpressConnectPhysicalConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead),
 		Schema: map[string]*schema.Schema{
 			"include_reservation_data": {
 				Type:     schema.TypeBool,
@@ -166,7 +165,7 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnections() *schema.Reso
 	}
 }
 
-func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribePhysicalConnections"
@@ -209,18 +208,7 @@ func dataSourceAlibabacloudStackExpressConnectPhysicalConnectionsRead(d *schema.
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_express_connect_physical_connections", action, AlibabacloudStackSdkGoERROR)
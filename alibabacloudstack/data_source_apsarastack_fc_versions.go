@@ -0,0 +1,112 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackFcVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackFcVersionsRead),
+
+		Schema: map[string]*schema.Schema{
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version_ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"created_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackFcVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	serviceName := d.Get("service_name").(string)
+	objects, err := fcService.ListFcVersions(serviceName)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	versionIdsMap := make(map[string]string)
+	if v, ok := d.GetOk("version_ids"); ok {
+		for _, id := range v.([]interface{}) {
+			versionIdsMap[id.(string)] = id.(string)
+		}
+	}
+
+	var ids []string
+	var s []map[string]interface{}
+	for _, object := range objects {
+		if len(versionIdsMap) > 0 {
+			if _, ok := versionIdsMap[object["version_id"].(string)]; !ok {
+				continue
+			}
+		}
+		id := serviceName + COLON_SEPARATED + object["version_id"].(string)
+		object["id"] = id
+		ids = append(ids, id)
+		s = append(s, object)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("versions", s); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+
+	// create a json file in current directory and write data source to it
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		if err := writeToFile(output.(string), s); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
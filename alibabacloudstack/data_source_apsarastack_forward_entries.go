@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackForwardEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackForwardEntriesRead),
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -85,7 +86,7 @@ func dataSourceAlibabacloudStackForwardEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackForwardEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeForwardTableEntriesRequest()
@@ -96,7 +97,7 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -115,15 +116,12 @@ func dataSourceAlibabacloudStackForwardEntriesRead(d *schema.ResourceData, meta
 		}
 	}
 	var allForwardEntries []vpc.ForwardTableEntry
-	invoker := NewInvoker()
 	var raw interface{}
 	for {
-		if err := invoker.Run(func() error {
-			raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeForwardTableEntries(request)
-			})
-			return err
-		}); err != nil {
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeForwardTableEntries(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_forward_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -17,7 +18,7 @@ import (
 
 func dataSourceAlibabacloudStackGpdbInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackGpdbInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackGpdbInstancesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackGpdbInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackGpdbInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackGpdbInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
@@ -128,7 +129,7 @@ func dataSourceAlibabacloudStackGpdbInstancesRead(d *schema.ResourceData, meta i
 	request.RegionId = client.RegionId
 	request.ApiName = "DescribeDBInstances"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "AccessKeySecret": client.SecretKey, "Product": "gpdb", "RegionId": client.RegionId, "Action": "DescribeDBInstances", "Version": "2016-05-03"}
+	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey, "Product": "gpdb", "RegionId": client.RegionId, "Action": "DescribeDBInstances", "Version": "2016-05-03"}
 	response := GpdbInstance{}
 
 	for {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackHBaseInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackHBaseInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackHBaseInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -136,9 +137,9 @@ func dataSourceAlibabacloudStackHBaseInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackHBaseInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackHBaseInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	hbaseService := HBaseService{client}
+	hbaseService := HBaseService{client, ctx}
 
 	request := hbase.CreateDescribeInstancesRequest()
 	request.RegionId = client.RegionId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"sort"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackImages() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackImagesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackImagesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -173,7 +174,7 @@ func dataSourceAlibabacloudStackImages() *schema.Resource {
 }
 
 // dataSourceAlibabacloudStackImagesDescriptionRead performs the AlibabacloudStack Image lookup.
-func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackImagesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	nameRegex, nameRegexOk := d.GetOk("name_regex")
@@ -191,7 +192,7 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
@@ -258,11 +259,11 @@ func dataSourceAlibabacloudStackImagesRead(d *schema.ResourceData, meta interfac
 		images = filteredImages
 	}
 
-	return imagesDescriptionAttributes(d, images, meta)
+	return imagesDescriptionAttributes(ctx, d, images, meta)
 }
 
 // populate the numerous fields that the image description returns.
-func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.Image, meta interface{}) error {
+func imagesDescriptionAttributes(ctx context.Context, d *schema.ResourceData, images []ecs.Image, meta interface{}) error {
 	var ids []string
 	var s []map[string]interface{}
 	for _, image := range images {
@@ -292,7 +293,7 @@ func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.Image, met
 
 			// Complex types get their own functions
 			"disk_device_mappings": imageDiskDeviceMappings(image.DiskDeviceMappings.DiskDeviceMapping),
-			"tags":                 imageTagsMappings(d, image.ImageId, meta),
+			"tags":                 imageTagsMappings(ctx, d, image.ImageId, meta),
 		}
 
 		ids = append(ids, image.ImageId)
@@ -314,7 +315,7 @@ func imagesDescriptionAttributes(d *schema.ResourceData, images []ecs.Image, met
 	return nil
 }
 
-//Find most recent image
+// Find most recent image
 type imageSort []ecs.Image
 
 func (a imageSort) Len() int {
@@ -353,10 +354,10 @@ func imageDiskDeviceMappings(m []ecs.DiskDeviceMapping) []map[string]interface{}
 	return s
 }
 
-//Returns a mapping of image tags
-func imageTagsMappings(d *schema.ResourceData, imageId string, meta interface{}) map[string]string {
+// Returns a mapping of image tags
+func imageTagsMappings(ctx context.Context, d *schema.ResourceData, imageId string, meta interface{}) map[string]string {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	tags, err := ecsService.DescribeTags(imageId, TagResourceImage)
 
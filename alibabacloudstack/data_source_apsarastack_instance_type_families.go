@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypeFamiliesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstanceTypeFamiliesRead),
 
 		Schema: map[string]*schema.Schema{
 			"generation": {
@@ -61,9 +62,9 @@ func dataSourceAlibabacloudStackInstanceTypeFamilies() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	request := ecs.CreateDescribeInstanceTypeFamiliesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -72,7 +73,7 @@ func dataSourceAlibabacloudStackInstanceTypeFamiliesRead(d *schema.ResourceData,
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	if v, ok := d.GetOk("generation"); ok {
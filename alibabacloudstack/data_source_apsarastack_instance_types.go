@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"sort"
@@ -20,7 +21,7 @@ type instanceTypeWithOriginalPrice struct {
 
 func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstanceTypesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstanceTypesRead),
 
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
@@ -157,9 +158,9 @@ func dataSourceAlibabacloudStackInstanceTypes() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstanceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	zoneId, validZones, _, err := ecsService.DescribeAvailableResources(d, meta, InstanceTypeResource)
 	if err != nil {
@@ -197,7 +198,7 @@ func dataSourceAlibabacloudStackInstanceTypesRead(d *schema.ResourceData, meta i
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.InstanceTypeFamily = family
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
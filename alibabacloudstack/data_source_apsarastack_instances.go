@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -191,7 +192,7 @@ func dataSourceAlibabacloudStackInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDescribeInstancesRequest()
@@ -202,7 +203,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Status = d.Get("status").(string)
 
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
@@ -298,7 +299,7 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceIds = convertListToJsonString(convertListStringToListInterface(instanceIds[index:IntMin(index+100, len(instanceIds))]))
 		request.RamRoleName = d.Get("ram_role_name").(string)
 		request.PageSize = requests.NewInteger(PageSizeLarge)
@@ -335,13 +336,13 @@ func dataSourceAlibabacloudStackInstancesRead(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 
-	return instancessDescriptionAttributes(d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta)
+	return instancessDescriptionAttributes(ctx, d, filteredInstancesTemp, instanceRoleNameMap, instanceDiskMappings, meta)
 }
 
 // populate the numerous fields that the instance description returns.
-func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Instance, instanceRoleNameMap map[string]string, instanceDisksMap map[string][]map[string]interface{}, meta interface{}) error {
+func instancessDescriptionAttributes(ctx context.Context, d *schema.ResourceData, instances []ecs.Instance, instanceRoleNameMap map[string]string, instanceDisksMap map[string][]map[string]interface{}, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
@@ -396,7 +397,7 @@ func instancessDescriptionAttributes(d *schema.ResourceData, instances []ecs.Ins
 	return nil
 }
 
-//Returns a mapping of instance disks
+// Returns a mapping of instance disks
 func getInstanceDisksMappings(instanceMap map[string]string, meta interface{}) (map[string][]map[string]interface{}, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateDescribeDisksRequest()
@@ -406,7 +407,7 @@ func getInstanceDisksMappings(instanceMap map[string]string, meta interface{}) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeXLarge)
 	request.PageNumber = requests.NewInteger(1)
 	instanceDisks := make(map[string][]map[string]interface{})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKeyPairsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKeyPairsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -74,7 +75,7 @@ func dataSourceAlibabacloudStackKeyPairs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKeyPairsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	var regex *regexp.Regexp
@@ -96,7 +97,7 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if fingerPrint, ok := d.GetOk("finger_print"); ok {
 		request.KeyPairFingerPrint = fingerPrint.(string)
 	}
@@ -161,7 +162,7 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 	describeInstancesRequest.PageNumber = requests.NewInteger(1)
 	describeInstancesRequest.PageSize = requests.NewInteger(PageSizeLarge)
 	describeInstancesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	describeInstancesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	describeInstancesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	for {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -214,12 +215,12 @@ func dataSourceAlibabacloudStackKeyPairsRead(d *schema.ResourceData, meta interf
 		describeInstancesRequest.PageNumber = page
 	}
 
-	return keyPairsDescriptionAttributes(d, keyPairs, keyPairsAttach, meta)
+	return keyPairsDescriptionAttributes(ctx, d, keyPairs, keyPairsAttach, meta)
 }
 
-func keyPairsDescriptionAttributes(d *schema.ResourceData, keyPairs []ecs.KeyPair, keyPairsAttach map[string][]map[string]interface{}, meta interface{}) error {
+func keyPairsDescriptionAttributes(ctx context.Context, d *schema.ResourceData, keyPairs []ecs.KeyPair, keyPairsAttach map[string][]map[string]interface{}, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	var names []string
 	var ids []string
 	var s []map[string]interface{}
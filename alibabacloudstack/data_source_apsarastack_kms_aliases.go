@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsAliases() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceApsarStackKmsAliasesRead,
+		ReadContext: withDiagnostics(dataSourceApsarStackKmsAliasesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -59,12 +60,12 @@ func dataSourceAlibabacloudStackKmsAliases() *schema.Resource {
 	}
 }
 
-func dataSourceApsarStackKmsAliasesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceApsarStackKmsAliasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateListAliasesRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
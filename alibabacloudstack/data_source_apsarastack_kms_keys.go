@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsKeysRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKmsKeysRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -89,13 +90,13 @@ func dataSourceAlibabacloudStackKmsKeys() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKmsKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateListKeysRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
@@ -150,7 +151,7 @@ func dataSourceAlibabacloudStackKmsKeysRead(d *schema.ResourceData, meta interfa
 
 		request := kms.CreateDescribeKeyRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.KeyId = k
 		raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKmsSecrets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKmsSecretsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKmsSecretsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -70,12 +71,12 @@ func dataSourceAlibabacloudStackKmsSecrets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKmsSecretsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKmsSecretsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateListSecretsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstanceEnginesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreInstanceEnginesRead),
 		Schema: map[string]*schema.Schema{
 			"zone_id": {
 				Type:     schema.TypeString,
@@ -71,27 +72,23 @@ func dataSourceAlibabacloudStackKVStoreInstanceEngines() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreInstanceEnginesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := r_kvstore.CreateDescribeAvailableResourceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ZoneId = d.Get("zone_id").(string)
 	instanceChargeType := d.Get("instance_charge_type").(string)
 	request.InstanceChargeType = instanceChargeType
 	request.Engine = d.Get("engine").(string)
 	var response = &r_kvstore.DescribeAvailableResourceResponse{}
-	err := resource.Retry(time.Minute*5, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, time.Minute*5, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeAvailableResource(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling}) {
-				time.Sleep(time.Duration(5) * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -143,13 +144,13 @@ func dataSourceAlibabacloudStackKVStoreInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := r_kvstore.CreateDescribeInstancesRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceType = d.Get("instance_type").(string)
 	request.InstanceStatus = d.Get("status").(string)
 	request.PageSize = requests.NewInteger(PageSizeLarge)
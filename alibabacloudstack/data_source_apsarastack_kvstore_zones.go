@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackKVStoreZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackKVStoreZoneRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackKVStoreZoneRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -58,7 +59,7 @@ func dataSourceAlibabacloudStackKVStoreZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackKVStoreZoneRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackKVStoreZoneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -67,7 +68,7 @@ func dataSourceAlibabacloudStackKVStoreZoneRead(d *schema.ResourceData, meta int
 	request := r_kvstore.CreateDescribeRegionsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//request.InstanceChargeType = instanceChargeType
 	raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 		return rkvClient.DescribeRegions(request)
@@ -0,0 +1,86 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackLogProjectsDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_log_projects.default"
+	name := fmt.Sprintf("tf-testacclogprojects-%d", rand)
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLogProjectsConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}_fake",
+		}),
+	}
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_log_project.default.id}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"ids": []string{"${alibabacloudstack_log_project.default.id}_fake"},
+		}),
+	}
+
+	allConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}",
+			"ids":        []string{"${alibabacloudstack_log_project.default.id}"},
+			"status":     "Normal",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"name_regex": "${alibabacloudstack_log_project.default.name}",
+			"ids":        []string{"${alibabacloudstack_log_project.default.id}"},
+			"status":     "Disable",
+		}),
+	}
+
+	var existLogProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":                   "1",
+			"names.#":                 "1",
+			"names.0":                 name,
+			"projects.#":              "1",
+			"projects.0.id":           name,
+			"projects.0.project_name": name,
+			"projects.0.description":  "tf unit test",
+			"projects.0.status":       "Normal",
+			"projects.0.create_time":  CHECKSET,
+		}
+	}
+	var fakeLogProjectsMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":      "0",
+			"names.#":    "0",
+			"projects.#": "0",
+		}
+	}
+	var logProjectsCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLogProjectsMapFunc,
+		fakeMapFunc:  fakeLogProjectsMapFunc,
+	}
+
+	logProjectsCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf, allConf)
+}
+
+func dataSourceLogProjectsConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	`, name)
+}
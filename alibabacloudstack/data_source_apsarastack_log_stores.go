@@ -0,0 +1,144 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackLogStores() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackLogStoresRead),
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsValidRegExp,
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			// Computed values
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"names": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"stores": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"store_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackLogStoresRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	projectName := d.Get("project").(string)
+
+	var storeNames []string
+	var requestInfo *sls.Client
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return slsClient.ListLogStore(projectName)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("ListLogStore", raw, requestInfo, map[string]string{
+				"project": projectName,
+			})
+		}
+		storeNames, _ = raw.([]string)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_log_stores", "ListLogStore", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	var nameRegex *regexp.Regexp
+	if v, ok := d.GetOk("name_regex"); ok {
+		nameRegex = regexp.MustCompile(v.(string))
+	}
+
+	var idsMap map[string]string
+	if v, ok := d.GetOk("ids"); ok {
+		idsMap = make(map[string]string)
+		for _, vv := range v.([]interface{}) {
+			idsMap[vv.(string)] = vv.(string)
+		}
+	}
+
+	var (
+		ids       []string
+		names     []string
+		storeMaps []map[string]interface{}
+	)
+	for _, storeName := range storeNames {
+		if nameRegex != nil && !nameRegex.MatchString(storeName) {
+			continue
+		}
+		if idsMap != nil && idsMap[storeName] == "" {
+			continue
+		}
+
+		mapping := map[string]interface{}{
+			"id":         storeName,
+			"store_name": storeName,
+		}
+		ids = append(ids, storeName)
+		names = append(names, storeName)
+		storeMaps = append(storeMaps, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(append([]string{projectName}, ids...)))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("names", names); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("stores", storeMaps); err != nil {
+		return WrapError(err)
+	}
+
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), storeMaps)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package alibabacloudstack
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+)
+
+func TestAccAlibabacloudStackLogStoresDataSource(t *testing.T) {
+	rand := acctest.RandIntRange(1000000, 9999999)
+	resourceId := "data.alibabacloudstack_log_stores.default"
+	name := fmt.Sprintf("tf-testacclogstores-%d", rand)
+	testAccConfig := dataSourceTestAccConfigFunc(resourceId, name, dataSourceLogStoresConfigDependence)
+
+	nameRegexConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_store.default.project}",
+			"name_regex": "${alibabacloudstack_log_store.default.name}",
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project":    "${alibabacloudstack_log_store.default.project}",
+			"name_regex": "${alibabacloudstack_log_store.default.name}_fake",
+		}),
+	}
+
+	idsConf := dataSourceTestAccConfig{
+		existConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_store.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_store.default.name}"},
+		}),
+		fakeConfig: testAccConfig(map[string]interface{}{
+			"project": "${alibabacloudstack_log_store.default.project}",
+			"ids":     []string{"${alibabacloudstack_log_store.default.name}_fake"},
+		}),
+	}
+
+	var existLogStoresMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":               "1",
+			"names.#":             "1",
+			"names.0":             name,
+			"stores.#":            "1",
+			"stores.0.id":         name,
+			"stores.0.store_name": name,
+		}
+	}
+	var fakeLogStoresMapFunc = func(rand int) map[string]string {
+		return map[string]string{
+			"ids.#":    "0",
+			"names.#":  "0",
+			"stores.#": "0",
+		}
+	}
+	var logStoresCheckInfo = dataSourceAttr{
+		resourceId:   resourceId,
+		existMapFunc: existLogStoresMapFunc,
+		fakeMapFunc:  fakeLogStoresMapFunc,
+	}
+
+	logStoresCheckInfo.dataSourceTestCheck(t, rand, nameRegexConf, idsConf)
+}
+
+func dataSourceLogStoresConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	}
+	`, name)
+}
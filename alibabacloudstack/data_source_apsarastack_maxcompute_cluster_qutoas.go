@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackMaxcomputeClusterQutaos() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead),
 		Schema: map[string]*schema.Schema{
 			"output_file": {
 				Type:     schema.TypeString,
@@ -45,7 +46,7 @@ func dataSourceAlibabacloudStackMaxcomputeClusterQutaos() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeClusterQutaosRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	conn, err := client.NewAscmClient()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackMaxcomputeClusters() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeClustersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMaxcomputeClustersRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -62,7 +63,7 @@ func dataSourceAlibabacloudStackMaxcomputeClusters() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeClustersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	objects, err := DescribeMaxcomputeProject(meta)
 	if err != nil {
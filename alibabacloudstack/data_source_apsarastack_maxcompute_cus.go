@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackMaxcomputeCus() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMaxcomputeCusRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMaxcomputeCusRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -67,7 +68,7 @@ func dataSourceAlibabacloudStackMaxcomputeCus() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMaxcomputeCusRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMaxcomputeCusRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	conn, err := client.NewAscmClient()
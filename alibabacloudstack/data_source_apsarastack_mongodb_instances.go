@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackMongoDBInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMongoDBInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMongoDBInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -175,14 +176,14 @@ func dataSourceAlibabacloudStackMongoDBInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMongoDBInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMongoDBInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, ctx}
 
 	request := dds.CreateDescribeDBInstancesRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackMongoDBZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackMongoDBZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"multi": {
@@ -50,7 +51,7 @@ func dataSourceAlibabacloudStackMongoDBZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackMongoDBZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackMongoDBZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	multi := d.Get("multi").(bool)
 	var zoneIds []string
@@ -58,7 +59,7 @@ func dataSourceAlibabacloudStackMongoDBZonesRead(d *schema.ResourceData, meta in
 	request := dds.CreateDescribeRegionsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 		return ddsClient.DescribeRegions(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackAccessRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackAccessRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackAccessRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"source_cidr_ip": {
@@ -73,7 +74,7 @@ func dataSourceAlibabacloudStackAccessRules() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackAccessRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackAccessRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeAccessRules"
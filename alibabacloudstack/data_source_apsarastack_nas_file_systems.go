@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackFileSystems() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackFileSystemsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackFileSystemsRead),
 
 		Schema: map[string]*schema.Schema{
 			"storage_type": {
@@ -112,7 +113,7 @@ func dataSourceAlibabacloudStackFileSystems() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackFileSystemsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackFileSystemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeFileSystems"
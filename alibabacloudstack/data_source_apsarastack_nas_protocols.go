@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackNasProtocols() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNasProtocolsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNasProtocolsRead),
 
 		Schema: map[string]*schema.Schema{
 			"type": {
@@ -42,7 +43,7 @@ func dataSourceAlibabacloudStackNasProtocols() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackNasProtocolsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNasProtocolsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DescribeZones"
 	var response map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackNatGatewaysRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackNatGatewaysRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -86,7 +87,7 @@ func dataSourceAlibabacloudStackNatGateways() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackNatGatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeNatGatewaysRequest()
@@ -98,7 +99,7 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.VpcId = d.Get("vpc_id").(string)
@@ -118,16 +119,11 @@ func dataSourceAlibabacloudStackNatGatewaysRead(d *schema.ResourceData, meta int
 			return WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		if err := invoker.Run(func() error {
-			response, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeNatGateways(request)
-			})
-			raw = response
-			return err
-		}); err != nil {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeNatGateways(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_nat_gateways", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackNetworkInterfacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackNetworkInterfacesRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeSet,
@@ -136,7 +137,7 @@ func dataSourceAlibabacloudStackNetworkInterfaces() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackNetworkInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateDescribeNetworkInterfacesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -145,7 +146,7 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	if networkInterfaceIds, ok := d.GetOk("ids"); ok {
 		ids := expandStringList(networkInterfaceIds.(*schema.Set).List())
@@ -223,12 +224,12 @@ func dataSourceAlibabacloudstackNetworkInterfacesRead(d *schema.ResourceData, me
 	} else {
 		filterEnis = allEnis
 	}
-	return WrapError(networkInterfaceDescriptionAttributes(d, filterEnis, meta))
+	return WrapError(networkInterfaceDescriptionAttributes(ctx, d, filterEnis, meta))
 }
 
-func networkInterfaceDescriptionAttributes(d *schema.ResourceData, enis []ecs.NetworkInterfaceSet, meta interface{}) error {
+func networkInterfaceDescriptionAttributes(ctx context.Context, d *schema.ResourceData, enis []ecs.NetworkInterfaceSet, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
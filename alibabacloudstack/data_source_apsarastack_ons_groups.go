@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOnsGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -80,7 +81,7 @@ func dataSourceAlibabacloudStackOnsGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOnsGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	namespaceid := d.Get("instance_id").(string)
 
@@ -93,16 +94,15 @@ func dataSourceAlibabacloudStackOnsGroupsRead(d *schema.ResourceData, meta inter
 	request.ApiName = "ConsoleGroupList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleGroupList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      namespaceid,
+		"Product":       "Ons-inner",
+		"RegionId":      client.RegionId,
+		"Action":        "ConsoleGroupList",
+		"Version":       "2018-02-05",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"OnsRegionId":   client.RegionId,
+		"PreventCache":  "",
+		"InstanceId":    namespaceid,
 	}
 	response := OnsGroup{}
 
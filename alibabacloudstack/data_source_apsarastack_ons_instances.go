@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsInstancesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOnsInstancesRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -97,7 +98,7 @@ func dataSourceAlibabacloudStackOnsInstances() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOnsInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -109,15 +110,14 @@ func dataSourceAlibabacloudStackOnsInstancesRead(d *schema.ResourceData, meta in
 	request.ApiName = "ConsoleInstanceList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleInstanceList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
+		"Product":       "Ons-inner",
+		"RegionId":      client.RegionId,
+		"Action":        "ConsoleInstanceList",
+		"Version":       "2018-02-05",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"OnsRegionId":   client.RegionId,
+		"PreventCache":  "",
 	}
 	response := OInstance{}
 
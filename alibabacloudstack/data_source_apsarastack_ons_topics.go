@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackOnsTopics() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOnsTopicsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOnsTopicsRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -106,7 +107,7 @@ func dataSourceAlibabacloudStackOnsTopics() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOnsTopicsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOnsTopicsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	namespaceid := d.Get("instance_id").(string)
 
@@ -119,16 +120,15 @@ func dataSourceAlibabacloudStackOnsTopicsRead(d *schema.ResourceData, meta inter
 	request.ApiName = "ConsoleTopicList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{"AccessKeyId": client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ons-inner",
-		"RegionId":        client.RegionId,
-		"Action":          "ConsoleTopicList",
-		"Version":         "2018-02-05",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"OnsRegionId":     client.RegionId,
-		"PreventCache":    "",
-		"namespaceId":     namespaceid,
+		"Product":       "Ons-inner",
+		"RegionId":      client.RegionId,
+		"Action":        "ConsoleTopicList",
+		"Version":       "2018-02-05",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"OnsRegionId":   client.RegionId,
+		"PreventCache":  "",
+		"namespaceId":   namespaceid,
 	}
 	response := Topic{}
 
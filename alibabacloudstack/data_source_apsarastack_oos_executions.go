@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackOosExecutions() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOosExecutionsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOosExecutionsRead),
 		Schema: map[string]*schema.Schema{
 			"category": {
 				Type:         schema.TypeString,
@@ -196,7 +197,7 @@ func dataSourceAlibabacloudStackOosExecutions() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOosExecutionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOosExecutionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListExecutions"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackOosTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOosTemplatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOosTemplatesRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -166,7 +167,7 @@ func dataSourceAlibabacloudStackOosTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOosTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOosTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListTemplates"
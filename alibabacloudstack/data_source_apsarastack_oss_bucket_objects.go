@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"regexp"
 	"time"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackOssBucketObjects() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOssBucketObjectsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOssBucketObjectsRead),
 
 		Schema: map[string]*schema.Schema{
 			"bucket_name": {
@@ -100,7 +101,7 @@ func dataSourceAlibabacloudStackOssBucketObjects() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOssBucketObjectsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOssBucketObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	bucketName := d.Get("bucket_name").(string)
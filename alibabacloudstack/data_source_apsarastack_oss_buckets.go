@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"encoding/xml"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,7 +17,7 @@ import (
 
 func dataSourceAlibabacloudStackOssBuckets() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOssBucketsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOssBucketsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -248,7 +249,7 @@ func dataSourceAlibabacloudStackOssBuckets() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOssBucketsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *oss.Client
 	var allBuckets []oss.BucketProperties
@@ -265,8 +266,7 @@ func dataSourceAlibabacloudStackOssBucketsRead(d *schema.ResourceData, meta inte
 		}
 		request.QueryParams = map[string]string{
 
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "OneRouter",
+			"Product": "OneRouter",
 			//"Department":       client.Department,
 			//"ResourceGroup":    client.ResourceGroup,
 			"RegionId":         client.RegionId,
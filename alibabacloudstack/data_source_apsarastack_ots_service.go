@@ -1,12 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsServiceRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsServiceRead),
 
 		Schema: map[string]*schema.Schema{
 			"enable": {
@@ -30,7 +29,7 @@ func dataSourceAlibabacloudStackOtsService() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	if v, ok := d.GetOk("enable"); !ok || v.(string) != "On" {
@@ -47,23 +46,8 @@ func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta inte
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Ots"
 	request["OrganizationId"] = client.Department
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-06-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				return resource.RetryableError(err)
-			}
-			addDebug(action, response, nil)
-			return resource.NonRetryableError(err)
-		}
-
-		addDebug(action, response, nil)
-
-		d.SetId(fmt.Sprintf("%v", response["OrderId"]))
-		d.Set("status", "Opened")
-		return nil
-	})
-
+	response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-06-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, nil)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"ORDER.OPEND"}) {
 			d.SetId("OtsServicHasBeenOpened")
@@ -72,6 +56,7 @@ func dataSourceAlibabacloudStackOtsServiceRead(d *schema.ResourceData, meta inte
 		}
 		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_ots_service", "OpenOtsService", AlibabacloudStackSdkGoERROR)
 	}
-
+	d.SetId(fmt.Sprintf("%v", response["OrderId"]))
+	d.Set("status", "Opened")
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"regexp"
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackOtsTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackOtsTablesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackOtsTablesRead),
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -103,9 +104,9 @@ type OtsTableInfo struct {
 	maxVersion   int
 }
 
-func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackOtsTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 	instanceName := d.Get("instance_name").(string)
 
 	object, err := otsService.ListOtsTable(instanceName)
@@ -160,12 +161,12 @@ func dataSourceAlibabacloudStackOtsTablesRead(d *schema.ResourceData, meta inter
 		})
 	}
 
-	return otsTablesDescriptionAttributes(d, allTableInfos, meta)
+	return otsTablesDescriptionAttributes(ctx, d, allTableInfos, meta)
 }
 
-func otsTablesDescriptionAttributes(d *schema.ResourceData, tableInfos []OtsTableInfo, meta interface{}) error {
+func otsTablesDescriptionAttributes(ctx context.Context, d *schema.ResourceData, tableInfos []OtsTableInfo, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 
 	var ids []string
 	var names []string
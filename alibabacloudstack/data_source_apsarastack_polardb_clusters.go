@@ -0,0 +1,215 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackPolarDBClusters() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackPolarDBClustersRead),
+
+		Schema: map[string]*schema.Schema{
+			"description_regex": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRegexp,
+				ForceNew:     true,
+			},
+			"ids": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MySQL", "PostgreSQL", "Oracle"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"descriptions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"clusters": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_node_class": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_node_number": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"pay_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"create_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expire_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"db_nodes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"db_node_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"db_node_class": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"db_node_role": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"zone_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackPolarDBClustersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	request := polardb.CreateDescribeDBClustersRequest()
+	if v, ok := d.GetOk("status"); ok {
+		request.DBClusterStatus = v.(string)
+	}
+	if v, ok := d.GetOk("db_type"); ok {
+		request.DBType = v.(string)
+	}
+	if v, ok := d.GetOk("ids"); ok && len(v.([]interface{})) > 0 {
+		request.DBClusterIds = strings.Join(expandStringList(v.([]interface{})), COMMA_SEPARATED)
+	}
+	clusters, err := polarDBService.DescribePolarDBClusters(request)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var descriptionRegex *regexp.Regexp
+	if v, ok := d.GetOk("description_regex"); ok {
+		r, err := regexp.Compile(v.(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		descriptionRegex = r
+	}
+
+	var ids []string
+	var descriptions []string
+	var s []map[string]interface{}
+	for _, cluster := range clusters {
+		if descriptionRegex != nil && !descriptionRegex.MatchString(cluster.DBClusterDescription) {
+			continue
+		}
+		nodes := make([]map[string]interface{}, 0, len(cluster.DBNodes.DBNode))
+		for _, node := range cluster.DBNodes.DBNode {
+			nodes = append(nodes, map[string]interface{}{
+				"db_node_id":    node.DBNodeId,
+				"db_node_class": node.DBNodeClass,
+				"db_node_role":  node.DBNodeRole,
+				"zone_id":       node.ZoneId,
+			})
+		}
+		mapping := map[string]interface{}{
+			"id":             cluster.DBClusterId,
+			"description":    cluster.DBClusterDescription,
+			"status":         cluster.DBClusterStatus,
+			"db_type":        cluster.DBType,
+			"db_version":     cluster.DBVersion,
+			"db_node_class":  cluster.DBNodeClass,
+			"db_node_number": cluster.DBNodeNumber,
+			"pay_type":       cluster.PayType,
+			"zone_id":        cluster.ZoneId,
+			"vpc_id":         cluster.VpcId,
+			"create_time":    cluster.CreateTime,
+			"expire_time":    cluster.ExpireTime,
+			"db_nodes":       nodes,
+		}
+		ids = append(ids, cluster.DBClusterId)
+		descriptions = append(descriptions, cluster.DBClusterDescription)
+		s = append(s, mapping)
+	}
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("ids", ids); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("descriptions", descriptions); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("clusters", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
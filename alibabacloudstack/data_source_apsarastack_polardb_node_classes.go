@@ -0,0 +1,168 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"sort"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceAlibabacloudStackPolarDBNodeClasses() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackPolarDBNodeClassesRead),
+
+		Schema: map[string]*schema.Schema{
+			"db_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MySQL", "PostgreSQL", "Oracle"}, false),
+			},
+			"db_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"db_node_class": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"pay_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Postpaid",
+				ValidateFunc: validation.StringInSlice([]string{"Postpaid", "Prepaid"}, false),
+			},
+			"output_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			// Computed values
+			"db_node_classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"classes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"supported_engines": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"engine": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"available_resources": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"db_node_class": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"category": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackPolarDBNodeClassesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	request := polardb.CreateDescribeDBClusterAvailableResourcesRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBType = d.Get("db_type").(string)
+	request.PayType = d.Get("pay_type").(string)
+	if v, ok := d.GetOk("db_version"); ok {
+		request.DBVersion = v.(string)
+	}
+	if v, ok := d.GetOk("zone_id"); ok {
+		request.ZoneId = v.(string)
+	}
+	if v, ok := d.GetOk("db_node_class"); ok {
+		request.DBNodeClass = v.(string)
+	}
+	raw, err := client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeDBClusterAvailableResources(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_polardb_node_classes", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeDBClusterAvailableResourcesResponse)
+
+	var ids []string
+	var s []map[string]interface{}
+	nodeClasses := make(map[string]bool)
+	for _, zone := range response.AvailableZones {
+		engines := make([]map[string]interface{}, 0, len(zone.SupportedEngines))
+		for _, engine := range zone.SupportedEngines {
+			resources := make([]map[string]interface{}, 0, len(engine.AvailableResources))
+			for _, resource := range engine.AvailableResources {
+				resources = append(resources, map[string]interface{}{
+					"db_node_class": resource.DBNodeClass,
+					"category":      resource.Category,
+				})
+				nodeClasses[resource.DBNodeClass] = true
+			}
+			engines = append(engines, map[string]interface{}{
+				"engine":              engine.Engine,
+				"available_resources": resources,
+			})
+		}
+		ids = append(ids, zone.ZoneId)
+		s = append(s, map[string]interface{}{
+			"zone_id":           zone.ZoneId,
+			"supported_engines": engines,
+		})
+	}
+	classes := make([]string, 0, len(nodeClasses))
+	for nodeClass := range nodeClasses {
+		classes = append(classes, nodeClass)
+	}
+	sort.Strings(classes)
+
+	d.SetId(dataResourceIdHash(ids))
+	if err := d.Set("db_node_classes", classes); err != nil {
+		return WrapError(err)
+	}
+	if err := d.Set("classes", s); err != nil {
+		return WrapError(err)
+	}
+	if output, ok := d.GetOk("output_file"); ok && output.(string) != "" {
+		writeToFile(output.(string), s)
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackProfileRead),
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"credentials_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"profile_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credentials_file": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_profiles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+
+	d.SetId(client.Config.CredentialsSource)
+	d.Set("credentials_source", client.Config.CredentialsSource)
+	profile := client.Config.ResolvedProfile
+	if profile == nil {
+		profile = &connectivity.ResolvedProfile{}
+	} else {
+		d.SetId(client.Config.CredentialsSource + ":" + profile.Name)
+	}
+	d.Set("profile_name", profile.Name)
+	d.Set("mode", profile.Mode)
+	d.Set("credentials_file", profile.Path)
+	if err := d.Set("source_profiles", profile.SourceProfiles); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
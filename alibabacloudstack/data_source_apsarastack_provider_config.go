@@ -0,0 +1,69 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAlibabacloudStackProviderConfig() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackProviderConfigRead),
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"region": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"protocol": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"insecure": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"endpoints": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"department": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"resource_group": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credentials_source": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAlibabacloudStackProviderConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	config := client.Config
+
+	endpoints := make(map[string]interface{})
+	for product, endpoint := range config.ResolvedEndpoints() {
+		endpoints[product] = endpoint
+	}
+
+	d.SetId(config.RegionId)
+	d.Set("region", config.RegionId)
+	d.Set("protocol", config.Protocol)
+	d.Set("insecure", config.Insecure)
+	if err := d.Set("endpoints", endpoints); err != nil {
+		return WrapError(err)
+	}
+	d.Set("department", client.Department)
+	d.Set("resource_group", client.ResourceGroup)
+	d.Set("credentials_source", config.CredentialsSource)
+	return nil
+}
@@ -1,19 +1,18 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func dataSourceAlibabacloudStackQuickBiUsers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackQuickBiUsersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackQuickBiUsersRead),
 		Schema: map[string]*schema.Schema{
 			"keyword": {
 				Type:     schema.TypeString,
@@ -88,7 +87,7 @@ func dataSourceAlibabacloudStackQuickBiUsers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackQuickBiUsersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackQuickBiUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "QueryUserList"
@@ -117,18 +116,7 @@ func dataSourceAlibabacloudStackQuickBiUsersRead(d *schema.ResourceData, meta in
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2022-03-01"), StringPointer("AK"), request, nil, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2022-03-01"), StringPointer("AK"), request, nil, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_quick_bi_users", action, AlibabacloudStackSdkGoERROR)
@@ -171,7 +159,7 @@ func dataSourceAlibabacloudStackQuickBiUsersRead(d *schema.ResourceData, meta in
 			continue
 		}
 		id := fmt.Sprint(object["UserId"])
-		quickbiPublicService := QuickbiPublicService{client}
+		quickbiPublicService := QuickbiPublicService{client, ctx}
 		getResp, err := quickbiPublicService.DescribeQuickBiUser(id)
 		if err != nil {
 			return WrapError(err)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudstackRamServiceRoleProductsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudstackRamServiceRoleProductsRead),
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
 				Type:         schema.TypeString,
@@ -50,7 +51,7 @@ func dataSourceAlibabacloudstackRamServiceRoleProducts() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudstackRamServiceRoleProductsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := requests.NewCommonRequest()
@@ -69,12 +70,11 @@ func dataSourceAlibabacloudstackRamServiceRoleProductsRead(d *schema.ResourceDat
 	request.ApiName = "ListRAMServiceRoleProducts"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"RegionId":        client.RegionId,
-		"Action":          "ListRAMServiceRoleProducts",
-		"Version":         "2019-05-10",
+		"AccessKeyId": client.AccessKey,
+		"Product":     "ascm",
+		"RegionId":    client.RegionId,
+		"Action":      "ListRAMServiceRoleProducts",
+		"Version":     "2019-05-10",
 	}
 	response := RoleProducts{}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosStacksRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRosStacksRead),
 		Schema: map[string]*schema.Schema{
 			"parent_stack_id": {
 				Type:     schema.TypeString,
@@ -161,7 +162,7 @@ func dataSourceAlibabacloudStackRosStacks() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRosStacksRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListStacks"
@@ -268,7 +269,7 @@ func dataSourceAlibabacloudStackRosStacksRead(d *schema.ResourceData, meta inter
 			continue
 		}
 
-		rosService := RosService{client}
+		rosService := RosService{client, ctx}
 		id := fmt.Sprint(object["StackId"])
 		getResp, err := rosService.DescribeRosStack(id)
 		if err != nil {
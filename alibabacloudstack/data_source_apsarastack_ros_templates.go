@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"regexp"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRosTemplatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRosTemplatesRead),
 		Schema: map[string]*schema.Schema{
 			"share_type": {
 				Type:         schema.TypeString,
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackRosTemplates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRosTemplatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "ListTemplates"
@@ -210,7 +211,7 @@ func dataSourceAlibabacloudStackRosTemplatesRead(d *schema.ResourceData, meta in
 			continue
 		}
 
-		rosService := RosService{client}
+		rosService := RosService{client, ctx}
 		id := fmt.Sprint(object["TemplateId"])
 		getResp, err := rosService.DescribeRosTemplate(id)
 		if err != nil {
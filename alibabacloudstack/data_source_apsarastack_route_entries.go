@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouteEntriesRead),
 		Schema: map[string]*schema.Schema{
 			"route_table_id": {
 				Type:     schema.TypeString,
@@ -68,7 +69,7 @@ func dataSourceAlibabacloudStackRouteEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouteEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := vpc.CreateDescribeRouteTablesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -78,23 +79,18 @@ func dataSourceAlibabacloudStackRouteEntriesRead(d *schema.ResourceData, meta in
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
 	request.RouteTableId = d.Get("route_table_id").(string)
 
 	var allRouteEntries []vpc.RouteEntry
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		if err := invoker.Run(func() error {
-			response, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeRouteTables(request)
-			})
-			raw = response
-			return err
-		}); err != nil {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeRouteTables(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
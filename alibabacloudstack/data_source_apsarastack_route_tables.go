@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouteTablesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouteTablesRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -79,9 +80,9 @@ func dataSourceAlibabacloudStackRouteTables() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouteTablesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateDescribeRouteTableListRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -91,7 +92,7 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -111,16 +112,13 @@ func dataSourceAlibabacloudStackRouteTablesRead(d *schema.ResourceData, meta int
 			return WrapError(err)
 		}
 	}
-	invoker := NewInvoker()
 	for {
 		var raw interface{}
 		var err error
-		if err := invoker.Run(func() error {
-			raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeRouteTableList(request)
-			})
-			return err
-		}); err != nil {
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeRouteTableList(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_route_tables", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
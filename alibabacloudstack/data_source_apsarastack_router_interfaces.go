@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackRouterInterfacesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackRouterInterfacesRead),
 
 		Schema: map[string]*schema.Schema{
 			"status": {
@@ -160,7 +161,7 @@ func dataSourceAlibabacloudStackRouterInterfaces() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackRouterInterfacesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeRouterInterfacesRequest()
@@ -171,7 +172,7 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -196,24 +197,16 @@ func dataSourceAlibabacloudStackRouterInterfacesRead(d *schema.ResourceData, met
 	}
 
 	var allRouterInterfaces []vpc.RouterInterfaceType
-	invoker := NewInvoker()
 
 	for {
-		var response *vpc.DescribeRouterInterfacesResponse
-		err := invoker.Run(func() error {
-			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeRouterInterfaces(request)
-			})
-			if err != nil {
-				return err
-			}
-			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			response, _ = raw.(*vpc.DescribeRouterInterfacesResponse)
-			return nil
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeRouterInterfaces(request)
 		})
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_router_interfaces", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*vpc.DescribeRouterInterfacesResponse)
 
 		if len(response.RouterInterfaceSet.RouterInterfaceType) < 1 {
 			break
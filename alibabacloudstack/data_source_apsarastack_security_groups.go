@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -20,7 +21,7 @@ type SecurityGroup struct {
 
 func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSecurityGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSecurityGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"name_regex": {
@@ -83,9 +84,9 @@ func dataSourceAlibabacloudStackSecurityGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSecurityGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDescribeSecurityGroupsRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -94,7 +95,7 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	request.VpcId = d.Get("vpc_id").(string)
 	request.PageNumber = requests.NewInteger(1)
@@ -178,12 +179,12 @@ func dataSourceAlibabacloudStackSecurityGroupsRead(d *schema.ResourceData, meta
 			request.PageNumber = page
 		}
 	}
-	return securityGroupsDescription(d, sg, meta)
+	return securityGroupsDescription(ctx, d, sg, meta)
 }
 
-func securityGroupsDescription(d *schema.ResourceData, sg []SecurityGroup, meta interface{}) error {
+func securityGroupsDescription(ctx context.Context, d *schema.ResourceData, sg []SecurityGroup, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbAcls() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbAclsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbAclsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -107,7 +108,7 @@ func dataSourceAlibabacloudStackSlbAcls() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbAclsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateDescribeAccessControlListsRequest()
 	request.RegionId = client.RegionId
@@ -117,7 +118,7 @@ func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	tags := d.Get("tags").(map[string]interface{})
 	if tags != nil && len(tags) > 0 {
 		KeyPairsTags := make([]slb.DescribeAccessControlListsTag, 0, len(tags))
@@ -167,12 +168,12 @@ func dataSourceAlibabacloudStackSlbAclsRead(d *schema.ResourceData, meta interfa
 		filteredAclsTemp = response.Acls.Acl
 	}
 
-	return slbAclsDescriptionAttributes(d, filteredAclsTemp, client, meta)
+	return slbAclsDescriptionAttributes(ctx, d, filteredAclsTemp, client, meta)
 }
 
-func aclTagsMappings(d *schema.ResourceData, aclId string, meta interface{}) map[string]string {
+func aclTagsMappings(ctx context.Context, d *schema.ResourceData, aclId string, meta interface{}) map[string]string {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	tags, err := slbService.DescribeTags(aclId, nil, TagResourceAcl)
 
 	if err != nil {
@@ -182,12 +183,12 @@ func aclTagsMappings(d *schema.ResourceData, aclId string, meta interface{}) map
 	return slbTagsToMap(tags)
 }
 
-func slbAclsDescriptionAttributes(d *schema.ResourceData, acls []slb.Acl, client *connectivity.AlibabacloudStackClient, meta interface{}) error {
+func slbAclsDescriptionAttributes(ctx context.Context, d *schema.ResourceData, acls []slb.Acl, client *connectivity.AlibabacloudStackClient, meta interface{}) error {
 
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	request := slb.CreateDescribeAccessControlListAttributeRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
@@ -196,7 +197,7 @@ func slbAclsDescriptionAttributes(d *schema.ResourceData, acls []slb.Acl, client
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	for _, item := range acls {
 		request.AclId = item.AclId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -213,7 +214,7 @@ func slbAclsDescriptionAttributes(d *schema.ResourceData, acls []slb.Acl, client
 			"ip_version":        response.AddressIPVersion,
 			"entry_list":        slbService.FlattenSlbAclEntryMappings(response.AclEntrys.AclEntry),
 			"related_listeners": slbService.flattenSlbRelatedListenerMappings(response.RelatedListeners.RelatedListener),
-			"tags":              aclTagsMappings(d, response.AclId, meta),
+			"tags":              aclTagsMappings(ctx, d, response.AclId, meta),
 		}
 
 		ids = append(ids, response.AclId)
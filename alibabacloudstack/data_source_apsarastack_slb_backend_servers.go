@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,7 +10,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbBackendServersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbBackendServersRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -48,7 +49,7 @@ func dataSourceAlibabacloudStackSlbBackendServers() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
@@ -59,7 +60,7 @@ func dataSourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
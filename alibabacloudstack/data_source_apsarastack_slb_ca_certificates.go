@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbCACertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbCACertificatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbCACertificatesRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -71,7 +72,7 @@ func dataSourceAlibabacloudStackSlbCACertificates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbCACertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeCACertificatesRequest()
@@ -81,7 +82,7 @@ func dataSourceAlibabacloudStackSlbCACertificatesRead(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 
@@ -15,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbListenersRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbListenersRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -187,7 +188,7 @@ func dataSourceAlibabacloudStackSlbListeners() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbListenersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeLoadBalancerAttributeRequest()
@@ -198,7 +199,7 @@ func dataSourceAlibabacloudStackSlbListenersRead(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -266,7 +267,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -303,7 +304,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 		case Https:
 			request := slb.CreateDescribeLoadBalancerHTTPSListenerAttributeRequest()
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			if strings.ToLower(client.Config.Protocol) == "https" {
 				request.Scheme = "https"
@@ -352,7 +353,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -389,7 +390,7 @@ func slbListenersDescriptionAttributes(d *schema.ResourceData, listeners []slb.L
 			} else {
 				request.Scheme = "http"
 			}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = loadBalancerId
 			request.ListenerPort = requests.NewInteger(listener.ListenerPort)
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -87,7 +88,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeMasterSlaveServerGroupsRequest()
@@ -98,7 +99,7 @@ func dataSourceAlibabacloudStackSlbMasterSlaveServerGroupsRead(d *schema.Resourc
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
@@ -162,7 +163,7 @@ func slbMasterSlaveServerGroupsDescriptionAttributes(d *schema.ResourceData, ser
 		} else {
 			request.Scheme = "http"
 		}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.MasterSlaveServerGroupId = serverGroup.MasterSlaveServerGroupId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeMasterSlaveServerGroupAttribute(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbRulesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbRulesRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -80,7 +81,7 @@ func dataSourceAlibabacloudStackSlbRules() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeRulesRequest()
@@ -91,7 +92,7 @@ func dataSourceAlibabacloudStackSlbRulesRead(d *schema.ResourceData, meta interf
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	request.ListenerPort = requests.NewInteger(d.Get("frontend_port").(int))
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerCertificatesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbServerCertificatesRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -71,7 +72,7 @@ func dataSourceAlibabacloudStackSlbServerCertificates() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbServerCertificatesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeServerCertificatesRequest()
@@ -82,7 +83,7 @@ func dataSourceAlibabacloudStackSlbServerCertificatesRead(d *schema.ResourceData
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	idsMap := make(map[string]string)
 	if v, ok := d.GetOk("ids"); ok {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -12,7 +13,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbServerGroupsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbServerGroupsRead),
 
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -83,7 +84,7 @@ func dataSourceAlibabacloudStackSlbServerGroups() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbServerGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateDescribeVServerGroupsRequest()
@@ -94,7 +95,7 @@ func dataSourceAlibabacloudStackSlbServerGroupsRead(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 
 	idsMap := make(map[string]string)
@@ -158,7 +159,7 @@ func slbServerGroupsDescriptionAttributes(d *schema.ResourceData, serverGroups [
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VServerGroupId = serverGroup.VServerGroupId
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeVServerGroupAttribute(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"sort"
 	"strings"
 
@@ -11,7 +12,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"output_file": {
@@ -54,7 +55,7 @@ func dataSourceAlibabacloudStackSlbZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slaveZones := make(map[string][]string)
 	localName := make(map[string][]string)
@@ -66,7 +67,7 @@ func dataSourceAlibabacloudStackSlbZonesRead(d *schema.ResourceData, meta interf
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeZones(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSlbsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSlbsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -133,9 +134,9 @@ func dataSourceAlibabacloudStackSlbs() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSlbsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := &SlbService{client}
+	slbService := &SlbService{client, ctx}
 
 	request := slb.CreateDescribeLoadBalancersRequest()
 	request.RegionId = client.RegionId
@@ -145,7 +146,7 @@ func dataSourceAlibabacloudStackSlbsRead(d *schema.ResourceData, meta interface{
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//request.ResourceGroupId = d.Get("resource_group_id").(string)
 	if v, ok := d.GetOk("master_availability_zone"); ok && v.(string) != "" {
 		request.MasterZoneId = v.(string)
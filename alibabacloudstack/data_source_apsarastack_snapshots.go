@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnapshotsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSnapshotsRead),
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -137,12 +138,12 @@ func dataSourceAlibabacloudStackSnapshots() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateDescribeSnapshotsRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
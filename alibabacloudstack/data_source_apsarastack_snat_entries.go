@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,7 +11,7 @@ import (
 
 func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackSnatEntriesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackSnatEntriesRead),
 
 		Schema: map[string]*schema.Schema{
 			"snat_table_id": {
@@ -65,7 +66,7 @@ func dataSourceAlibabacloudStackSnatEntries() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackSnatEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeSnatTableEntriesRequest()
@@ -76,7 +77,7 @@ func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta int
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	request.PageNumber = requests.NewInteger(1)
@@ -89,16 +90,11 @@ func dataSourceAlibabacloudStackSnatEntriesRead(d *schema.ResourceData, meta int
 	}
 
 	var allSnatEntries []vpc.SnatTableEntry
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		if err := invoker.Run(func() error {
-			response, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeSnatTableEntries(request)
-			})
-			raw = response
-			return err
-		}); err != nil {
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeSnatTableEntries(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_snat_entries", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
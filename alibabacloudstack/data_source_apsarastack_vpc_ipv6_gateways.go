@@ -1,21 +1,20 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVpcIpv6Gateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcIpv6GatewaysRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcIpv6GatewaysRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -111,7 +110,7 @@ func dataSourceAlibabacloudStackVpcIpv6Gateways() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpcIpv6GatewaysRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcIpv6GatewaysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeIpv6Gateways"
@@ -153,20 +152,9 @@ func dataSourceAlibabacloudStackVpcIpv6GatewaysRead(d *schema.ResourceData, meta
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			request["Product"] = "Vpc"
-			request["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		request["Product"] = "Vpc"
+		request["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alicloud_vpc_ipv6_gateways", action, AlibabacloudStackSdkGoERROR)
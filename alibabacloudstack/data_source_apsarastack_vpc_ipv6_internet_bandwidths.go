@@ -1,20 +1,19 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func dataSourceAlibabacloudStackVpcIpv6InternetBandwidths() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead),
 		Schema: map[string]*schema.Schema{
 			"ids": {
 				Type:     schema.TypeList,
@@ -87,7 +86,7 @@ func dataSourceAlibabacloudStackVpcIpv6InternetBandwidths() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DescribeIpv6Addresses"
@@ -121,20 +120,9 @@ func dataSourceAlibabacloudStackVpcIpv6InternetBandwidthsRead(d *schema.Resource
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			request["Product"] = "Vpc"
-			request["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		request["Product"] = "Vpc"
+		request["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpc_ipv6_internet_bandwidths", action, AlibabacloudStackSdkGoERROR)
@@ -1,12 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
 
-	"time"
-
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -17,7 +16,7 @@ import (
 
 func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpcsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpcsRead),
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -180,7 +179,7 @@ func dataSourceAlibabacloudStackVpcs() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpcsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVpcsRequest()
@@ -191,7 +190,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 
@@ -232,17 +231,11 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 	}
 
 	var allVpcs []vpc.Vpc
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		var err error
-		err = invoker.Run(func() error {
-			raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeVpcs(request)
-			})
-			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-			return err
+		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVpcs(request)
 		})
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vpcs", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
@@ -313,21 +306,16 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VRouterId = v.VRouterId
 		request.RegionId = string(client.Region)
 
 		var response *vpc.DescribeVRoutersResponse
-		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeVRouters(request)
 			})
 			if err != nil {
-				if IsThrottling(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -348,7 +336,7 @@ func dataSourceAlibabacloudStackVpcsRead(d *schema.ResourceData, meta interface{
 		filteredVpcs = append(filteredVpcs, v)
 	}
 
-	return vpcsDecriptionAttributes(d, filteredVpcs, route_tables, meta)
+	return vpcsDecriptionAttributes(ctx, d, filteredVpcs, route_tables, meta)
 }
 func vpcVswitchIdListContains(vswitchIdList []string, vswitchId string) bool {
 	for _, idListItem := range vswitchIdList {
@@ -358,9 +346,9 @@ func vpcVswitchIdListContains(vswitchIdList []string, vswitchId string) bool {
 	}
 	return false
 }
-func vpcsDecriptionAttributes(d *schema.ResourceData, vpcSetTypes []vpc.Vpc, route_tables []string, meta interface{}) error {
+func vpcsDecriptionAttributes(ctx context.Context, d *schema.ResourceData, vpcSetTypes []vpc.Vpc, route_tables []string, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnConnectionsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpnConnectionsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -176,7 +177,7 @@ func dataSourceAlibabacloudStackVpnConnections() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpnConnectionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVpnConnectionsRequest()
@@ -258,12 +259,12 @@ func dataSourceAlibabacloudStackVpnConnectionsRead(d *schema.ResourceData, meta
 		}
 	}
 
-	return vpnConnectionsDecriptionAttributes(d, filteredVpnConns, meta)
+	return vpnConnectionsDecriptionAttributes(ctx, d, filteredVpnConns, meta)
 }
 
-func vpnConnectionsDecriptionAttributes(d *schema.ResourceData, vpnSetTypes []vpc.VpnConnection, meta interface{}) error {
+func vpnConnectionsDecriptionAttributes(ctx context.Context, d *schema.ResourceData, vpnSetTypes []vpc.VpnConnection, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 	var ids []string
 	var names []string
 	var s []map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackVpnCustomerGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnCgwsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpnCgwsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -76,7 +77,7 @@ func dataSourceAlibabacloudStackVpnCustomerGateways() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnCgwsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpnCgwsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := vpc.CreateDescribeCustomerGatewaysRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
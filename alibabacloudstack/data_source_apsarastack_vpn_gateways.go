@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -13,7 +14,7 @@ import (
 
 func dataSourceAlibabacloudStackVpnGateways() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVpnsRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVpnsRead),
 
 		Schema: map[string]*schema.Schema{
 			"ids": {
@@ -132,7 +133,7 @@ func dataSourceAlibabacloudStackVpnGateways() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackVpnsRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVpnsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVpnGatewaysRequest()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 
@@ -14,7 +15,7 @@ import (
 
 func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackVSwitchesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackVSwitchesRead),
 
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
@@ -111,7 +112,7 @@ func dataSourceAlibabacloudStackVSwitches() *schema.Resource {
 		},
 	}
 }
-func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackVSwitchesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := vpc.CreateDescribeVSwitchesRequest()
@@ -123,7 +124,7 @@ func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta inter
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	// API DescribeVSwitches has some limitations
 	// If there is no vpc_id, setting PageSizeSmall can avoid ServiceUnavailable Error
 	request.PageSize = requests.NewInteger(PageSizeSmall)
@@ -151,16 +152,13 @@ func dataSourceAlibabacloudStackVSwitchesRead(d *schema.ResourceData, meta inter
 		}
 	}
 
-	invoker := NewInvoker()
 	for {
 		var raw interface{}
 		var err error
-		if err = invoker.Run(func() error {
-			raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeVSwitches(request)
-			})
-			return err
-		}); err != nil {
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVSwitches(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_vswitches", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -218,7 +216,7 @@ func VSwitchesDecriptionAttributes(d *schema.ResourceData, vsws []vpc.VSwitch, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	for _, vsw := range vsws {
 		mapping := map[string]interface{}{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -32,7 +33,7 @@ import (
 
 func dataSourceAlibabacloudStackZones() *schema.Resource {
 	return &schema.Resource{
-		Read: dataSourceAlibabacloudStackZonesRead,
+		ReadContext: withDiagnostics(dataSourceAlibabacloudStackZonesRead),
 
 		Schema: map[string]*schema.Schema{
 			"available_instance_type": {
@@ -185,9 +186,9 @@ func dataSourceAlibabacloudStackZones() *schema.Resource {
 	}
 }
 
-func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface{}) error {
+func dataSourceAlibabacloudStackZonesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	resType := d.Get("available_resource_creation").(string)
 	multi := d.Get("multi").(bool)
@@ -210,22 +211,18 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		//if instanceChargeType == string(PostPaid) {
 		//	request.InstanceChargeType = string(Postpaid)
 		//} else {
 		//	request.InstanceChargeType = string(Prepaid)
 		//}
 		var response = &rds.DescribeRegionsResponse{}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeRegions(request)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{Throttling}) {
-					time.Sleep(time.Duration(5) * time.Second)
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -274,7 +271,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		request := r_kvstore.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.DescribeRegions(request)
 		})
@@ -306,7 +303,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 	if strings.ToLower(Trim(resType)) == strings.ToLower(string(ResourceTypeMongoDB)) {
 		request := dds.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 			return ddsClient.DescribeRegions(request)
 		})
@@ -381,7 +378,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 	if strings.ToLower(Trim(resType)) == strings.ToLower(string(ResourceTypeGpdb)) {
 		request := gpdb.CreateDescribeRegionsRequest()
 		request.RegionId = client.RegionId
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.DescribeRegions(request)
 		})
@@ -460,7 +457,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DescribeZones(request)
 		})
@@ -491,7 +488,7 @@ func dataSourceAlibabacloudStackZonesRead(d *schema.ResourceData, meta interface
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.RegionId = client.RegionId
 	req.InstanceChargeType = instanceChargeType
 	if v, ok := d.GetOk("spot_strategy"); ok && v.(string) != "" {
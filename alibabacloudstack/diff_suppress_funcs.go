@@ -59,6 +59,9 @@ func csNodepoolSpotInstanceSettingDiffSuppressFunc(k, old, new string, d *schema
 	}
 	return true
 }
+func ecsSpotPriceLimitDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Get("spot_strategy").(string) != string(SpotWithPriceLimit)
+}
 func elasticsearchEnableKibanaPublicDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
 	return d.Get("enable_kibana_public_network").(bool) == false
 }
@@ -1,7 +1,7 @@
 package alibabacloudstack
 
 import (
-	"regexp"
+	"encoding/json"
 	"strings"
 
 	"github.com/alibabacloud-go/tea/tea"
@@ -18,6 +18,7 @@ import (
 	"github.com/aliyun/aliyun-oss-go-sdk/oss"
 	"github.com/aliyun/fc-go-sdk"
 	"github.com/denverdino/aliyungo/common"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 )
 
 const (
@@ -40,13 +41,14 @@ const (
 	InvalidFileSystemStatus_Ordering = "InvalidFileSystemStatus.Ordering"
 )
 
-var SlbIsBusy = []string{"SystemBusy", "OperationBusy", "ServiceIsStopping", "BackendServer.configuring", "ServiceIsConfiguring"}
+var SlbIsBusy = []string{"OperationBusy", "ServiceIsStopping", "BackendServer.configuring", "ServiceIsConfiguring"}
 var EcsNotFound = []string{"InvalidInstanceId.NotFound", "Forbidden.InstanceNotFound"}
 var DiskInvalidOperation = []string{"IncorrectDiskStatus", "IncorrectInstanceStatus", "OperationConflict", "InternalError", "InvalidOperation.Conflict", "IncorrectDiskStatus.Initializing"}
-var NetworkInterfaceInvalidOperations = []string{"InvalidOperation.InvalidEniState", "InvalidOperation.InvalidEcsState", "OperationConflict", "ServiceUnavailable", "InternalError"}
-var SnapshotInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
-var SnapshotPolicyInvalidOperations = []string{"OperationConflict", "ServiceUnavailable", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
+var NetworkInterfaceInvalidOperations = []string{"InvalidOperation.InvalidEniState", "InvalidOperation.InvalidEcsState", "OperationConflict", "InternalError"}
+var SnapshotInvalidOperations = []string{"OperationConflict", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
+var SnapshotPolicyInvalidOperations = []string{"OperationConflict", "InternalError", "SnapshotCreatedDisk", "SnapshotCreatedImage"}
 var DiskNotSupportOnlineChangeErrors = []string{"InvalidDiskCategory.NotSupported", "InvalidRegion.NotSupport", "IncorrectInstanceStatus", "IncorrectDiskStatus", "InvalidOperation.InstanceTypeNotSupport"}
+var FcNotFound = []string{"ServiceNotFound", "FunctionNotFound", "TriggerNotFound", "AliasNotFound", "VersionNotFound"}
 var DBReadInstanceNotReadyStatus = []string{"OperationDenied.ReadDBInstanceStatus", "OperationDenied.MasterDBInstanceState", "ReadDBInstance.Mismatch"}
 
 // An Error represents a custom error for Terraform failure response
@@ -116,42 +118,6 @@ func NotFoundError(err error) bool {
 	return false
 }
 
-func NeedRetry(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	postRegex := regexp.MustCompile("^Post [\"]*https://.*")
-	if postRegex.MatchString(err.Error()) {
-		return true
-	}
-
-	throttlingRegex := regexp.MustCompile("^Throttling.*")
-	codeRegex := regexp.MustCompile("^code: 5[\\d]{2}")
-
-	if e, ok := err.(*tea.SDKError); ok {
-		if strings.Contains(*e.Message, "code: 500, 您已开通过") {
-			return false
-		}
-		if strings.Contains(*e.Message, "The current status of the resource does not support this operation, please retry again.") {
-			return true
-		}
-		if *e.Code == ServiceUnavailable || *e.Code == "Rejected.Throttling" || throttlingRegex.MatchString(*e.Code) || codeRegex.MatchString(*e.Message) {
-			return true
-		}
-	}
-
-	if e, ok := err.(*errors.ServerError); ok {
-		return e.ErrorCode() == ServiceUnavailable || e.ErrorCode() == "Rejected.Throttling" || throttlingRegex.MatchString(e.ErrorCode()) || codeRegex.MatchString(e.Message())
-	}
-
-	if e, ok := err.(*common.Error); ok {
-		return e.Code == ServiceUnavailable || e.Code == "Rejected.Throttling" || throttlingRegex.MatchString(e.Code) || codeRegex.MatchString(e.Message)
-	}
-
-	return false
-}
-
 func IsExpectedErrorCodes(code string, errorCodes []string) bool {
 	if code == "" {
 		return false
@@ -247,27 +213,6 @@ func IsExpectedErrors(err error, expectCodes []string) bool {
 	return false
 }
 
-func IsThrottling(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	if e, ok := err.(*errors.ServerError); ok {
-		if e.ErrorCode() == Throttling {
-			return true
-		}
-		return false
-	}
-
-	if e, ok := err.(*common.Error); ok {
-		if e.Code == Throttling {
-			return true
-		}
-		return false
-	}
-	return false
-}
-
 func GetTimeErrorFromString(str string) error {
 	return &ProviderError{
 		errorCode: "WaitForTimeout",
@@ -293,6 +238,7 @@ const (
 	AlibabacloudStackOssGoSdk      = ErrorSource("[SDK aliyun-oss-go-sdk ERROR]")
 	AlibabacloudStackLogGoSdkERROR = ErrorSource("[SDK aliyun-log-go-sdk ERROR]")
 	AliyunTablestoreGoSdk          = ErrorSource("[SDK aliyun-tablestore-go-sdk ERROR]")
+	AlibabacloudStackFcGoSdk       = ErrorSource("[SDK fc-go-sdk ERROR]")
 	AlibabacloudStackDatahubSdkGo  = ErrorSource("[SDK aliyun-datahub-sdk-go ERROR]")
 	DenverdinoAliyungo             = ErrorSource("[SDK denverdino/aliyungo ERROR]")
 )
@@ -302,11 +248,15 @@ const (
 // Err: a new error is built from extra message
 // Path: the file path of error occurred
 // Line: the file line of error occurred
+// ResourceId: the id of the resource the failed operation ran against, if known
+// Action: the API action or function which failed, if known
 type ComplexError struct {
-	Cause error
-	Err   error
-	Path  string
-	Line  int
+	Cause      error
+	Err        error
+	Path       string
+	Line       int
+	ResourceId string
+	Action     string
 }
 
 func (e ComplexError) Error() string {
@@ -319,6 +269,10 @@ func (e ComplexError) Error() string {
 	return fmt.Sprintf("[ERROR] %s:%d: %s:\n%s", e.Path, e.Line, e.Err.Error(), e.Cause.Error())
 }
 
+func (e ComplexError) Unwrap() error {
+	return e.Cause
+}
+
 func Error(msg string, args ...interface{}) error {
 	return fmt.Errorf(msg, args...)
 }
@@ -358,7 +312,83 @@ func WrapErrorf(cause error, msg string, args ...interface{}) error {
 	if msg == NotFoundMsg && len(args) == 2 {
 		msg += RequestIdMsg
 	}
-	return WrapComplexError(cause, fmt.Errorf(msg, args...), filepath, line)
+	err := WrapComplexError(cause, fmt.Errorf(msg, args...), filepath, line)
+	// The resource messages are formatted as "Resource <resource-id> <operation> ...", keep both of them for the diagnostics.
+	switch msg {
+	case DefaultErrorMsg, DataDefaultErrorMsg, DefaultTimeoutMsg, WaitTimeoutMsg:
+		if len(args) >= 2 {
+			e := err.(*ComplexError)
+			e.ResourceId = fmt.Sprint(args[0])
+			e.Action = fmt.Sprint(args[1])
+		}
+	}
+	return err
+}
+
+// errorToDiagnostics converts the error returned by a CRUD function into diagnostics. The summary is the message of
+// the failed operation and the detail lists the API action, request id, error code and resource id found along the
+// error chain, followed by the whole error.
+func errorToDiagnostics(err error, id string) diag.Diagnostics {
+	if err == nil {
+		return nil
+	}
+	summary, action, requestId, code, resourceId := "", "", "", "", ""
+	for cause := err; cause != nil; {
+		switch e := cause.(type) {
+		case *ComplexError:
+			if summary == "" && e.Err != nil {
+				summary = e.Err.Error()
+			}
+			if action == "" {
+				action = e.Action
+			}
+			if resourceId == "" {
+				resourceId = e.ResourceId
+			}
+			cause = e.Cause
+			continue
+		case *errors.ServerError:
+			code, requestId = e.ErrorCode(), e.RequestId()
+		case *tea.SDKError:
+			code = tea.StringValue(e.Code)
+			data := make(map[string]interface{})
+			if json.Unmarshal([]byte(tea.StringValue(e.Data)), &data) == nil {
+				if v, ok := data["RequestId"].(string); ok {
+					requestId = v
+				}
+			}
+		case oss.ServiceError:
+			code, requestId = e.Code, e.RequestID
+		case *sls.Error:
+			code, requestId = e.Code, e.RequestID
+		}
+		if summary == "" {
+			summary = cause.Error()
+		}
+		break
+	}
+	if summary == "" {
+		summary = err.Error()
+	}
+	// Create failures are reported against the resource type, prefer the id once it is known
+	if id != "" && (resourceId == "" || strings.HasPrefix(resourceId, "alibabacloudstack_")) {
+		resourceId = id
+	}
+
+	var detail []string
+	for _, item := range [][2]string{{"Action", action}, {"RequestId", requestId}, {"ErrorCode", code}, {"ResourceId", resourceId}} {
+		if item[1] != "" {
+			detail = append(detail, fmt.Sprintf("%s: %s", item[0], item[1]))
+		}
+	}
+	detail = append(detail, "", err.Error())
+	return diag.Diagnostics{
+		{
+			Severity: diag.Error,
+			Summary:  summary,
+			Detail:   strings.TrimSpace(strings.Join(detail, "\n")),
+		},
+	}
 }
 
 func WrapComplexError(cause, err error, filepath string, fileline int) error {
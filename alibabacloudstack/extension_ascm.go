@@ -1,9 +1,9 @@
 package alibabacloudstack
 
-//type BindResourceAndUsers struct {
-//	ResourceGroupID int    `json:"resource_group_id"`
-//	AscmUserIds     string `json:"ascm_user_ids"`
-//}
+//	type BindResourceAndUsers struct {
+//		ResourceGroupID int    `json:"resource_group_id"`
+//		AscmUserIds     string `json:"ascm_user_ids"`
+//	}
 type ResourceGroup struct {
 	Code string `json:"code"`
 	Cost int    `json:"cost"`
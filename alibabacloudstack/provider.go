@@ -2,30 +2,28 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
-	"github.com/aliyun/alibaba-cloud-sdk-go/services/sts"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/helper/hashcode"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
-	"github.com/mitchellh/go-homedir"
-	"io/ioutil"
 	"log"
 	"os"
-	"runtime"
 	"strconv"
 	"strings"
 	"time"
 )
 
 func Provider() *schema.Provider {
-	return &schema.Provider{
+	return withResourceScope(&schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"access_key": {
 				Type:        schema.TypeString,
@@ -76,6 +74,18 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_PROFILE", ""),
 			},
 			"endpoints": endpointsSchema(),
+			"ascm_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_ASCM_ENDPOINT", nil),
+				Description: descriptions["ascm_endpoint"],
+			},
+			"location_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_LOCATION_ENDPOINT", nil),
+				Description: descriptions["location_endpoint"],
+			},
 			"shared_credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -88,7 +98,11 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_INSECURE", false),
 				Description: descriptions["insecure"],
 			},
-			"assume_role": assumeRoleSchema(),
+			"assume_role":           assumeRoleSchema(),
+			"assume_role_with_oidc": assumeRoleWithOIDCSchema(),
+			"default_tags":          defaultTagsSchema(),
+			"ignore_tags":           ignoreTagsSchema(),
+			"name_mapping":          nameMappingSchema(),
 			"fc": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -113,6 +127,26 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("CLIENT_CONNECT_TIMEOUT", 60000),
 				Description: descriptions["client_connect_timeout"],
 			},
+			"endpoint_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_ENDPOINT_TEMPLATE", nil),
+				Description: descriptions["endpoint_template"],
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_MAX_RETRIES", connectivity.DefaultMaxRetries),
+				Description:  descriptions["max_retries"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"max_retry_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("ALIBABACLOUDSTACK_MAX_RETRY_TIMEOUT", connectivity.DefaultMaxRetryTimeout),
+				Description:  descriptions["max_retry_timeout"],
+				ValidateFunc: validation.IntAtLeast(0),
+			},
 			"source_ip": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -183,12 +217,14 @@ func Provider() *schema.Provider {
 			"sts_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "It has been deprecated, the endpoint of the product is listed from the endpoint catalog of the platform or built from the endpoint_template instead.",
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_STS_ENDPOINT", os.Getenv("ALIBABACLOUDSTACK_STS_ENDPOINT")),
 				Description: descriptions["sts_endpoint"],
 			},
 			"quickbi_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "It has been deprecated, the endpoint of the product is listed from the endpoint catalog of the platform or built from the endpoint_template instead.",
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_QUICKBI_ENDPOINT", nil),
 				Description: descriptions["quickbi_endpoint"],
 			},
@@ -213,21 +249,28 @@ func Provider() *schema.Provider {
 			"dataworkspublic": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "It has been deprecated, the endpoint of the product is listed from the endpoint catalog of the platform or built from the endpoint_template instead.",
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_DATAWORKS_PUBLIC_ENDPOINT", nil),
 				Description: descriptions["dataworkspublic_endpoint"],
 			},
 			"dbs_endpoint": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "It has been deprecated, the endpoint of the product is listed from the endpoint catalog of the platform or built from the endpoint_template instead.",
 				DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_DBS_ENDPOINT", nil),
 				Description: descriptions["dbs_endpoint"],
 			},
 		},
 		DataSourcesMap: map[string]*schema.Resource{
 			"alibabacloudstack_account":                                dataSourceAlibabacloudStackAccount(),
+			"alibabacloudstack_caller_identity":                        dataSourceAlibabacloudStackCallerIdentity(),
 			"alibabacloudstack_adb_clusters":                           dataSourceAlibabacloudStackAdbDbClusters(),
 			"alibabacloudstack_adb_zones":                              dataSourceAlibabacloudStackAdbZones(),
 			"alibabacloudstack_adb_db_clusters":                        dataSourceAlibabacloudStackAdbDbClusters(),
+			"alibabacloudstack_alikafka_consumer_groups":               dataSourceAlibabacloudStackAlikafkaConsumerGroups(),
+			"alibabacloudstack_alikafka_instances":                     dataSourceAlibabacloudStackAlikafkaInstances(),
+			"alibabacloudstack_alikafka_sasl_users":                    dataSourceAlibabacloudStackAlikafkaSaslUsers(),
+			"alibabacloudstack_alikafka_topics":                        dataSourceAlibabacloudStackAlikafkaTopics(),
 			"alibabacloudstack_api_gateway_apis":                       dataSourceAlibabacloudStackApiGatewayApis(),
 			"alibabacloudstack_api_gateway_apps":                       dataSourceAlibabacloudStackApiGatewayApps(),
 			"alibabacloudstack_api_gateway_groups":                     dataSourceAlibabacloudStackApiGatewayGroups(),
@@ -250,6 +293,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_roles":                             dataSourceAlibabacloudStackAscmRoles(),
 			"alibabacloudstack_ascm_ram_policies":                      dataSourceAlibabacloudStackAscmRamPolicies(),
 			"alibabacloudstack_ascm_ram_policies_for_user":             dataSourceAlibabacloudStackAscmRamPoliciesForUser(),
+			"alibabacloudstack_cen_bandwidth_packages":                 dataSourceAlibabacloudStackCenBandwidthPackages(),
+			"alibabacloudstack_cen_instances":                          dataSourceAlibabacloudStackCenInstances(),
+			"alibabacloudstack_cen_route_entries":                      dataSourceAlibabacloudStackCenRouteEntries(),
+			"alibabacloudstack_cen_route_maps":                         dataSourceAlibabacloudStackCenRouteMaps(),
 			"alibabacloudstack_common_bandwidth_packages":              dataSourceAlibabacloudStackCommonBandwidthPackages(),
 			"alibabacloudstack_cr_ee_instances":                        dataSourceAlibabacloudStackCrEEInstances(),
 			"alibabacloudstack_cr_ee_namespaces":                       dataSourceAlibabacloudStackCrEENamespaces(),
@@ -264,6 +311,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_cms_metric_metalist":                    dataSourceAlibabacloudstackCmsMetricMetalist(),
 			"alibabacloudstack_cms_alarms":                             dataSourceAlibabacloudstackCmsAlarms(),
 			"alibabacloudstack_datahub_service":                        dataSourceAlibabacloudStackDatahubService(),
+			"alibabacloudstack_db_backups":                             dataSourceAlibabacloudStackDBBackups(),
+			"alibabacloudstack_db_instance_classes":                    dataSourceAlibabacloudStackDBInstanceClasses(),
+			"alibabacloudstack_db_instance_parameters":                 dataSourceAlibabacloudStackDBInstanceParameters(),
 			"alibabacloudstack_db_instances":                           dataSourceAlibabacloudStackDBInstances(),
 			"alibabacloudstack_db_zones":                               dataSourceAlibabacloudStackDBZones(),
 			"alibabacloudstack_disks":                                  dataSourceAlibabacloudStackDisks(),
@@ -287,6 +337,11 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ess_notifications":                      dataSourceAlibabacloudStackEssNotifications(),
 			"alibabacloudstack_ess_scaling_rules":                      dataSourceAlibabacloudStackEssScalingRules(),
 			"alibabacloudstack_ess_scheduled_tasks":                    dataSourceAlibabacloudStackEssScheduledTasks(),
+			"alibabacloudstack_fc_aliases":                             dataSourceAlibabacloudStackFcAliases(),
+			"alibabacloudstack_fc_functions":                           dataSourceAlibabacloudStackFcFunctions(),
+			"alibabacloudstack_fc_services":                            dataSourceAlibabacloudStackFcServices(),
+			"alibabacloudstack_fc_triggers":                            dataSourceAlibabacloudStackFcTriggers(),
+			"alibabacloudstack_fc_versions":                            dataSourceAlibabacloudStackFcVersions(),
 			"alibabacloudstack_forward_entries":                        dataSourceAlibabacloudStackForwardEntries(),
 			"alibabacloudstack_gpdb_accounts":                          dataSourceAlibabacloudStackGpdbAccounts(),
 			"alibabacloudstack_gpdb_instances":                         dataSourceAlibabacloudStackGpdbInstances(),
@@ -304,6 +359,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_kvstore_zones":                          dataSourceAlibabacloudStackKVStoreZones(),
 			"alibabacloudstack_kvstore_instance_classes":               dataSourceAlibabacloudStackKVStoreInstanceClasses(),
 			"alibabacloudstack_kvstore_instance_engines":               dataSourceAlibabacloudStackKVStoreInstanceEngines(),
+			"alibabacloudstack_log_projects":                           dataSourceAlibabacloudStackLogProjects(),
+			"alibabacloudstack_log_stores":                             dataSourceAlibabacloudStackLogStores(),
 			"alibabacloudstack_mongodb_instances":                      dataSourceAlibabacloudStackMongoDBInstances(),
 			"alibabacloudstack_mongodb_zones":                          dataSourceAlibabacloudStackMongoDBZones(),
 			"alibabacloudstack_maxcompute_cus":                         dataSourceAlibabacloudStackMaxcomputeCus(),
@@ -328,6 +385,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ots_instances":                          dataSourceAlibabacloudStackOtsInstances(),
 			"alibabacloudstack_ots_instances_attachment":               dataSourceAlibabacloudStackOtsInstanceAttachments(),
 			"alibabacloudstack_ots_service":                            dataSourceAlibabacloudStackOtsService(),
+			"alibabacloudstack_polardb_clusters":                       dataSourceAlibabacloudStackPolarDBClusters(),
+			"alibabacloudstack_polardb_node_classes":                   dataSourceAlibabacloudStackPolarDBNodeClasses(),
 			"alibabacloudstack_quick_bi_users":                         dataSourceAlibabacloudStackQuickBiUsers(),
 			"alibabacloudstack_router_interfaces":                      dataSourceAlibabacloudStackRouterInterfaces(),
 			"alibabacloudstack_ram_service_role_products":              dataSourceAlibabacloudstackRamServiceRoleProducts(),
@@ -361,6 +420,9 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_vswitches":                              dataSourceAlibabacloudStackVSwitches(),
 			"alibabacloudstack_vpcs":                                   dataSourceAlibabacloudStackVpcs(),
 			"alibabacloudstack_zones":                                  dataSourceAlibabacloudStackZones(),
+			"alibabacloudstack_endpoints":                              dataSourceAlibabacloudStackEndpoints(),
+			"alibabacloudstack_profile":                                dataSourceAlibabacloudStackProfile(),
+			"alibabacloudstack_provider_config":                        dataSourceAlibabacloudStackProviderConfig(),
 			"alibabacloudstack_elasticsearch_instances":                dataSourceAlibabacloudStackElasticsearch(),
 			"alibabacloudstack_elasticsearch_zones":                    dataSourceAlibabacloudStackElaticsearchZones(),
 			"alibabacloudstack_ehpc_job_templates":                     dataSourceAlibabacloudStackEhpcJobTemplates(),
@@ -379,6 +441,8 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_adb_cluster":                           resourceAlibabacloudStackAdbDbCluster(),
 			"alibabacloudstack_adb_connection":                        resourceAlibabacloudStackAdbConnection(),
 			"alibabacloudstack_adb_db_cluster":                        resourceAlibabacloudStackAdbDbCluster(),
+			"alibabacloudstack_alikafka_consumer_group":               resourceAlibabacloudStackAlikafkaConsumerGroup(),
+			"alibabacloudstack_alikafka_instance":                     resourceAlibabacloudStackAlikafkaInstance(),
 			"alibabacloudstack_alikafka_sasl_acl":                     resourceAlibabacloudStackAlikafkaSaslAcl(),
 			"alibabacloudstack_alikafka_sasl_user":                    resourceAlibabacloudStackAlikafkaSaslUser(),
 			"alibabacloudstack_alikafka_topic":                        resourceAlibabacloudStackAlikafkaTopic(),
@@ -403,6 +467,12 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ascm_user_group_role_binding":          resourceAlibabacloudStackAscmUserGroupRoleBinding(),
 			"alibabacloudstack_ascm_user_role_binding":                resourceAlibabacloudStackAscmUserRoleBinding(),
 			"alibabacloudstack_ascm_usergroup_user":                   resourceAlibabacloudStackAscmUserGroupUser(),
+			"alibabacloudstack_cen_bandwidth_package":                 resourceAlibabacloudStackCenBandwidthPackage(),
+			"alibabacloudstack_cen_bandwidth_package_attachment":      resourceAlibabacloudStackCenBandwidthPackageAttachment(),
+			"alibabacloudstack_cen_instance":                          resourceAlibabacloudStackCenInstance(),
+			"alibabacloudstack_cen_instance_attachment":               resourceAlibabacloudStackCenInstanceAttachment(),
+			"alibabacloudstack_cen_route_entry":                       resourceAlibabacloudStackCenRouteEntry(),
+			"alibabacloudstack_cen_route_map":                         resourceAlibabacloudStackCenRouteMap(),
 			"alibabacloudstack_cms_alarm":                             resourceAlibabacloudStackCmsAlarm(),
 			"alibabacloudstack_cms_alarm_contact":                     resourceAlibabacloudstackCmsAlarmContact(),
 			"alibabacloudstack_cms_alarm_contact_group":               resourceAlibabacloudstackCmsAlarmContactGroup(),
@@ -425,6 +495,7 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_db_connection":                         resourceAlibabacloudStackDBConnection(),
 			"alibabacloudstack_db_database":                           resourceAlibabacloudStackDBDatabase(),
 			"alibabacloudstack_db_instance":                           resourceAlibabacloudStackDBInstance(),
+			"alibabacloudstack_db_parameter_group":                    resourceAlibabacloudStackDBParameterGroup(),
 			"alibabacloudstack_db_read_write_splitting_connection":    resourceAlibabacloudStackDBReadWriteSplittingConnection(),
 			"alibabacloudstack_db_readonly_instance":                  resourceAlibabacloudStackDBReadonlyInstance(),
 			"alibabacloudstack_disk":                                  resourceAlibabacloudStackDisk(),
@@ -459,10 +530,15 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_ess_attachment":                        resourceAlibabacloudstackEssAttachment(),
 			"alibabacloudstack_ess_lifecycle_hook":                    resourceAlibabacloudStackEssLifecycleHook(),
 			"alibabacloudstack_ess_notification":                      resourceAlibabacloudStackEssNotification(),
-			"alibabacloudstack_ess_scaling_group":                     resourceAlibabacloudStackEssScalingGroup(),
+			"alibabacloudstack_ess_scaling_group":                     withTagging(resourceAlibabacloudStackEssScalingGroup(), essScalingGroupTagging),
 			"alibabacloudstack_ess_scaling_rule":                      resourceAlibabacloudStackEssScalingRule(),
 			"alibabacloudstack_ess_scalinggroup_vserver_groups":       resourceAlibabacloudStackEssScalingGroupVserverGroups(),
 			"alibabacloudstack_ess_scheduled_task":                    resourceAlibabacloudStackEssScheduledTask(),
+			"alibabacloudstack_fc_alias":                              resourceAlibabacloudStackFcAlias(),
+			"alibabacloudstack_fc_function":                           resourceAlibabacloudStackFcFunction(),
+			"alibabacloudstack_fc_service":                            resourceAlibabacloudStackFcService(),
+			"alibabacloudstack_fc_trigger":                            resourceAlibabacloudStackFcTrigger(),
+			"alibabacloudstack_fc_version":                            resourceAlibabacloudStackFcVersion(),
 			"alibabacloudstack_forward_entry":                         resourceAlibabacloudStackForwardEntry(),
 			"alibabacloudstack_gpdb_account":                          resourceAlibabacloudStackGpdbAccount(),
 			"alibabacloudstack_gpdb_connection":                       resourceAlibabacloudStackGpdbConnection(),
@@ -478,15 +554,20 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_key_pair_attachment":                   resourceAlibabacloudStackKeyPairAttachment(),
 			"alibabacloudstack_kms_alias":                             resourceAlibabacloudStackKmsAlias(),
 			"alibabacloudstack_kms_ciphertext":                        resourceAlibabacloudStackKmsCiphertext(),
-			"alibabacloudstack_kms_key":                               resourceAlibabacloudStackKmsKey(),
+			"alibabacloudstack_kms_key":                               withTagging(resourceAlibabacloudStackKmsKey(), kmsKeyTagging),
 			"alibabacloudstack_kms_secret":                            resourceAlibabacloudStackKmsSecret(),
 			"alibabacloudstack_kvstore_account":                       resourceAlibabacloudStackKVstoreAccount(),
 			"alibabacloudstack_kvstore_backup_policy":                 resourceAlibabacloudStackKVStoreBackupPolicy(),
 			"alibabacloudstack_kvstore_connection":                    resourceAlibabacloudStackKvstoreConnection(),
-			"alibabacloudstack_kvstore_instance":                      resourceAlibabacloudStackKVStoreInstance(),
+			"alibabacloudstack_kvstore_instance":                      withTagging(resourceAlibabacloudStackKVStoreInstance(), kvstoreInstanceTagging),
 			"alibabacloudstack_launch_template":                       resourceAlibabacloudStackLaunchTemplate(),
+			"alibabacloudstack_log_alert":                             resourceAlibabacloudStackLogAlert(),
+			"alibabacloudstack_log_dashboard":                         resourceAlibabacloudStackLogDashboard(),
+			"alibabacloudstack_log_etl":                               resourceAlibabacloudStackLogEtl(),
 			"alibabacloudstack_log_machine_group":                     resourceAlibabacloudStackLogMachineGroup(),
+			"alibabacloudstack_log_oss_shipper":                       resourceAlibabacloudStackLogOssShipper(),
 			"alibabacloudstack_log_project":                           resourceAlibabacloudStackLogProject(),
+			"alibabacloudstack_log_saved_search":                      resourceAlibabacloudStackLogSavedSearch(),
 			"alibabacloudstack_log_store":                             resourceAlibabacloudStackLogStore(),
 			"alibabacloudstack_log_store_index":                       resourceAlibabacloudStackLogStoreIndex(),
 			"alibabacloudstack_logtail_attachment":                    resourceAlibabacloudStackLogtailAttachment(),
@@ -495,10 +576,10 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_maxcompute_user":                       resourceAlibabacloudStackMaxcomputeUser(),
 			"alibabacloudstack_maxcompute_cu":                         resourceAlibabacloudStackMaxcomputeCu(),
 			"alibabacloudstack_mongodb_instance":                      resourceAlibabacloudStackMongoDBInstance(),
-			"alibabacloudstack_mongodb_sharding_instance":             resourceAlibabacloudStackMongoDBShardingInstance(),
+			"alibabacloudstack_mongodb_sharding_instance":             withTagging(resourceAlibabacloudStackMongoDBShardingInstance(), mongodbShardingInstanceTagging),
 			"alibabacloudstack_nas_access_group":                      resourceAlibabacloudStackNasAccessGroup(),
 			"alibabacloudstack_nas_access_rule":                       resourceAlibabacloudStackNasAccessRule(),
-			"alibabacloudstack_nas_file_system":                       resourceAlibabacloudStackNasFileSystem(),
+			"alibabacloudstack_nas_file_system":                       withTagging(resourceAlibabacloudStackNasFileSystem(), nasFileSystemTagging),
 			"alibabacloudstack_nas_mount_target":                      resourceAlibabacloudStackNasMountTarget(),
 			"alibabacloudstack_nat_gateway":                           resourceAlibabacloudStackNatGateway(),
 			"alibabacloudstack_network_acl":                           resourceAlibabacloudStackNetworkAcl(),
@@ -512,10 +593,15 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_oss_bucket":                            resourceAlibabacloudStackOssBucket(),
 			"alibabacloudstack_oss_bucket_quota":                      resourceAlibabacloudStackOssBucketQuota(),
 			"alibabacloudstack_oss_bucket_kms":                        resourceAlibabacloudStackOssBucketKms(),
-			"alibabacloudstack_oss_bucket_object":                     resourceAlibabacloudStackOssBucketObject(),
+			"alibabacloudstack_oss_bucket_object":                     withTagging(resourceAlibabacloudStackOssBucketObject(), ossBucketObjectTagging),
 			"alibabacloudstack_ots_instance":                          resourceAlibabacloudStackOtsInstance(),
 			"alibabacloudstack_ots_instance_attachment":               resourceAlibabacloudStackOtsInstanceAttachment(),
 			"alibabacloudstack_ots_table":                             resourceAlibabacloudStackOtsTable(),
+			"alibabacloudstack_polardb_account":                       resourceAlibabacloudStackPolarDBAccount(),
+			"alibabacloudstack_polardb_backup_policy":                 resourceAlibabacloudStackPolarDBBackupPolicy(),
+			"alibabacloudstack_polardb_cluster":                       resourceAlibabacloudStackPolarDBCluster(),
+			"alibabacloudstack_polardb_database":                      resourceAlibabacloudStackPolarDBDatabase(),
+			"alibabacloudstack_polardb_endpoint":                      resourceAlibabacloudStackPolarDBEndpoint(),
 			"alibabacloudstack_quick_bi_user":                         resourceAlibabacloudStackQuickBiUser(),
 			"alibabacloudstack_quick_bi_user_group":                   resourceAlibabacloudStackQuickBiUserGroup(),
 			"alibabacloudstack_quick_bi_workspace":                    resourceAlibabacloudStackQuickBiWorkspace(),
@@ -574,31 +660,41 @@ func Provider() *schema.Provider {
 			"alibabacloudstack_csb_project":                           resourceAlibabacloudStackCsbProject(),
 			"alibabacloudstack_graph_database_db_instance":            resourceAlibabacloudStackGraphDatabaseDbInstance(),
 		},
-		ConfigureFunc: providerConfigure,
-	}
+		ConfigureContextFunc: providerConfigureContext,
+	})
 }
 
-var providerConfig map[string]interface{}
+// providerConfigureContext configures the client with the stop context of the provider, so that the retries of
+// its requests stop waiting once terraform is interrupted.
+func providerConfigureContext(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	meta, err := providerConfigure(d)
+	if err != nil {
+		return nil, diag.FromErr(err)
+	}
+	if client, ok := meta.(*connectivity.AlibabacloudStackClient); ok {
+		if stopContext, ok := schema.StopContext(ctx); ok {
+			client.SetStopContext(stopContext)
+		}
+	}
+	return meta, nil
+}
 
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
-	var getProviderConfig = func(str string, key string) string {
-		if str == "" {
-			value, err := getConfigFromProfile(d, key)
-			if err == nil && value != nil {
-				str = value.(string)
-			}
+	region := d.Get("region").(string)
+	if region == "" {
+		value, err := getProfileRegion(d)
+		if err != nil {
+			return nil, err
 		}
-		return str
+		region = value
 	}
-
-	accessKey := getProviderConfig(d.Get("access_key").(string), "access_key_id")
-	secretKey := getProviderConfig(d.Get("secret_key").(string), "access_key_secret")
-	region := getProviderConfig(d.Get("region").(string), "region_id")
 	if region == "" {
 		region = DEFAULT_REGION
 	}
 
-	ecsRoleName := getProviderConfig(d.Get("ecs_role_name").(string), "ram_role_name")
+	accessKey := d.Get("access_key").(string)
+	secretKey := d.Get("secret_key").(string)
+	ecsRoleName := d.Get("ecs_role_name").(string)
 
 	config := &connectivity.Config{
 		AccessKey:            strings.TrimSpace(accessKey),
@@ -611,6 +707,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		Protocol:             d.Get("protocol").(string),
 		ClientReadTimeout:    d.Get("client_read_timeout").(int),
 		ClientConnectTimeout: d.Get("client_connect_timeout").(int),
+		MaxRetries:           d.Get("max_retries").(int),
+		MaxRetryTimeout:      d.Get("max_retry_timeout").(int),
 		Insecure:             d.Get("insecure").(bool),
 		Proxy:                d.Get("proxy").(string),
 		Department:           d.Get("department").(string),
@@ -622,24 +720,17 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	if v, ok := d.GetOk("security_transport"); config.SecureTransport == "" && ok && v.(string) != "" {
 		config.SecureTransport = v.(string)
 	}
-	token := getProviderConfig(d.Get("security_token").(string), "sts_token")
-	config.SecurityToken = strings.TrimSpace(token)
-	config.RamRoleArn = getProviderConfig(d.Get("role_arn").(string), "ram_role_arn")
-	log.Printf("$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$led!!! %s", config.RamRoleArn)
-	config.RamRoleSessionName = getProviderConfig("", "ram_session_name")
-	expiredSeconds, err := getConfigFromProfile(d, "expired_seconds")
-	if err == nil && expiredSeconds != nil {
-		config.RamRoleSessionExpiration = (int)(expiredSeconds.(float64))
-	}
+	config.SecurityToken = strings.TrimSpace(d.Get("security_token").(string))
+	config.RamRoleArn = d.Get("role_arn").(string)
+	config.Profile = d.Get("profile").(string)
+	config.ProfileFile = d.Get("shared_credentials_file").(string)
 
 	assumeRoleList := d.Get("assume_role").(*schema.Set).List()
-	log.Printf("$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$led!!! %s", assumeRoleList)
 	if len(assumeRoleList) == 1 {
 		assumeRole := assumeRoleList[0].(map[string]interface{})
 		if assumeRole["role_arn"].(string) != "" {
 			config.RamRoleArn = assumeRole["role_arn"].(string)
 		}
-		log.Printf("$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$$led!!! %s", config.RamRoleArn)
 		if assumeRole["session_name"].(string) != "" {
 			config.RamRoleSessionName = assumeRole["session_name"].(string)
 		}
@@ -664,9 +755,18 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.RamRoleArn, config.RamRoleSessionName, config.RamRolePolicy, config.RamRoleSessionExpiration)
 	}
 
-	if err := config.MakeConfigByEcsRoleName(); err != nil {
-		return nil, err
+	assumeRoleWithOIDCList := d.Get("assume_role_with_oidc").(*schema.Set).List()
+	if len(assumeRoleWithOIDCList) == 1 {
+		assumeRoleWithOIDC := assumeRoleWithOIDCList[0].(map[string]interface{})
+		config.OIDCProviderArn = assumeRoleWithOIDC["oidc_provider_arn"].(string)
+		config.OIDCRoleArn = assumeRoleWithOIDC["role_arn"].(string)
+		config.OIDCTokenFile = assumeRoleWithOIDC["oidc_token_file"].(string)
+		config.OIDCSessionName = assumeRoleWithOIDC["role_session_name"].(string)
+		config.OIDCSessionExpiration = assumeRoleWithOIDC["session_expiration"].(int)
+		log.Printf("[INFO] assume_role_with_oidc configuration set: (OIDCProviderArn: %q, RoleArn: %q, OIDCTokenFile: %q, RoleSessionName: %q, SessionExpiration: %d)",
+			config.OIDCProviderArn, config.OIDCRoleArn, config.OIDCTokenFile, config.OIDCSessionName, config.OIDCSessionExpiration)
 	}
+
 	ossServicedomain := d.Get("ossservice_domain").(string)
 	if ossServicedomain != "" {
 		config.OssServerEndpoint = ossServicedomain
@@ -687,6 +787,8 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.DnsEndpoint = domain
 		config.KVStoreEndpoint = domain
 		config.GpdbEndpoint = domain
+		config.PolarDBEndpoint = domain
+		config.CbnEndpoint = domain
 		config.DdsEndpoint = domain
 		config.CsEndpoint = domain
 		config.CmsEndpoint = domain
@@ -733,6 +835,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.DnsEndpoint = strings.TrimSpace(endpoints["dns"].(string))
 			config.KVStoreEndpoint = strings.TrimSpace(endpoints["kvstore"].(string))
 			config.GpdbEndpoint = strings.TrimSpace(endpoints["gpdb"].(string))
+			config.PolarDBEndpoint = strings.TrimSpace(endpoints["polardb"].(string))
+			config.CbnEndpoint = strings.TrimSpace(endpoints["cbn"].(string))
+			config.CenEndpoint = strings.TrimSpace(endpoints["cen"].(string))
 			config.DdsEndpoint = strings.TrimSpace(endpoints["dds"].(string))
 			config.CsEndpoint = strings.TrimSpace(endpoints["cs"].(string))
 			config.CmsEndpoint = strings.TrimSpace(endpoints["cms"].(string))
@@ -756,8 +861,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 			config.OosEndpoint = strings.TrimSpace(endpoints["oos"].(string))
 			config.ArmsEndpoint = strings.TrimSpace(endpoints["arms"].(string))
 			config.CloudfwEndpoint = strings.TrimSpace(endpoints["cloudfw"].(string))
+			config.LocationEndpoint = strings.TrimSpace(endpoints["location"].(string))
 		}
 	}
+	if ascmEndpoint := strings.TrimSpace(d.Get("ascm_endpoint").(string)); ascmEndpoint != "" {
+		config.AscmEndpoint = ascmEndpoint
+	}
+	if locationEndpoint := strings.TrimSpace(d.Get("location_endpoint").(string)); locationEndpoint != "" {
+		config.LocationEndpoint = locationEndpoint
+	}
+	config.EndpointTemplate = strings.TrimSpace(d.Get("endpoint_template").(string))
 	DbsEndpoint := d.Get("dbs_endpoint").(string)
 	if DbsEndpoint != "" {
 		config.DbsEndpoint = DbsEndpoint
@@ -796,6 +909,13 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		config.Protocol = "HTTP"
 	}
 
+	if err := config.LoadCredentials(); err != nil {
+		return nil, err
+	}
+	if err := config.LoadEndpoints(); err != nil {
+		return nil, err
+	}
+
 	config.ResourceSetName = d.Get("resource_group_set_name").(string)
 	if config.Department == "" || config.ResourceGroup == "" {
 		dept, rg, err := getResourceCredentials(config)
@@ -807,8 +927,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if config.RamRoleArn != "" {
-		config.AccessKey, config.SecretKey, config.SecurityToken, err = getAssumeRoleAK(config)
-		if err != nil {
+		if err := config.AssumeRole(); err != nil {
 			return nil, err
 		}
 	}
@@ -822,17 +941,39 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	if config.ConfigurationSource == "" {
-		sourceName := fmt.Sprintf("Default/%s:%s", config.AccessKey, strings.Trim(uuid.New().String(), "-"))
+		credentials, err := config.Credentials()
+		if err != nil {
+			return nil, err
+		}
+		sourceName := fmt.Sprintf("Default/%s:%s", credentials.AccessKey, strings.Trim(uuid.New().String(), "-"))
 		if len(sourceName) > 64 {
 			sourceName = sourceName[:64]
 		}
 		config.ConfigurationSource = sourceName
 	}
+	if defaultTagsList := d.Get("default_tags").(*schema.Set).List(); len(defaultTagsList) == 1 && defaultTagsList[0] != nil {
+		config.DefaultTags = make(map[string]string)
+		for key, value := range defaultTagsList[0].(map[string]interface{})["tags"].(map[string]interface{}) {
+			config.DefaultTags[key] = value.(string)
+		}
+	}
+	if ignoreTagsList := d.Get("ignore_tags").(*schema.Set).List(); len(ignoreTagsList) == 1 && ignoreTagsList[0] != nil {
+		ignoreTags := ignoreTagsList[0].(map[string]interface{})
+		config.IgnoreTagsKeys = expandStringList(ignoreTags["keys"].(*schema.Set).List())
+		config.IgnoreTagsKeyPrefixes = expandStringList(ignoreTags["key_prefixes"].(*schema.Set).List())
+	}
+
 	client, err := config.Client()
 	if err != nil {
 		return nil, err
 	}
 
+	if nameMappingList := d.Get("name_mapping").(*schema.Set).List(); len(nameMappingList) == 1 && nameMappingList[0] != nil {
+		if err := resolveNameMapping(client, nameMappingList[0].(map[string]interface{})); err != nil {
+			return nil, err
+		}
+	}
+
 	return client, nil
 }
 
@@ -850,13 +991,50 @@ func init() {
 
 		"proxy": "Use this to set proxy connection",
 
+		"ascm_endpoint": "The endpoint of the ASCM of the platform, the endpoint catalog is listed through when location_endpoint is not set.",
+
+		"location_endpoint": "The endpoint of the location service the endpoints of the other products are listed from.",
+
+		"endpoint_template": "The endpoint of the products which are neither set explicitly nor listed by the location service, with the {product} and {region} placeholders, e.g. {product}.{region}.example.com.",
+
+		"max_retries": "The maximum number of times a throttled or failed request is retried. Default to 10.",
+
+		"max_retry_timeout": "The maximum number of seconds a request is retried for, 0 to retry until max_retries is reached. Default to 300.",
+
 		"domain": "Use this to override the default domain. It's typically used to connect to custom domain.",
+
+		"default_tags": "Configuration block with the tags applied to all of the resources which support tags.",
+
+		"default_tags_tags": "The tags applied to all of the resources. A tag set on a resource overrides the default tag with the same key.",
+
+		"ignore_tags": "Configuration block with the tags which the provider neither reads nor manages on any resource.",
+
+		"ignore_tags_keys": "The tag keys to ignore.",
+
+		"ignore_tags_key_prefixes": "The tag key prefixes to ignore.",
+
+		"name_mapping": "Configuration block with the names of the departments and resource groups which the department_id and resource_group_id of the resources can refer to instead of their ids.",
+
+		"name_mapping_departments": "The names of the departments, which are looked up through the ASCM organization api.",
+
+		"name_mapping_resource_groups": "The names of the resource groups, which are looked up through the ASCM resource group api. A resource scoped to one of them is scoped to its department as well.",
+
+		"assume_role_with_oidc_oidc_provider_arn": "The ARN of the OIDC provider of the kubernetes cluster. It can be sourced from the ALIBABA_CLOUD_OIDC_PROVIDER_ARN environment variable.",
+
+		"assume_role_with_oidc_role_arn": "The ARN of the RAM role assumed with the OIDC token. It can be sourced from the ALIBABA_CLOUD_ROLE_ARN environment variable.",
+
+		"assume_role_with_oidc_oidc_token_file": "The path of the OIDC token file of the service account, which is read again every time the role is assumed. It can be sourced from the ALIBABA_CLOUD_OIDC_TOKEN_FILE environment variable.",
+
+		"assume_role_with_oidc_role_session_name": "The session name of the assumed role. It can be sourced from the ALIBABA_CLOUD_ROLE_SESSION_NAME environment variable. Default to terraform.",
+
+		"assume_role_with_oidc_session_expiration": "The number of seconds the credentials of the assumed role are valid for, from 900 to 43200.",
 	}
 }
 func endpointsSchema() *schema.Schema {
 	return &schema.Schema{
-		Type:     schema.TypeSet,
-		Optional: true,
+		Type:       schema.TypeSet,
+		Optional:   true,
+		Deprecated: "It has been deprecated, the endpoints of the products are listed from the endpoint catalog of the platform or built from the endpoint_template, and the ascm and location endpoints are set by the ascm_endpoint and location_endpoint fields instead.",
 		Elem: &schema.Resource{
 			Schema: map[string]*schema.Schema{
 				"cbn": {
@@ -1147,6 +1325,60 @@ func endpointsSchema() *schema.Schema {
 					DefaultFunc: schema.EnvDefaultFunc("ALIBABACLOUDSTACK_DBS_ENDPOINT", nil),
 					Description: descriptions["dbs_endpoint"],
 				},
+				"ros": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["ros_endpoint"],
+				},
+				"dts": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["dts_endpoint"],
+				},
+				"hbase": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["hbase_endpoint"],
+				},
+				"csb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["csb_endpoint"],
+				},
+				"gdb": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["gdb_endpoint"],
+				},
+				"dbs": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["dbs_endpoint"],
+				},
+				"oos": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["oos_endpoint"],
+				},
+				"arms": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["arms_endpoint"],
+				},
+				"cloudfw": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Default:     "",
+					Description: descriptions["cloudfw_endpoint"],
+				},
 			},
 		},
 		Set: endpointsToHash,
@@ -1203,75 +1435,28 @@ func endpointsToHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func getConfigFromProfile(d *schema.ResourceData, ProfileKey string) (interface{}, error) {
-
-	if providerConfig == nil {
-		if v, ok := d.GetOk("profile"); !ok && v.(string) == "" {
-			return nil, nil
-		}
-		current := d.Get("profile").(string)
-		// Set CredsFilename, expanding home directory
-		profilePath, err := homedir.Expand(d.Get("shared_credentials_file").(string))
-		if err != nil {
-			return nil, WrapError(err)
-		}
-		if profilePath == "" {
-			profilePath = fmt.Sprintf("%s/.alibabacloudstack/config.json", os.Getenv("HOME"))
-			if runtime.GOOS == "windows" {
-				profilePath = fmt.Sprintf("%s/.alibabacloudstack/config.json", os.Getenv("USERPROFILE"))
-			}
-		}
-		providerConfig = make(map[string]interface{})
-		_, err = os.Stat(profilePath)
-		if !os.IsNotExist(err) {
-			data, err := ioutil.ReadFile(profilePath)
-			if err != nil {
-				return nil, WrapError(err)
-			}
-			config := map[string]interface{}{}
-			err = json.Unmarshal(data, &config)
-			if err != nil {
-				return nil, WrapError(err)
-			}
-			for _, v := range config["profiles"].([]interface{}) {
-				if current == v.(map[string]interface{})["name"] {
-					providerConfig = v.(map[string]interface{})
-				}
-			}
-		}
+// getProfileRegion returns the region of the profile of the provider, the credentials of the profile are resolved
+// by the credentials chain of the connectivity package.
+func getProfileRegion(d *schema.ResourceData) (string, error) {
+	name := d.Get("profile").(string)
+	if name == "" {
+		name = os.Getenv(connectivity.EnvProfile)
 	}
-
-	mode := ""
-	if v, ok := providerConfig["mode"]; ok {
-		mode = v.(string)
-	} else {
-		return v, nil
+	path := d.Get("shared_credentials_file").(string)
+	if name == "" && path == "" {
+		return "", nil
 	}
-	switch ProfileKey {
-	case "access_key_id", "access_key_secret":
-		if mode == "EcsRamRole" {
-			return "", nil
-		}
-	case "ram_role_name":
-		if mode != "EcsRamRole" {
-			return "", nil
-		}
-	case "sts_token":
-		if mode != "StsToken" {
-			return "", nil
-		}
-	case "ram_role_arn", "ram_session_name":
-		if mode != "RamRoleArn" {
-			return "", nil
-		}
-	case "expired_seconds":
-		if mode != "RamRoleArn" {
-			return float64(0), nil
-		}
+	file, err := connectivity.LoadProfileFile(path)
+	if err != nil {
+		return "", WrapError(err)
 	}
-
-	return providerConfig[ProfileKey], nil
+	profile, err := file.Get(name)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	return profile.RegionId, nil
 }
+
 func assumeRoleSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeSet,
@@ -1307,48 +1492,119 @@ func assumeRoleSchema() *schema.Schema {
 	}
 }
 
-func getAssumeRoleAK(config *connectivity.Config) (string, string, string, error) {
-	request := sts.CreateAssumeRoleRequest()
-	request.RoleArn = config.RamRoleArn
-	if config.RamRoleSessionName == "" {
-		config.RamRoleSessionName = "terraform"
+func assumeRoleWithOIDCSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"oidc_provider_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_provider_arn"],
+					DefaultFunc: schema.EnvDefaultFunc(connectivity.EnvOIDCProviderArn, nil),
+				},
+				"role_arn": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_role_arn"],
+					DefaultFunc: schema.EnvDefaultFunc(connectivity.EnvRoleArn, nil),
+				},
+				"oidc_token_file": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: descriptions["assume_role_with_oidc_oidc_token_file"],
+					DefaultFunc: schema.EnvDefaultFunc(connectivity.EnvOIDCTokenFile, nil),
+				},
+				"role_session_name": {
+					Type:        schema.TypeString,
+					Optional:    true,
+					Description: descriptions["assume_role_with_oidc_role_session_name"],
+					DefaultFunc: schema.EnvDefaultFunc(connectivity.EnvRoleSessionName, ""),
+				},
+				"session_expiration": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Description:  descriptions["assume_role_with_oidc_session_expiration"],
+					ValidateFunc: intBetween(900, 43200),
+				},
+			},
+		},
 	}
-	request.RoleSessionName = config.RamRoleSessionName
-	//request.DurationSeconds = requests.NewInteger(config.RamRoleSessionExpiration)
-	request.Policy = config.RamRolePolicy
-	request.Scheme = "https"
-	request.SetHTTPSInsecure(config.Insecure)
-
-	request.Domain = config.StsEndpoint
-	request.Headers["x-ascm-product-name"] = "sts"
-	request.Headers["x-acs-organizationId"] = config.Department
+}
 
-	var client *sts.Client
-	var err error
-	if config.SecurityToken == "" {
-		client, err = sts.NewClientWithAccessKey(config.RegionId, config.AccessKey, config.SecretKey)
-	} else {
-		client, err = sts.NewClientWithStsToken(config.RegionId, config.AccessKey, config.SecretKey, config.SecurityToken)
+func defaultTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["default_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tags": {
+					Type:        schema.TypeMap,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: descriptions["default_tags_tags"],
+				},
+			},
+		},
 	}
+}
 
-	if err != nil {
-		return "", "", "", err
+func ignoreTagsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["ignore_tags"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"keys": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_keys"],
+				},
+				"key_prefixes": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["ignore_tags_key_prefixes"],
+				},
+			},
+		},
 	}
+}
 
-	client.Domain = config.StsEndpoint
-	client.AppendUserAgent(connectivity.Terraform, connectivity.TerraformVersion)
-	client.AppendUserAgent(connectivity.Provider, connectivity.ProviderVersion)
-	client.AppendUserAgent(connectivity.Module, config.ConfigurationSource)
-	client.SetHTTPSInsecure(config.Insecure)
-	if config.Proxy != "" {
-		client.SetHttpProxy(config.Proxy)
-	}
-	response, err := client.AssumeRole(request)
-	if err != nil {
-		return config.AccessKey, config.SecretKey, config.SecurityToken, err
+func nameMappingSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		MaxItems:    1,
+		Description: descriptions["name_mapping"],
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"departments": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["name_mapping_departments"],
+				},
+				"resource_groups": {
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Set:         schema.HashString,
+					Description: descriptions["name_mapping_resource_groups"],
+				},
+			},
+		},
 	}
-
-	return response.Credentials.AccessKeyId, response.Credentials.AccessKeySecret, response.Credentials.SecurityToken, nil
 }
 
 func getResourceCredentials(config *connectivity.Config) (string, string, error) {
@@ -1359,7 +1615,16 @@ func getResourceCredentials(config *connectivity.Config) (string, string, error)
 	if endpoint != "" {
 		endpoints.AddEndpointMapping(config.RegionId, string(connectivity.ASCMCode), endpoint)
 	}
-	ascmClient, err := sdk.NewClientWithAccessKey(config.RegionId, config.AccessKey, config.SecretKey)
+	credentials, err := config.Credentials()
+	if err != nil {
+		return "", "", err
+	}
+	var ascmClient *sdk.Client
+	if credentials.SecurityToken == "" {
+		ascmClient, err = sdk.NewClientWithAccessKey(config.RegionId, credentials.AccessKey, credentials.SecretKey)
+	} else {
+		ascmClient, err = sdk.NewClientWithStsToken(config.RegionId, credentials.AccessKey, credentials.SecretKey, credentials.SecurityToken)
+	}
 	if err != nil {
 		return "", "", fmt.Errorf("unable to initialize the ascm client: %#v", err)
 	}
@@ -1397,7 +1662,6 @@ func getResourceCredentials(config *connectivity.Config) (string, string, error)
 	}
 	request.ApiName = "ListResourceGroup"
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":   config.SecretKey,
 		"Product":           "ascm",
 		"Department":        config.Department,
 		"ResourceGroup":     config.ResourceGroup,
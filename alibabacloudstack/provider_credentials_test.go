@@ -0,0 +1,230 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackProviderCredentials_oidc(t *testing.T) {
+	// The first credentials are about to expire, so they are refreshed before the first request is sent
+	server := newMockApiServer(t).
+		on("AssumeRoleWithOIDC", mockApiOIDCCredentials("MockSecurityToken1", time.Now().Add(2*time.Minute))).
+		on("AssumeRoleWithOIDC", mockApiOIDCCredentials("MockSecurityToken2", time.Now().Add(time.Hour))).
+		loadFixture("vpc")
+	client := server.oidcClient(t)
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the vpc with the OIDC credentials got an error: %#v", diags)
+	}
+
+	call, ok := server.lastCall("AssumeRoleWithOIDC")
+	if !ok {
+		t.Fatalf("expected the role to be assumed with the OIDC token")
+	}
+	if call.Params["OIDCToken"] != "mock-oidc-token" || call.Params["RoleArn"] != "acs:ram::1234567890:role/mock" || call.Params["RoleSessionName"] != "terraform" {
+		t.Errorf("unexpected AssumeRoleWithOIDC params: %v", call.Params)
+	}
+	if count := server.callCount("AssumeRoleWithOIDC"); count != 2 {
+		t.Errorf("expected the expiring credentials to be refreshed once, the role was assumed %d times", count)
+	}
+	for _, action := range []string{"CreateVpc", "DescribeVpcs"} {
+		call, ok := server.lastCall(action)
+		if !ok {
+			t.Fatalf("expected %s to be called", action)
+		}
+		if token := call.Params["SecurityToken"]; token != "MockSecurityToken2" {
+			t.Errorf("expected %s to be sent with the refreshed security token, got %q", action, token)
+		}
+	}
+}
+
+func TestUnitAlibabacloudStackProviderCredentials_precedence(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("vpc")
+
+	// The access key of the provider comes first in the chain, the OIDC settings of the environment are ignored
+	unsetMockApiEnvironments(t)
+	environments := map[string]string{
+		connectivity.EnvOIDCProviderArn: "acs:ram::1234567890:oidc-provider/mock",
+		connectivity.EnvRoleArn:         "acs:ram::1234567890:role/mock",
+		connectivity.EnvOIDCTokenFile:   "/nonexistent/token",
+	}
+	for key, value := range environments {
+		os.Setenv(key, value)
+		name := key
+		t.Cleanup(func() {
+			os.Unsetenv(name)
+		})
+	}
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(server.providerConfig())); diags.HasError() {
+		t.Fatalf("configuring the provider with an access key and the OIDC environment variables got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the vpc with the provider access key got an error: %#v", diags)
+	}
+	if count := server.callCount("AssumeRoleWithOIDC"); count != 0 {
+		t.Errorf("expected the access key of the provider to be used, the role was assumed with the OIDC token %d times", count)
+	}
+	if call, _ := server.lastCall("CreateVpc"); call.Params["SecurityToken"] != "" {
+		t.Errorf("expected CreateVpc to be sent without a security token, got %q", call.Params["SecurityToken"])
+	}
+}
+
+func TestUnitAlibabacloudStackProviderCredentials_profile(t *testing.T) {
+	uri := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"Code":"Success","AccessKeyId":%q,"AccessKeySecret":%q,"SecurityToken":"MockUriToken","Expiration":%q}`,
+			mockApiAccessKey, mockApiSecretKey, time.Now().Add(time.Hour).UTC().Format("2006-01-02T15:04:05Z"))
+	}))
+	defer uri.Close()
+	dir, err := ioutil.TempDir("", "profiles")
+	if err != nil {
+		t.Fatalf("creating the profiles directory got an error: %#v", err)
+	}
+	defer os.RemoveAll(dir)
+	process := filepath.Join(dir, "credentials.sh")
+	script := fmt.Sprintf("#!/bin/sh\necho '{\"mode\":\"StsToken\",\"access_key_id\":%q,\"access_key_secret\":%q,\"sts_token\":\"MockProcessToken\"}'\n", mockApiAccessKey, mockApiSecretKey)
+	if err := ioutil.WriteFile(process, []byte(script), 0700); err != nil {
+		t.Fatalf("writing the process_command got an error: %#v", err)
+	}
+	file := filepath.Join(dir, "config.json")
+	profiles := fmt.Sprintf(`{
+  "current": "process",
+  "profiles": [
+    {"name": "process", "mode": "External", "process_command": %q, "region_id": %q},
+    {"name": "uri", "mode": "CredentialsURI", "credentials_uri": %q},
+    {"name": "loop", "mode": "ChainableRamRoleArn", "ram_role_arn": "acs:ram::1234567890:role/mock", "source_profile": "chained"},
+    {"name": "chained", "mode": "ChainableRamRoleArn", "ram_role_arn": "acs:ram::1234567890:role/mock", "source_profile": "loop"}
+  ]
+}`, process, mockApiRegion, uri.URL)
+	if err := ioutil.WriteFile(file, []byte(profiles), 0600); err != nil {
+		t.Fatalf("writing the profiles got an error: %#v", err)
+	}
+
+	for profile, token := range map[string]string{"": "MockProcessToken", "uri": "MockUriToken"} {
+		server := newMockApiServer(t).loadFixture("vpc")
+		p, err := server.configureProfile(t, file, profile)
+		if err != nil {
+			t.Fatalf("configuring the provider with the profile %q got an error: %#v", profile, err)
+		}
+		client := p.Meta().(*connectivity.AlibabacloudStackClient)
+		r := resourceAlibabacloudStackVpc()
+		d := newMockApiResourceData(t, r, map[string]interface{}{
+			"cidr_block": "172.16.0.0/12",
+			"vpc_name":   "tf-testAccVpcMock",
+		})
+		if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+			t.Fatalf("creating the vpc with the profile %q got an error: %#v", profile, diags)
+		}
+		if call, _ := server.lastCall("CreateVpc"); call.Params["SecurityToken"] != token {
+			t.Errorf("expected CreateVpc to be sent with the security token %q of the profile %q, got %q", token, profile, call.Params["SecurityToken"])
+		}
+
+		ds := dataSourceAlibabacloudStackProfile()
+		dd := ds.TestResourceData()
+		if diags := ds.ReadContext(context.Background(), dd, client); diags.HasError() {
+			t.Fatalf("reading the alibabacloudstack_profile got an error: %#v", diags)
+		}
+		expected := map[string]string{"credentials_source": "profile", "profile_name": "process", "mode": "External", "credentials_file": file}
+		if profile == "uri" {
+			expected["profile_name"], expected["mode"] = "uri", "CredentialsURI"
+		}
+		for key, value := range expected {
+			if got := dd.Get(key).(string); got != value {
+				t.Errorf("expected the %s of the alibabacloudstack_profile to be %q, got %q", key, value, got)
+			}
+		}
+	}
+
+	server := newMockApiServer(t)
+	if _, err := server.configureProfile(t, file, "chained"); err == nil || !strings.Contains(err.Error(), "chained to itself") {
+		t.Errorf("expected the profiles chained to each other to fail, got %v", err)
+	}
+}
+
+// configureProfile configures the provider against the mock server with the profile of the file instead of an access key.
+func (s *mockApiServer) configureProfile(t *testing.T, file, profile string) (*schema.Provider, error) {
+	unsetMockApiEnvironments(t)
+	config := s.providerConfig()
+	delete(config, "access_key")
+	delete(config, "secret_key")
+	delete(config, "region")
+	config["shared_credentials_file"] = file
+	config["profile"] = profile
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		return p, fmt.Errorf("%s", diags[0].Summary)
+	}
+	return p, nil
+}
+
+// oidcClient configures the provider against the mock server with the assume_role_with_oidc instead of an access key.
+func (s *mockApiServer) oidcClient(t *testing.T) *connectivity.AlibabacloudStackClient {
+	unsetMockApiEnvironments(t)
+	for _, key := range []string{"ALIBABACLOUDSTACK_ACCESS_KEY", "ALIBABACLOUDSTACK_SECRET_KEY"} {
+		if value, ok := os.LookupEnv(key); ok {
+			os.Unsetenv(key)
+			name, restore := key, value
+			t.Cleanup(func() {
+				os.Setenv(name, restore)
+			})
+		}
+	}
+	tokenFile, err := ioutil.TempFile("", "oidc-token")
+	if err != nil {
+		t.Fatalf("creating the OIDC token file got an error: %#v", err)
+	}
+	t.Cleanup(func() {
+		os.Remove(tokenFile.Name())
+	})
+	tokenFile.WriteString("mock-oidc-token\n")
+	tokenFile.Close()
+
+	config := s.providerConfig()
+	delete(config, "access_key")
+	delete(config, "secret_key")
+	config["assume_role_with_oidc"] = []interface{}{map[string]interface{}{
+		"oidc_provider_arn": "acs:ram::1234567890:oidc-provider/mock",
+		"role_arn":          "acs:ram::1234567890:role/mock",
+		"oidc_token_file":   tokenFile.Name(),
+	}}
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with the assume_role_with_oidc got an error: %#v", diags)
+	}
+	return p.Meta().(*connectivity.AlibabacloudStackClient)
+}
+
+func mockApiOIDCCredentials(securityToken string, expiration time.Time) map[string]interface{} {
+	return map[string]interface{}{
+		"Credentials": map[string]interface{}{
+			"AccessKeyId":     mockApiAccessKey,
+			"AccessKeySecret": mockApiSecretKey,
+			"SecurityToken":   securityToken,
+			"Expiration":      expiration.UTC().Format("2006-01-02T15:04:05Z"),
+		},
+	}
+}
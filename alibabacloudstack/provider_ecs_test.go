@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackProviderEcs(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
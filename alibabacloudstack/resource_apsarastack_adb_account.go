@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbAccountCreate,
-		Read:   resourceAlibabacloudStackAdbAccountRead,
-		Update: resourceAlibabacloudStackAdbAccountUpdate,
-		Delete: resourceAlibabacloudStackAdbAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAdbAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,9 +75,9 @@ func resourceAlibabacloudStackAdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	request := adb.CreateCreateAccountRequest()
 	request.RegionId = client.RegionId
 	request.DBClusterId = d.Get("db_cluster_id").(string)
@@ -92,7 +93,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	if password != "" {
 		request.AccountPassword = password
 	} else {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -106,7 +107,7 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 	}
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.CreateAccount(request)
 		})
@@ -131,12 +132,12 @@ func resourceAlibabacloudStackAdbAccountCreate(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	object, err := adbService.DescribeAdbAccount(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -159,9 +160,9 @@ func resourceAlibabacloudStackAdbAccountRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	instanceId := parts[0]
@@ -208,7 +209,7 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 		if password != "" {
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -227,12 +228,12 @@ func resourceAlibabacloudStackAdbAccountUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAdbAccountRead(d, meta)
+	return resourceAlibabacloudStackAdbAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestAccAlibabacloudStackAdbAccount_update_forSuper(t *testing.T) {
 	resourceId := "alibabacloudstack_adb_account.default"
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeAdbAccount")
 	rac := resourceAttrCheckInit(rc, ra)
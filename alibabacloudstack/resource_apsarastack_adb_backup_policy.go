@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackAdbBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackAdbBackupPolicyRead,
-		Update: resourceAlibabacloudStackAdbBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackAdbBackupPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbBackupPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbBackupPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAdbBackupPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbBackupPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -54,16 +55,16 @@ func resourceAlibabacloudStackAdbBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("db_cluster_id").(string))
 
-	return resourceAlibabacloudStackAdbBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAdbBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	object, err := adbService.DescribeAdbBackupPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -81,10 +82,10 @@ func resourceAlibabacloudStackAdbBackupPolicyRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 
 	if d.HasChange("preferred_backup_period") || d.HasChange("preferred_backup_time") {
 		periodList := expandStringList(d.Get("preferred_backup_period").(*schema.Set).List())
@@ -95,7 +96,7 @@ func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta
 		if err := adbService.WaitForCluster(d.Id(), Running, DefaultTimeoutMedium); err != nil {
 			return WrapError(err)
 		}
-		if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			if err := adbService.ModifyAdbBackupPolicy(d.Id(), preferredBackupTime, preferredBackupPeriod); err != nil {
 				if IsExpectedErrors(err, OperationDeniedDBStatus) {
 					return resource.RetryableError(err)
@@ -108,10 +109,10 @@ func resourceAlibabacloudStackAdbBackupPolicyUpdate(d *schema.ResourceData, meta
 		}
 	}
 
-	return resourceAlibabacloudStackAdbBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackAdbBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// In case of a delete we are resetting to default values which is Tuesday,Friday each 1am-2am
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := adb.CreateModifyBackupPolicyRequest()
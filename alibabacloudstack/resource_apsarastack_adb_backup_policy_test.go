@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackAdbBackupPolicy(t *testing.T) {
 	var v *adb.DescribeBackupPolicyResponse
 	resourceId := "alibabacloudstack_adb_backup_policy.default"
 	serverFunc := func() interface{} {
-		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeAdbBackupPolicy")
 	ra := resourceAttrInit(resourceId, nil)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strings"
@@ -17,11 +18,11 @@ import (
 
 func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbConnectionCreate,
-		Read:   resourceAlibabacloudStackAdbConnectionRead,
-		Delete: resourceAlibabacloudStackAdbConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbConnectionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,9 +54,9 @@ func resourceAlibabacloudStackAdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	dbClusterId := d.Get("db_cluster_id").(string)
 	prefix := d.Get("connection_prefix").(string)
 	if prefix == "" {
@@ -70,7 +71,7 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 	request.Headers["x-acs-organizationid"] = client.Department
 	var raw interface{}
 	var err error
-	err = resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.AllocateClusterPublicConnection(request)
 		})
@@ -99,12 +100,12 @@ func resourceAlibabacloudStackAdbConnectionCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackAdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackAdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	object, err := adbService.DescribeAdbConnection(d.Id())
 
 	if err != nil {
@@ -127,9 +128,9 @@ func resourceAlibabacloudStackAdbConnectionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 
 	split := strings.Split(d.Id(), COLON_SEPARATED)
 	request := adb.CreateReleaseClusterPublicConnectionRequest()
@@ -138,7 +139,7 @@ func resourceAlibabacloudStackAdbConnectionDelete(d *schema.ResourceData, meta i
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationid"] = client.Department
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		var raw interface{}
 		raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.ReleaseClusterPublicConnection(request)
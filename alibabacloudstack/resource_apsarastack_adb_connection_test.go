@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -26,7 +27,7 @@ func TestAccAlibabacloudStackAdbConnectionConfig(t *testing.T) {
 	resourceId := "alibabacloudstack_adb_connection.default"
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeAdbConnection")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/adb"
 	"log"
@@ -10,19 +11,18 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackAdbDbCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAdbDbClusterCreate,
-		Read:   resourceAlibabacloudStackAdbDbClusterRead,
-		Update: resourceAlibabacloudStackAdbDbClusterUpdate,
-		Delete: resourceAlibabacloudStackAdbDbClusterDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAdbDbClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAdbDbClusterRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAdbDbClusterUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAdbDbClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(50 * time.Minute),
@@ -219,9 +219,9 @@ func resourceAlibabacloudStackAdbDbCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbDbClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	var response *adb.CreateDBClusterResponse
 
 	request := adb.CreateCreateDBClusterRequest()
@@ -312,7 +312,7 @@ func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta in
 
 	vswitchId := Trim(d.Get("vswitch_id").(string))
 	if vswitchId != "" {
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, ctx}
 		//vsw, err := vpcService.DescribeVSwitchWithTeadsl(vswitchId)
 		var vsw, err = vpcService.DescribeVSwitch(vswitchId)
 		fmt.Sprint(vsw)
@@ -332,7 +332,7 @@ func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta in
 	request.ClientToken = buildClientToken("CreateDBCluster")
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationId"] = client.Department
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "adb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "adb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, err := client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 		return adbClient.CreateDBCluster(request)
 	})
@@ -344,15 +344,15 @@ func resourceAlibabacloudStackAdbDbClusterCreate(d *schema.ResourceData, meta in
 
 	d.SetId(fmt.Sprint(response.DBClusterId))
 	stateConf := BuildStateConf([]string{"Preparing", "Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 900*time.Second, adbService.AdbDbClusterStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackAdbDbClusterUpdate(d, meta)
+	return resourceAlibabacloudStackAdbDbClusterUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbDbClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	object, err := adbService.DescribeAdbDbCluster(d.Id())
 	info, err := adbService.DescribeAdbClusterNetInfo2(d.Id())
 
@@ -422,9 +422,9 @@ func resourceAlibabacloudStackAdbDbClusterRead(d *schema.ResourceData, meta inte
 	d.Set("db_cluster_version", describeDBClustersObject["DBVersion"])
 	return nil
 }
-func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbDbClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	adbService := AdbService{client}
+	adbService := AdbService{client, ctx}
 	var response map[string]interface{}
 	d.Partial(true)
 
@@ -445,23 +445,12 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			request["Product"] = "adb"
-			request["OrganizationId"] = client.Department
-			request["Department"] = client.Department
-			request["ResourceGroup"] = client.ResourceGroup
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		request["Product"] = "adb"
+		request["OrganizationId"] = client.Department
+		request["Department"] = client.Department
+		request["ResourceGroup"] = client.ResourceGroup
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
@@ -484,10 +473,6 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(action, response, request)
@@ -515,10 +500,6 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(action, response, request)
@@ -565,10 +546,6 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(action, response, request)
@@ -665,7 +642,7 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{"ClassChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 900*time.Second, adbService.AdbDbClusterStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("compute_resource")
@@ -676,9 +653,9 @@ func resourceAlibabacloudStackAdbDbClusterUpdate(d *schema.ResourceData, meta in
 		//d.SetPartial("elastic_io_resource")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackAdbDbClusterRead(d, meta)
+	return resourceAlibabacloudStackAdbDbClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackAdbDbClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAdbDbClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//adbService := AdbService{client}
 	action := "DeleteDBCluster"
@@ -696,20 +673,9 @@ func resourceAlibabacloudStackAdbDbClusterDelete(d *schema.ResourceData, meta in
 	runtime := util.RuntimeOptions{}
 	runtime.SetIgnoreSSL(client.Config.Insecure)
 	//var taskId string
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		//taskId = response["TaskId"].(json.Number).String()
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
+	addDebug(action, response, request)
+	//taskId = response["TaskId"].(json.Number).String()
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InvalidDBCluster.NotFound"}) {
 			return nil
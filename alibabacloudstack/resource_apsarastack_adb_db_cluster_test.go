@@ -1,11 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -85,19 +85,8 @@ func testSweepAdbDbInstances(region string) error {
 			request := map[string]interface{}{
 				"DBClusterId": id,
 			}
-			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				_, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-				if err != nil {
-					if NeedRetry(err) {
-						wait()
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				log.Printf("[ERROR] Deleting ADB cluster failed with error: %#v", err)
-				return nil
-			})
+			_, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+			log.Printf("[ERROR] Deleting ADB cluster failed with error: %#v", err)
 		}
 		if len(result) < PageSizeLarge {
 			break
@@ -113,7 +102,7 @@ func TestAccAlibabacloudStackAdbDbCluster_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_adb_db_cluster.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackAdbDbClusterMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeAdbDbCluster")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -283,7 +272,7 @@ func TestAccAlibabacloudStackAdbDbCluster_flexible(t *testing.T) {
 	resourceId := "alibabacloudstack_adb_db_cluster.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackAdbDbClusterMap1)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeAdbDbCluster")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
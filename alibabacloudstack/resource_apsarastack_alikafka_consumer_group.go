@@ -0,0 +1,145 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackAlikafkaConsumerGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaConsumerGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"consumer_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	instanceId := d.Get("instance_id").(string)
+	consumerId := d.Get("consumer_id").(string)
+
+	request := alikafka.CreateCreateConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	request.InstanceId = instanceId
+	request.ConsumerId = consumerId
+	if v, ok := d.GetOk("description"); ok {
+		request.Remark = v.(string)
+	}
+	if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.CreateConsumerGroup(request)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_consumer_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	d.SetId(instanceId + ":" + consumerId)
+
+	if err := alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+	d.Partial(true)
+	if err := alikafkaService.setInstanceTags(d, TagResourceConsumerGroup); err != nil {
+		return WrapError(err)
+	}
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	object, err := alikafkaService.DescribeAlikafkaConsumerGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", object.InstanceId)
+	d.Set("consumer_id", object.ConsumerId)
+	d.Set("description", object.Remark)
+
+	tags := make(map[string]string)
+	for _, tag := range object.Tags.TagVO {
+		if !ignoredTags(tag.Key, tag.Value) {
+			tags[tag.Key] = tag.Value
+		}
+	}
+	if err := setResourceTags(d, meta, tags); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaConsumerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := alikafka.CreateDeleteConsumerGroupRequest()
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	request.InstanceId = parts[0]
+	request.ConsumerId = parts[1]
+	if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.DeleteConsumerGroup(request)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	return WrapError(alikafkaService.WaitForAlikafkaConsumerGroup(d.Id(), Deleted, DefaultTimeoutMedium))
+}
@@ -0,0 +1,58 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackAlikafkaConsumerGroup_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_consumer_group")
+	client := server.client()
+
+	r := resourceAlibabacloudStackAlikafkaConsumerGroup()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id": "alikafka-mock0001",
+		"consumer_id": "tf-mock-group",
+		"description": "mock group",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the consumer group got an error: %#v", diags)
+	}
+	if d.Id() != "alikafka-mock0001:tf-mock-group" {
+		t.Fatalf("expected the consumer group id alikafka-mock0001:tf-mock-group, got %q", d.Id())
+	}
+	call, ok := server.lastCall("CreateConsumerGroup")
+	if !ok || call.Params["InstanceId"] != "alikafka-mock0001" || call.Params["ConsumerId"] != "tf-mock-group" || call.Params["Remark"] != "mock group" {
+		t.Errorf("CreateConsumerGroup was not called with the expected parameters: %v", call.Params)
+	}
+	if value := d.Get("description").(string); value != "mock group" {
+		t.Errorf("expected the description mock group, got %q", value)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the consumer group got an error: %#v", diags)
+	}
+	if count := server.callCount("DeleteConsumerGroup"); count != 1 {
+		t.Errorf("expected DeleteConsumerGroup to be called once, got %d", count)
+	}
+}
+
+func TestUnitAlibabacloudStackAlikafkaConsumerGroupsDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_consumer_group")
+	client := server.client()
+
+	r := dataSourceAlibabacloudStackAlikafkaConsumerGroups()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id":       "alikafka-mock0001",
+		"consumer_id_regex": "^other",
+	})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the consumer groups got an error: %#v", diags)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 1 || ids[0] != "alikafka-mock0001:other-group" {
+		t.Errorf("expected the consumer group ids [alikafka-mock0001:other-group], got %v", ids)
+	}
+	if value := d.Get("groups.0.tags.Team"); value != "kafka" {
+		t.Errorf("expected the consumer group tag Team kafka, got %v", value)
+	}
+}
@@ -0,0 +1,404 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// The alikafka instances are bought with post-paid orders, which the api reports as the paid type 1.
+const alikafkaPostPaidType = 1
+
+func resourceAlibabacloudStackAlikafkaInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaInstanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(120 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: setTagsAllDiff,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(3, 64),
+			},
+			"topic_quota": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"disk_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"disk_size": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"deploy_type": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntInSlice([]int{4, 5}),
+			},
+			"io_max": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"eip_max": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"spec_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "normal",
+				ValidateFunc: validation.StringInSlice([]string{"normal", "professional"}, false),
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"security_group": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+			},
+			"service_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: alikafkaInstanceConfigDiffSuppressFunc,
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"end_point": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+		},
+	}
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+	vpcService := VpcService{client, ctx}
+
+	request := alikafka.CreateCreatePostPayOrderRequest()
+	request.RegionId = client.RegionId
+	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	request.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+	request.DiskType = strconv.Itoa(d.Get("disk_type").(int))
+	request.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+	request.DeployType = requests.NewInteger(d.Get("deploy_type").(int))
+	request.IoMax = requests.NewInteger(d.Get("io_max").(int))
+	request.SpecType = d.Get("spec_type").(string)
+	if v, ok := d.GetOk("eip_max"); ok {
+		request.EipMax = requests.NewInteger(v.(int))
+	}
+
+	raw, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.CreatePostPayOrder(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_alikafka_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	response, _ := raw.(*alikafka.CreatePostPayOrderResponse)
+	if response == nil {
+		return WrapErrorf(Error("the %s response is empty", request.GetActionName()), DefaultErrorMsg, "alibabacloudstack_alikafka_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	if !response.Success {
+		return WrapErrorf(Error(response.Message), DefaultErrorMsg, "alibabacloudstack_alikafka_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	instance, err := alikafkaService.DescribeAlikafkaInstanceByOrderId(response.OrderId, DefaultTimeout)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.SetId(instance.InstanceId)
+
+	vswitchId := d.Get("vswitch_id").(string)
+	vswitch, err := vpcService.DescribeVSwitch(vswitchId)
+	if err != nil {
+		return WrapError(err)
+	}
+	startInstanceReq := alikafka.CreateStartInstanceRequest()
+	startInstanceReq.RegionId = client.RegionId
+	startInstanceReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	startInstanceReq.QueryParams = alikafkaService.queryParams(startInstanceReq.GetActionName())
+	startInstanceReq.InstanceId = d.Id()
+	startInstanceReq.VpcId = vswitch.VpcId
+	startInstanceReq.VSwitchId = vswitchId
+	startInstanceReq.ZoneId = vswitch.ZoneId
+	if v, ok := d.GetOk("zone_id"); ok {
+		startInstanceReq.ZoneId = v.(string)
+	}
+	// The deploy type 4 makes the instance reachable from the internet through an eip as well as from the vpc
+	if d.Get("deploy_type").(int) == 4 {
+		startInstanceReq.DeployModule = "eip"
+		startInstanceReq.IsEipInner = requests.NewBoolean(true)
+	} else {
+		startInstanceReq.DeployModule = "vpc"
+	}
+	if v, ok := d.GetOk("name"); ok {
+		startInstanceReq.Name = v.(string)
+	}
+	if v, ok := d.GetOk("security_group"); ok {
+		startInstanceReq.SecurityGroup = v.(string)
+	}
+	if v, ok := d.GetOk("service_version"); ok {
+		startInstanceReq.ServiceVersion = v.(string)
+	}
+	if v, ok := d.GetOk("config"); ok {
+		startInstanceReq.Config = v.(string)
+	}
+	if _, err := alikafkaInstanceInvoke(client, startInstanceReq.GetActionName(), startInstanceReq.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.StartInstance(startInstanceReq)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), startInstanceReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	if err := alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutCreate).Seconds())); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+
+	object, err := alikafkaService.DescribeAlikafkaInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object.Name)
+	d.Set("topic_quota", object.TopicNumLimit)
+	d.Set("disk_type", object.DiskType)
+	d.Set("disk_size", object.DiskSize)
+	d.Set("deploy_type", object.DeployType)
+	d.Set("io_max", object.IoMax)
+	d.Set("eip_max", object.EipMax)
+	d.Set("spec_type", object.SpecType)
+	d.Set("vswitch_id", object.VSwitchId)
+	d.Set("vpc_id", object.VpcId)
+	d.Set("zone_id", object.ZoneId)
+	d.Set("security_group", object.SecurityGroup)
+	d.Set("end_point", object.EndPoint)
+	d.Set("config", object.AllConfig)
+	d.Set("service_version", object.UpgradeServiceDetailInfo.Current2OpenSourceVersion)
+
+	tags := make(map[string]string)
+	for _, tag := range object.Tags.TagVO {
+		if !ignoredTags(tag.Key, tag.Value) {
+			tags[tag.Key] = tag.Value
+		}
+	}
+	if err := setResourceTags(d, meta, tags); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+	d.Partial(true)
+
+	if err := alikafkaService.setInstanceTags(d, TagResourceInstance); err != nil {
+		return WrapError(err)
+	}
+	if d.IsNewResource() {
+		d.Partial(false)
+		return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+	}
+
+	if d.HasChange("name") {
+		request := alikafka.CreateModifyInstanceNameRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+		request.InstanceId = d.Id()
+		request.InstanceName = d.Get("name").(string)
+		if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.ModifyInstanceName(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if d.HasChanges("topic_quota", "disk_size", "io_max", "eip_max", "spec_type") {
+		request := alikafka.CreateUpgradePostPayOrderRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+		request.InstanceId = d.Id()
+		request.TopicQuota = requests.NewInteger(d.Get("topic_quota").(int))
+		request.DiskSize = requests.NewInteger(d.Get("disk_size").(int))
+		request.IoMax = requests.NewInteger(d.Get("io_max").(int))
+		request.SpecType = d.Get("spec_type").(string)
+		if d.Get("deploy_type").(int) == 4 {
+			request.EipMax = requests.NewInteger(d.Get("eip_max").(int))
+		}
+		if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.UpgradePostPayOrder(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+
+		timeout := int(d.Timeout(schema.TimeoutUpdate).Seconds())
+		if err := alikafkaService.WaitForAlikafkaInstanceUpdated(d.Id(), d.Get("topic_quota").(int), d.Get("disk_size").(int), d.Get("io_max").(int),
+			d.Get("eip_max").(int), alikafkaPostPaidType, d.Get("spec_type").(string), timeout); err != nil {
+			return WrapError(err)
+		}
+		if err := alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, timeout); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("service_version") {
+		request := alikafka.CreateUpgradeInstanceVersionRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+		request.InstanceId = d.Id()
+		request.TargetVersion = d.Get("service_version").(string)
+		if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.UpgradeInstanceVersion(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		if err := alikafkaService.WaitForAlikafkaInstance(d.Id(), Running, int(d.Timeout(schema.TimeoutUpdate).Seconds())); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("config") {
+		request := alikafka.CreateUpdateInstanceConfigRequest()
+		request.RegionId = client.RegionId
+		request.Domain = client.Config.AlikafkaOpenAPIEndpoint
+		request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+		request.InstanceId = d.Id()
+		request.Config = d.Get("config").(string)
+		if _, err := alikafkaInstanceInvoke(client, request.GetActionName(), request.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.UpdateInstanceConfig(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackAlikafkaInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackAlikafkaInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	alikafkaService := AlikafkaService{client, ctx}
+	timeout := int(d.Timeout(schema.TimeoutDelete).Seconds())
+
+	// The instance is released first, which stops its nodes, and then deleted
+	releaseReq := alikafka.CreateReleaseInstanceRequest()
+	releaseReq.RegionId = client.RegionId
+	releaseReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	releaseReq.QueryParams = alikafkaService.queryParams(releaseReq.GetActionName())
+	releaseReq.InstanceId = d.Id()
+	releaseReq.ForceDeleteInstance = requests.NewBoolean(true)
+	releaseReq.ReleaseIgnoreTime = requests.NewBoolean(true)
+	if _, err := alikafkaInstanceInvoke(client, releaseReq.GetActionName(), releaseReq.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.ReleaseInstance(releaseReq)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), releaseReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	if err := alikafkaService.WaitForAllAlikafkaNodeRelease(d.Id(), "released", timeout); err != nil {
+		return WrapError(err)
+	}
+
+	deleteReq := alikafka.CreateDeleteInstanceRequest()
+	deleteReq.RegionId = client.RegionId
+	deleteReq.Domain = client.Config.AlikafkaOpenAPIEndpoint
+	deleteReq.QueryParams = alikafkaService.queryParams(deleteReq.GetActionName())
+	deleteReq.InstanceId = d.Id()
+	if _, err := alikafkaInstanceInvoke(client, deleteReq.GetActionName(), deleteReq.RpcRequest, func(alikafkaClient *alikafka.Client) (interface{}, error) {
+		return alikafkaClient.DeleteInstance(deleteReq)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), deleteReq.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	return WrapError(alikafkaService.WaitForAlikafkaInstance(d.Id(), Deleted, timeout))
+}
+
+// alikafkaInstanceInvoke calls the alikafka api and returns its response, the throttled requests are sent
+// again by the client.
+func alikafkaInstanceInvoke(client *connectivity.AlibabacloudStackClient, action string, request *requests.RpcRequest, do func(*alikafka.Client) (interface{}, error)) (interface{}, error) {
+	raw, err := client.WithAlikafkaClient(do)
+	addDebug(action, raw, request)
+	return raw, err
+}
+
+// alikafkaInstanceConfigDiffSuppressFunc compares the configured parameters with the ones the instance reports,
+// which include all of the parameters and not only the configured ones.
+func alikafkaInstanceConfigDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if new == "" {
+		return true
+	}
+	var oldConfig, newConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &oldConfig); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newConfig); err != nil {
+		return false
+	}
+	for key, value := range newConfig {
+		if fmt.Sprint(oldConfig[key]) != fmt.Sprint(value) {
+			return false
+		}
+	}
+	return true
+}
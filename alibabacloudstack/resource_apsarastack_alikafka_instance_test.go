@@ -0,0 +1,149 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"net/http"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackAlikafkaInstance_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_instance")
+	client := server.client()
+
+	r := resourceAlibabacloudStackAlikafkaInstance()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"name":        "tf-mock-kafka",
+		"topic_quota": 50,
+		"disk_type":   1,
+		"disk_size":   500,
+		"deploy_type": 5,
+		"io_max":      20,
+		"vswitch_id":  "vsw-mock0001",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the alikafka instance got an error: %#v", diags)
+	}
+	if d.Id() != "alikafka-mock0001" {
+		t.Fatalf("expected the alikafka instance id alikafka-mock0001, got %q", d.Id())
+	}
+	call, ok := server.lastCall("CreatePostPayOrder")
+	if !ok || call.Params["TopicQuota"] != "50" || call.Params["DiskType"] != "1" || call.Params["DiskSize"] != "500" || call.Params["IoMax"] != "20" {
+		t.Errorf("CreatePostPayOrder was not called with the expected parameters: %v", call.Params)
+	}
+	call, ok = server.lastCall("StartInstance")
+	if !ok || call.Params["InstanceId"] != "alikafka-mock0001" || call.Params["VpcId"] != "vpc-mock0001" || call.Params["ZoneId"] != "cn-qingdao-env66-amtest66001-a" || call.Params["DeployModule"] != "vpc" {
+		t.Errorf("StartInstance was not called with the expected parameters: %v", call.Params)
+	}
+	if value := d.Get("end_point").(string); value != "172.16.0.10:9092" {
+		t.Errorf("expected the end point 172.16.0.10:9092, got %q", value)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the alikafka instance got an error: %#v", diags)
+	}
+	if count := server.callCount("ReleaseInstance"); count != 1 {
+		t.Errorf("expected ReleaseInstance to be called once, got %d", count)
+	}
+	if count := server.callCount("DeleteInstance"); count != 1 {
+		t.Errorf("expected DeleteInstance to be called once, got %d", count)
+	}
+}
+
+func TestUnitAlibabacloudStackAlikafkaInstance_throttling(t *testing.T) {
+	server := newMockApiServer(t).onError("StartInstance", http.StatusBadRequest, "ONS_SYSTEM_FLOW_CONTROL").loadFixture("alikafka_instance")
+	client := server.client()
+
+	r := resourceAlibabacloudStackAlikafkaInstance()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"topic_quota": 50,
+		"disk_type":   1,
+		"disk_size":   500,
+		"deploy_type": 5,
+		"io_max":      20,
+		"vswitch_id":  "vsw-mock0001",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the alikafka instance after a throttled request got an error: %#v", diags)
+	}
+	// The throttled request is sent again by the client, and only by the client
+	if count := server.callCount("StartInstance"); count != 2 {
+		t.Errorf("expected the throttled StartInstance to be sent again once, it was sent %d times", count)
+	}
+}
+
+func TestUnitAlibabacloudStackAlikafkaInstancesDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_instance")
+	client := server.client()
+
+	r := dataSourceAlibabacloudStackAlikafkaInstances()
+	d := newMockApiResourceData(t, r, map[string]interface{}{})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alikafka instances got an error: %#v", diags)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 2 {
+		t.Errorf("expected the released instance to be left out of the ids, got %v", ids)
+	}
+
+	d = newMockApiResourceData(t, r, map[string]interface{}{
+		"name_regex": "^other",
+	})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alikafka instances got an error: %#v", diags)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 1 || ids[0] != "alikafka-mock0002" {
+		t.Errorf("expected the alikafka instance ids [alikafka-mock0002], got %v", ids)
+	}
+	if value := d.Get("instances.0.eip_max"); value != 10 {
+		t.Errorf("expected the eip max 10, got %v", value)
+	}
+	if value := d.Get("instances.0.tags.Team"); value != "kafka" {
+		t.Errorf("expected the alikafka instance tag Team kafka, got %v", value)
+	}
+}
+
+func TestUnitAlibabacloudStackAlikafkaTopicsDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_instance")
+	client := server.client()
+
+	r := dataSourceAlibabacloudStackAlikafkaTopics()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id": "alikafka-mock0001",
+		"name_regex":  "^other",
+	})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alikafka topics got an error: %#v", diags)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 1 || ids[0] != "alikafka-mock0001:other-topic" {
+		t.Errorf("expected the topic ids [alikafka-mock0001:other-topic], got %v", ids)
+	}
+	if value := d.Get("topics.0.partition_num"); value != 6 {
+		t.Errorf("expected the partition num 6, got %v", value)
+	}
+	if value := d.Get("topics.0.compact_topic"); value != true {
+		t.Errorf("expected the topic to be compacted, got %v", value)
+	}
+	call, ok := server.lastCall("GetTopicList")
+	if !ok || call.Params["InstanceId"] != "alikafka-mock0001" {
+		t.Errorf("GetTopicList was not called with the expected parameters: %v", call.Params)
+	}
+}
+
+func TestUnitAlibabacloudStackAlikafkaSaslUsersDataSource_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("alikafka_instance")
+	client := server.client()
+
+	r := dataSourceAlibabacloudStackAlikafkaSaslUsers()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id": "alikafka-mock0001",
+		"name_regex":  "^tf-",
+	})
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the alikafka sasl users got an error: %#v", diags)
+	}
+	if ids := d.Get("ids").([]interface{}); len(ids) != 1 || ids[0] != "alikafka-mock0001:tf-mock-user" {
+		t.Errorf("expected the sasl user ids [alikafka-mock0001:tf-mock-user], got %v", ids)
+	}
+	if value := d.Get("users.0.type"); value != "plain" {
+		t.Errorf("expected the sasl user type plain, got %v", value)
+	}
+}
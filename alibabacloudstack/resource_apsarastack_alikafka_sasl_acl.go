@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -13,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslAclCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslAclRead,
-		Delete: resourceAlibabacloudStackAlikafkaSaslAclDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslAclDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,10 +65,10 @@ func resourceAlibabacloudStackAlikafkaSaslAcl() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	instanceId := d.Get("instance_id").(string)
 	regionId := client.RegionId
@@ -86,15 +87,11 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateAcl(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(2 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -106,15 +103,17 @@ func resourceAlibabacloudStackAlikafkaSaslAclCreate(d *schema.ResourceData, meta
 	}
 
 	// Server may have cache, sleep a while.
-	time.Sleep(60 * time.Second)
+	if err := sleepContext(ctx, 60*time.Second); err != nil {
+		return WrapError(err)
+	}
 	d.SetId(fmt.Sprintf("%s:%s:%s:%s:%s:%s", instanceId, username, aclResourceType, aclResourceName, aclResourcePatternType, aclOperationType))
-	return resourceAlibabacloudStackAlikafkaSaslAclRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 6)
 	if err != nil {
@@ -141,10 +140,10 @@ func resourceAlibabacloudStackAlikafkaSaslAclRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 6)
 	if err != nil {
@@ -166,15 +165,11 @@ func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta
 	request.AclResourcePatternType = aclResourcePatternType
 	request.AclOperationType = aclOperationType
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteAcl(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -186,6 +181,8 @@ func resourceAlibabacloudStackAlikafkaSaslAclDelete(d *schema.ResourceData, meta
 	}
 
 	// Server may have cache, sleep a while.
-	time.Sleep(60 * time.Second)
+	if err := sleepContext(ctx, 60*time.Second); err != nil {
+		return WrapError(err)
+	}
 	return WrapError(alikafkaService.WaitForAlikafkaSaslAcl(d.Id(), Deleted, DefaultTimeoutMedium))
 }
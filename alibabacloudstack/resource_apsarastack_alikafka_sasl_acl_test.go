@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -26,7 +27,7 @@ func testSweepAlikafkaSaslAcl(region string) error {
 		return WrapErrorf(err, "error getting AlibabacloudStack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -196,7 +197,7 @@ func TestAccAlibabacloudStackAlikafkaSaslAcl_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_alikafka_sasl_acl.default"
 	ra := resourceAttrInit(resourceId, alikafkaSaslAclBasicMap)
 	serviceFunc := func() interface{} {
-		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -342,7 +343,6 @@ func TestAccAlicloudAlikafkaSaslAcl_multi(t *testing.T) {
 	})
 
 }
-
 */
 func resourceAlikafkaSaslAclConfigDependence(name string) string {
 	return fmt.Sprintf(`
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alikafka"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaSaslUserCreate,
-		Read:   resourceAlibabacloudStackAlikafkaSaslUserRead,
-		Update: resourceAlibabacloudStackAlikafkaSaslUserUpdate,
-		Delete: resourceAlibabacloudStackAlikafkaSaslUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaSaslUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -64,10 +65,10 @@ func resourceAlibabacloudStackAlikafkaSaslUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	instanceId := d.Get("instance_id").(string)
 	regionId := client.RegionId
@@ -89,7 +90,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 	if password != "" {
 		request.Password = password
 	} else {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -97,15 +98,11 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 		request.Password = decryptResp
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateSaslUser(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(2 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -119,13 +116,13 @@ func resourceAlibabacloudStackAlikafkaSaslUserCreate(d *schema.ResourceData, met
 	// Server may have cache, sleep a while.
 	time.Sleep(2 * time.Second)
 	d.SetId(instanceId + ":" + username + ":" + usertype)
-	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -149,10 +146,10 @@ func resourceAlibabacloudStackAlikafkaSaslUserRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -180,7 +177,7 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 		if password != "" {
 			request.Password = password
 		} else {
-			kmsService := KmsService{client}
+			kmsService := KmsService{client, ctx}
 			decryptResp, err := kmsService.Decrypt2(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -188,15 +185,11 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 			request.Password = decryptResp
 		}
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.CreateSaslUser(request)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-					time.Sleep(2 * time.Second)
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -210,13 +203,13 @@ func resourceAlibabacloudStackAlikafkaSaslUserUpdate(d *schema.ResourceData, met
 		// Server may have cache, sleep a while.
 		time.Sleep(1000)
 	}
-	return resourceAlibabacloudStackAlikafkaSaslUserRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaSaslUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaSaslUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -232,15 +225,11 @@ func resourceAlibabacloudStackAlikafkaSaslUserDelete(d *schema.ResourceData, met
 	request.Username = username
 	request.Type = usertype
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteSaslUser(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -29,7 +30,7 @@ func testSweepAlikafkaSaslUser(region string) error {
 		return WrapErrorf(err, "error getting alibabacloudstack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -115,7 +116,7 @@ func TestAccAlibabacloudStackAlikafkaSaslUser_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_alikafka_sasl_user.default"
 	ra := resourceAttrInit(resourceId, alikafkaSaslUserBasicMap)
 	serviceFunc := func() interface{} {
-		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -239,7 +240,6 @@ func TestAccAlibabacloudStackAlikafkaSaslUser_multi(t *testing.T) {
 	})
 
 }
-
 */
 func resourceAlikafkaSaslUserConfigDependence(name string) string {
 	return fmt.Sprintf(`
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strconv"
 
@@ -16,18 +17,19 @@ import (
 
 func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAlikafkaTopicCreate,
-		Update: resourceAlibabacloudStackAlikafkaTopicUpdate,
-		Read:   resourceAlibabacloudStackAlikafkaTopicRead,
-		Delete: resourceAlibabacloudStackAlikafkaTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicCreate),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicUpdate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAlikafkaTopicRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAlikafkaTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:     schema.TypeString,
@@ -63,14 +65,15 @@ func resourceAlibabacloudStackAlikafkaTopic() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.StringLenBetween(1, 64),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	instanceId := d.Get("instance_id").(string)
 	regionId := client.RegionId
@@ -82,14 +85,13 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 	request.Topic = topic
 
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateTopic",
-		"Version":         "2019-09-16",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "alikafka",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateTopic",
+		"Version":       "2019-09-16",
 	}
 	if v, ok := d.GetOk("local_topic"); ok {
 		request.LocalTopic = requests.NewBoolean(v.(bool))
@@ -105,15 +107,11 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 	}
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
 	request.QueryParams["Product"] = "alikafka"
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.CreateTopic(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -126,20 +124,20 @@ func resourceAlibabacloudStackAlikafkaTopicCreate(d *schema.ResourceData, meta i
 
 	d.SetId(instanceId + ":" + topic)
 
-	return resourceAlibabacloudStackAlikafkaTopicUpdate(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 	d.Partial(true)
 	if err := alikafkaService.setInstanceTags(d, TagResourceTopic); err != nil {
 		return WrapError(err)
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+		return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 	}
 
 	instanceId := d.Get("instance_id").(string)
@@ -152,28 +150,21 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 		modifyRemarkRequest.Topic = topic
 		modifyRemarkRequest.Remark = remark
 
-		
-
 		modifyRemarkRequest.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "alikafka",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyTopicRemark",
-			"Version":         "2019-09-16",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "alikafka",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyTopicRemark",
+			"Version":       "2019-09-16",
 		}
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 				return alikafkaClient.ModifyTopicRemark(modifyRemarkRequest)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{ThrottlingUser}) {
-					time.Sleep(10 * time.Second)
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(modifyRemarkRequest.GetActionName(), raw, modifyRemarkRequest.RpcRequest, modifyRemarkRequest)
@@ -200,29 +191,21 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 			modifyPartitionReq.Topic = topic
 			modifyPartitionReq.AddPartitionNum = requests.NewInteger(newPartitionNum - oldPartitionNum)
 
-			
-
-
 			modifyPartitionReq.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "alikafka",
-				"RegionId":        client.RegionId,
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"Action":          "ModifyPartitionNum",
-				"Version":         "2019-09-16",
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "alikafka",
+				"RegionId":      client.RegionId,
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"Action":        "ModifyPartitionNum",
+				"Version":       "2019-09-16",
 			}
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 					return alikafkaClient.ModifyPartitionNum(modifyPartitionReq)
 				})
 				if err != nil {
-					if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-						time.Sleep(10 * time.Second)
-						return resource.RetryableError(err)
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(modifyPartitionReq.GetActionName(), raw, modifyPartitionReq.RpcRequest, modifyPartitionReq)
@@ -236,12 +219,12 @@ func resourceAlibabacloudStackAlikafkaTopicUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackAlikafkaTopicRead(d, meta)
+	return resourceAlibabacloudStackAlikafkaTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	object, err := alikafkaService.DescribeAlikafkaTopic(d.Id())
 	if err != nil {
@@ -269,9 +252,9 @@ func resourceAlibabacloudStackAlikafkaTopicRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAlikafkaTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -286,24 +269,19 @@ func resourceAlibabacloudStackAlikafkaTopicDelete(d *schema.ResourceData, meta i
 	request.RegionId = client.RegionId
 	request.Domain = client.Config.AlikafkaOpenAPIEndpoint
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteTopic",
-		"Version":         "2019-09-16",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "alikafka",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteTopic",
+		"Version":       "2019-09-16",
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DeleteTopic(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				time.Sleep(10 * time.Second)
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -26,7 +27,7 @@ func testSweepAlikafkaTopic(region string) error {
 		return WrapErrorf(err, "error getting alibabacloudstack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	alikafkaService := AlikafkaService{client}
+	alikafkaService := AlikafkaService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -111,7 +112,7 @@ func TestAccAlibabacloudStackAlikafkaTopic_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_alikafka_topic.default"
 	ra := resourceAttrInit(resourceId, alikafkaTopicBasicMap)
 	serviceFunc := func() interface{} {
-		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AlikafkaService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayApiCreate,
-		Read:   resourceAlibabacloudStackApigatewayApiRead,
-		Update: resourceAlibabacloudStackApigatewayApiUpdate,
-		Delete: resourceAlibabacloudStackApigatewayApiDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayApiRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayApiDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -302,21 +303,20 @@ func resourceAlibabacloudStackApigatewayApi() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request, err := buildAlibabacloudStackApiArgs(d, meta)
 	request.Headers = map[string]string{
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateApi",
+		"Version":       "2016-07-14",
 	}
 	request.RegionId = client.RegionId
 	if err != nil {
@@ -335,18 +335,18 @@ func resourceAlibabacloudStackApigatewayApiCreate(d *schema.ResourceData, meta i
 	d.SetId(fmt.Sprintf("%s%s%s", request.GroupId, COLON_SEPARATED, response.ApiId))
 
 	if l, ok := d.GetOk("stage_names"); ok {
-		err = updateApiStages(d, l.(*schema.Set), meta)
+		err = updateApiStages(ctx, d, l.(*schema.Set), meta)
 		if err != nil {
 			return WrapError(err)
 		}
 	}
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	request := cloudapi.CreateDescribeApiRequest()
 	request.RegionId = client.RegionId
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -494,7 +494,7 @@ func resourceAlibabacloudStackApigatewayApiRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateModifyApiRequest()
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -517,14 +517,13 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "ModifyApi",
+		"Version":       "2016-07-14",
 	}
 	if d.HasChange("force_nonce_check") {
 		update = true
@@ -595,7 +594,7 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 
 	if update || d.HasChange("stage_names") {
 		if l, ok := d.GetOk("stage_names"); ok {
-			err = updateApiStages(d, l.(*schema.Set), meta)
+			err = updateApiStages(ctx, d, l.(*schema.Set), meta)
 			if err != nil {
 				return WrapError(err)
 			}
@@ -605,12 +604,12 @@ func resourceAlibabacloudStackApigatewayApiUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackApigatewayApiRead(d, meta)
+	return resourceAlibabacloudStackApigatewayApiRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayApiDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	request := cloudapi.CreateDeleteApiRequest()
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -622,17 +621,16 @@ func resourceAlibabacloudStackApigatewayApiDelete(d *schema.ResourceData, meta i
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteApi",
+		"Version":       "2016-07-14",
 	}
 	for _, stageName := range ApiGatewayStageNames {
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			err := cloudApiService.AbolishApi(d.Id(), stageName)
 			if err != nil {
 				if IsExpectedErrors(err, []string{"ConcurrencyLockTimeout"}) {
@@ -1024,10 +1022,10 @@ func getStageNameList(d *schema.ResourceData, cloudApiService CloudApiService) (
 	return stageNames, nil
 }
 
-func updateApiStages(d *schema.ResourceData, stageNames *schema.Set, meta interface{}) error {
+func updateApiStages(ctx context.Context, d *schema.ResourceData, stageNames *schema.Set, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	for _, stageName := range ApiGatewayStageNames {
 		if stageNames.Contains(stageName) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -89,7 +90,7 @@ func TestAccAlibabacloudStackApigatewayApi_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_api.default"
 	ra := resourceAttrInit(resourceId, apiGatewayApiMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &api, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -270,7 +271,7 @@ func TestAccAlibabacloudStackApigatewayApi_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_api.default"
 	ra := resourceAttrInit(resourceId, apiGatewayApiMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &api, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -338,7 +339,7 @@ func TestAccAlibabacloudStackApigatewayApi_fc(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_api.default"
 	ra := resourceAttrInit(resourceId, apiGatewayApiMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &api, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -405,7 +406,7 @@ func TestAccAlibabacloudStackApigatewayApi_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_api.default.9"
 	ra := resourceAttrInit(resourceId, apiGatewayApiMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &api, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -15,14 +16,15 @@ import (
 
 func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayAppCreate,
-		Read:   resourceAlibabacloudStackApigatewayAppRead,
-		Update: resourceAlibabacloudStackApigatewayAppUpdate,
-		Delete: resourceAlibabacloudStackApigatewayAppDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayAppRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayAppDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,12 +35,13 @@ func resourceAlibabacloudStackApigatewayApp() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateCreateAppRequest()
@@ -52,16 +55,15 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApp",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateApp",
+		"Version":       "2016-07-14",
 	}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApp(request)
 		})
@@ -78,15 +80,15 @@ func resourceAlibabacloudStackApigatewayAppCreate(d *schema.ResourceData, meta i
 	}); err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_apigateway_app", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackApigatewayAppUpdate(d, meta)
+	return resourceAlibabacloudStackApigatewayAppUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		tags, err := cloudApiService.DescribeTags(d.Id(), nil, TagResourceApp)
 		if err != nil {
 			if IsExpectedErrors(err, []string{"NotFoundResourceId"}) {
@@ -95,13 +97,15 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 			}
 			return resource.NonRetryableError(err)
 		}
-		d.Set("tags", cloudApiService.tagsToMap(tags))
+		if err := setResourceTags(d, meta, cloudApiService.tagsToMap(tags)); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		return nil
 	}); err != nil {
 		return WrapError(err)
 	}
 
-	if err := resource.Retry(3*time.Second, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Second, func() *resource.RetryError {
 		object, err := cloudApiService.DescribeApiGatewayApp(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -119,15 +123,15 @@ func resourceAlibabacloudStackApigatewayAppRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	if err := cloudApiService.setInstanceTags(d, TagResourceApp); err != nil {
 		return WrapError(err)
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+		return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 	}
 	if d.HasChange("name") || d.HasChange("description") {
 		request := cloudapi.CreateModifyAppRequest()
@@ -141,14 +145,13 @@ func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyApp",
-			"Version":         "2016-07-14",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "CloudAPI",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyApp",
+			"Version":       "2016-07-14",
 		}
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.ModifyApp(request)
@@ -159,12 +162,12 @@ func resourceAlibabacloudStackApigatewayAppUpdate(d *schema.ResourceData, meta i
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 	time.Sleep(3 * time.Second)
-	return resourceAlibabacloudStackApigatewayAppRead(d, meta)
+	return resourceAlibabacloudStackApigatewayAppRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayAppDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayAppDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	request := cloudapi.CreateDeleteAppRequest()
 	request.RegionId = client.RegionId
@@ -173,14 +176,13 @@ func resourceAlibabacloudStackApigatewayAppDelete(d *schema.ResourceData, meta i
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApp",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteApp",
+		"Version":       "2016-07-14",
 	}
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeleteApp(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
@@ -11,9 +12,12 @@ import (
 
 func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunApigatewayAppAttachmentCreate,
-		Read:   resourceAliyunApigatewayAppAttachmentRead,
-		Delete: resourceAliyunApigatewayAppAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAliyunApigatewayAppAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAliyunApigatewayAppAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAliyunApigatewayAppAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -45,9 +49,9 @@ func resourceAliyunApigatewayAppAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	apiId := d.Get("api_id").(string)
 	groupId := d.Get("group_id").(string)
@@ -64,14 +68,13 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "SetAppsAuthorities",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "SetAppsAuthorities",
+		"Version":       "2016-07-14",
 	}
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.SetAppsAuthorities(request)
@@ -88,12 +91,12 @@ func resourceAliyunApigatewayAppAttachmentCreate(d *schema.ResourceData, meta in
 	}
 
 	d.SetId(id)
-	return resourceAliyunApigatewayAppAttachmentRead(d, meta)
+	return resourceAliyunApigatewayAppAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	_, err := cloudApiService.DescribeApiGatewayAppAttachment(d.Id())
 	if err != nil {
@@ -112,9 +115,9 @@ func resourceAliyunApigatewayAppAttachmentRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunApigatewayAppAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	request := cloudapi.CreateRemoveAppsAuthoritiesRequest()
 	request.RegionId = client.RegionId
 	parts, err := ParseResourceId(d.Id(), 4)
@@ -129,14 +132,13 @@ func resourceAliyunApigatewayAppAttachmentDelete(d *schema.ResourceData, meta in
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "RemoveAppsAuthorities",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "RemoveAppsAuthorities",
+		"Version":       "2016-07-14",
 	}
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.RemoveAppsAuthorities(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,7 +18,7 @@ func TestAccAlibabacloudStackApigatewayAppAttachment(t *testing.T) {
 	ra := resourceAttrInit(resourceId, apigatewayAppAttachmentBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
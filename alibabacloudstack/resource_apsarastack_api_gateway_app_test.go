@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -89,7 +90,7 @@ func SkipTestAccAlibabacloudStackApigatewayApp_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, apigatewayAppBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -194,7 +195,7 @@ func SkipTestAccAlibabacloudStackApigatewayApp_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_app.default.9"
 	ra := resourceAttrInit(resourceId, apigatewayAppBasicMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
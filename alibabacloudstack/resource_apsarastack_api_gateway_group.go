@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cloudapi"
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayGroupCreate,
-		Read:   resourceAlibabacloudStackApigatewayGroupRead,
-		Update: resourceAlibabacloudStackApigatewayGroupUpdate,
-		Delete: resourceAlibabacloudStackApigatewayGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -41,7 +42,7 @@ func resourceAlibabacloudStackApigatewayGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cloudapi.CreateCreateApiGroupRequest()
@@ -49,20 +50,19 @@ func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateApiGroup",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateApiGroup",
+		"Version":       "2016-07-14",
 	}
 	request.RegionId = client.RegionId
 	request.GroupName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.CreateApiGroup(request)
 		})
@@ -80,12 +80,12 @@ func resourceAlibabacloudStackApigatewayGroupCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_api_gateway_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	apiGroup, err := cloudApiService.DescribeApiGatewayGroup(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -103,7 +103,7 @@ func resourceAlibabacloudStackApigatewayGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	if d.HasChange("name") || d.HasChange("description") {
@@ -116,14 +116,13 @@ func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyApiGroup",
-			"Version":         "2016-07-14",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "CloudAPI",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyApiGroup",
+			"Version":       "2016-07-14",
 		}
 		raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 			return cloudApiClient.ModifyApiGroup(request)
@@ -133,12 +132,12 @@ func resourceAlibabacloudStackApigatewayGroupUpdate(d *schema.ResourceData, meta
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackApigatewayGroupRead(d, meta)
+	return resourceAlibabacloudStackApigatewayGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 	request := cloudapi.CreateDeleteApiGroupRequest()
 	request.RegionId = client.RegionId
 	request.GroupId = d.Id()
@@ -146,14 +145,13 @@ func resourceAlibabacloudStackApigatewayGroupDelete(d *schema.ResourceData, meta
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteApiGroup",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteApiGroup",
+		"Version":       "2016-07-14",
 	}
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeleteApiGroup(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -106,7 +107,7 @@ func TestAccAlibabacloudStackApigatewayGroup_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, apigatewayGroupBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -182,7 +183,7 @@ func TestAccAlibabacloudStackApigatewayGroup_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_group.default.9"
 	ra := resourceAttrInit(resourceId, apigatewayGroupBasicMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,10 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 
@@ -15,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackApigatewayVpcAccessCreate,
-		Read:   resourceAlibabacloudStackApigatewayVpcAccessRead,
-		Delete: resourceAlibabacloudStackApigatewayVpcAccessDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackApigatewayVpcAccessDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +46,7 @@ func resourceAlibabacloudStackApigatewayVpc() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//client := meta.(*connectivity.AlibabacloudStackClient)
 	//
 	//request := cloudapi.CreateSetVpcAccessRequest()
@@ -62,8 +61,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	//	"RegionId": client.RegionId,
 	//}
 	//request.QueryParams = map[string]string{
-	//	"AccessKeySecret": client.SecretKey,
-	//	"AccessKeyId":     client.AccessKey,
+	//	//	"AccessKeyId":     client.AccessKey,
 	//	"Product":         "CloudAPI",
 	//	"RegionId":        client.RegionId,
 	//	"Department":      client.Department,
@@ -99,26 +97,15 @@ func resourceAlibabacloudStackApigatewayVpcAccessCreate(d *schema.ResourceData,
 	request["ClientToken"] = buildClientToken("SetVpcAccess")
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-07-14"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-07-14"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	d.SetId(fmt.Sprintf("%s%s%s%s%s%s%s", request["Name"], COLON_SEPARATED, request["VpcId"], COLON_SEPARATED, request["InstanceId"], COLON_SEPARATED, request["Port"]))
-	return resourceAlibabacloudStackApigatewayVpcAccessRead(d, meta)
+	return resourceAlibabacloudStackApigatewayVpcAccessRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudApiService := CloudApiService{client}
+	cloudApiService := CloudApiService{client, ctx}
 
 	vpc, err := cloudApiService.DescribeApiGatewayVpcAccess(d.Id())
 	if err != nil {
@@ -137,7 +124,7 @@ func resourceAlibabacloudStackApigatewayVpcAccessRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackApigatewayVpcAccessDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackApigatewayVpcAccessDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cloudapi.CreateRemoveVpcAccessRequest()
 	request.RegionId = client.RegionId
@@ -148,14 +135,13 @@ func resourceAlibabacloudStackApigatewayVpcAccessDelete(d *schema.ResourceData,
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "RemoveVpcAccess",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "RemoveVpcAccess",
+		"Version":       "2016-07-14",
 	}
 	raw, err := client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.RemoveVpcAccess(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -87,7 +88,7 @@ func TestAccAlibabacloudStackApigatewayVpcAccess_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_api_gateway_vpc_access.default"
 	ra := resourceAttrInit(resourceId, apiGatewayVpcAccessMap)
 	serviceFunc := func() interface{} {
-		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudApiService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
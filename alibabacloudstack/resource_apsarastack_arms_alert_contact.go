@@ -1,24 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsAlertContact() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsAlertContactCreate,
-		Read:   resourceAlibabacloudStackArmsAlertContactRead,
-		Update: resourceAlibabacloudStackArmsAlertContactUpdate,
-		Delete: resourceAlibabacloudStackArmsAlertContactDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackArmsAlertContactRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alert_contact_name": {
@@ -45,7 +44,7 @@ func resourceAlibabacloudStackArmsAlertContact() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAlertContact"
@@ -86,18 +85,7 @@ func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, met
 	if v, ok := d.GetOkExists("system_noc"); ok {
 		request["SystemNoc"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact", action, AlibabacloudStackSdkGoERROR)
@@ -105,11 +93,11 @@ func resourceAlibabacloudStackArmsAlertContactCreate(d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprint(response["ContactId"]))
 
-	return resourceAlibabacloudStackArmsAlertContactRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	armsService := ArmsService{client}
+	armsService := ArmsService{client, ctx}
 	object, err := armsService.DescribeArmsAlertContact(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -126,7 +114,7 @@ func resourceAlibabacloudStackArmsAlertContactRead(d *schema.ResourceData, meta
 	d.Set("system_noc", object["SystemNoc"])
 	return nil
 }
-func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -185,26 +173,15 @@ func resourceAlibabacloudStackArmsAlertContactUpdate(d *schema.ResourceData, met
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackArmsAlertContactRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteAlertContact"
 	var response map[string]interface{}
@@ -220,18 +197,7 @@ func resourceAlibabacloudStackArmsAlertContactDelete(d *schema.ResourceData, met
 
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -1,24 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsAlertContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsAlertContactGroupCreate,
-		Read:   resourceAlibabacloudStackArmsAlertContactGroupRead,
-		Update: resourceAlibabacloudStackArmsAlertContactGroupUpdate,
-		Delete: resourceAlibabacloudStackArmsAlertContactGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackArmsAlertContactGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackArmsAlertContactGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alert_contact_group_name": {
@@ -34,7 +33,7 @@ func resourceAlibabacloudStackArmsAlertContactGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAlertContactGroup"
@@ -50,18 +49,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData
 	request["RegionId"] = client.RegionId
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_alert_contact_group", action, AlibabacloudStackSdkGoERROR)
@@ -69,11 +57,11 @@ func resourceAlibabacloudStackArmsAlertContactGroupCreate(d *schema.ResourceData
 
 	d.SetId(fmt.Sprint(response["ContactGroupId"]))
 
-	return resourceAlibabacloudStackArmsAlertContactGroupRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	armsService := ArmsService{client}
+	armsService := ArmsService{client, ctx}
 	object, err := armsService.DescribeArmsAlertContactGroup(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -95,7 +83,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupRead(d *schema.ResourceData,
 	d.Set("contact_ids", contactIdsItems)
 	return nil
 }
-func resourceAlibabacloudStackArmsAlertContactGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -121,26 +109,15 @@ func resourceAlibabacloudStackArmsAlertContactGroupUpdate(d *schema.ResourceData
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackArmsAlertContactGroupRead(d, meta)
+	return resourceAlibabacloudStackArmsAlertContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsAlertContactGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsAlertContactGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteAlertContactGroup"
 	var response map[string]interface{}
@@ -155,18 +132,7 @@ func resourceAlibabacloudStackArmsAlertContactGroupDelete(d *schema.ResourceData
 	request["RegionId"] = client.RegionId
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -86,7 +87,7 @@ func TestAccAlibabacloudStackArmsAlertContactGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_arms_alert_contact_group.default"
 	ra := resourceAttrInit(resourceId, ArmsAlertContactGroupMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeArmsAlertContactGroup")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,11 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -46,18 +46,7 @@ func testSweepArmsAlertContact(region string) error {
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_arms_alert_contacts", action, AlibabacloudStackSdkGoERROR)
@@ -107,7 +96,7 @@ func TestAccAlibabacloudStackArmsAlertContact_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_arms_alert_contact.default"
 	ra := resourceAttrInit(resourceId, ArmsAlertContactMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeArmsAlertContact")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsDispatchRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsDispatchRuleCreate,
-		Read:   resourceAlibabacloudStackArmsDispatchRuleRead,
-		Update: resourceAlibabacloudStackArmsDispatchRuleUpdate,
-		Delete: resourceAlibabacloudStackArmsDispatchRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackArmsDispatchRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackArmsDispatchRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackArmsDispatchRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackArmsDispatchRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"is_recover": {
@@ -150,7 +149,7 @@ func resourceAlibabacloudStackArmsDispatchRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsDispatchRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateDispatchRule"
@@ -242,18 +241,7 @@ func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, met
 		request["DispatchRule"] = v
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR)
@@ -261,11 +249,11 @@ func resourceAlibabacloudStackArmsDispatchRuleCreate(d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprint(response["DispatchRuleId"]))
 
-	return resourceAlibabacloudStackArmsDispatchRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsDispatchRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsDispatchRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	armsService := ArmsService{client}
+	armsService := ArmsService{client, ctx}
 	object, err := armsService.DescribeArmsDispatchRule(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -346,7 +334,7 @@ func resourceAlibabacloudStackArmsDispatchRuleRead(d *schema.ResourceData, meta
 	d.Set("dispatch_rule_name", object["Name"])
 	return nil
 }
-func resourceAlibabacloudStackArmsDispatchRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsDispatchRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "UpdateDispatchRule"
@@ -444,26 +432,15 @@ func resourceAlibabacloudStackArmsDispatchRuleUpdate(d *schema.ResourceData, met
 	}
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_dispatch_rule", action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackArmsDispatchRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsDispatchRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsDispatchRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsDispatchRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteDispatchRule"
 	var response map[string]interface{}
@@ -477,18 +454,7 @@ func resourceAlibabacloudStackArmsDispatchRuleDelete(d *schema.ResourceData, met
 	}
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
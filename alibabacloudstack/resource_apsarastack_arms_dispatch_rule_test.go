@@ -1,11 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -43,18 +43,7 @@ func testSweepArmsDispatchRule(region string) error {
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		log.Printf("[ERROR] %s failed: %v", action, err)
@@ -87,18 +76,7 @@ func testSweepArmsDispatchRule(region string) error {
 			"Id":       fmt.Sprint(item["RuleId"]),
 			"RegionId": client.RegionId,
 		}
-		wait = incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			log.Printf("[ERROR] %s failed: %v", action, err)
@@ -112,7 +90,7 @@ func TestAccAlibabacloudStackArmsDispatchRule_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_arms_dispatch_rule.default"
 	ra := resourceAttrInit(resourceId, ArmsDispatchRuleMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeArmsDispatchRule")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackArmsPrometheusAlertRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackArmsPrometheusAlertRuleCreate,
-		Read:   resourceAlibabacloudStackArmsPrometheusAlertRuleRead,
-		Update: resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate,
-		Delete: resourceAlibabacloudStackArmsPrometheusAlertRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackArmsPrometheusAlertRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackArmsPrometheusAlertRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackArmsPrometheusAlertRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"annotations": {
@@ -110,7 +109,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreatePrometheusAlertRule"
@@ -160,18 +159,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 	if v, ok := d.GetOk("type"); ok {
 		request["Type"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_arms_prometheus_alert_rule", action, AlibabacloudStackSdkGoERROR)
@@ -179,11 +167,11 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleCreate(d *schema.ResourceDa
 	responsePrometheusAlertRule := response["PrometheusAlertRule"].(map[string]interface{})
 	d.SetId(fmt.Sprint(request["ClusterId"], ":", responsePrometheusAlertRule["AlertId"]))
 
-	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	armsService := ArmsService{client}
+	armsService := ArmsService{client, ctx}
 	object, err := armsService.DescribeArmsPrometheusAlertRule(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -243,7 +231,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d *schema.ResourceData
 	d.Set("type", object["Type"])
 	return nil
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -324,26 +312,15 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleUpdate(d *schema.ResourceDa
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(d, meta)
+	return resourceAlibabacloudStackArmsPrometheusAlertRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -361,18 +338,7 @@ func resourceAlibabacloudStackArmsPrometheusAlertRuleDelete(d *schema.ResourceDa
 	request["RegionId"] = client.RegionId
 	request["Product"] = "ARMS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-08-08"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
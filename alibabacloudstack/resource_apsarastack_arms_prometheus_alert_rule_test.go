@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackARMSPrometheusAlertRule_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_arms_prometheus_alert_rule.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackARMSPrometheusAlertRuleMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeArmsPrometheusAlertRule")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -160,7 +161,7 @@ func TestAccAlibabacloudStackARMSPrometheusAlertRule_basic1(t *testing.T) {
 	resourceId := "alibabacloudstack_arms_prometheus_alert_rule.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackARMSPrometheusAlertRuleMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ArmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeArmsPrometheusAlertRule")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRoleRead,
-		Update: resourceAlibabacloudStackAscmRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRoleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRoleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRoleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRoleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:         schema.TypeString,
@@ -51,10 +55,10 @@ func resourceAlibabacloudStackAscmRole() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("role_name").(string)
 	description := d.Get("description").(string)
 	roleRange := d.Get("role_range").(string)
@@ -82,7 +86,6 @@ func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interf
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
 			"Product":                "ascm",
 			"Action":                 "CreateRole",
 			"Version":                "2019-05-10",
@@ -123,7 +126,7 @@ func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interf
 		}
 		addDebug("CreateRole", raw, requestInfo, bresponse.GetHttpContentString())
 	}
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmCustomRole(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -131,20 +134,23 @@ func resourceAlibabacloudStackAscmRoleCreate(d *schema.ResourceData, meta interf
 		return resource.RetryableError(err)
 	})
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
-	return resourceAlibabacloudStackAscmRoleUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRoleUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRoleRead(d, meta)
+func resourceAlibabacloudStackAscmRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmCustomRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -152,40 +158,48 @@ func resourceAlibabacloudStackAscmRoleRead(d *schema.ResourceData, meta interfac
 		}
 		return WrapError(err)
 	}
+	if len(object.Data) == 0 {
+		d.SetId("")
+		return nil
+	}
 	log.Printf("Privileges for did[0]:%v", object.Data[0].Privileges)
 	d.Set("role_name", did[0])
 	d.Set("organization_visibility", object.Data[0].OrganizationVisibility)
 	d.Set("role_id", object.Data[0].ID)
 	d.Set("description", object.Data[0].Description)
+	d.Set("role_range", object.Data[0].RoleRange)
+	d.Set("privileges", object.Data[0].Privileges)
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmCustomRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRoleExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsCustomRoleExist", check, requestInfo, map[string]string{"roleName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveRole",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"roleName":        did[0],
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "RemoveRole",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"roleName":    did[0],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogonPolicyCreate,
-		Read:   resourceAlibabacloudStackLogonPolicyRead,
-		Update: resourceAlibabacloudStackLogonPolicyUpdate,
-		Delete: resourceAlibabacloudStackLogonPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogonPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogonPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -70,10 +71,10 @@ func resourceAlibabacloudStackLogonPolicy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("name").(string)
 	descr := d.Get("description").(string)
 	rule := d.Get("rule").(string)
@@ -95,7 +96,6 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
 			"Product":                "ascm",
 			"Department":             client.Department,
 			"ResourceGroup":          client.ResourceGroup,
@@ -134,7 +134,7 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 		addDebug("AddLoginPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		object, err = ascmService.DescribeAscmLogonPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -150,12 +150,12 @@ func resourceAlibabacloudStackLogonPolicyCreate(d *schema.ResourceData, meta int
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackLogonPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	request := requests.NewCommonRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -178,18 +178,17 @@ func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta int
 	policyId := fmt.Sprint(d.Get("policy_id").(int))
 
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ascm",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyLoginPolicy",
-		"Version":         "2019-05-10",
-		"ProductName":     "ascm",
-		"id":              policyId,
-		"Name":            name,
-		"Rule":            rule,
-		"Description":     desc,
+		"RegionId":      client.RegionId,
+		"Product":       "ascm",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "ModifyLoginPolicy",
+		"Version":       "2019-05-10",
+		"ProductName":   "ascm",
+		"id":            policyId,
+		"Name":          name,
+		"Rule":          rule,
+		"Description":   desc,
 	}
 	request.Domain = client.Domain
 	request.Method = "POST"
@@ -221,12 +220,12 @@ func resourceAlibabacloudStackLogonPolicyUpdate(d *schema.ResourceData, meta int
 
 	d.SetId(object.Data[0].Name)
 
-	return resourceAlibabacloudStackLogonPolicyRead(d, meta)
+	return resourceAlibabacloudStackLogonPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmLogonPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -242,9 +241,9 @@ func resourceAlibabacloudStackLogonPolicyRead(d *schema.ResourceData, meta inter
 	d.Set("rule", object.Data[0].Rule)
 	return nil
 }
-func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogonPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 
 	name := d.Get("name").(string)
@@ -254,7 +253,7 @@ func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsLoginPolicyExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsLoginPolicyExist", check, requestInfo, map[string]string{"loginpolicyName": d.Id()})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -267,7 +266,6 @@ func resourceAlibabacloudStackLogonPolicyDelete(d *schema.ResourceData, meta int
 		request.RegionId = client.RegionId
 		request.QueryParams = map[string]string{
 			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "ascm",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackLogonPolicy_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_logon_policy.default"
 	ra := resourceAttrInit(resourceId, ascmLogonPolicyBasicMap)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmOrganizationCreate,
-		Read:   resourceAlibabacloudStackAscmOrganizationRead,
-		Update: resourceAlibabacloudStackAscmOrganizationUpdate,
-		Delete: resourceAlibabacloudStackAscmOrganizationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmOrganizationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmOrganizationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"org_id": {
 				Type:     schema.TypeString,
@@ -48,9 +52,9 @@ func resourceAlibabacloudStackAscmOrganization() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 
 	var requestInfo *ecs.Client
 	name := d.Get("name").(string)
@@ -78,8 +82,7 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 		request.ApiName = "CreateOrganization"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
+			"Product": "ascm",
 			//"Department":      client.Department,
 			//"ResourceGroup":   client.ResourceGroup,
 			"RegionId": client.RegionId,
@@ -105,7 +108,7 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 		addDebug("CreateOrganization", raw, requestInfo, bresponse.GetHttpContentString())
 
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmOrganization(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -115,17 +118,20 @@ func resourceAlibabacloudStackAscmOrganizationCreate(d *schema.ResourceData, met
 
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationUpdate(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("name").(string)
 	attributeUpdate := false
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmOrganization(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR)
 	}
@@ -144,8 +150,7 @@ func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, met
 	}
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
+		"RegionId": client.RegionId,
 		//"Department":      client.Department,
 		//"ResourceGroup":   client.ResourceGroup,
 		"Product": "Ascm",
@@ -183,16 +188,19 @@ func resourceAlibabacloudStackAscmOrganizationUpdate(d *schema.ResourceData, met
 
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmOrganizationRead(d, meta)
+	return resourceAlibabacloudStackAscmOrganizationRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmOrganizationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmOrganization(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -212,27 +220,29 @@ func resourceAlibabacloudStackAscmOrganizationRead(d *schema.ResourceData, meta
 	return nil
 
 }
-func resourceAlibabacloudStackAscmOrganizationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmOrganizationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmOrganization(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsOrganizationExist", AlibabacloudStackSdkGoERROR)
 	}
 
 	addDebug("IsOrganizationExist", check, requestInfo, map[string]string{"id": did[1]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		if len(check.Data) != 0 {
 			request := requests.NewCommonRequest()
 			if client.Config.Insecure {
 				request.SetHTTPSInsecure(client.Config.Insecure)
 			}
 			request.QueryParams = map[string]string{
-				"RegionId":        client.RegionId,
-				"AccessKeySecret": client.SecretKey,
+				"RegionId": client.RegionId,
 				//"Department":      client.Department,
 				//"ResourceGroup":   client.ResourceGroup,
 				"Product":     "ascm",
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -18,10 +19,13 @@ import (
 
 func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmPasswordPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmPasswordPolicyRead,
-		Update: resourceAlibabacloudStackAscmPasswordPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmPasswordPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmPasswordPolicyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"hard_expiry": {
 				Type:     schema.TypeBool,
@@ -64,7 +68,7 @@ func resourceAlibabacloudStackAscmPasswordPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	value123 := strconv.Itoa(d.Get("minimum_password_length").(int))
@@ -88,7 +92,6 @@ func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, m
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
 		"RegionId":              client.RegionId,
-		"AccessKeySecret":       client.SecretKey,
 		"Department":            client.Department,
 		"ResourceGroup":         client.ResourceGroup,
 		"Product":               "ascm",
@@ -117,13 +120,13 @@ func resourceAlibabacloudStackAscmPasswordPolicyCreate(d *schema.ResourceData, m
 
 	d.SetId(fmt.Sprint(response.Data.ID))
 
-	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmPasswordPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -145,13 +148,13 @@ func resourceAlibabacloudStackAscmPasswordPolicyRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmPasswordPolicyRead(d, meta)
+func resourceAlibabacloudStackAscmPasswordPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmPasswordPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmPasswordPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmPasswordPolicy(d.Id())
 
@@ -159,20 +162,19 @@ func resourceAlibabacloudStackAscmPasswordPolicyDelete(d *schema.ResourceData, m
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ResetPasswordPolicy",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"id":              d.Id(),
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "ResetPasswordPolicy",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"id":          d.Id(),
 		}
 
 		request.Method = "POST"
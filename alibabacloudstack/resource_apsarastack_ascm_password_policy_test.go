@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"testing"
@@ -11,7 +12,7 @@ func TestAccAlibabacloudStackAscm_PasswordPolicy(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_password_policy.default"
 	ra := resourceAttrInit(resourceId, ascmPassword)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmQuotaCreate,
-		Read:   resourceAlibabacloudStackAscmQuotaRead,
-		Update: resourceAlibabacloudStackAscmQuotaUpdate,
-		Delete: resourceAlibabacloudStackAscmQuotaDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmQuotaRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmQuotaDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"product_name": {
 				Type:     schema.TypeString,
@@ -106,7 +110,7 @@ func resourceAlibabacloudStackAscmQuota() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	productName := strings.ToUpper(d.Get("product_name").(string))
@@ -120,18 +124,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalDiskCloudEfficiency := d.Get("total_disk_cloud_efficiency").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"regionName":      client.RegionId,
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"regionName":    client.RegionId,
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCpu,
 				"totalMem", totalMem,
@@ -170,18 +173,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalAmount := d.Get("total_amount").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalAmount", totalAmount,
 			),
@@ -215,18 +217,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalEIP := d.Get("total_eip").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalEIP", totalEIP,
 			),
@@ -261,18 +262,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalVipInternal := d.Get("total_vip_internal").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalVipPublic", totalVipPublic,
 				"totalVipInternal", totalVipInternal,
@@ -308,18 +308,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalDisk := d.Get("total_disk").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCu", totalCu,
 				"totalDisk", totalDisk,
@@ -356,18 +355,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalDisk := d.Get("total_disk").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCpu,
 				"totalMem", totalMem,
@@ -406,18 +404,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalDisk := d.Get("total_disk").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      targetType,
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    targetType,
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCpu,
 				"totalMem", totalMem,
@@ -455,18 +452,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalDisk := d.Get("total_disk").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCpu,
 				"totalMem", totalMem,
@@ -503,18 +499,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalMem := d.Get("total_mem").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      targetType,
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    targetType,
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalMem", totalMem,
 			),
@@ -548,18 +543,17 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 		totalVPC := d.Get("total_vpc").(int)
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "CreateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       quotaType,
-			"quotaTypeId":     quotaTypeId,
-			"productName":     productName,
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "CreateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     quotaType,
+			"quotaTypeId":   quotaTypeId,
+			"productName":   productName,
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":%d}",
 				"totalVPC", totalVPC,
 			),
@@ -594,13 +588,13 @@ func resourceAlibabacloudStackAscmQuotaCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(productName + COLON_SEPARATED + quotaType + COLON_SEPARATED + quotaTypeId)
 
-	return resourceAlibabacloudStackAscmQuotaUpdate(d, meta)
+	return resourceAlibabacloudStackAscmQuotaUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	attributeUpdate := false
 	check, err := ascmService.DescribeAscmQuota(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -624,18 +618,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalVPC", totalVPC,
 			),
@@ -702,18 +695,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "MySql",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "MySql",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCPU,
 				"totalMem", totalMEM,
@@ -757,18 +749,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalEIP", totalEIP,
 			),
@@ -858,18 +849,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCPU,
 				"totalMem", totalMEM,
@@ -928,18 +918,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalVipPublic", totalVP,
 				"totalVipInternal", totalVI,
@@ -983,18 +972,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalAmount", totalAmount,
 			),
@@ -1060,18 +1048,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCPU,
 				"totalMem", totalMEM,
@@ -1127,18 +1114,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCu", totalCU,
 				"totalDisk", totalDISK,
@@ -1205,18 +1191,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\",\"%s\":\"%d\",\"%s\":\"%d\"}",
 				"totalCpu", totalCPU,
 				"totalMem", totalMEM,
@@ -1261,18 +1246,17 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"regionName":      client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ascm",
-			"Action":          "UpdateQuota",
-			"Version":         "2019-05-10",
-			"quotaType":       did[1],
-			"quotaTypeId":     did[2],
-			"productName":     did[0],
-			"targetType":      "redis",
+			"RegionId":      client.RegionId,
+			"regionName":    client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ascm",
+			"Action":        "UpdateQuota",
+			"Version":       "2019-05-10",
+			"quotaType":     did[1],
+			"quotaTypeId":   did[2],
+			"productName":   did[0],
+			"targetType":    "redis",
 			"quotaBody": fmt.Sprintf("{\"%s\":\"%d\"}",
 				"totalMem", totalMEM,
 			),
@@ -1298,17 +1282,19 @@ func resourceAlibabacloudStackAscmQuotaUpdate(d *schema.ResourceData, meta inter
 		}
 	}
 
-	return resourceAlibabacloudStackAscmQuotaRead(d, meta)
+	return resourceAlibabacloudStackAscmQuotaRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmQuota(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -1320,6 +1306,7 @@ func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interfa
 		d.SetId("")
 		return nil
 	}
+	d.Set("product_name", did[0])
 
 	if did[0] == "VPC" {
 		d.Set("quota_id", object.Data.ID)
@@ -1393,33 +1380,34 @@ func resourceAlibabacloudStackAscmQuotaRead(d *schema.ResourceData, meta interfa
 
 	return nil
 }
-func resourceAlibabacloudStackAscmQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
+	did, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmQuota(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, did[0], "IsQuotaExist", AlibabacloudStackSdkGoERROR)
 	}
 
 	addDebug("IsQuotaExist", check, requestInfo, map[string]string{"productName": did[0]})
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"RegionName ":     client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "DeleteQuota",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"productName":     did[0],
-			"QuotaType":       did[1],
-			"QuotaTypeId":     did[2],
+			"RegionId":    client.RegionId,
+			"RegionName ": client.RegionId,
+			"Product":     "ascm",
+			"Action":      "DeleteQuota",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"productName": did[0],
+			"QuotaType":   did[1],
+			"QuotaTypeId": did[2],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamPolicyCreate,
-		Read:   resourceAlibabacloudStackAscmRamPolicyRead,
-		Update: resourceAlibabacloudStackAscmRamPolicyUpdate,
-		Delete: resourceAlibabacloudStackAscmRamPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -46,10 +47,10 @@ func resourceAlibabacloudStackAscmRamPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("name").(string)
 
 	policyDoc := d.Get("policy_document").(string)
@@ -62,16 +63,15 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 	if len(check.Data) == 0 {
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "ascm",
-			"Action":          "CreateRAMPolicy",
-			"Version":         "2019-05-10",
-			"policyName":      name,
-			"description":     description,
-			"policyDocument":  policyDoc,
+			"RegionId":       client.RegionId,
+			"Department":     client.Department,
+			"ResourceGroup":  client.ResourceGroup,
+			"Product":        "ascm",
+			"Action":         "CreateRAMPolicy",
+			"Version":        "2019-05-10",
+			"policyName":     name,
+			"description":    description,
+			"policyDocument": policyDoc,
 		}
 		request.Method = "POST"
 		request.Product = "ascm"
@@ -102,7 +102,7 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 		addDebug("CreateRAMPolicy", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamPolicy(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -111,13 +111,13 @@ func resourceAlibabacloudStackAscmRamPolicyCreate(d *schema.ResourceData, meta i
 	})
 	d.SetId(check.Data[0].PolicyName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 
 	response, err := ascmService.DescribeAscmRamPolicy(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -138,9 +138,9 @@ func resourceAlibabacloudStackAscmRamPolicyRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	attributeUpdate := false
 	check, err := ascmService.DescribeAscmRamPolicy(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -194,7 +194,6 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
 		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
 		"AccessKeyId":       client.AccessKey,
 		"Product":           "ascm",
 		"Department":        client.Department,
@@ -238,12 +237,12 @@ func resourceAlibabacloudStackAscmRamPolicyUpdate(d *schema.ResourceData, meta i
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmRamPolicyRead(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmRamPolicy(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -252,20 +251,19 @@ func resourceAlibabacloudStackAscmRamPolicyDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsPolicyExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsPolicyExist", check, requestInfo, map[string]string{"policyName": did[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "ascm",
-			"Action":          "RemoveRAMPolicy",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"ramPolicyId":     did[1],
+			"RegionId":      client.RegionId,
+			"AccessKeyId":   client.AccessKey,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "ascm",
+			"Action":        "RemoveRAMPolicy",
+			"Version":       "2019-05-10",
+			"ProductName":   "ascm",
+			"ramPolicyId":   did[1],
 		}
 
 		request.Method = "POST"
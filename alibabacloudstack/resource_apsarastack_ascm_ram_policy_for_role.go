@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,10 +16,13 @@ import (
 
 func resourceAlibabacloudStackAscmRamPolicyForRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamPolicyForRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRamPolicyForRoleRead,
-		Update: resourceAlibabacloudStackAscmRamPolicyForRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRamPolicyForRoleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamPolicyForRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"ram_policy_id": {
 				Type:     schema.TypeString,
@@ -32,7 +36,7 @@ func resourceAlibabacloudStackAscmRamPolicyForRole() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	ram_id := d.Get("ram_policy_id").(string)
@@ -42,14 +46,13 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d *schema.ResourceData,
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ascm",
-		"Action":          "AddRAMPolicyToRole",
-		"Version":         "2019-05-10",
-		"ProductName":     "ascm",
-		"RamPolicyId":     ram_id,
-		"RoleId":          fmt.Sprint(roleid),
+		"RegionId":    client.RegionId,
+		"Product":     "Ascm",
+		"Action":      "AddRAMPolicyToRole",
+		"Version":     "2019-05-10",
+		"ProductName": "ascm",
+		"RamPolicyId": ram_id,
+		"RoleId":      fmt.Sprint(roleid),
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -82,16 +85,18 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d *schema.ResourceData,
 
 	d.SetId(ram_id + COLON_SEPARATED + fmt.Sprint(roleid))
 
-	return resourceAlibabacloudStackAscmRamPolicyForRoleRead(d, meta)
+	return resourceAlibabacloudStackAscmRamPolicyForRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
-	_, err := ascmService.DescribeAscmRamPolicyForRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	_, err = ascmService.DescribeAscmRamPolicyForRole(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -106,39 +111,40 @@ func resourceAlibabacloudStackAscmRamPolicyForRoleRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRamPolicyForRoleCreate(d, meta)
+func resourceAlibabacloudStackAscmRamPolicyForRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmRamPolicyForRoleCreate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRamPolicyForRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamPolicyForRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmRamPolicyForRole(d.Id())
 
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"ramPolicyId": did[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveRAMPolicyFromRole",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"ramPolicyId":     did[0],
-			"roleId":          did[1],
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "RemoveRAMPolicyFromRole",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"ramPolicyId": did[0],
+			"roleId":      did[1],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackAscm_RamPolicyForRoleBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_ram_policy_for_role.default"
 	ra := resourceAttrInit(resourceId, testAccCheckAscmRamPolicyForRole)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -41,7 +42,7 @@ func TestAccAlibabacloudStackAscm_RamPolicyForRoleBasic(t *testing.T) {
 func testAccCheckAscm_RamPolicyForRoleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
 
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ascm_ram_policy_for_role" || rs.Type != "alibabacloudstack_ascm_ram_policy_for_role" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackAscm_RamPolicyBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_ram_policy.default"
 	ra := resourceAttrInit(resourceId, testAccCheckAscmRamPolicy)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -42,7 +43,7 @@ func TestAccAlibabacloudStackAscm_RamPolicyBasic(t *testing.T) {
 func testAccCheckAscm_RamPolicyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
 
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ascm_ram_policy" || rs.Type != "alibabacloudstack_ascm_ram_policy" {
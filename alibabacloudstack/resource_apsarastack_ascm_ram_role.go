@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmRamRoleCreate,
-		Read:   resourceAlibabacloudStackAscmRamRoleRead,
-		Update: resourceAlibabacloudStackAscmRamRoleUpdate,
-		Delete: resourceAlibabacloudStackAscmRamRoleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmRamRoleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmRamRoleDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:         schema.TypeString,
@@ -46,10 +50,10 @@ func resourceAlibabacloudStackAscmRamRole() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("role_name").(string)
 	description := d.Get("description").(string)
 	rolerange := d.Get("role_range").(string)
@@ -65,7 +69,6 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":               client.RegionId,
-			"AccessKeySecret":        client.SecretKey,
 			"Department":             client.Department,
 			"ResourceGroup":          client.ResourceGroup,
 			"Product":                "ascm",
@@ -108,14 +111,9 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		}
 		addDebug("CreateRole", raw, requestInfo, bresponse.GetHttpContentString())
 	}
-	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmRamRole(name)
 		if err != nil {
-			if IsThrottling(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		return nil
@@ -124,20 +122,23 @@ func resourceAlibabacloudStackAscmRamRoleCreate(d *schema.ResourceData, meta int
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DescribeAscmRamRole", AlibabacloudStackSdkGoERROR)
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
-	return resourceAlibabacloudStackAscmRamRoleUpdate(d, meta)
+	return resourceAlibabacloudStackAscmRamRoleUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmRamRoleUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmRamRoleRead(d, meta)
+func resourceAlibabacloudStackAscmRamRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmRamRoleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmRamRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -145,6 +146,10 @@ func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta inter
 		}
 		return WrapError(err)
 	}
+	if len(object.Data) == 0 {
+		d.SetId("")
+		return nil
+	}
 	if strings.Contains(object.Data[0].OrganizationVisibility, "organizationVisibility.") {
 		object.Data[0].OrganizationVisibility = strings.TrimPrefix(object.Data[0].OrganizationVisibility, "organizationVisibility.")
 	}
@@ -152,35 +157,37 @@ func resourceAlibabacloudStackAscmRamRoleRead(d *schema.ResourceData, meta inter
 	d.Set("organization_visibility", object.Data[0].OrganizationVisibility)
 	d.Set("role_id", object.Data[0].ID)
 	d.Set("description", object.Data[0].Description)
+	d.Set("role_range", object.Data[0].RoleRange)
 	return nil
 }
 
-func resourceAlibabacloudStackAscmRamRoleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmRamRoleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmRamRole(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsRamRoleExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsRamRoleExist", check, requestInfo, map[string]string{"roleName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveRole",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"roleName":        did[0],
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "RemoveRole",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"roleName":    did[0],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupRead,
-		Update: resourceAlibabacloudStackAscmResourceGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmResourceGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmResourceGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -39,9 +43,9 @@ func resourceAlibabacloudStackAscmResourceGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 
 	name := d.Get("name").(string)
@@ -59,7 +63,6 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":            client.RegionId,
-			"AccessKeySecret":     client.SecretKey,
 			"Product":             "Ascm",
 			"Action":              "CreateResourceGroup",
 			"Version":             "2019-05-10",
@@ -98,7 +101,7 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 		addDebug("CreateResourceGroup", raw, requestInfo, bresponse.GetHttpContentString())
 	}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		check, err = ascmService.DescribeAscmResourceGroup(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -107,18 +110,20 @@ func resourceAlibabacloudStackAscmResourceGroupCreate(d *schema.ResourceData, me
 	})
 	d.SetId(check.Data[0].ResourceGroupName + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	name := d.Get("name").(string)
 	attributeUpdate := false
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmResourceGroup(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
@@ -139,7 +144,6 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
 		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
 		"Department":        client.Department,
 		"ResourceGroup":     client.ResourceGroup,
 		"Product":           "ascm",
@@ -177,17 +181,20 @@ func resourceAlibabacloudStackAscmResourceGroupUpdate(d *schema.ResourceData, me
 	}
 	d.SetId(name + COLON_SEPARATED + fmt.Sprint(check.Data[0].ID))
 
-	return resourceAlibabacloudStackAscmResourceGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmResourceGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	object, err := ascmService.DescribeAscmResourceGroup(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -206,19 +213,21 @@ func resourceAlibabacloudStackAscmResourceGroupRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
+	did, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
 	check, err := ascmService.DescribeAscmResourceGroup(d.Id())
-	did := strings.Split(d.Id(), COLON_SEPARATED)
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsResourceGroupExist", check, requestInfo, map[string]string{"resourceGroupName": did[0]})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -226,7 +235,6 @@ func resourceAlibabacloudStackAscmResourceGroupDelete(d *schema.ResourceData, me
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":          client.RegionId,
-			"AccessKeySecret":   client.SecretKey,
 			"Product":           "ascm",
 			"Action":            "RemoveResourceGroup",
 			"Version":           "2019-05-10",
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -12,7 +13,7 @@ func TestAccAlibabacloudStackAscm_Resource_GroupBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_resource_group.default"
 	ra := resourceAttrInit(resourceId, testAccCheckResourceGroup)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -41,7 +42,7 @@ func TestAccAlibabacloudStackAscm_Resource_GroupBasic(t *testing.T) {
 
 func testAccCheckAscm_Resource_GroupDestroy(s *terraform.State) error { //destroy function
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ascm_resource_group" || rs.Type != "alibabacloudstack_ascm_resource_group" {
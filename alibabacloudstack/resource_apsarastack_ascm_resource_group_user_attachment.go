@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate,
-		Read:   resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead,
-		Delete: resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"rg_id": {
@@ -44,7 +45,7 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachment() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
@@ -87,7 +88,6 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.Re
 
 	request.QueryParams = map[string]string{
 		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
 		"Product":           "Ascm",
 		"Action":            "BindAscmUserAndResourceGroup",
 		"Version":           "2019-05-10",
@@ -119,14 +119,14 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(RgId)
-	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d, meta)
+	return resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ascmService := &AscmService{client: client}
+	ascmService := &AscmService{client: client, ctx: ctx}
 	obj, err := ascmService.DescribeAscmResourceGroupUserAttachment(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -140,16 +140,16 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentRead(d *schema.Reso
 
 	return nil
 }
-func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmResourceGroupUserAttachment(d.Id())
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsBindingExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
@@ -157,7 +157,6 @@ func resourceAlibabacloudStackAscmResourceGroupUserAttachmentDelete(d *schema.Re
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
 			"Product":         "ascm",
 			"Action":          "UnbindAscmUserAndResourceGroup",
 			"Version":         "2019-05-10",
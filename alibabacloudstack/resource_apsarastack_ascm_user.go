@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackAscmUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserCreate,
-		Read:   resourceAlibabacloudStackAscmUserRead,
-		Update: resourceAlibabacloudStackAscmUserUpdate,
-		Delete: resourceAlibabacloudStackAscmUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"cellphone_number": {
 				Type:     schema.TypeString,
@@ -71,10 +75,10 @@ func resourceAlibabacloudStackAscmUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	lname := d.Get("login_name").(string)
 	dname := d.Get("display_name").(string)
 	email := d.Get("email").(string)
@@ -111,7 +115,6 @@ func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interf
 		}
 		request.QueryParams = map[string]string{
 			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "Ascm",
 			"Action":           "AddUser",
 			"Version":          "2019-05-10",
@@ -162,10 +165,10 @@ func resourceAlibabacloudStackAscmUserCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(lname)
 
-	return resourceAlibabacloudStackAscmUserUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	lname := d.Get("login_name").(string)
@@ -198,7 +201,6 @@ func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interf
 
 	request.QueryParams = map[string]string{
 		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
 		"Product":          "ascm",
 		"Department":       client.Department,
 		"ResourceGroup":    client.ResourceGroup,
@@ -241,15 +243,15 @@ func resourceAlibabacloudStackAscmUserUpdate(d *schema.ResourceData, meta interf
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackAscmUserRead(d, meta)
+	return resourceAlibabacloudStackAscmUserRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmUser(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -280,30 +282,29 @@ func resourceAlibabacloudStackAscmUserRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmUser(d.Id())
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsUserExist", check, requestInfo, map[string]string{"loginName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveUserByLoginName",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"loginName":       d.Id(),
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "RemoveUserByLoginName",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"loginName":   d.Id(),
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRead,
-		Update: resourceAlibabacloudStackAscmUserGroupUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"group_name": {
 				Type:     schema.TypeString,
@@ -47,7 +51,7 @@ func resourceAlibabacloudStackAscmUserGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -118,19 +122,19 @@ func resourceAlibabacloudStackAscmUserGroupCreate(d *schema.ResourceData, meta i
 
 	d.SetId(groupName)
 
-	return resourceAlibabacloudStackAscmUserGroupUpdate(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
-	return resourceAlibabacloudStackAscmUserGroupRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmUserGroup(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -157,10 +161,10 @@ func resourceAlibabacloudStackAscmUserGroupRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 
 	check, err := ascmService.DescribeAscmUserGroup(d.Id())
@@ -168,20 +172,19 @@ func resourceAlibabacloudStackAscmUserGroupDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsUserGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsUserGroupExist", check, requestInfo, map[string]string{"groupName": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "DeleteUserGroup",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"userGroupId":     strconv.Itoa(check.Data[0].Id),
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "DeleteUserGroup",
+			"Version":     "2019-05-10",
+			"ProductName": "ascm",
+			"userGroupId": strconv.Itoa(check.Data[0].Id),
 		}
 
 		request.Method = "POST"
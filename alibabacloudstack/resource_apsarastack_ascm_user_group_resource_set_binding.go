@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead,
-		Delete: resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"resource_set_id": {
@@ -41,7 +42,7 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBinding() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//ascmService := AscmService{client}
@@ -83,15 +84,14 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.Re
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "Ascm",
-		"Action":          "AddResourceSetToUserGroup",
-		"Version":         "2019-05-10",
-		"ProductName":     "ascm",
-		"userGroupId":     userGroupId,
-		"resourceSetId":   resourceSetId,
-		"ascmRoleId":      ascmRoleId,
+		"RegionId":      client.RegionId,
+		"Product":       "Ascm",
+		"Action":        "AddResourceSetToUserGroup",
+		"Version":       "2019-05-10",
+		"ProductName":   "ascm",
+		"userGroupId":   userGroupId,
+		"resourceSetId": resourceSetId,
+		"ascmRoleId":    ascmRoleId,
 	}
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -116,14 +116,14 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingCreate(d *schema.Re
 	//})
 	//log.Printf("CreateOrganization Test %+v", check)
 	d.SetId(resourceSetId)
-	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ascmService := &AscmService{client: client}
+	ascmService := &AscmService{client: client, ctx: ctx}
 	obj, err := ascmService.DescribeAscmUserGroupResourceSetBinding(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -136,9 +136,9 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingRead(d *schema.Reso
 
 	return nil
 }
-func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmUserGroupResourceSetBinding(d.Id())
 	if err != nil {
@@ -146,21 +146,20 @@ func resourceAlibabacloudStackAscmUserGroupResourceSetBindingDelete(d *schema.Re
 	}
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"resourceGroupId": d.Id()})
 	userGroupId := d.Get("user_group_id").(string)
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RemoveResourceSetFromUserGroup",
-			"Version":         "2019-05-10",
-			"ProductName":     "ascm",
-			"userGroupId":     userGroupId,
-			"resourceSetId":   d.Id(),
+			"RegionId":      client.RegionId,
+			"Product":       "ascm",
+			"Action":        "RemoveResourceSetFromUserGroup",
+			"Version":       "2019-05-10",
+			"ProductName":   "ascm",
+			"userGroupId":   userGroupId,
+			"resourceSetId": d.Id(),
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackAscmUserGroupResourceSetBinding(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_user_group_resource_set_binding.default"
 	ra := resourceAttrInit(resourceId, testAccCheckUserGroupResourceSetBinding)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rand := acctest.RandInt()
 	name := fmt.Sprintf("tf-ascmusergroup%v", rand)
@@ -45,7 +46,7 @@ func TestAccAlibabacloudStackAscmUserGroupResourceSetBinding(t *testing.T) {
 
 func testAccCheckAscmUserGroupResourceSetBindingDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ascm_user_group_resource_set_binding" || rs.Type != "alibabacloudstack_ascm_user_group_resource_set_binding" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -16,10 +17,13 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupRoleBindingCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupRoleBindingRead,
-		Update: resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackAscmUserGroupRoleBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupRoleBindingDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeInt,
@@ -34,7 +38,7 @@ func resourceAlibabacloudStackAscmUserGroupRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	userGroupId := d.Get("user_group_id").(int)
@@ -52,14 +56,13 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceD
 				request.SetHTTPSInsecure(client.Config.Insecure)
 			}
 			request.QueryParams = map[string]string{
-				"RegionId":        client.RegionId,
-				"AccessKeySecret": client.SecretKey,
-				"Product":         "Ascm",
-				"Action":          "AddRoleToUserGroup",
-				"Version":         "2019-05-10",
-				"ProductName":     "ascm",
-				"userGroupId":     strconv.Itoa(userGroupId),
-				"RoleId":          fmt.Sprint(roleids[i]),
+				"RegionId":    client.RegionId,
+				"Product":     "Ascm",
+				"Action":      "AddRoleToUserGroup",
+				"Version":     "2019-05-10",
+				"ProductName": "ascm",
+				"userGroupId": strconv.Itoa(userGroupId),
+				"RoleId":      fmt.Sprint(roleids[i]),
 			}
 			request.Method = "POST"
 			request.Product = "Ascm"
@@ -97,13 +100,13 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d *schema.ResourceD
 
 	d.SetId(strconv.Itoa(userGroupId))
 
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmUserGroupRoleBinding(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -116,21 +119,33 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingRead(d *schema.ResourceDat
 		d.SetId("")
 		return nil
 	}
-	atoi, err := strconv.Atoi(d.Id())
-	d.Set("user_group_id", atoi)
+	// The user groups are looked up by name, which may match more groups than the bound one
+	for _, group := range object.Data {
+		if strconv.Itoa(group.Id) != d.Id() {
+			continue
+		}
+		d.Set("user_group_id", group.Id)
+		var roleIds []int
+		for _, role := range group.Roles {
+			roleIds = append(roleIds, role.Id)
+		}
+		d.Set("role_ids", roleIds)
+		return nil
+	}
+	d.SetId("")
 
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(d, meta)
+func resourceAlibabacloudStackAscmUserGroupRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackAscmUserGroupRoleBindingCreate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	var roleid int
 	flag := false
@@ -156,21 +171,20 @@ func resourceAlibabacloudStackAscmUserGroupRoleBindingDelete(d *schema.ResourceD
 	}
 
 	addDebug("IsBindingExist", check, requestInfo, map[string]string{"userGroupId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		if flag {
 			request := requests.NewCommonRequest()
 			if client.Config.Insecure {
 				request.SetHTTPSInsecure(client.Config.Insecure)
 			}
 			request.QueryParams = map[string]string{
-				"RegionId":        client.RegionId,
-				"AccessKeySecret": client.SecretKey,
-				"Product":         "ascm",
-				"Action":          "RemoveRoleFromUserGroup",
-				"Version":         "2019-05-10",
-				"ProductName":     "ascm",
-				"userGroupId":     d.Id(),
-				"RoleId":          fmt.Sprint(roleid),
+				"RegionId":    client.RegionId,
+				"Product":     "ascm",
+				"Action":      "RemoveRoleFromUserGroup",
+				"Version":     "2019-05-10",
+				"ProductName": "ascm",
+				"userGroupId": d.Id(),
+				"RoleId":      fmt.Sprint(roleid),
 			}
 
 			request.Method = "POST"
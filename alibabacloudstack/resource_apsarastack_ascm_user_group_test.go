@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackAscm_User_Group_Basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_user_group.default"
 	ra := resourceAttrInit(resourceId, ascmusergroupBasicMap)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -56,7 +57,7 @@ func TestAccAlibabacloudStackAscm_User_Group_Basic(t *testing.T) {
 
 func testAccCheckAscm_User_Group_Destroy(s *terraform.State) error { //destroy function
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if true {
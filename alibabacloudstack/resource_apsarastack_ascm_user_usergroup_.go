@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -14,9 +15,12 @@ import (
 
 func resourceAlibabacloudStackAscmUserGroupUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackAscmUserGroupUserCreate,
-		Read:   resourceAlibabacloudStackAscmUserGroupUserRead,
-		Delete: resourceAlibabacloudStackAscmUserGroupUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackAscmUserGroupUserRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackAscmUserGroupUserDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
 				Type:     schema.TypeString,
@@ -39,7 +43,7 @@ func resourceAlibabacloudStackAscmUserGroupUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -105,14 +109,14 @@ func resourceAlibabacloudStackAscmUserGroupUserCreate(d *schema.ResourceData, me
 
 	d.SetId(userGroupId)
 
-	return resourceAlibabacloudStackAscmUserGroupUserRead(d, meta)
+	return resourceAlibabacloudStackAscmUserGroupUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmUsergroupUser(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -131,12 +135,13 @@ func resourceAlibabacloudStackAscmUserGroupUserRead(d *schema.ResourceData, meta
 		loginNames = append(loginNames, data.LoginName)
 	}
 
+	d.Set("user_group_id", d.Id())
 	d.Set("login_names", loginNames)
 
 	return nil
 }
 
-func resourceAlibabacloudStackAscmUserGroupUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackAscmUserGroupUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	var login_names []string
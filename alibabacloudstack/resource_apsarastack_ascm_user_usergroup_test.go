@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackAscm_UserGroup_User_Basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ascm_usergroup_user.default"
 	ra := resourceAttrInit(resourceId, testAccCheckUserGroupUserBinding)
 	serviceFunc := func() interface{} {
-		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &AscmService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rand := acctest.RandInt()
 	name := fmt.Sprintf("tf-ascmusergroup%v", rand)
@@ -45,7 +46,7 @@ func TestAccAlibabacloudStackAscm_UserGroup_User_Basic(t *testing.T) {
 
 func testAccCheckAscmUserGroupUserDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ascm_usergroup_user" || rs.Type != "alibabacloudstack_ascm_usergroup_user" {
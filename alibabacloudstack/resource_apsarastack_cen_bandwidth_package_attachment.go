@@ -0,0 +1,110 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackCenBandwidthPackageAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenBandwidthPackageAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"bandwidth_package_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("instance_id").(string)
+	packageId := d.Get("bandwidth_package_id").(string)
+
+	action := "AssociateCenBandwidthPackage"
+	request := map[string]interface{}{
+		"CenId":                 cenId,
+		"CenBandwidthPackageId": packageId,
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_bandwidth_package_attachment", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", cenId, COLON_SEPARATED, packageId))
+
+	stateConf := BuildStateConf([]string{"Idle"}, []string{"InUse"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenBandwidthPackageStateRefreshFunc(packageId, []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenBandwidthPackageAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	if _, err := cenService.DescribeCenBandwidthPackageAttachment(d.Id()); err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("bandwidth_package_id", parts[1])
+	return nil
+}
+
+func resourceAlibabacloudStackCenBandwidthPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "UnassociateCenBandwidthPackage"
+	request := map[string]interface{}{
+		"CenId":                 parts[0],
+		"CenBandwidthPackageId": parts[1],
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"InUse"}, []string{"Idle"}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenBandwidthPackageStateRefreshFunc(parts[1], []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,144 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenInstance() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCenInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenInstanceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 128),
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(2, 256),
+			},
+			"protection_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"REDUCED"}, false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	action := "CreateCen"
+	request := map[string]interface{}{
+		"ClientToken": buildClientToken(action),
+	}
+	if v, ok := d.GetOk("name"); ok {
+		request["Name"] = v
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request["Description"] = v
+	}
+	if v, ok := d.GetOk("protection_level"); ok {
+		request["ProtectionLevel"] = v
+	}
+	response, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprint(response["CenId"]))
+
+	stateConf := BuildStateConf([]string{"Creating"}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	object, err := cenService.DescribeCenInstance(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", object["Name"])
+	d.Set("description", object["Description"])
+	d.Set("protection_level", object["ProtectionLevel"])
+	d.Set("status", object["Status"])
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+
+	if d.HasChanges("name", "description", "protection_level") {
+		action := "ModifyCenAttribute"
+		request := map[string]interface{}{
+			"CenId":       d.Id(),
+			"Name":        d.Get("name"),
+			"Description": d.Get("description"),
+		}
+		if v, ok := d.GetOk("protection_level"); ok {
+			request["ProtectionLevel"] = v
+		}
+		if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	return resourceAlibabacloudStackCenInstanceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	action := "DeleteCen"
+	request := map[string]interface{}{
+		"CenId": d.Id(),
+	}
+	// The delete is retried while the children and the bandwidth packages of the CEN are still being detached
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
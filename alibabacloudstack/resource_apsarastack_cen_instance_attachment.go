@@ -0,0 +1,165 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackCenInstanceAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenInstanceAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{ChildInstanceTypeVpc, ChildInstanceTypeVbr, ChildInstanceTypeCcn}, false),
+			},
+			"child_instance_region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"child_instance_owner_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("instance_id").(string)
+	childId := d.Get("child_instance_id").(string)
+	childType := d.Get("child_instance_type").(string)
+	if childType == "" {
+		// The type of the child is told by the prefix of its id, e.g. the vbr- of an express connect virtual border router
+		t, err := GetCenChildInstanceType(childId)
+		if err != nil {
+			return WrapError(err)
+		}
+		childType = t
+	}
+	childRegionId := client.RegionId
+	if v, ok := d.GetOk("child_instance_region_id"); ok {
+		childRegionId = v.(string)
+	}
+
+	action := "AttachCenChildInstance"
+	request := map[string]interface{}{
+		"CenId":                 cenId,
+		"ChildInstanceId":       childId,
+		"ChildInstanceType":     childType,
+		"ChildInstanceRegionId": childRegionId,
+	}
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request["ChildInstanceOwnerId"] = v
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_instance_attachment", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(strings.Join([]string{cenId, childId, childType, childRegionId}, COLON_SEPARATED))
+
+	stateConf := BuildStateConf([]string{"Attaching"}, []string{"Attached"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenInstanceAttachmentStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenInstanceAttachmentRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	object, err := cenService.DescribeCenInstanceAttachment(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("child_instance_id", parts[1])
+	d.Set("child_instance_type", parts[2])
+	d.Set("child_instance_region_id", parts[3])
+	if v, ok := object["ChildInstanceOwnerId"]; ok && fmt.Sprint(v) != "" {
+		d.Set("child_instance_owner_id", fmt.Sprint(v))
+	}
+	d.Set("status", object["Status"])
+	return nil
+}
+
+func resourceAlibabacloudStackCenInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 4)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "DetachCenChildInstance"
+	request := map[string]interface{}{
+		"CenId":                 parts[0],
+		"ChildInstanceId":       parts[1],
+		"ChildInstanceType":     parts[2],
+		"ChildInstanceRegionId": parts[3],
+	}
+	if v, ok := d.GetOk("child_instance_owner_id"); ok {
+		request["ChildInstanceOwnerId"] = v
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Attached", "Detaching"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenInstanceAttachmentStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
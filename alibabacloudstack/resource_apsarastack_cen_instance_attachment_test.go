@@ -0,0 +1,40 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackCenInstanceAttachment_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("cen_instance_attachment")
+	client := server.client()
+
+	r := resourceAlibabacloudStackCenInstanceAttachment()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id":       "cen-mock0001",
+		"child_instance_id": "vbr-mock0001",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("attaching the child instance got an error: %#v", diags)
+	}
+	if d.Id() != "cen-mock0001:vbr-mock0001:VBR:"+mockApiRegion {
+		t.Fatalf("expected the attachment id cen-mock0001:vbr-mock0001:VBR:%s, got %q", mockApiRegion, d.Id())
+	}
+	call, ok := server.lastCall("AttachCenChildInstance")
+	if !ok {
+		t.Fatalf("expected AttachCenChildInstance to be called")
+	}
+	if call.Params["ChildInstanceType"] != "VBR" || call.Params["ChildInstanceRegionId"] != mockApiRegion || call.Params["Product"] != "Cbn" {
+		t.Errorf("expected the virtual border router to be attached in the provider region, got %v", call.Params)
+	}
+	if value := d.Get("status").(string); value != "Attached" {
+		t.Errorf("expected the status Attached, got %q", value)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("detaching the child instance got an error: %#v", diags)
+	}
+	if call, _ := server.lastCall("DetachCenChildInstance"); call.Params["ChildInstanceId"] != "vbr-mock0001" || call.Params["ChildInstanceType"] != "VBR" {
+		t.Errorf("expected the virtual border router to be detached, got %v", call.Params)
+	}
+}
@@ -0,0 +1,161 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackCenRouteEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenRouteEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenRouteEntryRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenRouteEntryDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cidr_block": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"child_instance_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_hop_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"next_hop_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackCenRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("instance_id").(string)
+	childId := d.Get("child_instance_id").(string)
+	routeTableId := d.Get("route_table_id").(string)
+	cidrBlock := d.Get("cidr_block").(string)
+	// The route tables of a VPC and of an express connect virtual border router are published in the same way
+	childType, err := GetCenChildInstanceType(childId)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "PublishRouteEntries"
+	request := map[string]interface{}{
+		"CenId":                     cenId,
+		"ChildInstanceId":           childId,
+		"ChildInstanceType":         childType,
+		"ChildInstanceRegionId":     client.RegionId,
+		"ChildInstanceRouteTableId": routeTableId,
+		"DestinationCidrBlock":      cidrBlock,
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_route_entry", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(strings.Join([]string{cenId, childId, routeTableId, cidrBlock}, COLON_SEPARATED))
+
+	stateConf := BuildStateConf([]string{"Publishing"}, []string{"Published"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenRouteEntryStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenRouteEntryRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	object, err := cenService.DescribeCenRouteEntry(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := parseCenRouteEntryId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	childType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("instance_id", parts[0])
+	d.Set("child_instance_id", parts[1])
+	d.Set("route_table_id", parts[2])
+	d.Set("cidr_block", parts[3])
+	d.Set("child_instance_type", childType)
+	d.Set("next_hop_type", object["NextHopType"])
+	d.Set("next_hop_id", object["NextHopId"])
+	return nil
+}
+
+func resourceAlibabacloudStackCenRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	parts, err := parseCenRouteEntryId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	childType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "WithdrawPublishedRouteEntries"
+	request := map[string]interface{}{
+		"CenId":                     parts[0],
+		"ChildInstanceId":           parts[1],
+		"ChildInstanceType":         childType,
+		"ChildInstanceRegionId":     client.RegionId,
+		"ChildInstanceRouteTableId": parts[2],
+		"DestinationCidrBlock":      parts[3],
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Published", "Withdrawing"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenRouteEntryStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// cenRouteMapMatchConditions are the list arguments of the route map, the parameter names of their items and the
+// path of their items in the route map.
+var cenRouteMapMatchConditions = map[string][2]string{
+	"source_region_ids":                {"SourceRegionIds", "$.SourceRegionIds.SourceRegionId"},
+	"source_instance_ids":              {"SourceInstanceIds", "$.SourceInstanceIds.SourceInstanceId"},
+	"destination_instance_ids":         {"DestinationInstanceIds", "$.DestinationInstanceIds.DestinationInstanceId"},
+	"source_route_table_ids":           {"SourceRouteTableIds", "$.SourceRouteTableIds.SourceRouteTableId"},
+	"destination_route_table_ids":      {"DestinationRouteTableIds", "$.DestinationRouteTableIds.DestinationRouteTableId"},
+	"source_child_instance_types":      {"SourceChildInstanceTypes", "$.SourceChildInstanceTypes.SourceChildInstanceType"},
+	"destination_child_instance_types": {"DestinationChildInstanceTypes", "$.DestinationChildInstanceTypes.DestinationChildInstanceType"},
+	"destination_cidr_blocks":          {"DestinationCidrBlocks", "$.DestinationCidrBlocks.DestinationCidrBlock"},
+	"route_types":                      {"RouteTypes", "$.RouteTypes.RouteType"},
+}
+
+func resourceAlibabacloudStackCenRouteMap() *schema.Resource {
+	r := &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCenRouteMapCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCenRouteMapRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCenRouteMapUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCenRouteMapDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(6 * time.Minute),
+			Update: schema.DefaultTimeout(6 * time.Minute),
+			Delete: schema.DefaultTimeout(6 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cen_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"cen_region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"transmit_direction": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"RegionIn", "RegionOut"}, false),
+			},
+			"priority": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"map_result": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Permit", "Deny"}, false),
+			},
+			"next_priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"cidr_match_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Include", "Complete"}, false),
+			},
+			"preference": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"route_map_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+	for key := range cenRouteMapMatchConditions {
+		r.Schema[key] = &schema.Schema{
+			Type:     schema.TypeSet,
+			Optional: true,
+			Elem:     &schema.Schema{Type: schema.TypeString},
+		}
+	}
+	return r
+}
+
+// buildCenRouteMapRequest sets the conditions and the actions of the route map to the request.
+func buildCenRouteMapRequest(d *schema.ResourceData, request map[string]interface{}) {
+	request["Priority"] = d.Get("priority")
+	request["MapResult"] = d.Get("map_result")
+	request["Description"] = d.Get("description")
+	if v, ok := d.GetOk("next_priority"); ok {
+		request["NextPriority"] = v
+	}
+	if v, ok := d.GetOk("cidr_match_mode"); ok {
+		request["CidrMatchMode"] = v
+	}
+	if v, ok := d.GetOk("preference"); ok {
+		request["Preference"] = v
+	}
+	for key, condition := range cenRouteMapMatchConditions {
+		for i, item := range d.Get(key).(*schema.Set).List() {
+			request[fmt.Sprintf("%s.%d", condition[0], i+1)] = item
+		}
+	}
+}
+
+func resourceAlibabacloudStackCenRouteMapCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	cenId := d.Get("cen_id").(string)
+	action := "CreateCenRouteMap"
+	request := map[string]interface{}{
+		"CenId":             cenId,
+		"CenRegionId":       d.Get("cen_region_id"),
+		"TransmitDirection": d.Get("transmit_direction"),
+	}
+	buildCenRouteMapRequest(d, request)
+	response, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cen_route_map", action, AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%v", cenId, COLON_SEPARATED, response["RouteMapId"]))
+
+	stateConf := BuildStateConf([]string{"Creating"}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, cenService.CenRouteMapStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenRouteMapRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenRouteMapRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	object, err := cenService.DescribeCenRouteMap(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("cen_id", object["CenId"])
+	d.Set("cen_region_id", object["CenRegionId"])
+	d.Set("transmit_direction", object["TransmitDirection"])
+	d.Set("priority", formatInt(object["Priority"]))
+	d.Set("map_result", object["MapResult"])
+	if v, ok := object["NextPriority"]; ok && fmt.Sprint(v) != "0" {
+		d.Set("next_priority", formatInt(v))
+	}
+	d.Set("description", object["Description"])
+	d.Set("cidr_match_mode", object["CidrMatchMode"])
+	if v, ok := object["Preference"]; ok && fmt.Sprint(v) != "0" {
+		d.Set("preference", formatInt(v))
+	}
+	d.Set("route_map_id", object["RouteMapId"])
+	d.Set("status", object["Status"])
+	for key, condition := range cenRouteMapMatchConditions {
+		items, _ := jsonpath.Get(condition[1], object)
+		if items == nil {
+			items = []interface{}{}
+		}
+		if err := d.Set(key, items); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackCenRouteMapUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	// The route map is modified as a whole, the conditions which are left out are removed
+	action := "ModifyCenRouteMap"
+	request := map[string]interface{}{
+		"CenId":       parts[0],
+		"CenRegionId": d.Get("cen_region_id"),
+		"RouteMapId":  parts[1],
+	}
+	buildCenRouteMapRequest(d, request)
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Modifying"}, []string{"Active"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, cenService.CenRouteMapStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+
+	return resourceAlibabacloudStackCenRouteMapRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackCenRouteMapDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	cenService := CenService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	action := "DeleteCenRouteMap"
+	request := map[string]interface{}{
+		"CenId":       parts[0],
+		"CenRegionId": d.Get("cen_region_id"),
+		"RouteMapId":  parts[1],
+	}
+	if _, err := cenService.doRequest(action, request, d.Timeout(schema.TimeoutDelete)); err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf([]string{"Active", "Deleting"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, cenService.CenRouteMapStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
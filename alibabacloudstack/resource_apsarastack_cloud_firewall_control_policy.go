@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackCloudFirewallControlPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCloudFirewallControlPolicyCreate,
-		Read:   resourceAlibabacloudStackCloudFirewallControlPolicyRead,
-		Update: resourceAlibabacloudStackCloudFirewallControlPolicyUpdate,
-		Delete: resourceAlibabacloudStackCloudFirewallControlPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"acl_action": {
@@ -121,7 +120,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AddControlPolicy"
@@ -162,18 +161,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.Resourc
 		request["SourceIp"] = v
 	}
 	request["SourceType"] = d.Get("source_type")
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy", action, AlibabacloudStackSdkGoERROR)
@@ -181,11 +169,11 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyCreate(d *schema.Resourc
 
 	d.SetId(fmt.Sprint(response["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudfwService := CloudfwService{client}
+	cloudfwService := CloudfwService{client, ctx}
 	object, err := cloudfwService.DescribeCloudFirewallControlPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -217,7 +205,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyRead(d *schema.ResourceD
 	d.Set("source_type", object["SourceType"])
 	return nil
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -293,26 +281,15 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyUpdate(d *schema.Resourc
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -335,18 +312,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyDelete(d *schema.Resourc
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Cloudfw"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackCloudFirewallControlPolicyOrder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate,
-		Read:   resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead,
-		Update: resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate,
-		Delete: resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -42,7 +41,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrder() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "ModifyControlPolicyPriority"
@@ -57,18 +56,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.Re
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Cloudfw"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cloud_firewall_control_policy_order", action, AlibabacloudStackSdkGoERROR)
@@ -76,10 +64,10 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderCreate(d *schema.Re
 
 	d.SetId(fmt.Sprint(request["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -106,18 +94,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.Re
 	}
 
 	if update {
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-12-07"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	}
 	addDebug(action, response, request)
 	if err != nil {
@@ -126,12 +103,12 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderUpdate(d *schema.Re
 
 	d.SetId(fmt.Sprint(request["AclUuid"], ":", request["Direction"]))
 
-	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(d, meta)
+	return resourceAlibabacloudStackCloudFirewallControlPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cloudfwService := CloudfwService{client}
+	cloudfwService := CloudfwService{client, ctx}
 	object, err := cloudfwService.DescribeCloudFirewallControlPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -153,7 +130,7 @@ func resourceAlibabacloudStackCloudFirewallControlPolicyOrderRead(d *schema.Reso
 	return nil
 }
 
-func resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCloudFirewallControlPolicyOrderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Resource alibabacloudstack_cloud_firewall_control_policy_order [%s]  will not be deleted", d.Id())
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackCloudFirewallControlPolicy_basic0(t *testing.T) {
 	resourceId := "alibabacloudStack_cloud_firewall_control_policy.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackCloudFirewallControlPolicyMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &CloudfwService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudfwService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeCloudFirewallControlPolicy")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -204,7 +205,7 @@ func TestAccAlibabacloudStackCloudFirewallControlPolicy_basic1(t *testing.T) {
 	resourceId := "alibabacloudStack_cloud_firewall_control_policy.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackCloudFirewallControlPolicyMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &CloudfwService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CloudfwService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeCloudFirewallControlPolicy")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
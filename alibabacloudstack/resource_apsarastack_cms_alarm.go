@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -21,12 +22,12 @@ import (
 
 func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsAlarmCreate,
-		Read:   resourceAlibabacloudStackCmsAlarmRead,
-		Update: resourceAlibabacloudStackCmsAlarmUpdate,
-		Delete: resourceAlibabacloudStackCmsAlarmDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCmsAlarmRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCmsAlarmDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -186,10 +187,10 @@ func resourceAlibabacloudStackCmsAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 	d.Partial(true)
 
 	request := cms.CreatePutResourceMetricRuleRequest()
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 	request.MetricName = d.Get("metric").(string)
 	request.Period = strconv.Itoa(d.Get("period").(int))
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ContactGroups = strings.Join(expandStringList(d.Get("contact_groups").([]interface{})), ",")
 	if v, ok := d.GetOk("escalations_critical"); ok && len(v.([]interface{})) != 0 {
 		for _, val := range v.([]interface{}) {
@@ -287,7 +288,6 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 
 	nrequest.Headers = map[string]string{"RegionId": client.RegionId}
 	nrequest.QueryParams = map[string]string{
-		"AccessKeySecret":                client.SecretKey,
 		"Product":                        "cms",
 		"Department":                     client.Department,
 		"ResourceGroup":                  client.ResourceGroup,
@@ -345,19 +345,14 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 		request := cms.CreateEnableMetricRulesRequest()
 		request.RuleId = &[]string{d.Id()}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.EnableMetricRules(request)
 			})
 
 			if err != nil {
-				if IsExpectedErrors(err, []string{ThrottlingUser}) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(fmt.Errorf("Enabling alarm got an error: %#v", err))
 			}
 			return nil
@@ -369,19 +364,14 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 		request := cms.CreateDisableMetricRulesRequest()
 		request.RuleId = &[]string{d.Id()}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-		wait := incrementalWait(1*time.Second, 2*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 				return cmsClient.DisableMetricRules(request)
 			})
 
 			if err != nil {
-				if IsExpectedErrors(err, []string{ThrottlingUser}) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(fmt.Errorf("Disableing alarm got an error: %#v", err))
 			}
 			return nil
@@ -396,13 +386,13 @@ func resourceAlibabacloudStackCmsAlarmCreate(d *schema.ResourceData, meta interf
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackCmsAlarmUpdate(d, meta)
+	return resourceAlibabacloudStackCmsAlarmUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 
 	alarm, err := cmsService.DescribeCmsAlarm(d.Id())
 
@@ -492,35 +482,30 @@ func resourceAlibabacloudStackCmsAlarmRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCmsAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
-	return resourceAlibabacloudStackCmsAlarmRead(d, meta)
+	return resourceAlibabacloudStackCmsAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
 	}
 	request := cms.CreateDeleteMetricRulesRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Id = &[]string{parts[0]}
 
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	return resource.Retry(10*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteMetricRules(request)
 		})
 
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(fmt.Errorf("Deleting alarm rule got an error: %#v", err))
 		}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudstackCmsAlarmContactGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackCmsAlarmContactGroupCreate,
-		Read:   resourceAlibabacloudstackCmsAlarmContactGroupRead,
-		Update: resourceAlibabacloudstackCmsAlarmContactGroupUpdate,
-		Delete: resourceAlibabacloudstackCmsAlarmContactGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackCmsAlarmContactGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackCmsAlarmContactGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alarm_contact_group_name": {
@@ -45,7 +46,7 @@ func resourceAlibabacloudstackCmsAlarmContactGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackCmsAlarmContactGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cms.CreatePutContactGroupRequest()
@@ -78,12 +79,12 @@ func resourceAlibabacloudstackCmsAlarmContactGroupCreate(d *schema.ResourceData,
 	}
 	d.SetId(fmt.Sprintf("%v", request.ContactGroupName))
 
-	return resourceAlibabacloudstackCmsAlarmContactGroupRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 	object, err := cmsService.DescribeCmsAlarmContactGroup(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -100,7 +101,7 @@ func resourceAlibabacloudstackCmsAlarmContactGroupRead(d *schema.ResourceData, m
 	d.Set("enable_subscribed", object.EnableSubscribed)
 	return nil
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	request := cms.CreatePutContactGroupRequest()
@@ -133,9 +134,9 @@ func resourceAlibabacloudstackCmsAlarmContactGroupUpdate(d *schema.ResourceData,
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudstackCmsAlarmContactGroupRead(d, meta)
+	return resourceAlibabacloudstackCmsAlarmContactGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudstackCmsAlarmContactGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackCmsAlarmContactGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := cms.CreateDeleteContactGroupRequest()
 	request.ContactGroupName = d.Id()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -25,7 +26,7 @@ func testSweepCmsAlarmContactGroup(region string) error {
 		return WrapErrorf(err, "error getting Alibabacloudstack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -79,7 +80,7 @@ func TestAccAlibabacloudstackCmsAlarmContactGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_cms_alarm_contact_group.default"
 	ra := resourceAttrInit(resourceId, CmsAlarmContactGroupMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &CmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeCmsAlarmContactGroup")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
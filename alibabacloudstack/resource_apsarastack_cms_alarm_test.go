@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackCmsAlarmBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_cms_alarm.default"
 	ra := resourceAttrInit(resourceId, testAccCheckAlarm)
 	serviceFunc := func() interface{} {
-		return &CmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -46,7 +47,7 @@ func TestAccAlibabacloudStackCmsAlarmBasic(t *testing.T) {
 
 func testAccCheckCmsAlarm_Destroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_cms_alarm" || rs.Type != "alibabacloudstack_cms_alarm" {
@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCmsSiteMonitorCreate,
-		Read:   resourceAlibabacloudStackCmsSiteMonitorRead,
-		Update: resourceAlibabacloudStackCmsSiteMonitorUpdate,
-		Delete: resourceAlibabacloudStackCmsSiteMonitorDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCmsSiteMonitorDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -89,14 +90,14 @@ func resourceAlibabacloudStackCmsSiteMonitor() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 
 	taskName := d.Get("task_name").(string)
 	request := cms.CreateCreateSiteMonitorRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Address = d.Get("address").(string)
 	request.TaskName = taskName
 	request.TaskType = d.Get("task_type").(string)
@@ -135,13 +136,13 @@ func resourceAlibabacloudStackCmsSiteMonitorCreate(d *schema.ResourceData, meta
 
 	d.SetId(siteMonitor.TaskId)
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 
 	siteMonitor, err := cmsService.DescribeSiteMonitor(d.Id(), "")
 	if err != nil {
@@ -175,12 +176,12 @@ func resourceAlibabacloudStackCmsSiteMonitorRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := cms.CreateModifySiteMonitorRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.TaskId = d.Id()
 	request.Address = d.Get("address").(string)
 	request.Interval = strconv.Itoa(d.Get("interval").(int))
@@ -212,20 +213,20 @@ func resourceAlibabacloudStackCmsSiteMonitorUpdate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackCmsSiteMonitorRead(d, meta)
+	return resourceAlibabacloudStackCmsSiteMonitorRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCmsSiteMonitorDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCmsSiteMonitorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	cmsService := CmsService{client}
+	cmsService := CmsService{client, ctx}
 	request := cms.CreateDeleteSiteMonitorsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.TaskIds = d.Id()
 	request.IsDeleteAlarms = "false"
 
-	return resource.Retry(3*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		_, err := client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DeleteSiteMonitors(request)
 		})
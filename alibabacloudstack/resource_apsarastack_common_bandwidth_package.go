@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageRead,
-		Update: resourceAlibabacloudStackCommonBandwidthPackageUpdate,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,9 +64,9 @@ func resourceAlibabacloudStackCommonBandwidthPackage() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateCommonBandwidthPackageRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -76,7 +77,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Bandwidth = requests.NewInteger(d.Get("bandwidth").(int))
 	request.Name = d.Get("name").(string)
@@ -85,13 +86,13 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 	request.Ratio = requests.NewInteger(d.Get("ratio").(int))
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		request.ClientToken = buildClientToken(request.GetActionName())
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateCommonBandwidthPackage(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"BandwidthPackageOperation.conflict", Throttling}) {
+			if IsExpectedErrors(err, []string{"BandwidthPackageOperation.conflict"}) {
 				wait()
 				return resource.RetryableError(err)
 
@@ -111,13 +112,13 @@ func resourceAlibabacloudStackCommonBandwidthPackageCreate(d *schema.ResourceDat
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeCommonBandwidthPackage(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -139,7 +140,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageRead(d *schema.ResourceData,
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -153,7 +154,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = d.Id()
 	if d.HasChange("description") {
 		request.Description = d.Get("description").(string)
@@ -187,7 +188,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.BandwidthPackageId = d.Id()
 		request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -201,12 +202,12 @@ func resourceAlibabacloudStackCommonBandwidthPackageUpdate(d *schema.ResourceDat
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCommonBandwidthPackageRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateDeleteCommonBandwidthPackageRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -217,7 +218,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageDelete(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = d.Id()
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.DeleteCommonBandwidthPackage(request)
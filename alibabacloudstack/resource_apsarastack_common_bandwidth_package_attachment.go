@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate,
-		Read:   resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead,
-		Delete: resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bandwidth_package_id": {
@@ -34,9 +35,9 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachment() *schema.Resourc
 	}
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := vpc.CreateAddCommonBandwidthPackageIpRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -46,7 +47,7 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.R
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = Trim(d.Get("bandwidth_package_id").(string))
 	request.IpInstanceId = Trim(d.Get("instance_id").(string))
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -61,13 +62,13 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentCreate(d *schema.R
 	if err := vpcService.WaitForCommonBandwidthPackageAttachment(d.Id(), Available, 5*DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d, meta)
+	return resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -88,9 +89,9 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentRead(d *schema.Res
 	return nil
 }
 
-func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -105,11 +106,11 @@ func resourceAlibabacloudStackCommonBandwidthPackageAttachmentDelete(d *schema.R
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.BandwidthPackageId = bandwidthPackageId
 	request.IpInstanceId = ipInstanceId
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.RemoveCommonBandwidthPackageIp(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -46,7 +47,7 @@ func testSweepCommonBandwidthPackageAttachment(region string) error {
 	req.Headers = map[string]string{"RegionId": client.RegionId}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -97,7 +98,7 @@ func testSweepCommonBandwidthPackageAttachment(region string) error {
 			}
 			req.RegionId = client.RegionId
 			req.Headers = map[string]string{"RegionId": client.RegionId}
-			req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			req.BandwidthPackageId = id
 			req.IpInstanceId = eip.AllocationId
 			_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -120,7 +121,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackageAttachmentBasic(t *testing.T)
 		"instance_id":          CHECKSET,
 	})
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -159,7 +160,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackageAttachmentMulti(t *testing.T)
 		"instance_id":          CHECKSET,
 	})
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -186,7 +187,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackageAttachmentMulti(t *testing.T)
 
 func testAccCheckCommonBandwidthPackageAttachmentDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	VpcService := VpcService{client}
+	VpcService := VpcService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_common_bandwidth_package_attachment" {
 			continue
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -48,7 +49,7 @@ func testSweepCommonBandwidthPackage(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -99,7 +100,7 @@ func testSweepCommonBandwidthPackage(region string) error {
 		req.Headers = map[string]string{"RegionId": client.RegionId}
 		req.QueryParams["Department"] = client.Department
 		req.QueryParams["ResourceGroup"] = client.ResourceGroup
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.BandwidthPackageId = id
 		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteCommonBandwidthPackage(req)
@@ -124,7 +125,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackage_PayByTraffic(t *testing.T) {
 		"ratio":                "100",
 	})
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -200,7 +201,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackage_PayByBandwidth(t *testing.T)
 		"ratio":                "100",
 	})
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -276,7 +277,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackage_Multi(t *testing.T) {
 		"ratio":                "100",
 	})
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -303,7 +304,7 @@ func TestAccAlibabacloudStackCommonBandwidthPackage_Multi(t *testing.T) {
 
 func testAccCheckCommonBandwidthPackageDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	VpcService := VpcService{client}
+	VpcService := VpcService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_common_bandwidth_package" {
 			continue
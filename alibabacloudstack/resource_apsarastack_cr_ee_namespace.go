@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -10,12 +11,12 @@ import (
 
 func resourceAlibabacloudStackCrEENamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEENamespaceCreate,
-		Read:   resourceAlibabacloudStackCrEENamespaceRead,
-		Update: resourceAlibabacloudStackCrEENamespaceUpdate,
-		Delete: resourceAlibabacloudStackCrEENamespaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEENamespaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEENamespaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -43,9 +44,9 @@ func resourceAlibabacloudStackCrEENamespace() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEENamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("name").(string)
 	autoCreate := d.Get("auto_create").(bool)
@@ -56,7 +57,7 @@ func resourceAlibabacloudStackCrEENamespaceCreate(d *schema.ResourceData, meta i
 	request.RegionId = crService.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr-ee", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr-ee", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.InstanceId = instanceId
 	request.NamespaceName = namespace
@@ -79,13 +80,13 @@ func resourceAlibabacloudStackCrEENamespaceCreate(d *schema.ResourceData, meta i
 
 	d.SetId(crService.GenResourceId(instanceId, namespace))
 
-	return resourceAlibabacloudStackCrEENamespaceRead(d, meta)
+	return resourceAlibabacloudStackCrEENamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEENamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	resp, err := crService.DescribeCrEENamespace(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -103,9 +104,9 @@ func resourceAlibabacloudStackCrEENamespaceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackCrEENamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("name").(string)
 	if d.HasChanges("auto_create", "default_visibility") {
@@ -114,7 +115,7 @@ func resourceAlibabacloudStackCrEENamespaceUpdate(d *schema.ResourceData, meta i
 		response := &cr_ee.UpdateNamespaceResponse{}
 		request := cr_ee.CreateUpdateNamespaceRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.RegionId = crService.client.RegionId
 		request.InstanceId = instanceId
@@ -137,12 +138,12 @@ func resourceAlibabacloudStackCrEENamespaceUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	return resourceAlibabacloudStackCrEENamespaceRead(d, meta)
+	return resourceAlibabacloudStackCrEENamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEENamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEENamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("name").(string)
 	_, err := crService.DeleteCrEENamespace(instanceId, namespace)
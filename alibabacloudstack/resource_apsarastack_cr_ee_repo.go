@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,12 +10,12 @@ import (
 
 func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEERepoCreate,
-		Read:   resourceAlibabacloudStackCrEERepoRead,
-		Update: resourceAlibabacloudStackCrEERepoUpdate,
-		Delete: resourceAlibabacloudStackCrEERepoDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEERepoCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEERepoRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCrEERepoUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEERepoDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,9 +61,9 @@ func resourceAlibabacloudStackCrEERepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("namespace").(string)
 	repoName := d.Get("name").(string)
@@ -72,7 +73,7 @@ func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interf
 	response := &cr_ee.CreateRepositoryResponse{}
 	request := cr_ee.CreateCreateRepositoryRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.RegionId = crService.client.RegionId
 	request.InstanceId = instanceId
@@ -101,13 +102,13 @@ func resourceAlibabacloudStackCrEERepoCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(crService.GenResourceId(instanceId, namespace, repoName))
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	resp, err := crService.DescribeCrEERepo(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -128,9 +129,9 @@ func resourceAlibabacloudStackCrEERepoRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("namespace").(string)
 	repo := d.Get("name").(string)
@@ -138,7 +139,7 @@ func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interf
 		response := &cr_ee.UpdateRepositoryResponse{}
 		request := cr_ee.CreateUpdateRepositoryRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.RegionId = crService.client.RegionId
 		request.InstanceId = instanceId
@@ -165,12 +166,12 @@ func resourceAlibabacloudStackCrEERepoUpdate(d *schema.ResourceData, meta interf
 		}
 	}
 
-	return resourceAlibabacloudStackCrEERepoRead(d, meta)
+	return resourceAlibabacloudStackCrEERepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEERepoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEERepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	namespace := d.Get("namespace").(string)
 	repo := d.Get("name").(string)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/cr_ee"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -9,11 +10,11 @@ import (
 
 func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCrEESyncRuleCreate,
-		Read:   resourceAlibabacloudStackCrEESyncRuleRead,
-		Delete: resourceAlibabacloudStackCrEESyncRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCrEESyncRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCrEESyncRuleRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCrEESyncRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -83,9 +84,9 @@ func resourceAlibabacloudStackCrEESyncRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	syncRuleName := d.Get("name").(string)
 	instanceId := d.Get("instance_id").(string)
 	namespaceName := d.Get("namespace_name").(string)
@@ -109,7 +110,7 @@ func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta in
 	request := cr_ee.CreateCreateRepoSyncRuleRequest()
 	request.RegionId = crService.client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SyncRuleName = syncRuleName
 	request.InstanceId = instanceId
@@ -142,13 +143,13 @@ func resourceAlibabacloudStackCrEESyncRuleCreate(d *schema.ResourceData, meta in
 
 	d.SetId(crService.GenResourceId(instanceId, namespaceName, response.SyncRuleId))
 
-	return resourceAlibabacloudStackCrEESyncRuleRead(d, meta)
+	return resourceAlibabacloudStackCrEESyncRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	resp, err := crService.DescribeCrEESyncRule(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -174,9 +175,9 @@ func resourceAlibabacloudStackCrEESyncRuleRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackCrEESyncRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCrEESyncRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := &CrService{client}
+	crService := &CrService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	syncRuleId := d.Get("rule_id").(string)
 	syncDirection := d.Get("sync_direction").(string)
@@ -187,7 +188,7 @@ func resourceAlibabacloudStackCrEESyncRuleDelete(d *schema.ResourceData, meta in
 	response := &cr_ee.DeleteRepoSyncRuleResponse{}
 	request := cr_ee.CreateDeleteRepoSyncRuleRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cr", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.RegionId = crService.client.RegionId
 	request.InstanceId = instanceId
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRNamespaceCreate,
-		Read:   resourceAlibabacloudStackCRNamespaceRead,
-		Update: resourceAlibabacloudStackCRNamespaceUpdate,
-		Delete: resourceAlibabacloudStackCRNamespaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCRNamespaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCRNamespaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -44,7 +45,7 @@ func resourceAlibabacloudStackCRNamespace() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var uresponse, bresponse *responses.CommonResponse
 	resp := crResponse{}
@@ -63,7 +64,6 @@ func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta int
 	request.SetContentType("application/json")
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  client.SecretKey,
 		"AccessKeyId":      client.AccessKey,
 		"Product":          "cr",
 		"Department":       client.Department,
@@ -99,7 +99,6 @@ func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta int
 		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": namespaceName, "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
 
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"AccessKeyId":      client.AccessKey,
 			"Product":          "cr",
 			"Department":       client.Department,
@@ -133,10 +132,10 @@ func resourceAlibabacloudStackCRNamespaceCreate(d *schema.ResourceData, meta int
 
 	d.SetId(namespaceName)
 
-	return resourceAlibabacloudStackCRNamespaceUpdate(d, meta)
+	return resourceAlibabacloudStackCRNamespaceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//
 	create := d.Get("auto_create").(bool)
@@ -156,7 +155,6 @@ func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta int
 		request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": d.Id(), "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
 
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"AccessKeyId":      client.AccessKey,
 			"Product":          "cr",
 			"Format":           "JSON",
@@ -181,13 +179,13 @@ func resourceAlibabacloudStackCRNamespaceUpdate(d *schema.ResourceData, meta int
 		addDebug(request.GetActionName(), raw, request)
 	}
 
-	return resourceAlibabacloudStackCRNamespaceRead(d, meta)
+	return resourceAlibabacloudStackCRNamespaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := CrService{client}
+	crService := CrService{client, ctx}
 
 	object, err := crService.DescribeCrNamespace(d.Id())
 	if err != nil {
@@ -205,7 +203,7 @@ func resourceAlibabacloudStackCRNamespaceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackCRNamespaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRNamespaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	resp := crResponse{}
 	request := requests.NewCommonRequest()
@@ -222,15 +220,14 @@ func resourceAlibabacloudStackCRNamespaceDelete(d *schema.ResourceData, meta int
 	request.Headers = map[string]string{"RegionId": client.RegionId, "x-acs-instanceId": d.Id(), "x-acs-content-type": "application/json;charset=UTF-8", "Content-type": "application/json;charset=UTF-8"}
 
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteNamespace",
-		"Version":         "2016-06-07",
-		"Namespace":       d.Id(),
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "DeleteNamespace",
+		"Version":       "2016-06-07",
+		"Namespace":     d.Id(),
 	}
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -53,14 +54,13 @@ func testSweepCRNamespace(region string) error {
 	request.ApiName = "GetNamespaceList"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "GetNamespaceList",
-		"Version":         "2016-06-07",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "GetNamespaceList",
+		"Version":       "2016-06-07",
 	}
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -97,15 +97,14 @@ func testSweepCRNamespace(region string) error {
 		request.ApiName = "DeleteNamespace"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "cr",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteNamespace",
-			"Version":         "2016-06-07",
-			"Namespace":       n,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "cr",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "DeleteNamespace",
+			"Version":       "2016-06-07",
+			"Namespace":     n,
 		}
 		_, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 			return crClient.ProcessCommonRequest(request)
@@ -113,7 +112,7 @@ func testSweepCRNamespace(region string) error {
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cr_namespace", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
-		crService := CrService{client}
+		crService := CrService{client, context.Background()}
 		resp1 := crDescribeNamespaceResponse{}
 		raw, err = crService.DescribeCrNamespace(n)
 		resp := raw.(*responses.CommonResponse)
@@ -136,7 +135,7 @@ func testAccCheckNamespaceDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		crService := CrService{client}
+		crService := CrService{client, context.Background()}
 		log.Printf("namespace ID %s", rs.Primary.ID)
 		_, err := crService.DescribeCrNamespace(rs.Primary.ID)
 
@@ -155,7 +154,7 @@ func TestAccAlibabacloudStackCRNamespace_Basic(t *testing.T) {
 	resourceId := "alibabacloudstack_cr_namespace.default"
 	ra := resourceAttrInit(resourceId, crNamespaceMap)
 	serviceFunc := func() interface{} {
-		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -215,7 +214,7 @@ func TestAccAlibabacloudStackCRNamespace_Multi(t *testing.T) {
 	resourceId := "alibabacloudstack_cr_namespace.default.1"
 	ra := resourceAttrInit(resourceId, crNamespaceMap)
 	serviceFunc := func() interface{} {
-		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
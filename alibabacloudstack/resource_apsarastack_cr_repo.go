@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCRRepoCreate,
-		Read:   resourceAlibabacloudStackCRRepoRead,
-		Update: resourceAlibabacloudStackCRRepoUpdate,
-		Delete: resourceAlibabacloudStackCRRepoDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCRRepoCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCRRepoRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCRRepoUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCRRepoDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -77,7 +78,7 @@ func resourceAlibabacloudStackCRRepo() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	resp := ResponseCr{}
@@ -95,15 +96,14 @@ func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interfac
 	request.ApiName = "CreateRepo"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "CreateRepo",
-		"Version":         "2016-06-07",
-		"X-acs-body":      fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "RepoName", repoName, "RepoNamespace", repoNamespace, "repoType", repoType, "summary", summary, "detail", detail),
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "CreateRepo",
+		"Version":       "2016-06-07",
+		"X-acs-body":    fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "RepoName", repoName, "RepoNamespace", repoNamespace, "repoType", repoType, "summary", summary, "detail", detail),
 	}
 
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
@@ -122,7 +122,7 @@ func resourceAlibabacloudStackCRRepoCreate(d *schema.ResourceData, meta interfac
 	addDebug(request.GetActionName(), raw, request)
 	d.SetId(fmt.Sprintf("%s%s%s", repoNamespace, SLASH_SEPARATED, repoName))
 
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
 type ResponseCr struct {
@@ -135,7 +135,7 @@ type ResponseCr struct {
 	SuccessResponse bool `json:"successResponse"`
 }
 
-func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	resp := ResponseCr{}
 	repoNamespace := d.Get("namespace").(string)
@@ -153,17 +153,16 @@ func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interfac
 		request.ApiName = "UpdateRepo"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "cr",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "UpdateRepo",
-			"Version":         "2016-06-07",
-			"RepoNamespace":   repoNamespace,
-			"RepoName":        repoName,
-			"X-acs-body":      fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "repoType", repoType, "summary", summary, "detail", detail),
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "cr",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "UpdateRepo",
+			"Version":       "2016-06-07",
+			"RepoNamespace": repoNamespace,
+			"RepoName":      repoName,
+			"X-acs-body":    fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\",\"%s\":\"%s\"}}", "repo", "repoType", repoType, "summary", summary, "detail", detail),
 		}
 		raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 			return crClient.ProcessCommonRequest(request)
@@ -180,13 +179,13 @@ func resourceAlibabacloudStackCRRepoUpdate(d *schema.ResourceData, meta interfac
 		log.Printf("repo create unmarshalled response %v", &resp)
 		addDebug(request.GetActionName(), raw, request)
 	}
-	return resourceAlibabacloudStackCRRepoRead(d, meta)
+	return resourceAlibabacloudStackCRRepoRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	crService := CrService{client}
+	crService := CrService{client, ctx}
 
 	object, err := crService.DescribeCrRepo(d.Id())
 	if err != nil {
@@ -213,7 +212,7 @@ func resourceAlibabacloudStackCRRepoRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackCRRepoDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCRRepoDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	sli := strings.Split(d.Id(), SLASH_SEPARATED)
@@ -229,16 +228,15 @@ func resourceAlibabacloudStackCRRepoDelete(d *schema.ResourceData, meta interfac
 	request.ApiName = "DeleteRepo"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "cr",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteRepo",
-		"Version":         "2016-06-07",
-		"RepoNamespace":   repoNamespace,
-		"RepoName":        repoName,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "cr",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "DeleteRepo",
+		"Version":       "2016-06-07",
+		"RepoNamespace": repoNamespace,
+		"RepoName":      repoName,
 	}
 	raw, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
@@ -22,7 +23,7 @@ func testAccCheckRepoDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		crService := CrService{client}
+		crService := CrService{client, context.Background()}
 		log.Printf("repo ID %s", rs.Primary.ID)
 		_, err := crService.DescribeCrRepo(rs.Primary.ID)
 
@@ -41,7 +42,7 @@ func TestAccAlibabacloudStackCRRepo_Basic(t *testing.T) {
 	resourceId := "alibabacloudstack_cr_repo.default"
 	ra := resourceAttrInit(resourceId, crRepoMap)
 	serviceFunc := func() interface{} {
-		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -117,7 +118,7 @@ func TestAccAlibabacloudStackCRRepo_Multi(t *testing.T) {
 	resourceId := "alibabacloudstack_cr_repo.default.2"
 	ra := resourceAttrInit(resourceId, crRepoMap)
 	serviceFunc := func() interface{} {
-		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CrService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	//	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -42,12 +43,12 @@ var (
 
 func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesCreate,
-		Read:   resourceAlibabacloudStackCSKubernetesRead,
-		Update: resourceAlibabacloudStackCSKubernetesUpdate,
-		Delete: resourceAlibabacloudStackCSKubernetesDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCSKubernetesRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -56,6 +57,7 @@ func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -507,9 +509,10 @@ func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 				//Removed:          "Field 'vswitch_ids' has been removed from provider version 1.75.0. New field 'master_vswitch_ids' and 'worker_vswitch_ids' replace it.",
 			},
 			"master_count": {
-				Type:     schema.TypeInt,
-				Optional: true,
-				Default:  3,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntInSlice([]int{3, 5}),
 			},
 			// single instance type would cause extra troubles
 			"master_instance_type": {
@@ -596,7 +599,8 @@ func resourceAlibabacloudStackCSKubernetes() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
@@ -612,11 +616,10 @@ type ClusterCommonResponse struct {
 	InstanceId string `json:"instanceId"`
 }
 
-func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	log.Printf("check meta %v", meta)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 	var requestInfo *cs.Client
 	var raw interface{}
 
@@ -689,7 +692,7 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	log.Printf("checking runtime %v", runtime)
 	var tags string
 	tagss := make([]interface{}, 0)
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		for key, value := range v.(map[string]interface{}) {
 			tagss = append(tagss, cs.Tag{
 				Key:   key,
@@ -706,7 +709,7 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	var LoginPassword string
 	if password := d.Get("password").(string); password == "" {
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
-			kmsService := KmsService{client}
+			kmsService := KmsService{client, ctx}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -829,7 +832,6 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		if pod == 0 {
 			request.QueryParams = map[string]string{
 				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "Cs",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -885,7 +887,6 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		} else {
 			request.QueryParams = map[string]string{
 				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "Cs",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -944,7 +945,6 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		if pod == 0 {
 			request.QueryParams = map[string]string{
 				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "Cs",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -1001,7 +1001,6 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 		} else {
 			request.QueryParams = map[string]string{
 				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "Cs",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -1073,12 +1072,10 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 
 	var err error
 	err = nil
-	if err = invoker.Run(func() error {
-		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ProcessCommonRequest(request)
-		})
-		return err
-	}); err != nil {
+	raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
 		//return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "CreateKubernetesCluster", raw)
 		return err
 	}
@@ -1110,20 +1107,19 @@ func resourceAlibabacloudStackCSKubernetesCreate(d *schema.ResourceData, meta in
 	d.SetId(clusterresponse.ClusterID)
 
 	stateConf := BuildStateConf([]string{"initial", " "}, []string{"running"}, d.Timeout(schema.TimeoutCreate), 15*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackCSKubernetesUpdate(d, meta)
+	return resourceAlibabacloudStackCSKubernetesUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
+	csService := CsService{client, ctx}
 	d.Partial(true)
 	var raw interface{}
-	invoker := NewInvoker()
 
 	nodepool, err := csService.DescribeClusterNodePools(d.Id())
 	if err != nil {
@@ -1144,7 +1140,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 		password := d.Get("password").(string)
 		if password == "" {
 			if v := d.Get("kms_encrypted_password").(string); v != "" {
-				kmsService := KmsService{client}
+				kmsService := KmsService{client, ctx}
 				decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 				if err != nil {
 					return WrapError(err)
@@ -1187,7 +1183,6 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 			}
 			req.QueryParams = map[string]string{
 				"RegionId":         csService.client.RegionId,
-				"AccessKeySecret":  csService.client.SecretKey,
 				"Product":          "CS",
 				"Department":       csService.client.Department,
 				"ResourceGroup":    csService.client.ResourceGroup,
@@ -1217,14 +1212,10 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 			req.ApiName = "RemoveClusterNodes"
 			req.Headers = map[string]string{"RegionId": csService.client.RegionId}
 			req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
-			if err := invoker.Run(func() error {
-				var err error
-				raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-					return ecsClient.ProcessCommonRequest(req)
-				})
-
-				return err
-			}); err != nil {
+			raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.ProcessCommonRequest(req)
+			})
+			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, nodepoolid, "DeleteKubernetesClusterNodes", DenverdinoAliyungo)
 			}
 			resp, _ := raw.(*responses.CommonResponse)
@@ -1233,7 +1224,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 				return err
 			}
 			stateConf := BuildStateConf([]string{"removing"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(nodepoolid, d.Id(), []string{"deleting", "failed"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -1245,7 +1236,6 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 			}
 			request.QueryParams = map[string]string{
 				"RegionId":         client.RegionId,
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "CS",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -1275,12 +1265,10 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 			request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
 			//var err error
 			err = nil
-			if err = invoker.Run(func() error {
-				raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-					return ecsClient.ProcessCommonRequest(request)
-				})
-				return err
-			}); err != nil {
+			raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.ProcessCommonRequest(request)
+			})
+			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "CreateKubernetesCluster", raw)
 			}
 
@@ -1293,7 +1281,7 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 
 			stateConf := BuildStateConf([]string{"scaling"}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
 
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 			//d.SetPartial("num_of_nodes")
@@ -1301,15 +1289,15 @@ func resourceAlibabacloudStackCSKubernetesUpdate(d *schema.ResourceData, meta in
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackCSKubernetesRead(d, meta)
+	return resourceAlibabacloudStackCSKubernetesRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
+	csService := CsService{client, ctx}
 	object, err := csService.DescribeCsKubernetes(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -1354,23 +1342,21 @@ func resourceAlibabacloudStackCSKubernetesRead(d *schema.ResourceData, meta inte
 
 	d.Set("master_nodes", smaster)
 	d.Set("worker_nodes", sworker)
-	if err := d.Set("tags", flattenTagsConfig(object.Tags)); err != nil {
+	if err := setResourceTags(d, meta, flattenTagsConfig(object.Tags)); err != nil {
 		return WrapError(err)
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
 		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
 		"Product":          "CS",
 		"Department":       client.Department,
 		"ResourceGroup":    client.ResourceGroup,
@@ -1394,14 +1380,12 @@ func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta in
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
 	var response interface{}
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
-		if err := invoker.Run(func() error {
-			raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
-				return csClient.ProcessCommonRequest(request)
-			})
-			response = raw
-			return err
-		}); err != nil {
+	err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
+			return csClient.ProcessCommonRequest(request)
+		})
+		response = raw
+		if err != nil {
 			return resource.RetryableError(err)
 		}
 		if debugOn() {
@@ -1419,24 +1403,23 @@ func resourceAlibabacloudStackCSKubernetesDelete(d *schema.ResourceData, meta in
 	}
 
 	stateConf := BuildStateConf([]string{"running", "deleting", "initial"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Minute, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"delete_failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
-func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error {
+func updateKubernetesClusterTag(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
+	csService := CsService{client, ctx}
 	d.Partial(true)
 	var raw interface{}
-	invoker := NewInvoker()
 	request := requests.NewCommonRequest()
 	if client.Config.Insecure {
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	var tags string
 	tagss := make([]interface{}, 0)
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		for key, value := range v.(map[string]interface{}) {
 			tagss = append(tagss, cs.Tag{
 				Key:   key,
@@ -1449,7 +1432,6 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 	log.Printf("checking tags %v", tags)
 	request.QueryParams = map[string]string{
 		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
 		"Product":          "CS",
 		"Department":       client.Department,
 		"ResourceGroup":    client.ResourceGroup,
@@ -1480,13 +1462,11 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 		"x-acs-asapi-gateway-version": "3.0",
 	}
 	var err error
-	if err = invoker.Run(func() error {
-		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			//ecsClient.Domain = "cs.inter.env17e.shuguang.com"
-			return ecsClient.ProcessCommonRequest(request)
-		})
-		return err
-	}); err != nil {
+	raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		//ecsClient.Domain = "cs.inter.env17e.shuguang.com"
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes", "ModifyClusterTags", raw)
 	}
 	if debugOn() {
@@ -1496,7 +1476,7 @@ func updateKubernetesClusterTag(d *schema.ResourceData, meta interface{}) error
 		addDebug("ModifyClusterTags", raw, resizeRequestMap)
 	}
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Second, csService.CsKubernetesInstanceStateRefreshFunc(d.Id(), []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
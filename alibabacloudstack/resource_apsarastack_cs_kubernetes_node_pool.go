@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
@@ -27,12 +28,12 @@ const ResourceName = "resource_alibabacloudstack_cs_kubernetes_permissions"
 
 func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCSKubernetesNodePoolCreate,
-		Read:   resourceAlibabacloudStackCSNodePoolRead,
-		Update: resourceAlibabacloudStackCSNodePoolUpdate,
-		Delete: resourceAlibabacloudStackCSNodePoolDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCSKubernetesNodePoolCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCSNodePoolRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCSNodePoolUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCSNodePoolDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -41,6 +42,7 @@ func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 			Delete: schema.DefaultTimeout(60 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
 				Type:     schema.TypeString,
@@ -219,6 +221,7 @@ func resourceAlibabacloudStackCSKubernetesNodePool() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 			"labels": {
 				Optional: true,
 				Type:     schema.TypeList,
@@ -480,17 +483,16 @@ type CreateNodePoolRequest struct {
 	AutoScaling      autoScaling      `json:"auto_scaling"`
 }
 
-func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSKubernetesNodePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 
 	var requestInfo *cs.Client
 	var raw interface{}
 
 	clusterId := d.Get("cluster_id").(string)
 	// prepare args and set default value
-	request, err := buildNodePoolArgs(d, meta)
+	request, err := buildNodePoolArgs(ctx, d, meta)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "PrepareKubernetesNodePoolArgs", err)
 	}
@@ -507,13 +509,11 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
 
-	if err = invoker.Run(func() error {
-		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+	raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 
-			return ecsClient.ProcessCommonRequest(request)
-		})
-		return err
-	}); err != nil {
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
 
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_cs_kubernetes_node_pool", "CreateKubernetesNodePool", raw)
 	}
@@ -539,26 +539,25 @@ func resourceAlibabacloudStackCSKubernetesNodePoolCreate(d *schema.ResourceData,
 
 	// reset interval to 10s
 	stateConf := BuildStateConf([]string{"initial", "scaling"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, "ResourceID:%s , TaskID:%s ", d.Id(), nodepoolresponse.TaskId)
 	}
 
 	// attach existing node
 	if v, ok := d.GetOk("instances"); ok && v != nil {
-		attachExistingInstance(d, meta)
+		attachExistingInstance(ctx, d, meta)
 	}
 
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
+	csService := CsService{client, ctx}
 
 	clusterId := d.Get("cluster_id").(string)
 	d.Partial(true)
 	update := false
-	invoker := NewInvoker()
 
 	args := &CreateNodePoolRequest{
 		ClusterID:        clusterId,
@@ -582,26 +581,26 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		}
 		log.Printf("UUUUUUUUUUUUUUUUUUUUUUUUUUUU %d , %d", newValue, oldValue)
 		if newValue < oldValue {
-			err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), nil, nil)
+			err := RemoveNodePoolNodes(ctx, d, meta, clusterId, d.Id(), nil, nil)
 			if err != nil {
 				return err
 			}
 
 			// The removal of a node is logically independent.
 			// The removal of a node should not involve parameter changes.
-			return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+			return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 
 		}
 		//update = true
 		if newValue > oldValue {
-			err := ScaleClusterNodePool(d, meta, clusterId, d.Id(), oldValue, newValue)
+			err := ScaleClusterNodePool(ctx, d, meta, clusterId, d.Id(), oldValue, newValue)
 			if err != nil {
 				return err
 			}
 
 			// The removal of a node is logically independent.
 			// The removal of a node should not involve parameter changes.
-			return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+			return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 
 		}
 	}
@@ -654,7 +653,7 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		setNodePoolDataDisks(&args.ScalingGroup, d)
 	}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		update = true
 		setNodePoolTags(&args.ScalingGroup, d)
 	}
@@ -717,7 +716,6 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		argJson, _ := json.Marshal(args)
 		request.QueryParams = map[string]string{
 			"RegionId":         client.RegionId,
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "CS",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -743,14 +741,12 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		request.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
 
 		var raw interface{}
-		if err := invoker.Run(func() error {
-			var err error
-			raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				//log.Printf("##################### %s", *csClient)
-				return ecsClient.ProcessCommonRequest(request)
-			})
-			return err
-		}); err != nil {
+		var err error
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			//log.Printf("##################### %s", *csClient)
+			return ecsClient.ProcessCommonRequest(request)
+		})
+		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateKubernetesNodePool", raw)
 		}
 		if debugOn() {
@@ -763,7 +759,7 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 		stateConf := BuildStateConf([]string{"scaling", "updating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -781,9 +777,9 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 		}
 
 		if len(newValue) > len(oldValue) {
-			attachExistingInstance(d, meta)
+			attachExistingInstance(ctx, d, meta)
 		} else {
-			err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), oldValue, newValue)
+			err := RemoveNodePoolNodes(ctx, d, meta, clusterId, d.Id(), oldValue, newValue)
 			if err != nil {
 				return err
 			}
@@ -792,13 +788,13 @@ func resourceAlibabacloudStackCSNodePoolUpdate(d *schema.ResourceData, meta inte
 
 	update = false
 	d.Partial(false)
-	return resourceAlibabacloudStackCSNodePoolRead(d, meta)
+	return resourceAlibabacloudStackCSNodePoolRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	clusterId := d.Get("cluster_id").(string)
-	csService := CsService{client}
+	csService := CsService{client, ctx}
 
 	object, err := csService.DescribeCsKubernetesNodePool(d.Id(), clusterId)
 	if err != nil {
@@ -861,7 +857,7 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 		return WrapError(err)
 	}
 
-	if err := d.Set("tags", flattenTagsConfig(object.ScalingGroup.Tags)); err != nil {
+	if err := setResourceTags(d, meta, flattenTagsConfig(object.ScalingGroup.Tags)); err != nil {
 		return WrapError(err)
 	}
 
@@ -884,14 +880,13 @@ func resourceAlibabacloudStackCSNodePoolRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCSNodePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 	clusterId := d.Get("cluster_id").(string)
 	var raw interface{}
 	// delete all nodes
-	err := RemoveNodePoolNodes(d, meta, clusterId, d.Id(), nil, nil)
+	err := RemoveNodePoolNodes(ctx, d, meta, clusterId, d.Id(), nil, nil)
 	if err != nil {
 		return err
 	}
@@ -902,7 +897,6 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	}
 	req.QueryParams = map[string]string{
 		"RegionId":         csService.client.RegionId,
-		"AccessKeySecret":  csService.client.SecretKey,
 		"Product":          "CS",
 		"Department":       csService.client.Department,
 		"ResourceGroup":    csService.client.ResourceGroup,
@@ -930,14 +924,10 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	req.ApiName = "DeleteClusterNodepool"
 	req.Headers = map[string]string{"RegionId": csService.client.RegionId}
 	req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
-	if err := invoker.Run(func() error {
-		var err error
-		raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ProcessCommonRequest(req)
-		})
-
-		return err
-	}); err != nil {
+	raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(req)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteClusterNodePool", raw)
 	}
 	if debugOn() {
@@ -948,20 +938,20 @@ func resourceAlibabacloudStackCSNodePoolDelete(d *schema.ResourceData, meta inte
 	}
 
 	stateConf := BuildStateConf([]string{"deleting"}, []string{}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
+func buildNodePoolArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	password := d.Get("password").(string)
 	if password == "" {
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
-			kmsService := KmsService{client}
+			kmsService := KmsService{client, ctx}
 			decryptResp, err := kmsService.Decrypt2(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return nil, WrapError(err)
@@ -1050,7 +1040,6 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*requests.Comm
 	AutoScalingJson, _ := json.Marshal(AutoScaling)
 	request.QueryParams = map[string]string{
 		"RegionId":         client.RegionId,
-		"AccessKeySecret":  client.SecretKey,
 		"Product":          "CS",
 		"Department":       client.Department,
 		"ResourceGroup":    client.ResourceGroup,
@@ -1075,7 +1064,7 @@ func buildNodePoolArgs(d *schema.ResourceData, meta interface{}) (*requests.Comm
 
 func ConvertCsTags(d *schema.ResourceData) ([]cs.Tag, error) {
 	tags := make([]cs.Tag, 0)
-	tagsMap, ok := d.Get("tags").(map[string]interface{})
+	tagsMap, ok := d.Get("tags_all").(map[string]interface{})
 	if ok {
 		for key, value := range tagsMap {
 			if value != nil {
@@ -1093,7 +1082,7 @@ func ConvertCsTags(d *schema.ResourceData) ([]cs.Tag, error) {
 }
 
 func setNodePoolTags(scalingGroup *scalingGroup, d *schema.ResourceData) error {
-	if _, ok := d.GetOk("tags"); ok {
+	if _, ok := d.GetOk("tags_all"); ok {
 		if tags, err := ConvertCsTags(d); err == nil {
 			scalingGroup.Tags = tags
 		}
@@ -1338,11 +1327,10 @@ func flattenTagsConfig(config []cs.Tag) map[string]string {
 
 	return m
 }
-func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, nodepoolid string, oldNodes []interface{}, newNodes []interface{}) error {
+func RemoveNodePoolNodes(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterid, nodepoolid string, oldNodes []interface{}, newNodes []interface{}) error {
 	var raw interface{}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 
 	// list all nodes of the nodepool
 	object, err := csService.DescribeClusterNodes(clusterid, d.Id())
@@ -1394,7 +1382,6 @@ func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, no
 		}
 		req.QueryParams = map[string]string{
 			"RegionId":         csService.client.RegionId,
-			"AccessKeySecret":  csService.client.SecretKey,
 			"Product":          "CS",
 			"Department":       csService.client.Department,
 			"ResourceGroup":    csService.client.ResourceGroup,
@@ -1424,14 +1411,11 @@ func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, no
 		req.ApiName = "RemoveClusterNodes"
 		req.Headers = map[string]string{"RegionId": csService.client.RegionId}
 		req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
-		if err := invoker.Run(func() error {
-			var err error
-			raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.ProcessCommonRequest(req)
-			})
-
-			return err
-		}); err != nil {
+		var err error
+		raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ProcessCommonRequest(req)
+		})
+		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteKubernetesClusterNodes", DenverdinoAliyungo)
 		}
 		resp, _ := raw.(*responses.CommonResponse)
@@ -1440,17 +1424,16 @@ func RemoveNodePoolNodes(d *schema.ResourceData, meta interface{}, clusterid, no
 			return err
 		}
 		stateConf := BuildStateConf([]string{"removing"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 60*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterid, []string{"deleting", "failed"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 	return nil
 }
-func ScaleClusterNodePool(d *schema.ResourceData, meta interface{}, clusterid, nodepoolid string, oldValue, newValue int) error {
+func ScaleClusterNodePool(ctx context.Context, d *schema.ResourceData, meta interface{}, clusterid, nodepoolid string, oldValue, newValue int) error {
 	var raw interface{}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csService := CsService{client}
-	invoker := NewInvoker()
+	csService := CsService{client, ctx}
 
 	// list all nodes of the nodepool
 
@@ -1460,7 +1443,6 @@ func ScaleClusterNodePool(d *schema.ResourceData, meta interface{}, clusterid, n
 	}
 	req.QueryParams = map[string]string{
 		"RegionId":         csService.client.RegionId,
-		"AccessKeySecret":  csService.client.SecretKey,
 		"Product":          "CS",
 		"Department":       csService.client.Department,
 		"ResourceGroup":    csService.client.ResourceGroup,
@@ -1489,19 +1471,16 @@ func ScaleClusterNodePool(d *schema.ResourceData, meta interface{}, clusterid, n
 	req.ApiName = "ScaleClusterNodePool"
 	req.Headers = map[string]string{"RegionId": csService.client.RegionId}
 	req.Headers = map[string]string{"x-acs-asapi-gateway-version": "3.0"}
-	if err := invoker.Run(func() error {
-		var err error
-		raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ProcessCommonRequest(req)
-		})
-
-		return err
-	}); err != nil {
+	var err error
+	raw, err = csService.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(req)
+	})
+	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "ScaleClusterNodePool", raw)
 	}
 
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterid, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1530,8 +1509,8 @@ func difference(slice1 []string, slice2 []string) []string {
 
 	return diff
 }
-func attachExistingInstance(d *schema.ResourceData, meta interface{}) error {
-	csService := CsService{meta.(*connectivity.AlibabacloudStackClient)}
+func attachExistingInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	csService := CsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 	client, err := meta.(*connectivity.AlibabacloudStackClient).NewRoaCsClient()
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, ResourceName, "InitializeClient", err)
@@ -1574,7 +1553,7 @@ func attachExistingInstance(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	stateConf := BuildStateConf([]string{"scaling"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, csService.CsKubernetesNodePoolStateRefreshFunc(d.Id(), clusterId, []string{"deleting", "failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestAccAlibabacloudStackCSKubernetesNodePool_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, csdKubernetesNodePoolBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -129,7 +130,7 @@ func TestAccAlibabacloudStackCSKubernetesNodePool_autoScaling(t *testing.T) {
 	ra := resourceAttrInit(resourceId, csdKubernetesNodePoolBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -240,7 +241,7 @@ func TestAccAlibabacloudStackCSKubernetesNodePool_PrePaid(t *testing.T) {
 	ra := resourceAttrInit(resourceId, csdKubernetesNodePoolBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -334,7 +335,7 @@ func TestAccAlibabacloudStackCSKubernetesNodePool_Spot(t *testing.T) {
 	ra := resourceAttrInit(resourceId, csdKubernetesNodePoolBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/denverdino/aliyungo/cs"
 
@@ -23,7 +24,7 @@ func testAccCheckCsK8sDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		csService := CsService{client}
+		csService := CsService{client, context.Background()}
 		log.Printf("repo ID %s", rs.Primary.ID)
 		_, err := csService.DescribeCsKubernetes(rs.Primary.ID)
 
@@ -43,7 +44,7 @@ func TestAccAlibabacloudStackCsK8s_Basic(t *testing.T) {
 	resourceId := "alibabacloudstack_cs_kubernetes.k8s"
 	ra := resourceAttrInit(resourceId, CsK8sMap)
 	serviceFunc := func() interface{} {
-		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
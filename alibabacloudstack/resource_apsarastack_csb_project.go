@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackCsbProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackCsbProjectCreate,
-		Read:   resourceAlibabacloudStackCsbProjectRead,
-		Update: resourceAlibabacloudStackCsbProjectUpdate,
-		Delete: resourceAlibabacloudStackCsbProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackCsbProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackCsbProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackCsbProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackCsbProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(5 * time.Minute),
@@ -100,7 +101,7 @@ func resourceAlibabacloudStackCsbProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackCsbProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCsbProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateProject"
@@ -134,11 +135,11 @@ func resourceAlibabacloudStackCsbProjectCreate(d *schema.ResourceData, meta inte
 	}
 	addDebug(action, response, request)
 	d.SetId(fmt.Sprint(request["CsbId"], ":", request1["ProjectName"]))
-	return resourceAlibabacloudStackCsbProjectRead(d, meta)
+	return resourceAlibabacloudStackCsbProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCsbProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCsbProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csbService := CsbService{client}
+	csbService := CsbService{client, ctx}
 	object, err := csbService.DescribeCsbProjectDetail(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -164,7 +165,7 @@ func resourceAlibabacloudStackCsbProjectRead(d *schema.ResourceData, meta interf
 
 	return nil
 }
-func resourceAlibabacloudStackCsbProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCsbProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	var response map[string]interface{}
@@ -196,12 +197,12 @@ func resourceAlibabacloudStackCsbProjectUpdate(d *schema.ResourceData, meta inte
 		}
 
 	}
-	return resourceAlibabacloudStackCsbProjectRead(d, meta)
+	return resourceAlibabacloudStackCsbProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackCsbProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackCsbProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	csbService := CsbService{client}
+	csbService := CsbService{client, ctx}
 	object, err := csbService.DescribeCsbProjectDetail(d.Id())
 	action := "DeleteProject"
 	var response map[string]interface{}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackCSBProject_basic1(t *testing.T) {
 	resourceId := "alibabacloudstack_csb_project.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackCSBProjectMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &CsbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &CsbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeCsbProjectDetail")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
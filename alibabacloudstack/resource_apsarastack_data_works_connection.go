@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksConnectionCreate,
-		Read:   resourceAlibabacloudStackDataWorksConnectionRead,
-		Update: resourceAlibabacloudStackDataWorksConnectionUpdate,
-		Delete: resourceAlibabacloudStackDataWorksConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"connection_id": {
@@ -65,7 +64,7 @@ func resourceAlibabacloudStackDataWorksConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateConnection"
@@ -106,18 +105,7 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
@@ -125,11 +113,11 @@ func resourceAlibabacloudStackDataWorksConnectionCreate(d *schema.ResourceData,
 
 	d.SetId(fmt.Sprint(response["Data"], ":", request["ProjectId"], ":", request["Name"]))
 
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksConnection(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -154,7 +142,7 @@ func resourceAlibabacloudStackDataWorksConnectionRead(d *schema.ResourceData, me
 	//d.Set("content", tempMap)
 	return nil
 }
-func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 3)
@@ -189,25 +177,14 @@ func resourceAlibabacloudStackDataWorksConnectionUpdate(d *schema.ResourceData,
 	if err != nil {
 		return WrapError(err)
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("PUT"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("PUT"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksConnectionRead(d, meta)
+	return resourceAlibabacloudStackDataWorksConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -226,18 +203,7 @@ func resourceAlibabacloudStackDataWorksConnectionDelete(d *schema.ResourceData,
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
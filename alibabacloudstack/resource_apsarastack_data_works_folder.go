@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"path"
 	"strings"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksFolder() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksFolderCreate,
-		Read:   resourceAlibabacloudStackDataWorksFolderRead,
-		Update: resourceAlibabacloudStackDataWorksFolderUpdate,
-		Delete: resourceAlibabacloudStackDataWorksFolderDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksFolderRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksFolderDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"folder_id": {
@@ -45,7 +44,7 @@ func resourceAlibabacloudStackDataWorksFolder() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateFolder"
@@ -66,18 +65,7 @@ func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
@@ -85,11 +73,11 @@ func resourceAlibabacloudStackDataWorksFolderCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["Data"], ":", request["ProjectId"]))
 
-	return resourceAlibabacloudStackDataWorksFolderRead(d, meta)
+	return resourceAlibabacloudStackDataWorksFolderRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksFolderRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksFolder(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -108,7 +96,7 @@ func resourceAlibabacloudStackDataWorksFolderRead(d *schema.ResourceData, meta i
 	d.Set("folder_path", object["FolderPath"].(string))
 	return nil
 }
-func resourceAlibabacloudStackDataWorksFolderUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -137,25 +125,14 @@ func resourceAlibabacloudStackDataWorksFolderUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapError(err)
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksFolderRead(d, meta)
+	return resourceAlibabacloudStackDataWorksFolderRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksFolderDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksFolderDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -179,18 +156,7 @@ func resourceAlibabacloudStackDataWorksFolderDelete(d *schema.ResourceData, meta
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
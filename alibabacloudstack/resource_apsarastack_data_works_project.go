@@ -1,24 +1,23 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksProjectCreate,
-		Read:   resourceAlibabacloudStackDataWorksProjectRead,
-		Update: resourceAlibabacloudStackDataWorksProjectUpdate,
-		Delete: resourceAlibabacloudStackDataWorksProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -38,7 +37,7 @@ func resourceAlibabacloudStackDataWorksProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateProject"
@@ -61,18 +60,7 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-17"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-17"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_project", action, AlibabacloudStackSdkGoERROR)
@@ -80,11 +68,11 @@ func resourceAlibabacloudStackDataWorksProjectCreate(d *schema.ResourceData, met
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksProject(d.Id())
 	log.Printf(fmt.Sprint(object))
 	if err != nil {
@@ -103,11 +91,11 @@ func resourceAlibabacloudStackDataWorksProjectRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksProjectRead(d, meta)
+	return resourceAlibabacloudStackDataWorksProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
 	return nil
 }
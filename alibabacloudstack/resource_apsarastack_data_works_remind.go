@@ -1,27 +1,26 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strconv"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksRemindCreate,
-		Read:   resourceAlibabacloudStackDataWorksRemindRead,
-		Update: resourceAlibabacloudStackDataWorksRemindUpdate,
-		Delete: resourceAlibabacloudStackDataWorksRemindDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksRemindRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksRemindDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"remind_id": {
@@ -111,7 +110,7 @@ func resourceAlibabacloudStackDataWorksRemind() *schema.Resource {
 var RemindUnit string
 var RemindType string
 
-func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateRemind"
@@ -125,18 +124,7 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_remind", action, AlibabacloudStackSdkGoERROR)
@@ -144,11 +132,11 @@ func resourceAlibabacloudStackDataWorksRemindCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["Data"]))
 
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksRemind(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -199,7 +187,7 @@ func resourceAlibabacloudStackDataWorksRemindRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
@@ -261,26 +249,15 @@ func resourceAlibabacloudStackDataWorksRemindUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapError(err)
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDataWorksRemindRead(d, meta)
+	return resourceAlibabacloudStackDataWorksRemindRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksRemindDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	action := "DeleteRemind"
@@ -294,18 +271,7 @@ func resourceAlibabacloudStackDataWorksRemindDelete(d *schema.ResourceData, meta
 		"RegionId": "default",
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
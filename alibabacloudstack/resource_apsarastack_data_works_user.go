@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRead,
-		Update: resourceAlibabacloudStackDataWorksUserUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -40,7 +39,7 @@ func resourceAlibabacloudStackDataWorksUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateProjectMember"
@@ -66,18 +65,7 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
@@ -85,11 +73,11 @@ func resourceAlibabacloudStackDataWorksUserCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response["RequestId"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksUser(d.Id())
 	log.Printf(fmt.Sprint(object))
 	if err != nil {
@@ -109,11 +97,11 @@ func resourceAlibabacloudStackDataWorksUserRead(d *schema.ResourceData, meta int
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -134,18 +122,7 @@ func resourceAlibabacloudStackDataWorksUserDelete(d *schema.ResourceData, meta i
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDataWorksUserRoleBindingCreate,
-		Read:   resourceAlibabacloudStackDataWorksUserRoleBindingRead,
-		Update: resourceAlibabacloudStackDataWorksUserRoleBindingUpdate,
-		Delete: resourceAlibabacloudStackDataWorksUserRoleBindingDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDataWorksUserRoleBindingDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -40,7 +39,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBinding() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AddProjectMemberToRole"
@@ -66,18 +65,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
 	request["ClientToken"] = fmt.Sprint(uuid.NewRandom())
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_data_works_folder", action, AlibabacloudStackSdkGoERROR)
@@ -85,11 +73,11 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingCreate(d *schema.ResourceD
 
 	d.SetId(fmt.Sprint(request["RoleCode"], ":", request["ProjectId"], ":", request["UserId"]))
 
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dataworksPublicService := DataworksPublicService{client}
+	dataworksPublicService := DataworksPublicService{client, ctx}
 	object, err := dataworksPublicService.DescribeDataWorksUserRoleBinding(d.Id())
 	log.Printf(fmt.Sprint(object))
 	if err != nil {
@@ -110,11 +98,11 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingRead(d *schema.ResourceDat
 
 	return nil
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有对应 API
-	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(d, meta)
+	return resourceAlibabacloudStackDataWorksUserRoleBindingRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -136,18 +124,7 @@ func resourceAlibabacloudStackDataWorksUserRoleBindingDelete(d *schema.ResourceD
 	request["Product"] = "dataworks-public"
 	request["product"] = "dataworks-public"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-05-18"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
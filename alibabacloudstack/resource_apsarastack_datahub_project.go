@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubProjectCreate,
-		Read:   resourceAlibabacloudStackDatahubProjectRead,
-		Update: resourceAlibabacloudStackDatahubProjectUpdate,
-		Delete: resourceAlibabacloudStackDatahubProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,9 +58,9 @@ func resourceAlibabacloudStackDatahubProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	//var dataService DatahubService = DatahubService{client: client}
+	//var dataService DatahubService = DatahubService{client: client, ctx: ctx}
 	projectName := d.Get("name").(string)
 	projectComment := d.Get("comment").(string)
 	request := requests.NewCommonRequest()
@@ -75,16 +76,15 @@ func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta
 	request.ApiName = "CreateProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"Comment":         projectComment,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateProject",
+		"Version":       "2019-11-20",
+		"ProjectName":   projectName,
+		"Comment":       projectComment,
 	}
 
 	raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
@@ -101,12 +101,12 @@ func resourceAlibabacloudStackDatahubProjectCreate(d *schema.ResourceData, meta
 	}
 
 	d.SetId(strings.ToLower(projectName))
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 	object, err := datahubService.DescribeDatahubProject(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -124,7 +124,7 @@ func resourceAlibabacloudStackDatahubProjectRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	/*
 		client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -152,12 +152,12 @@ func resourceAlibabacloudStackDatahubProjectUpdate(d *schema.ResourceData, meta
 
 	*/
 
-	return resourceAlibabacloudStackDatahubProjectRead(d, meta)
+	return resourceAlibabacloudStackDatahubProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 	projectName := d.Id()
 	request := requests.NewCommonRequest()
 	request.Method = "GET"
@@ -172,19 +172,18 @@ func resourceAlibabacloudStackDatahubProjectDelete(d *schema.ResourceData, meta
 	request.ApiName = "DeleteProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteProject",
+		"Version":       "2019-11-20",
+		"ProjectName":   projectName,
 	}
 
 	var requestInfo *datahub.DataHub
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -45,14 +46,13 @@ func testSweepDatahubProject(region string) error {
 	request.ApiName = "ListProjects"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListProjects",
-		"Version":         "2019-11-20",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListProjects",
+		"Version":       "2019-11-20",
 	}
 
 	raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
@@ -83,15 +83,14 @@ func testSweepDatahubProject(region string) error {
 		request.ApiName = "ListTopics"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "datahub",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "ListTopics",
-			"Version":         "2019-11-20",
-			"ProjectName":     projectName,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "datahub",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "ListTopics",
+			"Version":       "2019-11-20",
+			"ProjectName":   projectName,
 		}
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
@@ -114,16 +113,15 @@ func testSweepDatahubProject(region string) error {
 			request.ApiName = "ListSubscription"
 			request.Headers = map[string]string{"RegionId": client.RegionId}
 			request.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "datahub",
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"RegionId":        client.RegionId,
-				"Action":          "ListSubscription",
-				"Version":         "2019-11-20",
-				"ProjectName":     projectName,
-				"TopicName":       topicName,
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "datahub",
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"RegionId":      client.RegionId,
+				"Action":        "ListSubscription",
+				"Version":       "2019-11-20",
+				"ProjectName":   projectName,
+				"TopicName":     topicName,
 			}
 			raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 				return slsClient.ProcessCommonRequest(request)
@@ -147,16 +145,15 @@ func testSweepDatahubProject(region string) error {
 				request.ApiName = "DeleteSubscription"
 				request.Headers = map[string]string{"RegionId": client.RegionId}
 				request.QueryParams = map[string]string{
-					"AccessKeySecret": client.SecretKey,
-					"AccessKeyId":     client.AccessKey,
-					"Product":         "datahub",
-					"Department":      client.Department,
-					"ResourceGroup":   client.ResourceGroup,
-					"RegionId":        client.RegionId,
-					"Action":          "DeleteSubscription",
-					"Version":         "2019-11-20",
-					"ProjectName":     projectName,
-					"TopicName":       topicName,
+					"AccessKeyId":   client.AccessKey,
+					"Product":       "datahub",
+					"Department":    client.Department,
+					"ResourceGroup": client.ResourceGroup,
+					"RegionId":      client.RegionId,
+					"Action":        "DeleteSubscription",
+					"Version":       "2019-11-20",
+					"ProjectName":   projectName,
+					"TopicName":     topicName,
 				}
 				_, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 					return slsClient.ProcessCommonRequest(request)
@@ -177,16 +174,15 @@ func testSweepDatahubProject(region string) error {
 			topicrequest.ApiName = "DeleteTopic"
 			topicrequest.Headers = map[string]string{"RegionId": client.RegionId}
 			topicrequest.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "datahub",
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"RegionId":        client.RegionId,
-				"Action":          "DeleteTopic",
-				"Version":         "2019-11-20",
-				"ProjectName":     projectName,
-				"TopicName":       topicName,
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "datahub",
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"RegionId":      client.RegionId,
+				"Action":        "DeleteTopic",
+				"Version":       "2019-11-20",
+				"ProjectName":   projectName,
+				"TopicName":     topicName,
 			}
 			_, err = client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 				return slsClient.ProcessCommonRequest(topicrequest)
@@ -207,15 +203,14 @@ func testSweepDatahubProject(region string) error {
 		projectrequest.ApiName = "DeleteProject"
 		projectrequest.Headers = map[string]string{"RegionId": client.RegionId}
 		projectrequest.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "datahub",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteProject",
-			"Version":         "2019-11-20",
-			"ProjectName":     projectName,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "datahub",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"RegionId":      client.RegionId,
+			"Action":        "DeleteProject",
+			"Version":       "2019-11-20",
+			"ProjectName":   projectName,
 		}
 		_, err = client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(projectrequest)
@@ -234,7 +229,7 @@ func TestAccAlibabacloudStackDatahubProject_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_datahub_project.default"
 	ra := resourceAttrInit(resourceId, datahubProjectBasicMap)
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -297,7 +292,7 @@ func TestAccAlibabacloudStackDatahubProject_multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubProjectBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
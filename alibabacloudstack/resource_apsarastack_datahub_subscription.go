@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	//"encoding/json"
 	"fmt"
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubSubscriptionCreate,
-		Read:   resourceAlibabacloudStackDatahubSubscriptionRead,
-		Update: resourceAlibabacloudStackDatahubSubscriptionUpdate,
-		Delete: resourceAlibabacloudStackDatahubSubscriptionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubSubscriptionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,7 +75,7 @@ func resourceAlibabacloudStackDatahubSubscription() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	subscription := &datahub.SubscriptionCreate{}
 	projectName := d.Get("project_name").(string)
@@ -94,18 +95,17 @@ func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData,
 	request.ApiName = "CreateSubscription"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateSubscription",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-		"Application":     "CreateSubscription",
-		"Comment":         subComment,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateSubscription",
+		"Version":       "2019-11-20",
+		"ProjectName":   projectName,
+		"TopicName":     topicName,
+		"Application":   "CreateSubscription",
+		"Comment":       subComment,
 	}
 
 	raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
@@ -126,12 +126,12 @@ func resourceAlibabacloudStackDatahubSubscriptionCreate(d *schema.ResourceData,
 		addDebug("CreateSubscription", raw, requestInfo, requestMap)
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", strings.ToLower(projectName), COLON_SEPARATED, strings.ToLower(topicName), COLON_SEPARATED, subscription.SubId))
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
 		return WrapError(err)
@@ -159,7 +159,7 @@ func resourceAlibabacloudStackDatahubSubscriptionRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//parts, err := ParseResourceId(d.Id(), 3)
 	//if err != nil {
 	//	return WrapError(err)
@@ -189,12 +189,12 @@ func resourceAlibabacloudStackDatahubSubscriptionUpdate(d *schema.ResourceData,
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubSubscriptionRead(d, meta)
+	return resourceAlibabacloudStackDatahubSubscriptionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -215,22 +215,21 @@ func resourceAlibabacloudStackDatahubSubscriptionDelete(d *schema.ResourceData,
 	request.ApiName = "DeleteSubscription"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteSubscription",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
-		"SubscriptionId":  subId,
+		"AccessKeyId":    client.AccessKey,
+		"Product":        "datahub",
+		"RegionId":       client.RegionId,
+		"Department":     client.Department,
+		"ResourceGroup":  client.ResourceGroup,
+		"Action":         "DeleteSubscription",
+		"Version":        "2019-11-20",
+		"ProjectName":    projectName,
+		"TopicName":      topicName,
+		"SubscriptionId": subId,
 	}
 
 	var requestInfo *datahub.DataHub
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,7 +18,7 @@ func TestAccAlibabacloudStackDatahubSubscription_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubSubscriptionBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -82,7 +83,7 @@ func TestAccAlibabacloudStackDatahubSubscription_multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubSubscriptionBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
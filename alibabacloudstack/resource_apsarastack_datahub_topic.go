@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDatahubTopicCreate,
-		Read:   resourceAlibabacloudStackDatahubTopicRead,
-		Update: resourceAlibabacloudStackDatahubTopicUpdate,
-		Delete: resourceAlibabacloudStackDatahubTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDatahubTopicRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDatahubTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -102,7 +103,7 @@ func resourceAlibabacloudStackDatahubTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	t := &datahub.GetTopicResult{
 		ProjectName: d.Get("project_name").(string),
@@ -144,21 +145,20 @@ func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta in
 	recordschema = fmt.Sprintf("[{'Type':'%s','AllowNull':'%t','Name':'%s'}]", t.RecordSchema.Fields[0].Type.String(), t.RecordSchema.Fields[0].AllowNull, t.RecordSchema.Fields[0].Name)
 
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateTopic",
-		"Version":         "2019-11-20",
-		"ProjectName":     t.ProjectName,
-		"Lifecycle":       strconv.Itoa(t.LifeCycle),
-		"ShardCount":      strconv.Itoa(t.ShardCount),
-		"TopicName":       t.TopicName,
-		"RecordType":      recordType,
-		"Comment":         t.Comment,
-		"RecordSchema":    recordschema,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateTopic",
+		"Version":       "2019-11-20",
+		"ProjectName":   t.ProjectName,
+		"Lifecycle":     strconv.Itoa(t.LifeCycle),
+		"ShardCount":    strconv.Itoa(t.ShardCount),
+		"TopicName":     t.TopicName,
+		"RecordType":    recordType,
+		"Comment":       t.Comment,
+		"RecordSchema":  recordschema,
 	}
 
 	raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
@@ -171,13 +171,13 @@ func resourceAlibabacloudStackDatahubTopicCreate(d *schema.ResourceData, meta in
 	addDebug("CreateTopic", raw, requestInfo, t)
 
 	d.SetId(strings.ToLower(fmt.Sprintf("%s%s%s", t.ProjectName, COLON_SEPARATED, t.TopicName)))
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 	object, err := datahubService.DescribeDatahubTopic(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -205,7 +205,7 @@ func resourceAlibabacloudStackDatahubTopicRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//parts, err := ParseResourceId(d.Id(), 2)
 	//if err != nil {
 	//	return WrapError(err)
@@ -252,10 +252,10 @@ func resourceAlibabacloudStackDatahubTopicUpdate(d *schema.ResourceData, meta in
 	//	}
 	//}
 
-	return resourceAlibabacloudStackDatahubTopicRead(d, meta)
+	return resourceAlibabacloudStackDatahubTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDatahubTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -263,7 +263,7 @@ func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta in
 	projectName, topicName := parts[0], parts[1]
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	datahubService := DatahubService{client}
+	datahubService := DatahubService{client, ctx}
 	var requestInfo *datahub.DataHub
 
 	request := requests.NewCommonRequest()
@@ -279,19 +279,18 @@ func resourceAlibabacloudStackDatahubTopicDelete(d *schema.ResourceData, meta in
 	request.ApiName = "DeleteTopic"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "datahub",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteTopic",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "datahub",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteTopic",
+		"Version":       "2019-11-20",
+		"ProjectName":   projectName,
+		"TopicName":     topicName,
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
 			return dataHubClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -19,7 +20,7 @@ func TestAccAlibabacloudStackDatahubTopic_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubTopicBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -93,7 +94,7 @@ func TestAccAlibabacloudStackDatahubTopic_blob(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubTopicBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -164,7 +165,7 @@ func TestAccAlibabacloudStackDatahubTopic_multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, datahubTopicBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DatahubService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
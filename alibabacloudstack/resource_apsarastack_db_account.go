@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountCreate,
-		Read:   resourceAlibabacloudStackDBAccountRead,
-		Update: resourceAlibabacloudStackDBAccountUpdate,
-		Delete: resourceAlibabacloudStackDBAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -71,9 +72,9 @@ func resourceAlibabacloudStackDBAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	request := rds.CreateCreateAccountRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Get("instance_id").(string)
 	request.AccountName = d.Get("name").(string)
 
@@ -96,7 +97,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	if password != "" {
 		request.AccountPassword = password
 	} else {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -113,7 +114,7 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 	if err := rdsService.WaitForDBInstance(request.DBInstanceId, Running, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateAccount(request)
 		})
@@ -137,13 +138,13 @@ func resourceAlibabacloudStackDBAccountCreate(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	object, err := rdsService.DescribeDBAccount(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -161,9 +162,9 @@ func resourceAlibabacloudStackDBAccountRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	instanceId := parts[0]
@@ -181,7 +182,7 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountDescription = d.Get("description").(string)
@@ -203,7 +204,7 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 		request := rds.CreateResetAccountPasswordRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = instanceId
 		request.AccountName = accountName
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -222,7 +223,7 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 			//d.SetPartial("password")
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -243,12 +244,12 @@ func resourceAlibabacloudStackDBAccountUpdate(d *schema.ResourceData, meta inter
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountRead(d, meta)
+	return resourceAlibabacloudStackDBAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -256,7 +257,7 @@ func resourceAlibabacloudStackDBAccountDelete(d *schema.ResourceData, meta inter
 	request := rds.CreateDeleteAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBAccountPrivilegeCreate,
-		Read:   resourceAlibabacloudStackDBAccountPrivilegeRead,
-		Update: resourceAlibabacloudStackDBAccountPrivilegeUpdate,
-		Delete: resourceAlibabacloudStackDBAccountPrivilegeDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBAccountPrivilegeDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -54,9 +55,9 @@ func resourceAlibabacloudStackDBAccountPrivilege() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	account := d.Get("account_name").(string)
 	privilege := d.Get("privilege").(string)
@@ -69,7 +70,7 @@ func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, m
 
 	if len(dbList) > 0 {
 		for _, db := range dbList {
-			if err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			if err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 				if err := rdsService.GrantAccountPrivilege(d.Id(), db.(string)); err != nil {
 					if IsExpectedErrors(err, OperationDeniedDBStatus) {
 						return resource.RetryableError(err)
@@ -83,13 +84,13 @@ func resourceAlibabacloudStackDBAccountPrivilegeCreate(d *schema.ResourceData, m
 		}
 	}
 
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rsdService := RdsService{client}
+	rsdService := RdsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
 		return WrapError(err)
@@ -118,14 +119,14 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 		request := rds.CreateDescribeDatabasesRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = object.DBInstanceId
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
 		} else {
 			request.Scheme = "http"
 		}
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.DescribeDatabases(request)
 			})
@@ -158,9 +159,9 @@ func resourceAlibabacloudStackDBAccountPrivilegeRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	d.Partial(true)
 
 	if d.HasChange("db_names") {
@@ -202,12 +203,12 @@ func resourceAlibabacloudStackDBAccountPrivilegeUpdate(d *schema.ResourceData, m
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBAccountPrivilegeRead(d, meta)
+	return resourceAlibabacloudStackDBAccountPrivilegeRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBAccountPrivilegeDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBAccountPrivilegeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
 		return WrapError(err)
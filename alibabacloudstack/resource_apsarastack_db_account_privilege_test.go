@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -25,7 +26,7 @@ func TestAccAlibabacloudStackDBAccountPrivilege_mysql(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeDBAccountPrivilege")
 	rac := resourceAttrCheckInit(rc, ra)
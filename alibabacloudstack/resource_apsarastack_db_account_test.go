@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -24,7 +25,7 @@ func TestAccAlibabacloudStackDBAccountUpdate(t *testing.T) {
 	resourceId := "alibabacloudstack_db_account.default"
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeDBAccount")
 	rac := resourceAttrCheckInit(rc, ra)
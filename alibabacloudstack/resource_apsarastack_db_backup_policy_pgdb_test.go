@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -13,7 +14,7 @@ func TestAccAlibabacloudStackDBBackupPolicy_pgdb(t *testing.T) {
 	var v *rds.DescribeBackupPolicyResponse
 	resourceId := "alibabacloudstack_db_instance.default"
 	serverFunc := func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeBackupPolicy")
 	ra := resourceAttrInit(resourceId, nil)
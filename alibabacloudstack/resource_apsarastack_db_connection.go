@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -22,12 +23,12 @@ var dbConnectionIdWithSuffixRegexp = regexp.MustCompile(dbConnectionIdWithSuffix
 
 func resourceAlibabacloudStackDBConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBConnectionCreate,
-		Read:   resourceAlibabacloudStackDBConnectionRead,
-		Update: resourceAlibabacloudStackDBConnectionUpdate,
-		Delete: resourceAlibabacloudStackDBConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -61,9 +62,9 @@ func resourceAlibabacloudStackDBConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	prefix := d.Get("connection_prefix").(string)
 	if prefix == "" {
@@ -79,13 +80,13 @@ func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta in
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.ConnectionStringPrefix = prefix
 	request.Port = d.Get("port").(string)
 	var raw interface{}
 	var err error
-	err = resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.AllocateInstancePublicConnection(request)
 		})
@@ -114,10 +115,10 @@ func resourceAlibabacloudStackDBConnectionCreate(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDBConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
 	if len(submatch) > 1 {
@@ -125,7 +126,7 @@ func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta inte
 	}
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	object, err := rdsService.DescribeDBConnection(d.Id())
 
 	if err != nil {
@@ -148,9 +149,9 @@ func resourceAlibabacloudStackDBConnectionRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
 	if len(submatch) > 1 {
@@ -171,7 +172,7 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = parts[0]
 		object, err := rdsService.DescribeDBConnection(d.Id())
 		if err != nil {
@@ -180,7 +181,7 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 		request.CurrentConnectionString = object.ConnectionString
 		request.ConnectionStringPrefix = parts[1]
 		request.Port = d.Get("port").(string)
-		if err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceConnectionString(request)
 			})
@@ -201,12 +202,12 @@ func resourceAlibabacloudStackDBConnectionUpdate(d *schema.ResourceData, meta in
 			return WrapError(err)
 		}
 	}
-	return resourceAlibabacloudStackDBConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	submatch := dbConnectionIdWithSuffixRegexp.FindStringSubmatch(d.Id())
 	if len(submatch) > 1 {
@@ -222,11 +223,11 @@ func resourceAlibabacloudStackDBConnectionDelete(d *schema.ResourceData, meta in
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.DBInstanceId = split[0]
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := rdsService.DescribeDBConnection(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(WrapError(err))
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"regexp"
@@ -34,7 +35,7 @@ func TestAccAlibabacloudStackDBConnectionConfigUpdate(t *testing.T) {
 	resourceId := "alibabacloudstack_db_connection.default"
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeDBConnection")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBDatabaseCreate,
-		Read:   resourceAlibabacloudStackDBDatabaseRead,
-		Update: resourceAlibabacloudStackDBDatabaseUpdate,
-		Delete: resourceAlibabacloudStackDBDatabaseDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBDatabaseRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBDatabaseDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -48,7 +49,7 @@ func resourceAlibabacloudStackDBDatabase() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := rds.CreateCreateDatabaseRequest()
@@ -59,7 +60,7 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Get("instance_id").(string)
 	request.DBName = d.Get("name").(string)
 	request.CharacterSetName = d.Get("character_set").(string)
@@ -72,7 +73,7 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 		request.DBDescription = v.(string)
 	}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.CreateDatabase(request)
 		})
@@ -92,13 +93,13 @@ func resourceAlibabacloudStackDBDatabaseCreate(d *schema.ResourceData, meta inte
 
 	d.SetId(fmt.Sprintf("%s%s%s", request.DBInstanceId, COLON_SEPARATED, request.DBName))
 
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rsdService := RdsService{client}
+	rsdService := RdsService{client, ctx}
 	object, err := rsdService.DescribeDBDatabase(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -116,7 +117,7 @@ func resourceAlibabacloudStackDBDatabaseRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("description") && !d.IsNewResource() {
 		parts, err := ParseResourceId(d.Id(), 2)
@@ -131,7 +132,7 @@ func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
 		} else {
@@ -149,12 +150,12 @@ func resourceAlibabacloudStackDBDatabaseUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDBDatabaseRead(d, meta)
+	return resourceAlibabacloudStackDBDatabaseRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -172,7 +173,7 @@ func resourceAlibabacloudStackDBDatabaseDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.DBName = parts[1]
 	// wait instance status is running before deleting database
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -22,7 +23,7 @@ func TestAccAlibabacloudStackDBDatabaseUpdate(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, dbDatabaseBasicMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &database, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBDatabase")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -93,3 +94,32 @@ provider "alibabacloudstack" {
 		storage_type         = "local_ssd"
 	}`, RdsCommonTestCase, name)
 }
+
+func TestUnitAlibabacloudStackDBDatabase_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("db_database")
+	client := server.client()
+
+	r := resourceAlibabacloudStackDBDatabase()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"instance_id":   "rm-mock0001",
+		"name":          "tftestdatabase",
+		"character_set": "utf8",
+		"description":   "mock database",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the database got an error: %#v", diags)
+	}
+	if d.Id() != "rm-mock0001:tftestdatabase" {
+		t.Fatalf("expected the database id rm-mock0001:tftestdatabase, got %q", d.Id())
+	}
+	if value := d.Get("character_set").(string); value != "utf8" {
+		t.Errorf("expected the character_set utf8, got %q", value)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the database got an error: %#v", diags)
+	}
+	if count := server.callCount("DeleteDatabase"); count != 1 {
+		t.Errorf("expected DeleteDatabase to be called once, got %d", count)
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -25,12 +26,12 @@ import (
 
 func resourceAlibabacloudStackDBInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBInstanceCreate,
-		Read:   resourceAlibabacloudStackDBInstanceRead,
-		Update: resourceAlibabacloudStackDBInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -39,6 +40,16 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			setTagsAllDiff,
+			vswitchZoneDiff("vswitch_id", "zone_id"),
+			// The storage of an instance can only be scaled out and TDE can not be disabled once enabled. Replacing
+			// the instance instead would drop its data, so these changes fail the plan
+			rejectChangeIf("instance_storage", diffValueDecreased("instance_storage"), "the storage of an instance can only be scaled out"),
+			rejectChangeIf("tde_status", diffValueChangedTo("tde_status", false), "TDE can not be disabled once it has been enabled"),
+			dbInstanceCloneDiff,
+		),
+
 		Schema: map[string]*schema.Schema{
 			"engine": {
 				Type:     schema.TypeString,
@@ -51,12 +62,14 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 				Required: true,
 			},
 			"zone_id_slave1": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"zone_id"},
 			},
 			"zone_id_slave2": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"zone_id", "zone_id_slave1"},
 			},
 			"tde_status": {
 				Type:     schema.TypeBool,
@@ -192,7 +205,8 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 
 			"maintain_time": {
 				Type:     schema.TypeString,
@@ -204,25 +218,125 @@ func resourceAlibabacloudStackDBInstance() *schema.Resource {
 				Optional: true,
 				Computed: true,
 			},
+			"source_db_instance_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"restore_time": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"backup_id"},
+				RequiredWith:  []string{"source_db_instance_id"},
+			},
+			"backup_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"restore_time"},
+			},
+			"parameter_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"sql_collector_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enabled", "Disabled"}, false),
+			},
+			"sql_collector_config_value": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntInSlice([]int{30, 180, 365, 1095, 1825}),
+			},
+			"storage_auto_scale": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enable", "Disable"}, false),
+			},
+			"storage_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{10, 20, 30, 40, 50}),
+				RequiredWith: []string{"storage_auto_scale"},
+			},
+			"storage_upper_bound": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+				RequiredWith: []string{"storage_auto_scale"},
+			},
+			"ha_config": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Auto", "Manual"}, false),
+			},
+			"manual_ha_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"ha_config"},
+			},
+			"primary_node_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// dbInstanceRestoreParams returns the api the instance is created with and its parameters when the instance is cloned
+// from another one or restored from a backup, or no api when the instance is created empty.
+func dbInstanceRestoreParams(d *schema.ResourceData) (string, map[string]string) {
+	sourceId := d.Get("source_db_instance_id").(string)
+	backupId := d.Get("backup_id").(string)
+	switch {
+	case sourceId != "" && backupId != "":
+		return "CloneDBInstance", map[string]string{"DBInstanceId": sourceId, "RestoreType": "BackupSet", "BackupId": backupId}
+	case sourceId != "":
+		return "CloneDBInstance", map[string]string{"DBInstanceId": sourceId, "RestoreType": "BackupTime", "RestoreTime": d.Get("restore_time").(string)}
+	case backupId != "":
+		return "RecoveryDBInstance", map[string]string{"BackupId": backupId}
+	}
+	return "", nil
+}
+
+// dbInstanceCloneDiff fails when a clone has nothing to be restored from.
+func dbInstanceCloneDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("restore_time") || !d.NewValueKnown("backup_id") {
+		return nil
+	}
+	if _, ok := d.GetOk("source_db_instance_id"); !ok {
+		return nil
+	}
+	_, restoreTime := d.GetOk("restore_time")
+	_, backupId := d.GetOk("backup_id")
+	if !restoreTime && !backupId {
+		return fmt.Errorf(`"restore_time" or "backup_id" is required when "source_db_instance_id" is set`)
+	}
+	return nil
+}
+
 func parameterToHash(v interface{}) int {
 	m := v.(map[string]interface{})
 	return hashcode.String(m["name"].(string) + "|" + m["value"].(string))
 }
 
-func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	//request, err := buildDBCreateRequest(d, meta)
 	//if err != nil {
 	//	return WrapError(err)
 	//}
 	//client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	//request := rds.CreateCreateDBInstanceRequest()
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -262,13 +376,12 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 			req.Scheme = "http"
 		}
 		req.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Rds",
-			"RegionId":        client.RegionId,
-			"TargetRegionId":  client.RegionId,
+			"AccessKeyId":    client.AccessKey,
+			"Department":     client.Department,
+			"ResourceGroup":  client.ResourceGroup,
+			"Product":        "Rds",
+			"RegionId":       client.RegionId,
+			"TargetRegionId": client.RegionId,
 		}
 		ram, err := client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 			return crClient.ProcessCommonRequest(req)
@@ -344,7 +457,6 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 	}
 	ClientToken := fmt.Sprintf("Terraform-AlibabacloudStack-%d-%s", time.Now().Unix(), uuid)
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":       client.SecretKey,
 		"Product":               "rds",
 		"Department":            client.Department,
 		"ResourceGroup":         client.ResourceGroup,
@@ -369,8 +481,18 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 		"VPCId":                 VPCId,
 		"RoleARN":               arnrole,
 	}
+	// The clones and the restored instances take the engine, the encryption and the whitelists of their source
+	if action, params := dbInstanceRestoreParams(d); action != "" {
+		request.ApiName = action
+		for _, key := range []string{"Engine", "EngineVersion", "Encryption", "EncryptionKey", "RoleARN", "SecurityIPList", "DBInstanceNetType", "ZoneIdSlave1", "ZoneIdSlave2"} {
+			delete(request.QueryParams, key)
+		}
+		for key, value := range params {
+			request.QueryParams[key] = value
+		}
+	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	//request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	//request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	log.Printf("request245 %v", request.QueryParams)
 	//log.Printf("request245 %v",request)
 	//raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -402,17 +524,17 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	if tde := d.Get("tde_status"); tde == true {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		rdsService = RdsService{client}
+		rdsService = RdsService{client, ctx}
 		tde_req := rds.CreateModifyDBInstanceTDERequest()
 		tde_req.RegionId = client.RegionId
 		tde_req.Headers = map[string]string{"RegionId": client.RegionId}
-		tde_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		tde_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		tde_req.DBInstanceId = d.Id()
 		tde_req.TDEStatus = "Enabled"
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -438,7 +560,7 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 		ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 		ssl_req.RegionId = client.RegionId
 		ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-		ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+		ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 		ssl_req.DBInstanceId = d.Id()
 		ssl_req.SSLEnabled = "1"
 		ssl_req.ConnectionString = d.Get("connection_string").(string)
@@ -459,13 +581,13 @@ func resourceAlibabacloudStackDBInstanceCreate(d *schema.ResourceData, meta inte
 		log.Print("enabled SSL")
 		addDebug(request.GetActionName(), sslraw, request)
 	}
-	return resourceAlibabacloudStackDBInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//const tde_set = false
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
@@ -489,7 +611,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		prePaidRequest.RegionId = client.RegionId
 		prePaidRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		prePaidRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		prePaidRequest.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		prePaidRequest.DBInstanceId = d.Id()
 		prePaidRequest.PayType = string(payType)
 		prePaidRequest.AutoPay = "true"
@@ -508,7 +630,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		addDebug(prePaidRequest.GetActionName(), raw, prePaidRequest.RpcRequest, prePaidRequest)
 		// wait instance status is Normal after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("instance_charge_type")
@@ -526,7 +648,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		auto_renew := d.Get("auto_renew").(bool)
 		if auto_renew {
 			request.AutoRenew = "True"
@@ -557,7 +679,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.Period = strconv.Itoa(period)
 
@@ -579,7 +701,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.MaintainTime = d.Get("maintain_time").(string)
 		request.ClientToken = buildClientToken(request.GetActionName())
@@ -603,7 +725,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	//		request.Scheme = "http"
 	//	}
 	//	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	//	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	//	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	//	request.DBInstanceId = d.Id()
 	//	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 	//		return rdsClient.MigrateSecurityIPMode(request)
@@ -615,9 +737,111 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	//	//d.SetPartial("security_ip_mode")
 	//}
 
+	if v, ok := d.GetOk("parameter_group_id"); ok && d.HasChange("parameter_group_id") {
+		if err := rdsService.ModifyDBInstanceParameterGroup(d.Id(), v.(string), d.Get("force_restart").(bool)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("sql_collector_status") {
+		request := rds.CreateModifySQLCollectorPolicyRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.SQLCollectorStatus = d.Get("sql_collector_status").(string)
+
+		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
+			return client.ModifySQLCollectorPolicy(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if d.HasChange("sql_collector_config_value") {
+		request := rds.CreateModifySQLCollectorRetentionRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.ConfigValue = strconv.Itoa(d.Get("sql_collector_config_value").(int))
+
+		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
+			return client.ModifySQLCollectorRetention(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if d.HasChanges("storage_auto_scale", "storage_threshold", "storage_upper_bound") {
+		request := rds.CreateModifyDasInstanceConfigRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.StorageAutoScale = d.Get("storage_auto_scale").(string)
+		if request.StorageAutoScale == "Enable" {
+			request.StorageThreshold = requests.NewInteger(d.Get("storage_threshold").(int))
+			request.StorageUpperBound = requests.NewInteger(d.Get("storage_upper_bound").(int))
+		}
+		request.ClientToken = buildClientToken(request.GetActionName())
+
+		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
+			return client.ModifyDasInstanceConfig(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	if d.HasChanges("ha_config", "manual_ha_time") {
+		request := rds.CreateModifyHASwitchConfigRequest()
+		request.RegionId = client.RegionId
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.DBInstanceId = d.Id()
+		request.HAConfig = d.Get("ha_config").(string)
+		if request.HAConfig == "Manual" {
+			request.ManualHATime = d.Get("manual_ha_time").(string)
+		}
+
+		raw, err := client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
+			return client.ModifyHASwitchConfig(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -629,7 +853,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
@@ -667,7 +891,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.PayType = d.Get("instance_charge_type").(string)
 
@@ -682,10 +906,10 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 	}
 	if update {
 		// wait instance status is running before modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -706,20 +930,26 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 		}
 
 		// wait instance status is running after modifying
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
+	if d.HasChange("primary_node_id") {
+		if err := switchDBInstancePrimaryNode(ctx, d, meta); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	d.Partial(false)
 	if d.HasChange("tde_status") {
 		//if tde:=d.Get("tde_status");tde==true{
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		rdsService = RdsService{client}
+		rdsService = RdsService{client, ctx}
 		tde_req := rds.CreateModifyDBInstanceTDERequest()
 		tde_req.RegionId = client.RegionId
 		tde_req.Headers = map[string]string{"RegionId": client.RegionId}
-		tde_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		tde_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		tde_req.DBInstanceId = d.Id()
 		tde_req.TDEStatus = "Enabled"
 		//tde_req.RoleArn=d.Get("role_arn").(string)
@@ -750,7 +980,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 			ssl_req.RegionId = client.RegionId
 			ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-			ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+			ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 			ssl_req.DBInstanceId = d.Id()
 			ssl_req.SSLEnabled = "1"
 			ssl_req.ConnectionString = d.Get("connection_string").(string)
@@ -774,7 +1004,7 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			ssl_req := rds.CreateModifyDBInstanceSSLRequest()
 			ssl_req.RegionId = client.RegionId
 			ssl_req.Headers = map[string]string{"RegionId": client.RegionId}
-			ssl_req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
+			ssl_req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup, "Forwardedregionid": client.RegionId}
 			ssl_req.DBInstanceId = d.Id()
 			ssl_req.SSLEnabled = "0"
 			ssl_req.ConnectionString = d.Get("connection_string").(string)
@@ -796,13 +1026,58 @@ func resourceAlibabacloudStackDBInstanceUpdate(d *schema.ResourceData, meta inte
 			addDebug(request.GetActionName(), sslraw, request)
 		}
 	}
-	return resourceAlibabacloudStackDBInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
+}
+
+// switchDBInstancePrimaryNode switches the primary and the standby nodes of the instance over and waits for
+// primary_node_id to become the primary one.
+func switchDBInstancePrimaryNode(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rdsService := RdsService{client, ctx}
+	nodeId := d.Get("primary_node_id").(string)
+	request := rds.CreateSwitchDBInstanceHARequest()
+	request.RegionId = client.RegionId
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.DBInstanceId = d.Id()
+	request.NodeId = nodeId
+
+	if err := rdsService.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout); err != nil {
+		return WrapError(err)
+	}
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.SwitchDBInstanceHA(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		primary, err := rdsService.DescribeDBInstancePrimaryNode(d.Id())
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if primary != nodeId {
+			return resource.RetryableError(Error("the primary node of %s is %s, waiting for %s", d.Id(), primary, nodeId))
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return WrapError(rdsService.WaitForDBInstance(d.Id(), Running, DefaultLongTimeout))
 }
 
-func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
 	if err != nil {
@@ -823,7 +1098,9 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 		return WrapError(err)
 	}
 	if len(tags) > 0 {
-		d.Set("tags", rdsService.tagsToMap(tags))
+		if err := setResourceTags(d, meta, rdsService.tagsToMap(tags)); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	monitoringPeriod, err := rdsService.DescribeDbInstanceMonitor(d.Id())
@@ -848,6 +1125,66 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 	d.Set("maintain_time", instance.MaintainTime)
 	d.Set("storage_type", instance.DBInstanceStorageType)
 
+	parameterGroupId, err := rdsService.DescribeDBInstanceParameterGroupId(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("parameter_group_id", parameterGroupId)
+
+	// The SQL audit and the high availability apis are not supported by every category of instance,
+	// so they are only read for the instances which configure them
+	_, collectorStatusOk := d.GetOk("sql_collector_status")
+	_, collectorConfigOk := d.GetOk("sql_collector_config_value")
+	if collectorStatusOk || collectorConfigOk {
+		collectorPolicy, err := rdsService.DescribeSQLCollectorPolicy(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("sql_collector_status", collectorPolicy.SQLCollectorStatus)
+		if collectorPolicy.SQLCollectorStatus == "Enabled" {
+			collectorRetention, err := rdsService.DescribeSQLCollectorRetention(d.Id())
+			if err != nil {
+				return WrapError(err)
+			}
+			configValue, _ := strconv.Atoi(collectorRetention.ConfigValue)
+			d.Set("sql_collector_config_value", configValue)
+		}
+	}
+
+	if _, ok := d.GetOk("storage_auto_scale"); ok {
+		dasConfig, err := rdsService.DescribeDasInstanceConfig(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		if dasConfig.StorageAutoScale {
+			d.Set("storage_auto_scale", "Enable")
+			d.Set("storage_threshold", dasConfig.StorageThreshold)
+			d.Set("storage_upper_bound", dasConfig.StorageUpperBound)
+		} else {
+			d.Set("storage_auto_scale", "Disable")
+		}
+	}
+
+	if _, ok := d.GetOk("ha_config"); ok {
+		haConfig, err := rdsService.DescribeHASwitchConfig(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("ha_config", haConfig.HAConfig)
+		if haConfig.HAConfig == "Manual" {
+			d.Set("manual_ha_time", haConfig.ManualHATime)
+		}
+	}
+
+	_, primaryNodeOk := d.GetOk("primary_node_id")
+	if _, haConfigOk := d.GetOk("ha_config"); primaryNodeOk || haConfigOk {
+		primaryNode, err := rdsService.DescribeDBInstancePrimaryNode(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("primary_node_id", primaryNode)
+	}
+
 	//if err = rdsService.RefreshParameters(d, "parameters"); err != nil {
 	//	return WrapError(err)
 	//}
@@ -861,7 +1198,7 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -887,9 +1224,9 @@ func resourceAlibabacloudStackDBInstanceRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
 	if err != nil {
@@ -910,10 +1247,10 @@ func resourceAlibabacloudStackDBInstanceDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": string(client.RegionId)}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -39,7 +40,7 @@ func testSweepDBInstances(region string) error {
 	req := rds.CreateDescribeDBInstancesRequest()
 	req.RegionId = client.RegionId
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		req.Scheme = "https"
@@ -72,7 +73,7 @@ func testSweepDBInstances(region string) error {
 	}
 
 	sweeped := false
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, v := range insts {
 		name := v.DBInstanceDescription
 		id := v.DBInstanceId
@@ -106,7 +107,7 @@ func testSweepDBInstances(region string) error {
 			}
 			request.DBInstanceId = id
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			if _, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ReleaseReadWriteSplittingConnection(request)
 			}); err != nil {
@@ -123,7 +124,7 @@ func testSweepDBInstances(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		_, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(req)
 		})
@@ -147,7 +148,7 @@ func TestAccAlibabacloudStackDBInstanceMysql(t *testing.T) {
 	resourceId := "alibabacloudstack_db_instance.default"
 	ra := resourceAttrInit(resourceId, instanceBasicMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -327,7 +328,7 @@ func TestAccAlibabacloudStackDBInstanceMultiInstance(t *testing.T) {
 	resourceId := "alibabacloudstack_db_instance.default.2"
 	ra := resourceAttrInit(resourceId, instanceBasicMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -368,7 +369,7 @@ func TestAccAlibabacloudStackDBInstanceMultiAZ(t *testing.T) {
 	var instance = &rds.DBInstanceAttribute{}
 	resourceId := "alibabacloudstack_db_instance.default"
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBInstance")
 	ra := resourceAttrInit(resourceId, instanceBasicMap)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -435,7 +436,7 @@ func TestAccAlibabacloudStackDBInstanceClassic(t *testing.T) {
 	resourceId := "alibabacloudstack_db_instance.default"
 	ra := resourceAttrInit(resourceId, instanceBasicMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -498,7 +499,7 @@ func testAccCheckSecurityIpExists(n string, ips []map[string]interface{}) resour
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		rdsService := RdsService{client}
+		rdsService := RdsService{client, context.Background()}
 		resp, err := rdsService.DescribeDBSecurityIps(rs.Primary.ID)
 		log.Printf("[DEBUG] check instance %s security ip %#v", rs.Primary.ID, resp)
 
@@ -525,3 +526,174 @@ var instanceBasicMap = map[string]string{
 	"connection_string": CHECKSET,
 	"port":              CHECKSET,
 }
+
+func TestUnitAlibabacloudStackDBInstance_customizeDiff(t *testing.T) {
+	r := resourceAlibabacloudStackDBInstance()
+	config := map[string]interface{}{
+		"engine":           "MySQL",
+		"engine_version":   "5.6",
+		"instance_type":    "rds.mysql.s2.large",
+		"instance_storage": 20,
+		"storage_type":     "local_ssd",
+		"zone_id_slave1":   "cn-qingdao-env66-d01-b",
+	}
+	if diags := r.Validate(terraform.NewResourceConfigRaw(config)); !diags.HasError() {
+		t.Fatalf("expected zone_id_slave1 without zone_id to fail the plan")
+	}
+	config["zone_id"] = "cn-qingdao-env66-d01-a"
+	if diags := r.Validate(terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("expected zone_id_slave1 with zone_id to pass the validation, got %#v", diags)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "rm-mock0001",
+		Attributes: map[string]string{
+			"id":               "rm-mock0001",
+			"engine":           "MySQL",
+			"engine_version":   "5.6",
+			"instance_type":    "rds.mysql.s2.large",
+			"instance_storage": "20",
+			"storage_type":     "local_ssd",
+			"tde_status":       "true",
+		},
+	}
+	for _, c := range []struct {
+		key      string
+		value    interface{}
+		rejected bool
+	}{
+		{"instance_storage", 30, false},
+		{"instance_storage", 10, true},
+		{"tde_status", false, true},
+	} {
+		config := map[string]interface{}{
+			"engine":           "MySQL",
+			"engine_version":   "5.6",
+			"instance_type":    "rds.mysql.s2.large",
+			"instance_storage": 20,
+			"storage_type":     "local_ssd",
+			"tde_status":       true,
+		}
+		config[c.key] = c.value
+		diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), nil)
+		if c.rejected {
+			if err == nil {
+				t.Errorf("expected changing %s to %v to fail the plan", c.key, c.value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("planning %s = %v got an error: %#v", c.key, c.value, err)
+		}
+		if attr := diff.Attributes[c.key]; attr == nil || attr.RequiresNew {
+			t.Errorf("expected changing %s to %v to be updated in place, got %#v", c.key, c.value, attr)
+		}
+	}
+}
+
+func TestUnitAlibabacloudStackDBInstance_restore(t *testing.T) {
+	r := resourceAlibabacloudStackDBInstance()
+	base := func(extra map[string]interface{}) map[string]interface{} {
+		config := map[string]interface{}{
+			"engine":           "MySQL",
+			"engine_version":   "5.6",
+			"instance_type":    "rds.mysql.s2.large",
+			"instance_storage": 20,
+			"storage_type":     "local_ssd",
+		}
+		for key, value := range extra {
+			config[key] = value
+		}
+		return config
+	}
+	for _, c := range []struct {
+		config map[string]interface{}
+		action string
+		params map[string]string
+	}{
+		{base(nil), "", nil},
+		{
+			base(map[string]interface{}{"source_db_instance_id": "rm-source", "restore_time": "2026-10-01T08:00:00Z"}),
+			"CloneDBInstance",
+			map[string]string{"DBInstanceId": "rm-source", "RestoreType": "BackupTime", "RestoreTime": "2026-10-01T08:00:00Z"},
+		},
+		{
+			base(map[string]interface{}{"source_db_instance_id": "rm-source", "backup_id": "321"}),
+			"CloneDBInstance",
+			map[string]string{"DBInstanceId": "rm-source", "RestoreType": "BackupSet", "BackupId": "321"},
+		},
+		{
+			base(map[string]interface{}{"backup_id": "321"}),
+			"RecoveryDBInstance",
+			map[string]string{"BackupId": "321"},
+		},
+	} {
+		d := newMockApiResourceData(t, r, c.config)
+		action, params := dbInstanceRestoreParams(d)
+		if action != c.action || len(params) != len(c.params) {
+			t.Errorf("expected %s with %v for %v, got %s with %v", c.action, c.params, c.config, action, params)
+			continue
+		}
+		for key, value := range c.params {
+			if params[key] != value {
+				t.Errorf("expected %s to be %s for %v, got %v", key, value, c.config, params)
+			}
+		}
+	}
+
+	if diags := r.Validate(terraform.NewResourceConfigRaw(base(map[string]interface{}{"source_db_instance_id": "rm-source", "restore_time": "2026-10-01T08:00:00Z", "backup_id": "321"}))); !diags.HasError() {
+		t.Errorf("expected restore_time and backup_id together to fail the validation")
+	}
+	if diags := r.Validate(terraform.NewResourceConfigRaw(base(map[string]interface{}{"restore_time": "2026-10-01T08:00:00Z"}))); !diags.HasError() {
+		t.Errorf("expected restore_time without source_db_instance_id to fail the validation")
+	}
+	if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(base(map[string]interface{}{"source_db_instance_id": "rm-source"})), nil); err == nil ||
+		!strings.Contains(err.Error(), `"restore_time" or "backup_id" is required when "source_db_instance_id" is set`) {
+		t.Errorf("expected a clone without restore_time or backup_id to fail the plan, got %v", err)
+	}
+}
+
+func TestUnitAlibabacloudStackDBInstance_switchPrimaryNode(t *testing.T) {
+	nodes := func(primary, standby string) map[string]interface{} {
+		return map[string]interface{}{
+			"HostInstanceInfos": map[string]interface{}{
+				"NodeInfo": []interface{}{
+					map[string]interface{}{"NodeId": primary, "NodeType": "Master"},
+					map[string]interface{}{"NodeId": standby, "NodeType": "Slave"},
+				},
+			},
+		}
+	}
+	server := newMockApiServer(t).
+		on("DescribeDBInstanceAttribute", map[string]interface{}{
+			"Items": map[string]interface{}{
+				"DBInstanceAttribute": []interface{}{
+					map[string]interface{}{"DBInstanceId": "rm-mock0001", "DBInstanceStatus": "Running"},
+				},
+			},
+		}).
+		on("SwitchDBInstanceHA", map[string]interface{}{}).
+		on("DescribeDBInstanceHAConfig", nodes("rn-mock0001", "rn-mock0002")).
+		after("SwitchDBInstanceHA", "DescribeDBInstanceHAConfig", nodes("rn-mock0002", "rn-mock0001"))
+	client := server.client()
+
+	r := resourceAlibabacloudStackDBInstance()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"engine":           "MySQL",
+		"engine_version":   "5.7",
+		"instance_type":    "rds.mysql.s2.large",
+		"instance_storage": 20,
+		"storage_type":     "local_ssd",
+		"primary_node_id":  "rn-mock0002",
+	})
+	d.SetId("rm-mock0001")
+	if err := switchDBInstancePrimaryNode(context.Background(), d, client); err != nil {
+		t.Fatalf("switching the primary node got an error: %v", err)
+	}
+	if call, ok := server.lastCall("SwitchDBInstanceHA"); !ok || call.Params["DBInstanceId"] != "rm-mock0001" || call.Params["NodeId"] != "rn-mock0002" {
+		t.Errorf("SwitchDBInstanceHA was not called with the expected parameters: %v", call.Params)
+	}
+	if count := server.callCount("DescribeDBInstanceHAConfig"); count != 1 {
+		t.Errorf("expected the nodes to be described until rn-mock0002 is the primary one, got %d calls", count)
+	}
+}
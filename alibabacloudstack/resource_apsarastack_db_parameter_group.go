@@ -0,0 +1,202 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/rds"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackDBParameterGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBParameterGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBParameterGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBParameterGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBParameterGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(MySQL), string(PostgreSQL)}, false),
+			},
+			"engine_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"parameter_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(8, 64),
+			},
+			"parameter_group_desc": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 200),
+			},
+			"param_detail": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"param_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"param_value": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"force_restart": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackDBParameterGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	request := rds.CreateCreateParameterGroupRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.Engine = d.Get("engine").(string)
+	request.EngineVersion = d.Get("engine_version").(string)
+	request.ParameterGroupName = d.Get("parameter_group_name").(string)
+	request.ParameterGroupDesc = d.Get("parameter_group_desc").(string)
+	request.Parameters = expandDBParameterGroupParameters(d.Get("param_detail").(*schema.Set))
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.CreateParameterGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_db_parameter_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	// The sdk does not decode the ParameterGroupId of the response
+	var response map[string]interface{}
+	if err := json.Unmarshal(raw.(*rds.CreateParameterGroupResponse).GetHttpContentBytes(), &response); err != nil {
+		return WrapError(err)
+	}
+	id, _ := response["ParameterGroupId"].(string)
+	if id == "" {
+		return WrapErrorf(Error(GetNotFoundMessage("DBParameterGroup", request.ParameterGroupName)), IdMsg, request.ParameterGroupName)
+	}
+	d.SetId(id)
+
+	return resourceAlibabacloudStackDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackDBParameterGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	rdsService := RdsService{client, ctx}
+	object, err := rdsService.DescribeDBParameterGroup(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("engine", object.Engine)
+	d.Set("engine_version", object.EngineVersion)
+	d.Set("parameter_group_name", object.ParameterGroupName)
+	d.Set("parameter_group_desc", object.ParameterGroupDesc)
+	d.Set("force_restart", object.ForceRestart == 1)
+	var params []map[string]interface{}
+	for _, param := range object.ParamDetail.ParameterDetail {
+		params = append(params, map[string]interface{}{
+			"param_name":  param.ParamName,
+			"param_value": param.ParamValue,
+		})
+	}
+	if err := d.Set("param_detail", params); err != nil {
+		return WrapError(err)
+	}
+
+	return nil
+}
+
+func resourceAlibabacloudStackDBParameterGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	request := rds.CreateModifyParameterGroupRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.ParameterGroupId = d.Id()
+	request.ParameterGroupName = d.Get("parameter_group_name").(string)
+	request.ParameterGroupDesc = d.Get("parameter_group_desc").(string)
+	// The parameter group is replaced by the parameters of the request
+	request.Parameters = expandDBParameterGroupParameters(d.Get("param_detail").(*schema.Set))
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifyParameterGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	return resourceAlibabacloudStackDBParameterGroupRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackDBParameterGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	request := rds.CreateDeleteParameterGroupRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.ParameterGroupId = d.Id()
+
+	raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DeleteParameterGroup(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParamGroupsNotExist", "InvalidParameterGroupId.NotFound"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
+// expandDBParameterGroupParameters returns the parameters in the json object the parameter group apis take.
+func expandDBParameterGroupParameters(params *schema.Set) string {
+	config := make(map[string]string)
+	for _, param := range params.List() {
+		p := param.(map[string]interface{})
+		config[p["param_name"].(string)] = p["param_value"].(string)
+	}
+	cfg, _ := json.Marshal(config)
+	return string(cfg)
+}
@@ -0,0 +1,92 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestUnitAlibabacloudStackDBParameterGroup_mock(t *testing.T) {
+	group := func(value string) map[string]interface{} {
+		return map[string]interface{}{
+			"ParamGroup": map[string]interface{}{
+				"ParameterGroup": []interface{}{
+					map[string]interface{}{
+						"ParameterGroupId":   "rpg-mock0001",
+						"ParameterGroupName": "tf-mock-group",
+						"ParameterGroupDesc": "mock group",
+						"Engine":             "MySQL",
+						"EngineVersion":      "5.7",
+						"ForceRestart":       1,
+						"ParamDetail": map[string]interface{}{
+							"ParameterDetail": []interface{}{
+								map[string]interface{}{"ParamName": "back_log", "ParamValue": value},
+								map[string]interface{}{"ParamName": "wait_timeout", "ParamValue": "86400"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	server := newMockApiServer(t).
+		on("CreateParameterGroup", map[string]interface{}{"ParameterGroupId": "rpg-mock0001"}).
+		on("DescribeParameterGroup", group("3000")).
+		after("ModifyParameterGroup", "DescribeParameterGroup", group("4000")).
+		on("ModifyParameterGroup", map[string]interface{}{}).
+		on("DeleteParameterGroup", map[string]interface{}{})
+	client := server.client()
+
+	r := resourceAlibabacloudStackDBParameterGroup()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"engine":               "MySQL",
+		"engine_version":       "5.7",
+		"parameter_group_name": "tf-mock-group",
+		"parameter_group_desc": "mock group",
+		"param_detail": []interface{}{
+			map[string]interface{}{"param_name": "back_log", "param_value": "3000"},
+			map[string]interface{}{"param_name": "wait_timeout", "param_value": "86400"},
+		},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the parameter group got an error: %#v", diags)
+	}
+	if d.Id() != "rpg-mock0001" {
+		t.Fatalf("expected the parameter group id rpg-mock0001, got %q", d.Id())
+	}
+	call, ok := server.lastCall("CreateParameterGroup")
+	if !ok || call.Params["Engine"] != "MySQL" || call.Params["EngineVersion"] != "5.7" || call.Params["ParameterGroupName"] != "tf-mock-group" {
+		t.Errorf("CreateParameterGroup was not called with the expected parameters: %v", call.Params)
+	}
+	var params map[string]string
+	if err := json.Unmarshal([]byte(call.Params["Parameters"]), &params); err != nil || len(params) != 2 || params["back_log"] != "3000" || params["wait_timeout"] != "86400" {
+		t.Errorf("expected the parameters to be sent as a json object, got %q", call.Params["Parameters"])
+	}
+	if d.Get("force_restart") != true || d.Get("param_detail").(*schema.Set).Len() != 2 {
+		t.Errorf("expected the parameter group to be read, got %v", d.State())
+	}
+
+	d.Set("param_detail", []interface{}{
+		map[string]interface{}{"param_name": "back_log", "param_value": "4000"},
+		map[string]interface{}{"param_name": "wait_timeout", "param_value": "86400"},
+	})
+	if diags := r.UpdateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("updating the parameter group got an error: %#v", diags)
+	}
+	call, ok = server.lastCall("ModifyParameterGroup")
+	if !ok || call.Params["ParameterGroupId"] != "rpg-mock0001" || call.Params["ParameterGroupName"] != "tf-mock-group" {
+		t.Errorf("ModifyParameterGroup was not called with the expected parameters: %v", call.Params)
+	}
+	if err := json.Unmarshal([]byte(call.Params["Parameters"]), &params); err != nil || params["back_log"] != "4000" {
+		t.Errorf("expected the modified parameters to be sent, got %q", call.Params["Parameters"])
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the parameter group got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("DeleteParameterGroup"); !ok || call.Params["ParameterGroupId"] != "rpg-mock0001" {
+		t.Errorf("expected the parameter group to be deleted, got %v", call.Params)
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadonlyInstanceCreate,
-		Read:   resourceAlibabacloudStackDBReadonlyInstanceRead,
-		Update: resourceAlibabacloudStackDBReadonlyInstanceUpdate,
-		Delete: resourceAlibabacloudStackDBReadonlyInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBReadonlyInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -30,6 +31,7 @@ func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"engine_version": {
 				Type:     schema.TypeString,
@@ -155,6 +157,10 @@ func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 				DiffSuppressFunc: PostPaidAndRenewDiffSuppressFunc,
 			},
 
+			"parameter_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"force_restart": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -184,16 +190,17 @@ func resourceAlibabacloudStackDBReadonlyInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
-	request, err := buildDBReadonlyCreateRequest(d, meta)
+	request, err := buildDBReadonlyCreateRequest(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -216,18 +223,25 @@ func resourceAlibabacloudStackDBReadonlyInstanceCreate(d *schema.ResourceData, m
 
 	// wait instance status change from Creating to running
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	d.Partial(true)
 
+	// The parameters of the group are applied first so that the inline parameters take precedence over them
+	if v, ok := d.GetOk("parameter_group_id"); ok && d.HasChange("parameter_group_id") {
+		if err := rdsService.ModifyDBInstanceParameterGroup(d.Id(), v.(string), d.Get("force_restart").(bool)); err != nil {
+			return WrapError(err)
+		}
+	}
+
 	if d.HasChange("parameters") {
 		if err := rdsService.ModifyParameters(d, "parameters"); err != nil {
 			return WrapError(err)
@@ -240,7 +254,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDBInstanceRead(d, meta)
+		return resourceAlibabacloudStackDBInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_name") {
@@ -252,11 +266,11 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("instance_name").(string)
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceDescription(request)
 			})
@@ -289,7 +303,7 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.PayType = string(Postpaid)
 
@@ -310,12 +324,12 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 	if update {
 		// wait instance status is running before modifying
 		stateConf := BuildStateConf([]string{"DBInstanceClassChanging", "DBInstanceNetTypeChanging"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 10*time.Minute, rdsService.RdsDBInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
-		_, err := stateConf.WaitForState()
+		_, err := stateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyDBInstanceSpec(request)
 			})
@@ -337,20 +351,20 @@ func resourceAlibabacloudStackDBReadonlyInstanceUpdate(d *schema.ResourceData, m
 		}
 
 		// wait instance status is running after modifying
-		_, err = stateConf.WaitForState()
+		_, err = stateConf.WaitForStateContext(ctx)
 		if err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDBReadonlyInstanceRead(d, meta)
+	return resourceAlibabacloudStackDBReadonlyInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
 	if err != nil {
@@ -382,15 +396,17 @@ func resourceAlibabacloudStackDBReadonlyInstanceRead(d *schema.ResourceData, met
 		return WrapError(err)
 	}
 	if len(tags) > 0 {
-		d.Set("tags", rdsService.tagsToMap(tags))
+		if err := setResourceTags(d, meta, rdsService.tagsToMap(tags)); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadonlyInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	instance, err := rdsService.DescribeDBInstance(d.Id())
 	if err != nil {
@@ -411,10 +427,10 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DeleteDBInstance(request)
@@ -442,9 +458,9 @@ func resourceAlibabacloudStackDBReadonlyInstanceDelete(d *schema.ResourceData, m
 	return nil
 }
 
-func buildDBReadonlyCreateRequest(d *schema.ResourceData, meta interface{}) (*rds.CreateReadOnlyDBInstanceRequest, error) {
+func buildDBReadonlyCreateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) (*rds.CreateReadOnlyDBInstanceRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := rds.CreateCreateReadOnlyDBInstanceRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -453,7 +469,7 @@ func buildDBReadonlyCreateRequest(d *schema.ResourceData, meta interface{}) (*rd
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = Trim(d.Get("master_db_instance_id").(string))
 	request.EngineVersion = Trim(d.Get("engine_version").(string))
 	request.DBInstanceStorage = requests.NewInteger(d.Get("instance_storage").(int))
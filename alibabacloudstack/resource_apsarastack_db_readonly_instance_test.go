@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -30,7 +31,7 @@ func TestAccAlibabacloudStackDBReadonlyInstance_update(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, DBReadonlyMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBReadonlyInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -165,7 +166,7 @@ func TestAccAlibabacloudStackDBReadonlyInstance_multi(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, DBReadonlyMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBReadonlyInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
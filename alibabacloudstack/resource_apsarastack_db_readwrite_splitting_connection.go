@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"regexp"
 	"strconv"
@@ -21,12 +22,12 @@ var dbConnectionPrefixWithSuffixRegexp = regexp.MustCompile(dbConnectionPrefixWi
 
 func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate,
-		Read:   resourceAlibabacloudStackDBReadWriteSplittingConnectionRead,
-		Update: resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate,
-		Delete: resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,9 +69,9 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnection() *schema.Resource
 	}
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	request := rds.CreateAllocateReadWriteSplittingConnectionRequest()
 	request.RegionId = string(client.Region)
@@ -80,7 +81,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = Trim(d.Get("instance_id").(string))
 	request.MaxDelayTime = strconv.Itoa(d.Get("max_delay_time").(int))
 
@@ -104,7 +105,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		}
 	}
 
-	if err := resource.Retry(60*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 60*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.AllocateReadWriteSplittingConnection(request)
 		})
@@ -128,14 +129,14 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionCreate(d *schema.Res
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	err := rdsService.WaitForDBReadWriteSplitting(d.Id(), "", DefaultLongTimeout)
 	if err != nil {
@@ -179,9 +180,9 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d *schema.Resou
 	return nil
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 
 	request := rds.CreateModifyReadWriteSplittingConnectionRequest()
 	request.RegionId = client.RegionId
@@ -191,7 +192,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
 	update := false
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 	}
 
 	if !update && d.IsNewResource() {
-		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+		return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 	}
 
 	if d.HasChange("weight") {
@@ -227,7 +228,7 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 			return WrapError(err)
 		}
 
-		if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 				return rdsClient.ModifyReadWriteSplittingConnection(request)
 			})
@@ -249,12 +250,12 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionUpdate(d *schema.Res
 		}
 	}
 
-	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(d, meta)
+	return resourceAlibabacloudStackDBReadWriteSplittingConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rdsService := RdsService{client}
+	rdsService := RdsService{client, ctx}
 	request := rds.CreateReleaseReadWriteSplittingConnectionRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -263,10 +264,10 @@ func resourceAlibabacloudStackDBReadWriteSplittingConnectionDelete(d *schema.Res
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	if err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 
 		raw, err := client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.ReleaseReadWriteSplittingConnection(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -29,13 +30,13 @@ func TestAccAlibabacloudStackDBReadWriteSplittingConnection_update(t *testing.T)
 	ra := resourceAttrInit(resourceId, DBReadWriteMap)
 
 	rc_connection := resourceCheckInitWithDescribeMethod(resourceId, &connection, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBReadWriteSplittingConnection")
 	rc_primary := resourceCheckInitWithDescribeMethod("alibabacloudstack_db_instance.default", &primary, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBInstance")
 	rc_readonly := resourceCheckInitWithDescribeMethod("alibabacloudstack_db_readonly_instance.default", &readonly, func() interface{} {
-		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDBReadonlyInstance")
 	rand := acctest.RandIntRange(10000, 999999)
 
@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDbsBackupPlanCreate,
-		Read:   resourceAlibabacloudStackDbsBackupPlanRead,
-		Update: resourceAlibabacloudStackDbsBackupPlanUpdate,
-		Delete: resourceAlibabacloudStackDbsBackupPlanDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDbsBackupPlanRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDbsBackupPlanDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"backup_plan_id": {
@@ -69,7 +68,7 @@ func resourceAlibabacloudStackDbsBackupPlan() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateBackupPlan"
@@ -113,18 +112,7 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 	request["Product"] = "dbs"
 	request["ClientToken"] = buildClientToken("CreateBackupPlan")
 	request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dbs_backup_plan", action, AlibabacloudStackSdkGoERROR)
@@ -132,12 +120,12 @@ func resourceAlibabacloudStackDbsBackupPlanCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response["BackupPlanId"]))
 
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dbsService := DbsService{client}
+	dbsService := DbsService{client, ctx}
 	object, err := dbsService.DescribeDbsBackupPlan(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -161,7 +149,7 @@ func resourceAlibabacloudStackDbsBackupPlanRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := make(map[string]interface{})
 	var response map[string]interface{}
@@ -180,26 +168,15 @@ func resourceAlibabacloudStackDbsBackupPlanUpdate(d *schema.ResourceData, meta i
 	if err != nil {
 		return WrapError(err)
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-06"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackDbsBackupPlanRead(d, meta)
+	return resourceAlibabacloudStackDbsBackupPlanRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDbsBackupPlanDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDbsBackupPlanDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// 没有接口
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackDbsBackupPlan_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_dbs_backup_plan.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDbsBackupPlanMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DbsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DbsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDbsBackupPlan")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -16,14 +17,15 @@ import (
 
 func resourceAlibabacloudStackDisk() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskCreate,
-		Read:   resourceAlibabacloudStackDiskRead,
-		Update: resourceAlibabacloudStackDiskUpdate,
-		Delete: resourceAlibabacloudStackDiskDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDiskCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDiskRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDiskUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDiskDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -95,14 +97,15 @@ func resourceAlibabacloudStackDisk() *schema.Resource {
 				Computed: true,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	availabilityZone, err := ecsService.DescribeZone(d.Get("availability_zone").(string))
 	if err != nil {
@@ -118,7 +121,7 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 	request.RegionId = client.RegionId
 	request.ZoneId = availabilityZone.ZoneId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("category"); ok && v.(string) != "" {
 		category := DiskCategory(v.(string))
@@ -152,7 +155,7 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 			}
 		}
 	}
-	if v, ok := d.GetOk("tags"); ok && len(v.(map[string]interface{})) > 0 {
+	if v, ok := d.GetOk("tags_all"); ok && len(v.(map[string]interface{})) > 0 {
 		tags := make([]ecs.CreateDiskTag, len(v.(map[string]interface{})))
 		for key, value := range v.(map[string]interface{}) {
 			tags = append(tags, ecs.CreateDiskTag{
@@ -176,13 +179,13 @@ func resourceAlibabacloudStackDiskCreate(d *schema.ResourceData, meta interface{
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackDiskUpdate(d, meta)
+	return resourceAlibabacloudStackDiskUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeDisk(d.Id())
 
 	if err != nil {
@@ -205,12 +208,14 @@ func resourceAlibabacloudStackDiskRead(d *schema.ResourceData, meta interface{})
 	d.Set("delete_auto_snapshot", object.DeleteAutoSnapshot)
 	d.Set("delete_with_instance", object.DeleteWithInstance)
 	d.Set("enable_auto_snapshot", object.EnableAutoSnapshot)
-	d.Set("tags", ecsService.tagsToMap(object.Tags.Tag))
+	if err := setResourceTags(d, meta, ecsService.tagsToMap(object.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -219,7 +224,7 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 	request := ecs.CreateModifyDiskAttributeRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -271,10 +276,10 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackDiskRead(d, meta)
+		return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 	}
 
-	err := setTags(client, TagResourceDisk, d)
+	err := setTags(ctx, client, TagResourceDisk, d)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -285,7 +290,7 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 		request := ecs.CreateResizeDiskRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DiskId = d.Id()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -311,16 +316,16 @@ func resourceAlibabacloudStackDiskUpdate(d *schema.ResourceData, meta interface{
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackDiskRead(d, meta)
+	return resourceAlibabacloudStackDiskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDeleteDiskRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RegionId = client.RegionId
 	request.DiskId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -328,7 +333,7 @@ func resourceAlibabacloudStackDiskDelete(d *schema.ResourceData, meta interface{
 	} else {
 		request.Scheme = "http"
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteDisk(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,9 +15,12 @@ import (
 
 func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDiskAttachmentCreate,
-		Read:   resourceAlibabacloudStackDiskAttachmentRead,
-		Delete: resourceAlibabacloudStackDiskAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDiskAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDiskAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDiskAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -40,9 +44,9 @@ func resourceAlibabacloudStackDiskAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	diskID := d.Get("disk_id").(string)
 	instanceID := d.Get("instance_id").(string)
@@ -59,10 +63,10 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = diskID
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachDisk(request)
 		})
@@ -97,7 +101,7 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DiskId = diskID
 		request.DeleteAutoSnapshot = requests.NewBoolean(oldDisk.DeleteAutoSnapshot)
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -108,13 +112,13 @@ func resourceAlibabacloudStackDiskAttachmentCreate(d *schema.ResourceData, meta
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackDiskAttachmentRead(d, meta)
+	return resourceAlibabacloudStackDiskAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	disk, err := ecsService.DescribeDiskAttachment(d.Id())
 
 	if err != nil {
@@ -136,9 +140,9 @@ func resourceAlibabacloudStackDiskAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -151,11 +155,11 @@ func resourceAlibabacloudStackDiskAttachmentDelete(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = parts[1]
 	request.DiskId = parts[0]
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachDisk(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -15,7 +16,7 @@ func TestAccAlibabacloudStackDiskAttachment(t *testing.T) {
 	var v ecs.Disk
 	var attachment ecs.Disk
 	serverFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	diskRc := resourceCheckInit("alibabacloudstack_disk.default", &v, serverFunc)
 
@@ -65,7 +66,7 @@ func TestAccAlibabacloudStackDiskMultiAttachment(t *testing.T) {
 	var v ecs.Disk
 	var attachment ecs.Disk
 	serverFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	diskRc := resourceCheckInit("alibabacloudstack_disk.default.1", &v, serverFunc)
 
@@ -106,7 +107,7 @@ func testAccCheckDiskAttachmentDestroy(s *terraform.State) error {
 		}
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 		_, err := ecsService.DescribeDiskAttachment(rs.Primary.ID)
 
 		if err != nil {
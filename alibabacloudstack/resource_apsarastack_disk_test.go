@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -39,7 +40,7 @@ func testSweepDisks(region string) error {
 	req := ecs.CreateDescribeDisksRequest()
 	req.RegionId = client.RegionId
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -90,7 +91,7 @@ func testSweepDisks(region string) error {
 		log.Printf("[INFO] Deleting Disk: %s (%s)", name, id)
 		req := ecs.CreateDeleteDiskRequest()
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.QueryParams["Department"] = client.Department
 		req.QueryParams["ResourceGroup"] = client.ResourceGroup
 		req.DiskId = id
@@ -118,7 +119,7 @@ func testAccCheckDiskDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 
 		_, err := ecsService.DescribeDisk(rs.Primary.ID)
 
@@ -137,7 +138,7 @@ func TestAccAlibabacloudStackDisk_basic(t *testing.T) {
 	var v ecs.Disk
 	resourceId := "alibabacloudstack_disk.default"
 	serverFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serverFunc)
 	ra := resourceAttrInit(resourceId, testAccCheckResourceDiskBasicMap)
@@ -238,7 +239,7 @@ func TestAccAlibabacloudStackDisk_multi(t *testing.T) {
 	var v ecs.Disk
 	resourceId := "alibabacloudstack_disk.default.4"
 	serverFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serverFunc)
 	ra := resourceAttrInit(resourceId, testAccCheckResourceDiskBasicMap)
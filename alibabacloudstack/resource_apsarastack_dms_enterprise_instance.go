@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseInstanceCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseInstanceRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseInstanceUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "RegisterInstance"
@@ -229,13 +230,13 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 	}
 
 	wait := incrementalWait(3*time.Second, 2*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		request["RegionId"] = client.RegionId
 		request["Product"] = "dms-enterprise"
 		request["OrganizationId"] = client.Department
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"RegisterInstanceFailure"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"RegisterInstanceFailure"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -250,11 +251,11 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceCreate(d *schema.ResourceData
 
 	d.SetId(fmt.Sprint(request["Host"], ":", request["Port"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dms_enterpriseService := Dms_enterpriseService{client}
+	dms_enterpriseService := Dms_enterpriseService{client, ctx}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseInstance(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -294,7 +295,7 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceRead(d *schema.ResourceData,
 
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -397,29 +398,18 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceUpdate(d *schema.ResourceData
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			request["RegionId"] = client.RegionId
-			request["Product"] = "dms-enterprise"
-			request["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		request["RegionId"] = client.RegionId
+		request["Product"] = "dms-enterprise"
+		request["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -442,22 +432,11 @@ func resourceAlibabacloudStackDmsEnterpriseInstanceDelete(d *schema.ResourceData
 	if v, ok := d.GetOk("tid"); ok {
 		request["Tid"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		request["RegionId"] = client.RegionId
-		request["Product"] = "dms-enterprise"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	request["RegionId"] = client.RegionId
+	request["Product"] = "dms-enterprise"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InstanceNoEnoughNumber"}) {
 			return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -106,7 +107,7 @@ func TestAccAlibabacloudStackDmsEnterprise(t *testing.T) {
 	ra := resourceAttrInit(resourceId, testAccCheckKeyValueInMapsForDMS)
 
 	serviceFunc := func() interface{} {
-		return &Dms_enterpriseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &Dms_enterpriseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDmsEnterpriseUserCreate,
-		Read:   resourceAlibabacloudStackDmsEnterpriseUserRead,
-		Update: resourceAlibabacloudStackDmsEnterpriseUserUpdate,
-		Delete: resourceAlibabacloudStackDmsEnterpriseUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDmsEnterpriseUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"max_execute_count": {
@@ -73,7 +72,7 @@ func resourceAlibabacloudStackDmsEnterpriseUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "RegisterUser"
@@ -101,32 +100,21 @@ func resourceAlibabacloudStackDmsEnterpriseUserCreate(d *schema.ResourceData, me
 		request["UserNick"] = v
 	}
 	request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		request["Product"] = "dms-enterprise"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	request["Product"] = "dms-enterprise"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dms_enterprise_user", action, AlibabacloudStackSdkGoERROR)
 	}
 
 	d.SetId(fmt.Sprint(request["Uid"]))
 
-	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dms_enterpriseService := Dms_enterpriseService{client}
+	dms_enterpriseService := Dms_enterpriseService{client, ctx}
 	object, err := dms_enterpriseService.DescribeDmsEnterpriseUser(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -145,9 +133,9 @@ func resourceAlibabacloudStackDmsEnterpriseUserRead(d *schema.ResourceData, meta
 	d.Set("nick_name", object["NickName"])
 	return nil
 }
-func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dms_enterpriseService := Dms_enterpriseService{client}
+	dms_enterpriseService := Dms_enterpriseService{client, ctx}
 	var response map[string]interface{}
 	d.Partial(true)
 
@@ -186,22 +174,11 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			request["RegionId"] = client.RegionId
-			request["Product"] = "dms-enterprise"
-			request["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		request["RegionId"] = client.RegionId
+		request["Product"] = "dms-enterprise"
+		request["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
@@ -229,22 +206,11 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 				if err != nil {
 					return WrapError(err)
 				}
-				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-					request["RegionId"] = client.RegionId
-					request["Product"] = "dms-enterprise"
-					request["OrganizationId"] = client.Department
-					response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-					if err != nil {
-						if NeedRetry(err) {
-							wait()
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					addDebug(action, response, request)
-					return nil
-				})
+				request["RegionId"] = client.RegionId
+				request["Product"] = "dms-enterprise"
+				request["OrganizationId"] = client.Department
+				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+				addDebug(action, response, request)
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 				}
@@ -261,22 +227,11 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 				if err != nil {
 					return WrapError(err)
 				}
-				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-					request["RegionId"] = client.RegionId
-					request["Product"] = "dms-enterprise"
-					request["OrganizationId"] = client.Department
-					response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-					if err != nil {
-						if NeedRetry(err) {
-							wait()
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					addDebug(action, response, request)
-					return nil
-				})
+				request["RegionId"] = client.RegionId
+				request["Product"] = "dms-enterprise"
+				request["OrganizationId"] = client.Department
+				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+				addDebug(action, response, request)
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 				}
@@ -285,9 +240,9 @@ func resourceAlibabacloudStackDmsEnterpriseUserUpdate(d *schema.ResourceData, me
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDmsEnterpriseUserRead(d, meta)
+	return resourceAlibabacloudStackDmsEnterpriseUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDmsEnterpriseUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUser"
 	var response map[string]interface{}
@@ -302,22 +257,11 @@ func resourceAlibabacloudStackDmsEnterpriseUserDelete(d *schema.ResourceData, me
 	if v, ok := d.GetOk("tid"); ok {
 		request["Tid"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		request["RegionId"] = client.RegionId
-		request["Product"] = "dms-enterprise"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	request["RegionId"] = client.RegionId
+	request["Product"] = "dms-enterprise"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2018-11-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
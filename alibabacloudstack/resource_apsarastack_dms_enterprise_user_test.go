@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -103,7 +104,7 @@ func TestAccAlibabacloudStackDMSEnterpriseUser_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_dms_enterprise_user.default"
 	ra := resourceAttrInit(resourceId, DmsEnterpriseUserMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &Dms_enterpriseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &Dms_enterpriseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDmsEnterpriseUser")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainCreate,
-		Read:   resourceAlibabacloudStackDnsDomainRead,
-		Update: resourceAlibabacloudStackDnsDomainUpdate,
-		Delete: resourceAlibabacloudStackDnsDomainDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDnsDomainRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsDomainDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"dns_servers": {
@@ -57,9 +58,9 @@ func resourceAlibabacloudStackDnsDomain() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 	var requestInfo *ecs.Client
 	DomainName := d.Get("domain_name").(string)
 	check, err := dnsService.DescribeDnsDomain(DomainName)
@@ -81,13 +82,12 @@ func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta inter
 		request.ApiName = "AddGlobalZone"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "AddGlobalZone",
-			"Version":         "2022-06-24",
-			"Name":            DomainName,
+			"AccessKeyId": client.AccessKey,
+			"Product":     "CloudDns",
+			"RegionId":    client.RegionId,
+			"Action":      "AddGlobalZone",
+			"Version":     "2022-06-24",
+			"Name":        DomainName,
 		}
 		raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 			return dnsClient.ProcessCommonRequest(request)
@@ -116,12 +116,12 @@ func resourceAlibabacloudStackDnsDomainCreate(d *schema.ResourceData, meta inter
 	//d.SetId(id)
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].Id))
 	//d.SetId(DomainName)
-	return resourceAlibabacloudStackDnsDomainUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 	object, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
 	if err != nil {
@@ -136,9 +136,9 @@ func resourceAlibabacloudStackDnsDomainRead(d *schema.ResourceData, meta interfa
 	d.Set("remark", object.Data[0].Remark)
 	return nil
 }
-func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 	remarkUpdate := false
 	check, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -176,15 +176,14 @@ func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta inter
 	request.RegionId = client.RegionId
 
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        client.RegionId,
-		"Action":          "UpdateGlobalZoneRemark",
-		"Version":         "2021-06-24",
-		"Name":            did[0],
-		"Id":              did[1],
-		"Remark":          desc,
+		"AccessKeyId": client.AccessKey,
+		"Product":     "CloudDns",
+		"RegionId":    client.RegionId,
+		"Action":      "UpdateGlobalZoneRemark",
+		"Version":     "2021-06-24",
+		"Name":        did[0],
+		"Id":          did[1],
+		"Remark":      desc,
 	}
 	if remarkUpdate {
 
@@ -200,11 +199,11 @@ func resourceAlibabacloudStackDnsDomainUpdate(d *schema.ResourceData, meta inter
 	}
 	d.SetId(check.Data[0].Name + COLON_SEPARATED + fmt.Sprint(check.Data[0].Id))
 	//d.SetId(did[0])
-	return resourceAlibabacloudStackDnsDomainRead(d, meta)
+	return resourceAlibabacloudStackDnsDomainRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDnsDomainDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := dnsService.DescribeDnsDomain(d.Id())
 	did := strings.Split(d.Id(), COLON_SEPARATED)
@@ -227,13 +226,12 @@ func resourceAlibabacloudStackDnsDomainDelete(d *schema.ResourceData, meta inter
 		request.ApiName = "DeleteGlobalZone"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "DeleteGlobalZone",
-			"Version":         "2021-06-24",
-			"Id":              did[1],
+			"AccessKeyId": client.AccessKey,
+			"Product":     "CloudDns",
+			"RegionId":    client.RegionId,
+			"Action":      "DeleteGlobalZone",
+			"Version":     "2021-06-24",
+			"Id":          did[1],
 		}
 		_, err := client.WithEcsClient(func(csClient *ecs.Client) (interface{}, error) {
 			return csClient.ProcessCommonRequest(request)
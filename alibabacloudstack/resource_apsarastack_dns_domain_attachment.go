@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
@@ -10,12 +11,12 @@ import (
 
 func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsDomainAttachmentCreate,
-		Read:   resourceApasaraStackDnsDomainAttachmentRead,
-		Update: resourceAlibabacloudStackDnsDomainAttachmentUpdate,
-		Delete: resourceAlibabacloudStackDnsdomainAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceApasaraStackDnsDomainAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsDomainAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsdomainAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -32,14 +33,14 @@ func resourceAlibabacloudStackDnsDomainAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsDomainAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("instance_id").(string))
-	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceApasaraStackDnsDomainAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 
 	object, err := dnsService.DescribeDnsDomainAttachment(d.Id())
 	if err != nil {
@@ -54,9 +55,9 @@ func resourceApasaraStackDnsDomainAttachmentRead(d *schema.ResourceData, meta in
 	return nil
 
 }
-func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsDomainAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 
 	o, n := d.GetChange("domain_names")
 	oldmap := make(map[string]string)
@@ -84,7 +85,7 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 		removeNames := strings.Join(remove, ",")
 		request := alidns.CreateUnbindInstanceDomainsRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = client.Department
 		request.QueryParams["ResourceGroup"] = client.ResourceGroup
 		request.InstanceId = d.Id()
@@ -101,7 +102,7 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 		addNames := strings.Join(add, ",")
 		request := alidns.CreateBindInstanceDomainsRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = client.Department
 		request.QueryParams["ResourceGroup"] = client.ResourceGroup
 		request.InstanceId = d.Id()
@@ -117,12 +118,12 @@ func resourceAlibabacloudStackDnsDomainAttachmentUpdate(d *schema.ResourceData,
 	if err := dnsService.WaitForAlidnsDomainAttachment(d.Id(), map[string]interface{}{"Domain": d.Get("domain_names").(*schema.Set).List()}, false, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceApasaraStackDnsDomainAttachmentRead(d, meta)
+	return resourceApasaraStackDnsDomainAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsdomainAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 
 	domainNames := d.Get("domain_names").(*schema.Set).List()
 	deleteSli := make([]string, 0)
@@ -132,7 +133,7 @@ func resourceAlibabacloudStackDnsdomainAttachmentDelete(d *schema.ResourceData,
 
 	request := alidns.CreateUnbindInstanceDomainsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.InstanceId = d.Id()
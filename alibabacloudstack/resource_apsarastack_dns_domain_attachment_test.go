@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -20,7 +21,7 @@ func TestAccAlibabacloudStackDnsDomainAttachment_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, dnsDomainAttachmnetMap)
 
 	serviceFunc := func() interface{} {
-		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
 	"testing"
@@ -51,7 +52,7 @@ func TestAccAlibabacloudStackDnsDomain_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_dns_domain.default"
 	ra := resourceAttrInit(resourceId, dnsDomainBasicMap)
 	serviceFunc := func() interface{} {
-		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
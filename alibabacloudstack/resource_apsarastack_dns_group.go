@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strings"
@@ -14,10 +15,13 @@ import (
 
 func resourceAlibabacloudStackDnsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsGroupCreate,
-		Read:   resourceAlibabacloudStackDnsGroupRead,
-		Update: resourceAlibabacloudStackDnsGroupUpdate,
-		Delete: resourceAlibabacloudStackDnsGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDnsGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsGroupDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -28,7 +32,7 @@ func resourceAlibabacloudStackDnsGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//request := alidns.CreateAddDomainGroupRequest()
 	GroupName := d.Get("name").(string)
@@ -45,13 +49,12 @@ func resourceAlibabacloudStackDnsGroupCreate(d *schema.ResourceData, meta interf
 	request.ApiName = "AddDomainGroup"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "GenesisDns",
-		"RegionId":        client.RegionId,
-		"Action":          "AddDomainGroup",
-		"Version":         "2018-07-20",
-		"GroupName":       GroupName,
+		"AccessKeyId": client.AccessKey,
+		"Product":     "GenesisDns",
+		"RegionId":    client.RegionId,
+		"Action":      "AddDomainGroup",
+		"Version":     "2018-07-20",
+		"GroupName":   GroupName,
 	}
 	raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 		return dnsClient.ProcessCommonRequest(request)
@@ -62,15 +65,15 @@ func resourceAlibabacloudStackDnsGroupCreate(d *schema.ResourceData, meta interf
 	addDebug(request.GetActionName(), raw, request)
 	response, _ := raw.(*alidns.AddDomainGroupResponse)
 	d.SetId(response.GroupId)
-	return resourceAlibabacloudStackDnsGroupRead(d, meta)
+	return resourceAlibabacloudStackDnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := alidns.CreateUpdateDomainGroupRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "GenesisDns"}
+	request.QueryParams = map[string]string{"Product": "GenesisDns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.RegionId = client.RegionId
@@ -87,13 +90,13 @@ func resourceAlibabacloudStackDnsGroupUpdate(d *schema.ResourceData, meta interf
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackDnsGroupRead(d, meta)
+	return resourceAlibabacloudStackDnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := &DnsService{client: client}
+	dnsService := &DnsService{client: client, ctx: ctx}
 	object, err := dnsService.DescribeDnsGroup(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -106,18 +109,18 @@ func resourceAlibabacloudStackDnsGroupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackDnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := alidns.CreateDeleteDomainGroupRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 	request.RegionId = client.RegionId
 	request.GroupId = d.Id()
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithDnsClient(func(dnsClient *alidns.Client) (interface{}, error) {
 			return dnsClient.DeleteDomainGroup(request)
 		})
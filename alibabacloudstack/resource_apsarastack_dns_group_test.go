@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -32,7 +33,7 @@ func testSweepDnsGroup(region string) error {
 
 	request := alidns.CreateDescribeDomainGroupsRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = client.Department
 	request.QueryParams["ResourceGroup"] = client.ResourceGroup
 
@@ -68,7 +69,7 @@ func testSweepDnsGroup(region string) error {
 
 	removeRequest := alidns.CreateDeleteDomainGroupRequest()
 	removeRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	removeRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "alidns"}
+	removeRequest.QueryParams = map[string]string{"Product": "alidns"}
 	removeRequest.QueryParams["Department"] = client.Department
 	removeRequest.QueryParams["ResourceGroup"] = client.ResourceGroup
 
@@ -90,7 +91,7 @@ func TestAccAlibabacloudStackDnsGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_dns_group.default"
 	var v alidns.DomainGroup
 	serviceFunc := func() interface{} {
-		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	ra := resourceAttrInit(resourceId, nil)
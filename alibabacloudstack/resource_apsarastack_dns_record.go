@@ -1,27 +1,26 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
-	"time"
 )
 
 func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDnsRecordCreate,
-		Read:   resourceAlibabacloudStackDnsRecordRead,
-		Update: resourceAlibabacloudStackDnsRecordUpdate,
-		Delete: resourceAlibabacloudStackDnsRecordDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDnsRecordCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDnsRecordRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDnsRecordUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDnsRecordDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +65,7 @@ func resourceAlibabacloudStackDnsRecord() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var requestInfo *ecs.Client
 	request := make(map[string]interface{})
@@ -103,30 +102,19 @@ func resourceAlibabacloudStackDnsRecordCreate(d *schema.ResourceData, meta inter
 	request["ClientToken"] = buildClientToken("AddGlobalZoneRecord")
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
 
 	addDebug("AddGlobalZoneRecord", response, request)
 	d.SetId(fmt.Sprint(ZoneId))
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	dnsService := &DnsService{client: client}
+	dnsService := &DnsService{client: client, ctx: ctx}
 	object, err := dnsService.DescribeDnsRecord(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -145,9 +133,9 @@ func resourceAlibabacloudStackDnsRecordRead(d *schema.ResourceData, meta interfa
 
 	return nil
 }
-func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dnsService := DnsService{client}
+	dnsService := DnsService{client, ctx}
 	ID := d.Get("record_id").(int)
 	ZoneId := d.Get("zone_id").(int)
 	Name := d.Get("name").(string)
@@ -180,14 +168,13 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 		request.RegionId = client.RegionId
 
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "CloudDns",
-			"RegionId":        client.RegionId,
-			"Action":          "UpdateGlobalZoneRecordRemark",
-			"Version":         "2021-06-24",
-			"Id":              fmt.Sprint(ID),
-			"Remark":          desc,
+			"AccessKeyId": client.AccessKey,
+			"Product":     "CloudDns",
+			"RegionId":    client.RegionId,
+			"Action":      "UpdateGlobalZoneRecordRemark",
+			"Version":     "2021-06-24",
+			"Id":          fmt.Sprint(ID),
+			"Remark":      desc,
 		}
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.ProcessCommonRequest(request)
@@ -277,27 +264,16 @@ func resourceAlibabacloudStackDnsRecordUpdate(d *schema.ResourceData, meta inter
 		request["ClientToken"] = buildClientToken("UpdateGlobalZoneRecord")
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequesttowpoint1(StringPointer(action), nil, StringPointer("POST"), StringPointer("2021-06-24"), StringPointer("AK"), nil, request, &runtime)
 
 		addDebug("UpdateGlobalZoneRecord", response, request)
 
 	}
 
-	return resourceAlibabacloudStackDnsRecordRead(d, meta)
+	return resourceAlibabacloudStackDnsRecordRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDnsRecordDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDnsRecordDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ID := d.Get("record_id").(int)
 	ZoneId := d.Get("zone_id").(int)
@@ -314,14 +290,13 @@ func resourceAlibabacloudStackDnsRecordDelete(d *schema.ResourceData, meta inter
 	request.ApiName = "DeleteGlobalZoneRecord"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        client.RegionId,
-		"Action":          "DeleteGlobalZoneRecord",
-		"Version":         "2021-06-24",
-		"Id":              fmt.Sprint(ID),
-		"ZoneId":          fmt.Sprint(ZoneId),
+		"AccessKeyId": client.AccessKey,
+		"Product":     "CloudDns",
+		"RegionId":    client.RegionId,
+		"Action":      "DeleteGlobalZoneRecord",
+		"Version":     "2021-06-24",
+		"Id":          fmt.Sprint(ID),
+		"ZoneId":      fmt.Sprint(ZoneId),
 	}
 	raw, err := client.WithEcsClient(func(dnsClient *ecs.Client) (interface{}, error) {
 		return dnsClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
@@ -52,7 +53,7 @@ func TestAccAlibabacloudStackDnsRecord_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_dns_record.default"
 	ra := resourceAttrInit(resourceId, dnsRecordBasicMap)
 	serviceFunc := func() interface{} {
-		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
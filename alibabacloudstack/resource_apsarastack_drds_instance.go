@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDRDSInstanceCreate,
-		Read:   resourceAlibabacloudStackDRDSInstanceRead,
-		Update: resourceAlibabacloudStackDRDSInstanceUpdate,
-		Delete: resourceAlibabacloudStackDRDSInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDRDSInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDRDSInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDRDSInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDRDSInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -64,9 +65,9 @@ func resourceAlibabacloudStackDRDSInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDRDSInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	drdsService := DrdsService{client}
+	drdsService := DrdsService{client, ctx}
 
 	request := drds.CreateCreateDrdsInstanceRequest()
 	request.RegionId = client.RegionId
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta in
 
 	if request.VswitchId != "" {
 
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, ctx}
 		vsw, err := vpcService.DescribeVSwitch(request.VswitchId)
 		if err != nil {
 			return WrapError(err)
@@ -99,7 +100,7 @@ func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta in
 		request.PayType = "drdsPre"
 	}
 	request.ResourceGroupId = client.ResourceGroup
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Headers["x-ascm-product-name"] = "Drds"
 	request.Headers["x-acs-organizationId"] = client.Department
 	raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
@@ -118,17 +119,17 @@ func resourceAlibabacloudStackDRDSInstanceCreate(d *schema.ResourceData, meta in
 
 	// wait instance status change from DO_CREATE to RUN
 	stateConf := BuildStateConf([]string{"DO_CREATE"}, []string{"RUN"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackDRDSInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackDRDSInstanceUpdate(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDRDSInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	drdsService := DrdsService{client}
+	drdsService := DrdsService{client, ctx}
 
 	configItem := make(map[string]string)
 	if d.HasChange("description") {
@@ -140,7 +141,7 @@ func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta in
 		request.RegionId = client.RegionId
 		request.Headers["x-ascm-product-name"] = "Drds"
 		request.Headers["x-acs-organizationId"] = client.Department
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "Drds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithDrdsClient(func(drdsClient *drds.Client) (interface{}, error) {
 			return drdsClient.ModifyDrdsInstanceDescription(request)
 		})
@@ -156,16 +157,16 @@ func resourceAlibabacloudStackDRDSInstanceUpdate(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"RUN"}, d.Timeout(schema.TimeoutUpdate), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackDRDSInstanceRead(d, meta)
+	return resourceAlibabacloudStackDRDSInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackDRDSInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDRDSInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	drdsService := DrdsService{client}
+	drdsService := DrdsService{client, ctx}
 
 	object, err := drdsService.DescribeDrdsInstance(d.Id())
 	if err != nil {
@@ -183,9 +184,9 @@ func resourceAlibabacloudStackDRDSInstanceRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackDRDSInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDRDSInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	drdsService := DrdsService{client}
+	drdsService := DrdsService{client, ctx}
 	request := drds.CreateRemoveDrdsInstanceRequest()
 	request.RegionId = client.RegionId
 	request.DrdsInstanceId = d.Id()
@@ -210,7 +211,7 @@ func resourceAlibabacloudStackDRDSInstanceDelete(d *schema.ResourceData, meta in
 
 	//0 -> RUN, 1->DO_CREATE, 2->EXCEPTION, 3->EXPIRE, 4->DO_RELEASE, 5->RELEASE, 6->UPGRADE, 7->DOWNGRADE, 10->VersionUpgrade, 11->VersionRollback, 14->RESTART
 	stateConf := BuildStateConf([]string{"RUN", "DO_CREATE", "EXCEPTION", "EXPIRE", "DO_RELEASE", "RELEASE", "UPGRADE", "DOWNGRADE", "VersionUpgrade", "VersionRollback", "RESTART"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, drdsService.DrdsInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
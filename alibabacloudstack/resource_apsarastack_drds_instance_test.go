@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -49,7 +50,7 @@ func testSweepDRDSInstances(region string) error {
 	}
 	response, _ := raw.(*drds.DescribeDrdsInstancesResponse)
 
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, v := range response.Instances.Instance {
 		name := v.Description
 		id := v.DrdsInstanceId
@@ -108,7 +109,7 @@ func TestAccAlibabacloudStackDRDSInstance_Vpc(t *testing.T) {
 	ra := resourceAttrInit(resourceId, drdsInstancebasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DrdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DrdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -180,7 +181,7 @@ func TestAccAlibabacloudStackDRDSInstance_Multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, drdsInstancebasicMap)
 
 	serviceFunc := func() interface{} {
-		return &DrdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DrdsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -9,23 +10,23 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSubscriptionJobCreate,
-		Read:   resourceAlibabacloudStackDtsSubscriptionJobRead,
-		Update: resourceAlibabacloudStackDtsSubscriptionJobUpdate,
-		Delete: resourceAlibabacloudStackDtsSubscriptionJobDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDtsSubscriptionJobDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(5 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"checkpoint": {
 				Type:     schema.TypeString,
@@ -196,12 +197,13 @@ func resourceAlibabacloudStackDtsSubscriptionJob() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateDtsInstance"
@@ -249,18 +251,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 	if v, ok := d.GetOk("payment_duration"); ok {
 		request["UsedTime"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_subscription_job", action, AlibabacloudStackSdkGoERROR)
@@ -269,11 +260,11 @@ func resourceAlibabacloudStackDtsSubscriptionJobCreate(d *schema.ResourceData, m
 	d.SetId(fmt.Sprint(response["JobId"]))
 	d.Set("dts_instance_id", response["InstanceId"])
 
-	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dtsService := DtsService{client}
+	dtsService := DtsService{client, ctx}
 	object, err := dtsService.DescribeDtsSubscriptionJob(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -314,17 +305,19 @@ func resourceAlibabacloudStackDtsSubscriptionJobRead(d *schema.ResourceData, met
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", tagsToMap(listTagResourcesObject))
+	if err := setResourceTags(d, meta, tagsToMap(listTagResourcesObject)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dtsService := DtsService{client}
+	dtsService := DtsService{client, ctx}
 	var response map[string]interface{}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := dtsService.SetResourceTags(d, "ALIYUN::DTS::INSTANCE"); err != nil {
 			return WrapError(err)
 		}
@@ -351,18 +344,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -396,18 +378,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, modifyDtsJobPasswordReq, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, modifyDtsJobPasswordReq, &util.RuntimeOptions{})
 		addDebug(action, response, modifyDtsJobPasswordReq)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -419,7 +390,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		//d.SetPartial("source_endpoint_user_name")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
@@ -427,7 +398,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 
 	if !d.IsNewResource() && d.HasChange("status") {
 		target := d.Get("status").(string)
-		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err := resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
@@ -578,18 +549,7 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, configureSubscriptionReq, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, configureSubscriptionReq, &util.RuntimeOptions{})
 		addDebug(action, response, configureSubscriptionReq)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -616,15 +576,15 @@ func resourceAlibabacloudStackDtsSubscriptionJobUpdate(d *schema.ResourceData, m
 		//d.SetPartial("subscription_instance_vswitch_id")
 
 		target := d.Get("status").(string)
-		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d, meta, target)
+		err = resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx, d, meta, target)
 		if err != nil {
 			return WrapError(Error(FailedToReachTargetStatus, d.Get("status")))
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackDtsSubscriptionJobRead(d, meta)
+	return resourceAlibabacloudStackDtsSubscriptionJobRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSubscriptionJobDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
@@ -652,27 +612,16 @@ func resourceAlibabacloudStackDtsSubscriptionJobDelete(d *schema.ResourceData, m
 	if v, ok := d.GetOk("synchronization_direction"); ok {
 		request["SynchronizationDirection"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	return nil
 }
-func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceData, meta interface{}, target string) error {
+func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(ctx context.Context, d *schema.ResourceData, meta interface{}, target string) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dtsService := DtsService{client}
+	dtsService := DtsService{client, ctx}
 	var response map[string]interface{}
 	object, err := dtsService.DescribeDtsSubscriptionJob(d.Id())
 	if err != nil {
@@ -692,24 +641,13 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 			if err != nil {
 				return WrapError(err)
 			}
-			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-				if err != nil {
-					if NeedRetry(err) {
-						wait()
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			addDebug(action, response, request)
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"NotConfigured"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -726,24 +664,13 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 			if err != nil {
 				return WrapError(err)
 			}
-			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-				if err != nil {
-					if NeedRetry(err) {
-						wait()
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			addDebug(action, response, request)
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Starting", "Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -760,24 +687,13 @@ func resourceAlibabacloudStackDtsSubscriptionJobStatusFlow(d *schema.ResourceDat
 			if err != nil {
 				return WrapError(err)
 			}
-			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-				if err != nil {
-					if NeedRetry(err) {
-						wait()
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			addDebug(action, response, request)
 			if err != nil {
 				return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 			}
 			stateConf := BuildStateConf([]string{}, []string{"Abnormal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, dtsService.DtsSubscriptionJobStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -1,11 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"testing"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -52,18 +52,7 @@ func testSweepDtsSubscriptionJob(region string) error {
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(2*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			log.Printf("[ERROR] Failed to fetch Dts SubscriptionJobs: %s", WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_dts_subscription_jobs", action, AlibabacloudStackSdkGoERROR))
@@ -115,7 +104,7 @@ func TestAccAlibabacloudStackDTSSubscriptionJob_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_dts_subscription_job.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDTSSubscriptionJobMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDtsSubscriptionJob")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -272,7 +261,7 @@ func TestAccAlibabacloudStackDTSSubscriptionJob_basic1(t *testing.T) {
 	resourceId := "alibabacloudstack_dts_subscription_job.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDTSSubscriptionJobMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDtsSubscriptionJob")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -402,7 +391,7 @@ func TestAccAlibabacloudStackDTSSubscriptionJob_basic2(t *testing.T) {
 	resourceId := "alibabacloudstack_dts_subscription_job.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDTSSubscriptionJobMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDtsSubscriptionJob")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
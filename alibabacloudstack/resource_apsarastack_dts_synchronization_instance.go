@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackDtsSynchronizationInstanceCreate,
-		Read:   resourceAlibabacloudStackDtsSynchronizationInstanceRead,
-		Update: resourceAlibabacloudStackDtsSynchronizationInstanceUpdate,
-		Delete: resourceAlibabacloudStackDtsSynchronizationInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackDtsSynchronizationInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"compute_unit": {
@@ -91,7 +90,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateDtsInstance"
@@ -149,18 +148,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 	if v, ok := d.GetOk("payment_duration"); ok {
 		request["UsedTime"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_dts_synchronization_instance", action, AlibabacloudStackSdkGoERROR)
@@ -168,11 +156,11 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceCreate(d *schema.Resourc
 
 	d.SetId(fmt.Sprint(response["InstanceId"]))
 
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	dtsService := DtsService{client}
+	dtsService := DtsService{client, ctx}
 	object, err := dtsService.DescribeDtsSynchronizationInstance(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -186,11 +174,11 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceRead(d *schema.ResourceD
 	d.Set("payment_type", convertDtsSyncPaymentTypeResponse(object["PayType"]))
 	return nil
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	log.Println(fmt.Sprintf("[WARNING] The resouce has not update operation."))
-	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(d, meta)
+	return resourceAlibabacloudStackDtsSynchronizationInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if v, ok := d.GetOk("payment_type"); ok {
 		if v.(string) == "Subscription" {
@@ -213,18 +201,7 @@ func resourceAlibabacloudStackDtsSynchronizationInstanceDelete(d *schema.Resourc
 	request["RegionId"] = client.RegionId
 	request["product"] = "Dts"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2020-01-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InvalidJobId"}) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"testing"
@@ -17,7 +18,7 @@ func TestAccAlibabacloudStackDTSSynchronizationInstance_basic0(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDTSSynchronizationInstanceMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDtsSynchronizationInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
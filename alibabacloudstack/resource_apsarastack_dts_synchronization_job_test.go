@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackDTSSynchronizationJob_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_dts_synchronization_job.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackDTSSynchronizationJobMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &DtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeDtsSynchronizationJob")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
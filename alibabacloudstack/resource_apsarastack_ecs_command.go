@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -8,21 +9,19 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
 	"strings"
-	"time"
 )
 
 func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsCommandCreate,
-		Read:   resourceAlibabacloudStackEcsCommandRead,
-		Delete: resourceAlibabacloudStackEcsCommandDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsCommandCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsCommandRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsCommandDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"command_content": {
@@ -67,7 +66,7 @@ func resourceAlibabacloudStackEcsCommand() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateCommand"
@@ -136,7 +135,6 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
 		"AccessKeyId":     client.AccessKey,
 		"Product":         "Ecs",
 		"RegionId":        client.RegionId,
@@ -153,36 +151,25 @@ func resourceAlibabacloudStackEcsCommandCreate(d *schema.ResourceData, meta inte
 		"Type":            Type,
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw, request)
-		bresponse := raw.(*responses.CommonResponse)
-		err = json.Unmarshal(bresponse.GetHttpContentBytes(), response)
-
-		//var response *ecs.CreateCommandResponse
-		//response, _ := raw.(*ecs.CreateCommandResponse)
-		d.SetId(fmt.Sprint(response.CommandId))
-		return nil
+	raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
 	}
+	addDebug(action, raw, request)
+	bresponse := raw.(*responses.CommonResponse)
+	err = json.Unmarshal(bresponse.GetHttpContentBytes(), response)
+
+	//var response *ecs.CreateCommandResponse
+	//response, _ := raw.(*ecs.CreateCommandResponse)
+	d.SetId(fmt.Sprint(response.CommandId))
 
-	return resourceAlibabacloudStackEcsCommandRead(d, meta)
+	return resourceAlibabacloudStackEcsCommandRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeEcsCommand(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -202,7 +189,7 @@ func resourceAlibabacloudStackEcsCommandRead(d *schema.ResourceData, meta interf
 	d.Set("working_dir", object.Commands.Command[0].WorkingDir)
 	return nil
 }
-func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsCommandDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteCommand"
 	//var response map[string]interface{}
@@ -231,34 +218,22 @@ func resourceAlibabacloudStackEcsCommandDelete(d *schema.ResourceData, meta inte
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"CommandId":       d.Id(),
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "Ecs",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        action,
+		"Version":       "2014-05-26",
+		"CommandId":     d.Id(),
 	}
 
 	//request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
+	//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InvalidCmdId.NotFound", "InvalidRegionId.NotFound", "Operation.Forbidden"}) {
 			return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -10,24 +11,23 @@ import (
 	"log"
 	"regexp"
 	"strings"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsDeploymentSetCreate,
-		Read:   resourceAlibabacloudStackEcsDeploymentSetRead,
-		Update: resourceAlibabacloudStackEcsDeploymentSetUpdate,
-		Delete: resourceAlibabacloudStackEcsDeploymentSetDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsDeploymentSetDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"deployment_set_name": {
 				Type:         schema.TypeString,
@@ -62,12 +62,13 @@ func resourceAlibabacloudStackEcsDeploymentSet() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"Availability"}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateDeploymentSet"
@@ -118,7 +119,6 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":                  client.SecretKey,
 		"AccessKeyId":                      client.AccessKey,
 		"Product":                          "Ecs",
 		"RegionId":                         client.RegionId,
@@ -136,35 +136,23 @@ func resourceAlibabacloudStackEcsDeploymentSetCreate(d *schema.ResourceData, met
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw, request)
-		resp := &datahub.EcsDeploymentSetCreateResult{}
-		bresponse := raw.(*responses.CommonResponse)
-		err = json.Unmarshal(bresponse.GetHttpContentBytes(), resp)
-		d.SetId(fmt.Sprint(resp.DeploymentSetId))
-		return nil
+	raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
-
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_deployment_set", action, AlibabacloudStackSdkGoERROR)
 	}
+	addDebug(action, raw, request)
+	resp := &datahub.EcsDeploymentSetCreateResult{}
+	bresponse := raw.(*responses.CommonResponse)
+	err = json.Unmarshal(bresponse.GetHttpContentBytes(), resp)
+	d.SetId(fmt.Sprint(resp.DeploymentSetId))
 
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeEcsDeploymentSet(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -182,16 +170,18 @@ func resourceAlibabacloudStackEcsDeploymentSetRead(d *schema.ResourceData, meta
 
 	if object["Tags"] != nil {
 		tags := object["Tags"].(map[string]interface{})["Tag"]
-		d.Set("tags", tagsToMap(tags))
+		if err := setResourceTags(d, meta, tagsToMap(tags)); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
 }
-func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ecsService := EcsService{client}
-	if d.HasChange("tags") {
+	ecsService := EcsService{client, ctx}
+	if d.HasChange("tags_all") {
 		if err := ecsService.SetResourceTagsNew(d, "deployment_set"); err != nil {
 			return WrapError(err)
 		}
@@ -230,7 +220,6 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":   client.SecretKey,
 		"AccessKeyId":       client.AccessKey,
 		"Product":           "Ecs",
 		"RegionId":          client.RegionId,
@@ -243,29 +232,17 @@ func resourceAlibabacloudStackEcsDeploymentSetUpdate(d *schema.ResourceData, met
 		"Description":       Description,
 	}
 	if update {
-
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-				return EcsClient.ProcessCommonRequest(request)
-			})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
+		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+			return EcsClient.ProcessCommonRequest(request)
 		})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackEcsDeploymentSetRead(d, meta)
+	return resourceAlibabacloudStackEcsDeploymentSetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsDeploymentSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteDeploymentSet"
 	DeploymentSetId := d.Id()
@@ -282,7 +259,6 @@ func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, met
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
 		"AccessKeyId":     client.AccessKey,
 		"Product":         "Ecs",
 		"RegionId":        client.RegionId,
@@ -292,21 +268,10 @@ func resourceAlibabacloudStackEcsDeploymentSetDelete(d *schema.ResourceData, met
 		"Version":         "2014-05-26",
 		"DeploymentSetId": DeploymentSetId,
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
+	response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -8,21 +9,19 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"strconv"
 	"strings"
-	"time"
 )
 
 func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsEbsStorageSetsCreate,
-		Read:   resourceAlibabacloudStackEcsEbsStorageSetsRead,
-		Delete: resourceAlibabacloudStackEcsEbsStorageSetsDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsEbsStorageSetsDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"storage_set_name": {
@@ -49,7 +48,7 @@ func resourceAlibabacloudStackEcsEbsStorageSets() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateStorageSet"
@@ -83,7 +82,6 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":    client.SecretKey,
 		"AccessKeyId":        client.AccessKey,
 		"Product":            "Ecs",
 		"RegionId":           client.RegionId,
@@ -96,36 +94,25 @@ func resourceAlibabacloudStackEcsEbsStorageSetsCreate(d *schema.ResourceData, me
 		"ZoneId":             ZoneId,
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, raw, request)
-		bresponse := raw.(*responses.CommonResponse)
-		err = json.Unmarshal(bresponse.GetHttpContentBytes(), response)
-
-		//var response *ecs.CreateCommandResponse
-		//response, _ := raw.(*ecs.CreateCommandResponse)
-		d.SetId(fmt.Sprint(response.StorageSetId))
-		return nil
+	raw, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ecs_command", action, AlibabacloudStackSdkGoERROR)
 	}
+	addDebug(action, raw, request)
+	bresponse := raw.(*responses.CommonResponse)
+	err = json.Unmarshal(bresponse.GetHttpContentBytes(), response)
 
-	return resourceAlibabacloudStackEcsEbsStorageSetsRead(d, meta)
+	//var response *ecs.CreateCommandResponse
+	//response, _ := raw.(*ecs.CreateCommandResponse)
+	d.SetId(fmt.Sprint(response.StorageSetId))
+
+	return resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeEcsEbsStorageSet(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -142,7 +129,7 @@ func resourceAlibabacloudStackEcsEbsStorageSetsRead(d *schema.ResourceData, meta
 	d.Set("storage_set_id", object.StorageSets.StorageSet[0].StorageSetId)
 	return nil
 }
-func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsEbsStorageSetsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteStorageSet"
 	//var response map[string]interface{}
@@ -171,34 +158,22 @@ func resourceAlibabacloudStackEcsEbsStorageSetsDelete(d *schema.ResourceData, me
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"StorageSetId":    d.Id(),
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "Ecs",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        action,
+		"Version":       "2014-05-26",
+		"StorageSetId":  d.Id(),
 	}
 
 	//request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
-			return EcsClient.ProcessCommonRequest(request)
-		})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
+	//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
+		return EcsClient.ProcessCommonRequest(request)
 	})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InvalidCmdId.NotFound", "InvalidRegionId.NotFound", "Operation.Forbidden"}) {
 			return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/aliyun-datahub-sdk-go/datahub"
 	"testing"
@@ -15,7 +16,7 @@ func TestAccAlibabacloudStackEcsEbsStorageSets_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ecs_ebs_storage_set.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackEcsEbsMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeEcsEbsStorageSet")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEcsHpcClusterCreate,
-		Read:   resourceAlibabacloudStackEcsHpcClusterRead,
-		Update: resourceAlibabacloudStackEcsHpcClusterUpdate,
-		Delete: resourceAlibabacloudStackEcsHpcClusterDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEcsHpcClusterRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEcsHpcClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"description": {
@@ -36,7 +37,7 @@ func resourceAlibabacloudStackEcsHpcCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//var response map[string]interface{}
 	action := "CreateHpcCluster"
@@ -67,17 +68,16 @@ func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta i
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"Name":            Name,
-		"ClientToken":     ClientToken,
-		"Description":     Description,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "Ecs",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        action,
+		"Version":       "2014-05-26",
+		"Name":          Name,
+		"ClientToken":   ClientToken,
+		"Description":   Description,
 	}
 
 	//response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
@@ -96,12 +96,12 @@ func resourceAlibabacloudStackEcsHpcClusterCreate(d *schema.ResourceData, meta i
 	//var response *ecs.CreateHpcClusterResponse
 	//response, _ = raw.(*ecs.CreateHpcClusterResponse)
 	d.SetId(fmt.Sprint(resp.HpcClusterId))
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	object, err := ecsService.DescribeEcsHpcCluster(d.Id())
 	if err != nil {
@@ -116,7 +116,7 @@ func resourceAlibabacloudStackEcsHpcClusterRead(d *schema.ResourceData, meta int
 	d.Set("name", object.HpcClusters.HpcCluster[0].Name)
 	return nil
 }
-func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	//var response map[string]interface{}
@@ -160,18 +160,17 @@ func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta i
 		request.ApiName = action
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "Ecs",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          action,
-			"Version":         "2014-05-26",
-			"ClientToken":     ClientToken,
-			"HpcClusterId":    HpcClusterId,
-			"Description":     Description,
-			"Name":            Name,
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "Ecs",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        action,
+			"Version":       "2014-05-26",
+			"ClientToken":   ClientToken,
+			"HpcClusterId":  HpcClusterId,
+			"Description":   Description,
+			"Name":          Name,
 		}
 		response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 			return EcsClient.ProcessCommonRequest(request)
@@ -182,9 +181,9 @@ func resourceAlibabacloudStackEcsHpcClusterUpdate(d *schema.ResourceData, meta i
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackEcsHpcClusterRead(d, meta)
+	return resourceAlibabacloudStackEcsHpcClusterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEcsHpcClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEcsHpcClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteHpcCluster"
 
@@ -205,16 +204,15 @@ func resourceAlibabacloudStackEcsHpcClusterDelete(d *schema.ResourceData, meta i
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "Ecs",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          action,
-		"Version":         "2014-05-26",
-		"ClientToken":     ClientToken,
-		"HpcClusterId":    HpcClusterId,
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "Ecs",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        action,
+		"Version":       "2014-05-26",
+		"ClientToken":   ClientToken,
+		"HpcClusterId":  HpcClusterId,
 	}
 	response, err := client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
 		return EcsClient.ProcessCommonRequest(request)
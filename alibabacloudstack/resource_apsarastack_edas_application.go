@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -20,12 +21,12 @@ var component_id int
 
 func resourceAlibabacloudStackEdasApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasApplicationCreate,
-		Update: resourceAlibabacloudStackEdasApplicationUpdate,
-		Read:   resourceAlibabacloudStackEdasApplicationRead,
-		Delete: resourceAlibabacloudStackEdasApplicationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasApplicationCreate),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEdasApplicationUpdate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasApplicationRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasApplicationDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -85,9 +86,9 @@ func resourceAlibabacloudStackEdasApplication() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 	request := edas.CreateInsertApplicationRequest()
 
 	request.ApplicationName = d.Get("application_name").(string)
@@ -162,7 +163,7 @@ func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -211,18 +212,18 @@ func resourceAlibabacloudStackEdasApplicationCreate(d *schema.ResourceData, meta
 
 		if len(changeOrderId) > 0 {
 			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
 	}
 
-	return resourceAlibabacloudStackEdasApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	if d.HasChange("application_name") || d.HasChange("descriotion") {
 		request := edas.CreateUpdateApplicationBaseInfoRequest()
@@ -245,12 +246,12 @@ func resourceAlibabacloudStackEdasApplicationUpdate(d *schema.ResourceData, meta
 	}
 
 	time.Sleep(3 * time.Second)
-	return resourceAlibabacloudStackEdasApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	regionId := client.RegionId
 	appId := d.Id()
@@ -262,16 +263,11 @@ func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta i
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetApplication(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -303,9 +299,9 @@ func resourceAlibabacloudStackEdasApplicationRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	regionId := client.RegionId
 	appId := d.Id()
@@ -329,7 +325,7 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -341,16 +337,11 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 	req.Headers["x-acs-organizationid"] = client.Department
 	req.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteApplication(req)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(req.GetActionName(), raw, req.RoaRequest, req)
@@ -364,7 +355,7 @@ func resourceAlibabacloudStackEdasApplicationDelete(d *schema.ResourceData, meta
 		delete(component_ids, request.AppId)
 		if len(changeOrderId) > 0 {
 			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return resource.NonRetryableError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
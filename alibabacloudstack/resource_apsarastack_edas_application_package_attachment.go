@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -13,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackEdasApplicationPackageAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasApplicationPackageAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasApplicationPackageAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -50,9 +51,9 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachment() *schema.Resourc
 	}
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	appId := d.Get("app_id").(string)
 	groupId := d.Get("group_id").(string)
@@ -96,18 +97,18 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentCreate(d *schema.R
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 	d.SetId(appId + ":" + packageVersion)
 
-	return resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	regionId := client.RegionId
 	appId := strings.Split(d.Id(), ":")[0]
@@ -141,9 +142,9 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentRead(d *schema.Res
 	return nil
 }
 
-func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	regionId := client.RegionId
 	appId := d.Get("app_id").(string)
@@ -178,7 +179,7 @@ func resourceAlibabacloudStackEdasApplicationPackageAttachmentDelete(d *schema.R
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -19,7 +20,7 @@ func TestAccAlibabacloudStackEdasApplicationPackageAttachment_basic(t *testing.T
 	ra := resourceAttrInit(resourceId, edasAPAttachmentBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
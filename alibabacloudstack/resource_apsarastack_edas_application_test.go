@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -30,7 +31,7 @@ func testSweepEdasApplication(region string) error {
 		return WrapErrorf(err, "error getting AlibabacloudStack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -126,7 +127,7 @@ func TestAccAlibabacloudStackEdasApplication_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_application.default"
 	ra := resourceAttrInit(resourceId, edasApplicationBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -196,7 +197,7 @@ func TestAccAlibabacloudStackEdasApplication_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_application.default.1"
 	ra := resourceAttrInit(resourceId, edasApplicationBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"time"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: rresourceAlibabacloudStackEdasClusterCreate,
-		Read:   resourceAlibabacloudStackEdasClusterRead,
-		Delete: resourceAlibabacloudStackEdasClusterDelete,
+		CreateContext: withDiagnostics(rresourceAlibabacloudStackEdasClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasClusterRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_name": {
@@ -53,9 +54,9 @@ func resourceAlibabacloudStackEdasCluster() *schema.Resource {
 	}
 }
 
-func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func rresourceAlibabacloudStackEdasClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	request := edas.CreateInsertClusterRequest()
 	request.RegionId = client.RegionId
@@ -89,12 +90,12 @@ func rresourceAlibabacloudStackEdasClusterCreate(d *schema.ResourceData, meta in
 	}
 	d.SetId(response.Cluster.ClusterId)
 
-	return resourceAlibabacloudStackEdasClusterRead(d, meta)
+	return resourceAlibabacloudStackEdasClusterRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	clusterId := d.Id()
 	regionId := client.RegionId
@@ -129,9 +130,9 @@ func resourceAlibabacloudStackEdasClusterRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	clusterId := d.Id()
 	regionId := client.RegionId
@@ -143,17 +144,12 @@ func resourceAlibabacloudStackEdasClusterDelete(d *schema.ResourceData, meta int
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteCluster(request)
 		})
 		response, _ := raw.(*edas.DeleteClusterResponse)
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		if response.Code != 200 {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -32,7 +33,7 @@ func testSweepEdasCluster(region string) error {
 		return WrapErrorf(err, "error getting AlibabacloudStack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -108,7 +109,7 @@ func TestAccAlibabacloudStackEdasCluster_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_cluster.name1"
 	ra := resourceAttrInit(resourceId, edasClusterBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -177,7 +178,7 @@ func TestAccAlibabacloudStackEdasCluster_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_cluster.default.1"
 	ra := resourceAttrInit(resourceId, edasClusterBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -222,7 +223,7 @@ var edasClusterBasicMap = map[string]string{
 
 func testAccCheckEdasClusterDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_edas_cluster" {
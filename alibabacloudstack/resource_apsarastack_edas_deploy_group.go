@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/edas"
@@ -11,11 +12,11 @@ import (
 
 func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasDeployGroupCreate,
-		Read:   resourceAlibabacloudStackEdasDeployGroupRead,
-		Delete: resourceAlibabacloudStackEdasDeployGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasDeployGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasDeployGroupRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasDeployGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"app_id": {
@@ -37,9 +38,9 @@ func resourceAlibabacloudStackEdasDeployGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	appId := d.Get("app_id").(string)
 	regionId := client.RegionId
@@ -52,16 +53,11 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 	request.Headers["x-ascm-product-name"] = "Edas"
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertDeployGroup(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		response := raw.(*edas.InsertDeployGroupResponse)
@@ -75,12 +71,12 @@ func resourceAlibabacloudStackEdasDeployGroupCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_edas_deploy_group", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEdasDeployGroupRead(d, meta)
+	return resourceAlibabacloudStackEdasDeployGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	strs, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
@@ -105,9 +101,9 @@ func resourceAlibabacloudStackEdasDeployGroupRead(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasDeployGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	request := edas.CreateDeleteDeployGroupRequest()
 	request.RegionId = client.RegionId
@@ -116,16 +112,11 @@ func resourceAlibabacloudStackEdasDeployGroupDelete(d *schema.ResourceData, meta
 	request.Headers["x-ascm-product-name"] = "Edas"
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteDeployGroup(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
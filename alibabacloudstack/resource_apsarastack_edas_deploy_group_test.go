@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestAccAlibabacloudStackEdasDeployGroup_basic(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, edasDeployGroupBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
@@ -76,7 +77,7 @@ func TestAccAlibabacloudStackEdasDeployGroup_multi(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, edasDeployGroupBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
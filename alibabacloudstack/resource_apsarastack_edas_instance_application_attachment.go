@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAlibabacloudStackEdasInstanceApplicationAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"ecc_info": {
@@ -49,9 +50,9 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachment() *schema.Resour
 	}
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	appId := d.Get("app_id").(string)
 	ecuInfo := d.Get("ecu_info").([]interface{})
@@ -90,17 +91,17 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentCreate(d *schema.
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
-	return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	strs, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -144,9 +145,9 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentRead(d *schema.Re
 	return nil
 }
 
-func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	request := edas.CreateScaleInApplicationRequest()
 	request.RegionId = client.RegionId
@@ -176,7 +177,7 @@ func resourceAlibabacloudStackEdasInstanceApplicationAttachmentDelete(d *schema.
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
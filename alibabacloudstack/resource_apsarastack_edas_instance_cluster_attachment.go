@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,11 +14,11 @@ import (
 
 func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate,
-		Read:   resourceAlibabacloudStackEdasInstanceClusterAttachmentRead,
-		Delete: resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"cluster_id": {
@@ -64,9 +65,9 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	clusterId := d.Get("cluster_id").(string)
 	instanceIds := d.Get("instance_ids").([]interface{})
@@ -89,16 +90,11 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		return err
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.InsertClusterMember(request)
 		})
 		if err != nil {
-			if IsThrottling(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -153,15 +149,17 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentCreate(d *schema.Reso
 		if ImportSuccessFlag == true {
 			break
 		}
-		time.Sleep(30 * time.Second)
+		if err := sleepContext(ctx, 30*time.Second); err != nil {
+			return WrapError(err)
+		}
 		cnt++
 	}
-	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d, meta)
+	return resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	strs, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -207,10 +205,10 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentRead(d *schema.Resour
 	return nil
 }
 
-//有问题 单个实例删除失败会影响整个过程
-func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+// 有问题 单个实例删除失败会影响整个过程
+func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	memIds := d.Get("cluster_member_ids").(map[string]interface{})
 	for instanceId, memberId := range memIds {
@@ -221,17 +219,12 @@ func resourceAlibabacloudStackEdasInstanceClusterAttachmentDelete(d *schema.Reso
 		request.Headers["x-ascm-product-name"] = "Edas"
 		request.Headers["x-acs-organizationid"] = client.Department
 		request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 			raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 				return edasClient.DeleteClusterMember(request)
 
 			})
 			if err != nil {
-				if IsThrottling(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RoaRequest, request)
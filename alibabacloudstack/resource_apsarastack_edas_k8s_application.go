@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strconv"
 	"time"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackEdasK8sApplication() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasK8sApplicationCreate,
-		Read:   resourceAlibabacloudStackEdasK8sApplicationRead,
-		Update: resourceAlibabacloudStackEdasK8sApplicationUpdate,
-		Delete: resourceAlibabacloudStackEdasK8sApplicationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasK8sApplicationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasK8sApplicationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEdasK8sApplicationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasK8sApplicationDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -187,9 +188,9 @@ func resourceAlibabacloudStackEdasK8sApplication() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sApplicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 	request := edas.CreateInsertK8sApplicationRequest()
 
 	packageType := d.Get("package_type").(string)
@@ -360,17 +361,17 @@ func resourceAlibabacloudStackEdasK8sApplicationCreate(d *schema.ResourceData, m
 
 	if len(changeOrderId) > 0 {
 		stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
 
-	return resourceAlibabacloudStackEdasK8sApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sApplicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	response, err := edasService.DescribeEdasK8sApplication(d.Id())
 	if err != nil {
@@ -445,9 +446,9 @@ func resourceAlibabacloudStackEdasK8sApplicationRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sApplicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 	var partialKeys []string
 	request := edas.CreateDeployK8sApplicationRequest()
 	request.AppId = d.Id()
@@ -613,7 +614,7 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 
 		if len(changeOrderId) > 0 {
 			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 		}
@@ -622,12 +623,12 @@ func resourceAlibabacloudStackEdasK8sApplicationUpdate(d *schema.ResourceData, m
 		//}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEdasK8sApplicationRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sApplicationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sApplicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	request := edas.CreateDeleteK8sApplicationRequest()
 	request.RegionId = client.RegionId
@@ -635,16 +636,11 @@ func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, m
 	request.Headers["x-ascm-product-name"] = "Edas"
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteK8sApplication(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -656,7 +652,7 @@ func resourceAlibabacloudStackEdasK8sApplicationDelete(d *schema.ResourceData, m
 
 		if len(changeOrderId) > 0 {
 			stateConf := BuildStateConf([]string{"0", "1"}, []string{"2"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, edasService.EdasChangeOrderStatusRefreshFunc(changeOrderId, []string{"3", "6", "10"}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return resource.NonRetryableError(WrapErrorf(err, IdMsg, d.Id()))
 			}
 		}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -28,7 +29,7 @@ func testSweepEdasK8sApplication(region string) error {
 		return WrapErrorf(err, "error getting AlibabacloudStack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -125,7 +126,7 @@ func TestAccAlibabacloudStackEdasK8sApplication_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_k8s_application.default"
 	ra := resourceAttrInit(resourceId, edasK8sApplicationBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
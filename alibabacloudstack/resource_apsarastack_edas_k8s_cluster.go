@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,11 +13,11 @@ import (
 
 func resourceAlibabacloudStackEdasK8sCluster() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEdasK8sClusterCreate,
-		Read:   resourceAlibabacloudStackEdasK8sClusterRead,
-		Delete: resourceAlibabacloudStackEdasK8sClusterDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEdasK8sClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEdasK8sClusterRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEdasK8sClusterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
@@ -57,9 +58,9 @@ func resourceAlibabacloudStackEdasK8sCluster() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	request := edas.CreateImportK8sClusterRequest()
 	request.RegionId = client.RegionId
@@ -94,18 +95,15 @@ func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta
 	req.Headers["x-acs-organizationid"] = client.Department
 	req.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
 	req.RegionId = client.RegionId
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetCluster(req)
 		})
-		time.Sleep(120 * time.Second)
+		if err := sleepContext(ctx, 120*time.Second); err != nil {
+			return resource.NonRetryableError(err)
+		}
 		response, _ := raw.(*edas.GetClusterResponse)
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		if response.Code != 200 {
@@ -119,12 +117,12 @@ func resourceAlibabacloudStackEdasK8sClusterCreate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEdasK8sClusterRead(d, meta)
+	return resourceAlibabacloudStackEdasK8sClusterRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEdasK8sClusterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	object, err := edasService.DescribeEdasListCluster(d.Id())
 	if err != nil {
@@ -151,9 +149,9 @@ func resourceAlibabacloudStackEdasK8sClusterRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEdasK8sClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, ctx}
 
 	clusterId := d.Id()
 	regionId := client.RegionId
@@ -164,17 +162,12 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 	request.Headers["x-ascm-product-name"] = "Edas"
 	request.Headers["x-acs-organizationid"] = client.Department
 	request.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	wait := incrementalWait(1*time.Second, 2*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.DeleteCluster(request)
 		})
 		response, _ := raw.(*edas.DeleteClusterResponse)
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		if response.Code != 200 {
@@ -194,16 +187,12 @@ func resourceAlibabacloudStackEdasK8sClusterDelete(d *schema.ResourceData, meta
 	reqGet.Headers["x-ascm-product-name"] = "Edas"
 	reqGet.Headers["x-acs-organizationid"] = client.Department
 	reqGet.Headers["x-acs-content-type"] = "application/x-www-form-urlencoded"
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := edasService.client.WithEdasClient(func(edasClient *edas.Client) (interface{}, error) {
 			return edasClient.GetCluster(reqGet)
 		})
 		response, _ := raw.(*edas.GetClusterResponse)
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RoaRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -30,7 +31,7 @@ func testSweepEdasK8sCluster(region string) error {
 		return WrapErrorf(err, "error getting AlibabacloudStack client.")
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	prefixes := []string{
 		"tf-testAcc",
@@ -106,7 +107,7 @@ func TestAccAlibabacloudStackEdasK8sCluster_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_edas_k8s_cluster.default"
 	ra := resourceAttrInit(resourceId, edasK8sClusterBasicMap)
 	serviceFunc := func() interface{} {
-		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EdasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -156,7 +157,7 @@ var edasK8sClusterBasicMap = map[string]string{
 
 func testAccCheckEdasK8sClusterDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	edasService := EdasService{client}
+	edasService := EdasService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_edas_k8s_cluster" {
@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEhpcJobTemplateCreate,
-		Read:   resourceAlibabacloudStackEhpcJobTemplateRead,
-		Update: resourceAlibabacloudStackEhpcJobTemplateUpdate,
-		Delete: resourceAlibabacloudStackEhpcJobTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEhpcJobTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"array_request": {
@@ -95,7 +94,7 @@ func resourceAlibabacloudStackEhpcJobTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateJobTemplate"
@@ -151,18 +150,7 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 	if v, ok := d.GetOk("variables"); ok {
 		request["Variables"] = v
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ehpc_job_template", action, AlibabacloudStackSdkGoERROR)
@@ -170,11 +158,11 @@ func resourceAlibabacloudStackEhpcJobTemplateCreate(d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ehpcService := EhpcService{client}
+	ehpcService := EhpcService{client, ctx}
 	object, err := ehpcService.DescribeEhpcJobTemplate(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -218,7 +206,7 @@ func resourceAlibabacloudStackEhpcJobTemplateRead(d *schema.ResourceData, meta i
 	d.Set("variables", object["Variables"])
 	return nil
 }
-func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -294,26 +282,15 @@ func resourceAlibabacloudStackEhpcJobTemplateUpdate(d *schema.ResourceData, meta
 	if err != nil {
 		return WrapError(err)
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEhpcJobTemplateRead(d, meta)
+	return resourceAlibabacloudStackEhpcJobTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEhpcJobTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteJobTemplates"
 	var response map[string]interface{}
@@ -325,18 +302,7 @@ func resourceAlibabacloudStackEhpcJobTemplateDelete(d *schema.ResourceData, meta
 		"Templates": fmt.Sprintf("[{\"Id\":\"%s\"}]", d.Id()),
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("GET"), StringPointer("2018-04-12"), StringPointer("AK"), request, nil, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
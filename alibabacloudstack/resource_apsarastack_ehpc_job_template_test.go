@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackEhpcJobTemplate_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_ehpc_job_template.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackEhpcJobTemplateMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &EhpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EhpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeEhpcJobTemplate")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -13,14 +14,15 @@ import (
 
 func resourceAlibabacloudStackEip() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipCreate,
-		Read:   resourceAlibabacloudStackEipRead,
-		Update: resourceAlibabacloudStackEipUpdate,
-		Delete: resourceAlibabacloudStackEipDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEipCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEipRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEipUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEipDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -45,14 +47,15 @@ func resourceAlibabacloudStackEip() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateAllocateEipAddressRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -63,7 +66,7 @@ func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Bandwidth = strconv.Itoa(d.Get("bandwidth").(int))
 	request.ClientToken = buildClientToken(request.GetActionName())
 
@@ -80,13 +83,13 @@ func resourceAlibabacloudStackEipCreate(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackEipUpdate(d, meta)
+	return resourceAlibabacloudStackEipUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpcService.DescribeEip(d.Id())
 	if err != nil {
@@ -107,16 +110,18 @@ func resourceAlibabacloudStackEipRead(d *schema.ResourceData, meta interface{})
 	d.Set("ip_address", object.IpAddress)
 	d.Set("status", object.Status)
 	if tag := object.Tags.Tag; tag != nil {
-		d.Set("tags", vpcService.tagToMap(tag))
+		if err := setResourceTags(d, meta, vpcService.tagToMap(tag)); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := vpcService.SetResourceTags(d, "EIP"); err != nil {
 			return WrapError(err)
 		}
@@ -131,7 +136,7 @@ func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = d.Id()
 
 	if d.HasChange("bandwidth") && !d.IsNewResource() {
@@ -155,12 +160,12 @@ func resourceAlibabacloudStackEipUpdate(d *schema.ResourceData, meta interface{}
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackEipRead(d, meta)
+	return resourceAlibabacloudStackEipRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateReleaseEipAddressRequest()
 	request.AllocationId = d.Id()
@@ -171,8 +176,8 @@ func resourceAlibabacloudStackEipDelete(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ReleaseEipAddress(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"strings"
 	"time"
@@ -13,9 +14,16 @@ import (
 
 func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEipAssociationCreate,
-		Read:   resourceAlibabacloudStackEipAssociationRead,
-		Delete: resourceAlibabacloudStackEipAssociationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEipAssociationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEipAssociationRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEipAssociationDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				// force only applies to the unassociation, an imported association gets its default
+				d.Set("force", true)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
 
 		Schema: map[string]*schema.Schema{
 			"allocation_id": {
@@ -46,9 +54,9 @@ func resourceAlibabacloudStackEipAssociation() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateAssociateEipAddressRequest()
 	request.RegionId = client.RegionId
@@ -58,7 +66,7 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = Trim(d.Get("allocation_id").(string))
 	request.InstanceId = Trim(d.Get("instance_id").(string))
 	request.InstanceType = EcsInstance
@@ -73,7 +81,7 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.AssociateEipAddress(request)
 		})
@@ -94,18 +102,20 @@ func resourceAlibabacloudStackEipAssociationCreate(d *schema.ResourceData, meta
 	}
 	// There is at least 30 seconds delay for ecs instance
 	if request.InstanceType == EcsInstance {
-		time.Sleep(30 * time.Second)
+		if err := sleepContext(ctx, 30*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	d.SetId(request.AllocationId + ":" + request.InstanceId)
 
-	return resourceAlibabacloudStackEipAssociationRead(d, meta)
+	return resourceAlibabacloudStackEipAssociationRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpcService.DescribeEipAssociation(d.Id())
 	if err != nil {
@@ -122,9 +132,9 @@ func resourceAlibabacloudStackEipAssociationRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEipAssociationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -142,7 +152,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AllocationId = allocationId
 	request.InstanceId = instanceId
 	request.Force = requests.NewBoolean(d.Get("force").(bool))
@@ -158,7 +168,7 @@ func resourceAlibabacloudStackEipAssociationDelete(d *schema.ResourceData, meta
 	if instanceType, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = instanceType.(string)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateEipAddress(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -13,7 +14,7 @@ import (
 
 func testAccCheckEIPAssociationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_eip_association" {
@@ -44,7 +45,7 @@ func TestAccAlibabacloudStackEipAssociationBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_eip_association.default"
 	ra := resourceAttrInit(resourceId, testAccCheckEipAssociationBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -78,7 +79,7 @@ func TestAccAlibabacloudStackEipAssociationMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_eip_association.default.1"
 	ra := resourceAttrInit(resourceId, testAccCheckEipAssociationBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -112,7 +113,7 @@ func TestAccAlibabacloudStackEipAssociationEni(t *testing.T) {
 	resourceId := "alibabacloudstack_eip_association.default"
 	ra := resourceAttrInit(resourceId, testAccCheckEipAssociationBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -91,7 +92,7 @@ func testSweepElasticsearch(region string) error {
 	}
 
 	sweeped := false
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, v := range instances {
 		description := v.Description
 		id := v.InstanceId
@@ -142,7 +143,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -348,7 +349,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_multizone(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -406,7 +407,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_version(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -469,7 +470,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -520,7 +521,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_encrypt_disk(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -580,7 +581,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_client_node(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -647,7 +648,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_https(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
@@ -722,7 +723,7 @@ func TestAccAlibabacloudStackElasticsearchInstance_setting_config(t *testing.T)
 	ra := resourceAttrInit(resourceId, AlibabacloudStackElasticsearchMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
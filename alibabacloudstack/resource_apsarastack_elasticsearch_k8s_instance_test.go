@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -139,7 +140,7 @@ func TestAccAlibabacloudStackElasticsearchK8sInstance_multi(t *testing.T) {
 	ra := resourceAttrInit(resourceId, elasticsearchk8sMap)
 
 	serviceFunc := func() interface{} {
-		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &ElasticsearchService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &instance, serviceFunc)
 
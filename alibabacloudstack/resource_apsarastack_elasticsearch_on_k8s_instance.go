@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"regexp"
 	"strings"
 	"time"
@@ -19,18 +20,19 @@ import (
 
 func resourceAlibabacloudStackElasticsearchOnk8s() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackElasticsearchOnk8sCreate,
-		Read:   resourceAlibabacloudStackElasticsearchOnk8sRead,
-		Update: resourceAlibabacloudStackElasticsearchOnk8sUpdate,
-		Delete: resourceAlibabacloudStackElasticsearchOnk8sDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackElasticsearchOnk8sCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackElasticsearchOnk8sRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackElasticsearchOnk8sUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackElasticsearchOnk8sDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(120 * time.Minute),
 			Update: schema.DefaultTimeout(120 * time.Minute),
 			Delete: schema.DefaultTimeout(120 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			// Basic instance information
 			"description": {
@@ -70,7 +72,8 @@ func resourceAlibabacloudStackElasticsearchOnk8s() *schema.Resource {
 				DiffSuppressFunc: esVersionDiffSuppressFunc,
 				ForceNew:         true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 
 			// Life cycle
 			"instance_charge_type": {
@@ -238,12 +241,12 @@ func resourceAlibabacloudStackElasticsearchOnk8s() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchOnk8sCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	action := "createInstance"
 
-	requestBody, err := buildElasticsearchOnk8sCreateRequestBody(d, meta)
+	requestBody, err := buildElasticsearchOnk8sCreateRequestBody(ctx, d, meta)
 	var response map[string]interface{}
 
 	// retry
@@ -253,10 +256,10 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, requestBody, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, errorCodeList) || NeedRetry(err) {
+			if IsExpectedErrors(err, errorCodeList) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -279,16 +282,16 @@ func resourceAlibabacloudStackElasticsearchOnk8sCreate(d *schema.ResourceData, m
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutCreate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackElasticsearchOnk8sUpdate(d, meta)
+	return resourceAlibabacloudStackElasticsearchOnk8sUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchOnk8sRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 
 	object, err := elasticsearchService.DescribeElasticsearchOnk8sInstance(d.Id())
 	if err != nil {
@@ -361,15 +364,15 @@ func resourceAlibabacloudStackElasticsearchOnk8sRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchOnk8sUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	d.Partial(true)
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
 	if d.HasChange("description") {
-		if err := updateDescription(d, meta); err != nil {
+		if err := updateDescription(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -460,8 +463,8 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		//d.SetPartial("kibana_private_whitelist")
 	}
 
-	if d.HasChange("tags") {
-		if err := updateInstanceTags(d, meta); err != nil {
+	if d.HasChange("tags_all") {
+		if err := updateInstanceTags(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -470,11 +473,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("client_node_spec") || d.HasChange("client_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateClientNode(d, meta); err != nil {
+		if err := updateClientNode(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -484,11 +487,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("protocol") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		var https func(*schema.ResourceData, interface{}) error
+		var https func(context.Context, *schema.ResourceData, interface{}) error
 
 		if d.Get("protocol") == "HTTPS" {
 			https = openHttps
@@ -497,7 +500,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		}
 
 		if nil != https {
-			if err := https(d, meta); err != nil {
+			if err := https(ctx, d, meta); err != nil {
 				return WrapError(err)
 			}
 		}
@@ -524,11 +527,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		runtime.SetAutoretry(true)
 		// retry
 		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 
 			if err != nil {
-				if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction", "InstanceDuplicateScheduledTask"}) || NeedRetry(err) {
+				if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction", "InstanceDuplicateScheduledTask"}) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -543,7 +546,7 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 		}
 		stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 		stateConf.PollInterval = 5 * time.Second
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("setting_config")
@@ -551,18 +554,18 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackElasticsearchOnk8sRead(d, meta)
+		return resourceAlibabacloudStackElasticsearchOnk8sRead(ctx, d, meta)
 	}
 
 	if d.HasChange("instance_charge_type") {
-		if err := updateInstanceChargeType(d, meta); err != nil {
+		if err := updateInstanceChargeType(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
 		//d.SetPartial("instance_charge_type")
 		//d.SetPartial("period")
 	} else if d.Get("instance_charge_type").(string) == string(PrePaid) && d.HasChange("period") {
-		if err := renewInstance(d, meta); err != nil {
+		if err := renewInstance(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -571,11 +574,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("data_node_amount") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateDataNodeAmount(d, meta); err != nil {
+		if err := updateDataNodeAmount(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -584,11 +587,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("data_node_spec") || d.HasChange("data_node_disk_size") || d.HasChange("data_node_disk_type") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateDataNodeSpec(d, meta); err != nil {
+		if err := updateDataNodeSpec(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -600,11 +603,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("master_node_spec") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updateMasterNode(d, meta); err != nil {
+		if err := updateMasterNode(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -613,11 +616,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 
 	if d.HasChange("password") || d.HasChange("kms_encrypted_password") {
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 
-		if err := updatePassword(d, meta); err != nil {
+		if err := updatePassword(ctx, d, meta); err != nil {
 			return WrapError(err)
 		}
 
@@ -625,12 +628,12 @@ func resourceAlibabacloudStackElasticsearchOnk8sUpdate(d *schema.ResourceData, m
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackElasticsearchOnk8sRead(d, meta)
+	return resourceAlibabacloudStackElasticsearchOnk8sRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackElasticsearchOnk8sDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	action := "DeleteInstance"
 
 	if strings.ToLower(d.Get("instance_charge_type").(string)) == strings.ToLower(string(PrePaid)) {
@@ -651,11 +654,11 @@ func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, m
 	errorCodeList := []string{"InstanceActivating", "TokenPreviousRequestProcessError"}
 	conn, err := client.NewElasticsearchClient()
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 
 		if err != nil {
-			if IsExpectedErrors(err, errorCodeList) || NeedRetry(err) {
+			if IsExpectedErrors(err, errorCodeList) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -676,18 +679,20 @@ func resourceAlibabacloudStackElasticsearchOnk8sDelete(d *schema.ResourceData, m
 	stateConf := BuildStateConf([]string{"activating", "inactive", "active"}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	// Instance will be completed deleted in 5 minutes, so deleting vswitch is available after the time.
-	time.Sleep(5 * time.Minute)
+	if err := sleepContext(ctx, 5*time.Minute); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func buildElasticsearchOnk8sCreateRequestBody(d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
+func buildElasticsearchOnk8sCreateRequestBody(ctx context.Context, d *schema.ResourceData, meta interface{}) (map[string]interface{}, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	content := make(map[string]interface{})
 	if v, ok := d.GetOk("resource_group_id"); ok && v.(string) != "" {
@@ -726,7 +731,7 @@ func buildElasticsearchOnk8sCreateRequestBody(d *schema.ResourceData, meta inter
 	if password != "" {
 		content["esAdminPassword"] = password
 	} else {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return content, WrapError(err)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssAlarmCreate,
-		Read:   resourceAlibabacloudStackEssAlarmRead,
-		Update: resourceAlibabacloudStackEssAlarmUpdate,
-		Delete: resourceAlibabacloudStackEssAlarmDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssAlarmCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssAlarmRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssAlarmUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssAlarmDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -112,7 +113,7 @@ func resourceAlibabacloudStackEssAlarm() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request, err := buildAlibabacloudStackEssAlarmArgs(d)
 	if err != nil {
@@ -123,21 +124,18 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
 		request.Scheme = "http"
 	}
 	var raw interface{}
-	if err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateAlarm(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling}) {
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -161,12 +159,12 @@ func resourceAlibabacloudStackEssAlarmCreate(d *schema.ResourceData, meta interf
 		}
 		addDebug(disableAlarmRequest.GetActionName(), raw, disableAlarmRequest.RpcRequest, disableAlarmRequest)
 	}
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	object, err := essService.DescribeEssAlarm(d.Id())
 	if err != nil {
@@ -207,14 +205,14 @@ func resourceAlibabacloudStackEssAlarmRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyAlarmRequest()
 	request.AlarmTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -319,18 +317,18 @@ func resourceAlibabacloudStackEssAlarmUpdate(d *schema.ResourceData, meta interf
 		//d.SetPartial("enable")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEssAlarmRead(d, meta)
+	return resourceAlibabacloudStackEssAlarmRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssAlarmDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssAlarmDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	request := ess.CreateDeleteAlarmRequest()
 	request.AlarmTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.Domain = client.Domain
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
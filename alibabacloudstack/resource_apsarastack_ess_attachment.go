@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackEssAttachmentCreate,
-		Read:   resourceAlibabacloudstackEssAttachmentRead,
-		Update: resourceAlibabacloudstackEssAttachmentUpdate,
-		Delete: resourceAlibabacloudstackEssAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackEssAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackEssAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,15 +48,15 @@ func resourceAlibabacloudstackEssAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackEssAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("scaling_group_id").(string))
-	return resourceAlibabacloudstackEssAttachmentUpdate(d, meta)
+	return resourceAlibabacloudstackEssAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	d.Partial(true)
 
 	if d.HasChange("instance_ids") {
@@ -85,12 +86,12 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			request.ScalingGroupId = d.Id()
 			s := reflect.ValueOf(request).Elem()
 
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				for i, id := range add {
 					s.FieldByName(fmt.Sprintf("InstanceId%d", i+1)).Set(reflect.ValueOf(id))
 				}
@@ -150,7 +151,7 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 				return WrapError(err)
 			}
 
-			err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+			err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 
 				instances, err := essService.DescribeEssAttachment(d.Id(), add)
 				if err != nil {
@@ -182,13 +183,13 @@ func resourceAlibabacloudstackEssAttachmentUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudstackEssAttachmentRead(d, meta)
+	return resourceAlibabacloudstackEssAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	object, err := essService.DescribeEssAttachment(d.Id(), make([]string, 0))
 
 	if err != nil {
@@ -210,9 +211,9 @@ func resourceAlibabacloudstackEssAttachmentRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackEssAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	removed := convertArrayInterfaceToArrayString(d.Get("instance_ids").(*schema.Set).List())
 
@@ -231,7 +232,7 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.RegionId = client.RegionId
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -240,7 +241,7 @@ func resourceAlibabacloudstackEssAttachmentDelete(d *schema.ResourceData, meta i
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.ScalingGroupId = d.Id()
 
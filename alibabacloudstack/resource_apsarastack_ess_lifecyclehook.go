@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssLifeCycleHookCreate,
-		Read:   resourceAlibabacloudStackEssLifeCycleHookRead,
-		Update: resourceAlibabacloudStackEssLifeCycleHookUpdate,
-		Delete: resourceAlibabacloudStackEssLifeCycleHookDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssLifeCycleHookDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -66,7 +67,7 @@ func resourceAlibabacloudStackEssLifecycleHook() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request := buildAlibabacloudStackEssLifeCycleHookArgs(d)
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -77,16 +78,13 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateLifecycleHook(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling}) {
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -97,14 +95,14 @@ func resourceAlibabacloudStackEssLifeCycleHookCreate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_lifecyclehook", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	object, err := essService.DescribeEssLifecycleHook(d.Id())
 	if err != nil {
@@ -126,7 +124,7 @@ func resourceAlibabacloudStackEssLifeCycleHookRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyLifecycleHookRequest()
@@ -139,7 +137,7 @@ func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, met
 	request.LifecycleHookId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("lifecycle_transition") {
 		request.LifecycleTransition = d.Get("lifecycle_transition").(string)
@@ -168,13 +166,13 @@ func resourceAlibabacloudStackEssLifeCycleHookUpdate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssLifeCycleHookRead(d, meta)
+	return resourceAlibabacloudStackEssLifeCycleHookRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssLifeCycleHookDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	request := ess.CreateDeleteLifecycleHookRequest()
 	request.LifecycleHookId = d.Id()
 	request.RegionId = client.RegionId
@@ -191,7 +189,7 @@ func resourceAlibabacloudStackEssLifeCycleHookDelete(d *schema.ResourceData, met
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteLifecycleHook(request)
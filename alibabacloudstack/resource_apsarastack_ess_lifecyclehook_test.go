@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -24,7 +25,7 @@ func TestAccAlibabacloudStackEssLifecycleHookBasic(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -94,7 +95,7 @@ func TestAccAlibabacloudStackEssLifecycleHookBasic(t *testing.T) {
 
 func testAccCheckEssLifecycleHookDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_lifecycle_hook" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -25,7 +26,7 @@ func TestAccAlibabacloudStackEssNotification_basic(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -76,7 +77,7 @@ func TestAccAlibabacloudStackEssNotification_basic(t *testing.T) {
 
 func testAccCheckEssNotificationDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_notification" {
 			continue
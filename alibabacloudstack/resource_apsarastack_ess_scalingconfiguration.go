@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/base64"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,14 +18,15 @@ import (
 
 func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingConfigurationCreate,
-		Read:   resourceAlibabacloudStackEssScalingConfigurationRead,
-		Update: resourceAlibabacloudStackEssScalingConfigurationUpdate,
-		Delete: resourceAlibabacloudStackEssScalingConfigurationDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingConfigurationDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"active": {
 				Type:     schema.TypeBool,
@@ -162,6 +164,7 @@ func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 
 			"instance_name": {
 				Type:         schema.TypeString,
@@ -179,7 +182,7 @@ func resourceAlibabacloudStackEssScalingConfiguration() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	// Ensure instance_type is generation three
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -198,12 +201,12 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		request.IoOptimized = string(NoneOptimized)
 	}
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingConfiguration(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling, "IncorrectScalingGroupStatus"}) {
+			if IsExpectedErrors(err, []string{"IncorrectScalingGroupStatus"}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -216,12 +219,12 @@ func resourceAlibabacloudStackEssScalingConfigurationCreate(d *schema.ResourceDa
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_ess_scalingconfiguration", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	return resourceAlibabacloudStackEssScalingConfigurationUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	d.Partial(true)
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(strings.Split(d.Id(), COLON_SEPARATED)[1])
@@ -247,7 +250,7 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 			}
 		} else {
 			if c.LifecycleState == string(Active) {
-				_, err := activeSubstituteScalingConfiguration(d, meta)
+				_, err := activeSubstituteScalingConfiguration(ctx, d, meta)
 				if err != nil {
 					return WrapError(err)
 				}
@@ -256,7 +259,7 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 		//d.SetPartial("active")
 	}
 
-	if err := enableEssScalingConfiguration(d, meta); err != nil {
+	if err := enableEssScalingConfiguration(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
@@ -266,7 +269,7 @@ func resourceAlibabacloudStackEssScalingConfigurationUpdate(d *schema.ResourceDa
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackEssScalingConfigurationRead(d, meta)
+	return resourceAlibabacloudStackEssScalingConfigurationRead(ctx, d, meta)
 }
 
 func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) error {
@@ -278,7 +281,7 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingConfigurationId = d.Id()
 
@@ -366,8 +369,8 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 		//d.SetPartial("instance_name")
 	}
 
-	if d.HasChange("tags") {
-		if v, ok := d.GetOk("tags"); ok {
+	if d.HasChange("tags_all") {
+		if v, ok := d.GetOk("tags_all"); ok {
 			tags := "{"
 			for key, value := range v.(map[string]interface{}) {
 				tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
@@ -408,9 +411,9 @@ func modifyEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) error {
+func enableEssScalingConfiguration(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	if d.HasChange("enable") {
 		sgId := d.Get("scaling_group_id").(string)
@@ -449,7 +452,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.ScalingGroupId = sgId
 				request.ActiveScalingConfigurationId = activeConfig
@@ -477,7 +480,7 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.ScalingGroupId = sgId
 				raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
@@ -498,11 +501,11 @@ func enableEssScalingConfiguration(d *schema.ResourceData, meta interface{}) err
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(strings.Split(d.Id(), COLON_SEPARATED)[1])
 	}
@@ -526,7 +529,9 @@ func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData
 	d.Set("role_name", object.RamRoleName)
 	d.Set("key_name", object.KeyPairName)
 	d.Set("force_delete", d.Get("force_delete").(bool))
-	d.Set("tags", essTagsToMap(object.Tags.Tag))
+	if err := setResourceTags(d, meta, essTagsToMap(object.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 	d.Set("instance_name", object.InstanceName)
 	d.Set("override", d.Get("override").(bool))
 
@@ -556,9 +561,9 @@ func resourceAlibabacloudStackEssScalingConfigurationRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(strings.Split(d.Id(), COLON_SEPARATED)[1])
@@ -580,7 +585,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = object.ScalingGroupId
 
@@ -603,13 +608,13 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			request.ScalingGroupId = object.ScalingGroupId
 			request.ForceDelete = requests.NewBoolean(true)
 			request.RegionId = client.RegionId
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 			raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 				return essClient.DeleteScalingGroup(request)
@@ -636,7 +641,7 @@ func resourceAlibabacloudStackEssScalingConfigurationDelete(d *schema.ResourceDa
 		deleteScalingConfigurationRequest.Scheme = "http"
 	}
 	deleteScalingConfigurationRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	deleteScalingConfigurationRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	deleteScalingConfigurationRequest.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	rawDeleteScalingConfiguration, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScalingConfiguration(deleteScalingConfigurationRequest)
@@ -659,7 +664,7 @@ func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, m
 	request := ess.CreateCreateScalingConfigurationRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -747,7 +752,7 @@ func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, m
 		}
 	}
 
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		tags := "{"
 		for key, value := range v.(map[string]interface{}) {
 			tags += "\"" + key + "\"" + ":" + "\"" + value.(string) + "\"" + ","
@@ -762,9 +767,9 @@ func buildAlibabacloudStackEssScalingConfigurationArgs(d *schema.ResourceData, m
 	return request, nil
 }
 
-func activeSubstituteScalingConfiguration(d *schema.ResourceData, meta interface{}) (configures []ess.ScalingConfiguration, err error) {
+func activeSubstituteScalingConfiguration(ctx context.Context, d *schema.ResourceData, meta interface{}) (configures []ess.ScalingConfiguration, err error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	substituteId, ok := d.GetOk("substitute")
 
 	c, err := essService.DescribeEssScalingConfiguration(d.Id())
@@ -781,7 +786,7 @@ func activeSubstituteScalingConfiguration(d *schema.ResourceData, meta interface
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = c.ScalingGroupId
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -23,7 +24,7 @@ func TestAccAlibabacloudStackEssScalingConfigurationUpdate(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -178,7 +179,7 @@ func TestAccAlibabacloudStackEssScalingConfigurationMulti(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
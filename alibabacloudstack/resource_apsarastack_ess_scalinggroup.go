@@ -1,7 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,13 +20,19 @@ import (
 
 func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingGroupCreate,
-		Read:   resourceAlibabacloudStackEssScalingGroupRead,
-		Update: resourceAlibabacloudStackEssScalingGroupUpdate,
-		Delete: resourceAlibabacloudStackEssScalingGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+		CustomizeDiff: customizeDiffAll(
+			cannotUnsetDiff("launch_template_id"),
+		),
 
 		Schema: map[string]*schema.Schema{
 			"min_size": {
@@ -74,13 +82,72 @@ func resourceAlibabacloudStackEssScalingGroup() *schema.Resource {
 				Optional: true,
 				MinItems: 0,
 			},
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"launch_template_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"active_scaling_configuration_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"multi_az_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PRIORITY", "BALANCE", "COST_OPTIMIZED"}, false),
+			},
+			"health_check_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ECS", "NONE"}, false),
+			},
+			"desired_capacity": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"group_deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"instance_refresh": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"batch_size": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      1,
+							ValidateFunc: validation.IntBetween(1, 100),
+						},
+						"pause_time": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validation.IntBetween(0, 3600),
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
-	request, err := buildAlibabacloudStackEssScalingGroupArgs(d, meta)
+	request, err := buildAlibabacloudStackEssScalingGroupArgs(ctx, d, meta)
 
 	if err != nil {
 		return WrapError(err)
@@ -92,13 +159,13 @@ func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta
 	} else {
 		request.Scheme = "http"
 	}
-	essService := EssService{client}
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	essService := EssService{client, ctx}
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.CreateScalingGroup(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling, "IncorrectLoadBalancerHealthCheck", "IncorrectLoadBalancerStatus"}) {
+			if IsExpectedErrors(err, []string{"IncorrectLoadBalancerHealthCheck", "IncorrectLoadBalancerStatus"}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -114,14 +181,14 @@ func resourceAlibabacloudStackEssScalingGroupCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackEssScalingGroupUpdate(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
@@ -166,11 +233,18 @@ func resourceAlibabacloudStackEssScalingGroupRead(d *schema.ResourceData, meta i
 		}
 	}
 	d.Set("vswitch_ids", vswitchIds)
+	d.Set("launch_template_id", object.LaunchTemplateId)
+	d.Set("launch_template_version", object.LaunchTemplateVersion)
+	d.Set("active_scaling_configuration_id", object.ActiveScalingConfigurationId)
+	d.Set("multi_az_policy", object.MultiAZPolicy)
+	d.Set("health_check_type", object.HealthCheckType)
+	d.Set("desired_capacity", object.DesiredCapacity)
+	d.Set("group_deletion_protection", object.GroupDeletionProtection)
 
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ess.CreateModifyScalingGroupRequest()
@@ -181,7 +255,7 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = d.Id()
 
@@ -214,6 +288,28 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 		}
 	}
 
+	// The attributes below are given to CreateScalingGroup already
+	if !d.IsNewResource() {
+		if d.HasChange("launch_template_id") {
+			request.LaunchTemplateId = d.Get("launch_template_id").(string)
+		}
+		if d.HasChanges("launch_template_id", "launch_template_version") {
+			request.LaunchTemplateVersion = d.Get("launch_template_version").(string)
+		}
+		if d.HasChange("active_scaling_configuration_id") {
+			request.ActiveScalingConfigurationId = d.Get("active_scaling_configuration_id").(string)
+		}
+		if d.HasChange("health_check_type") {
+			request.HealthCheckType = d.Get("health_check_type").(string)
+		}
+		if d.HasChange("desired_capacity") {
+			request.DesiredCapacity = requests.NewInteger(d.Get("desired_capacity").(int))
+		}
+		if d.HasChange("group_deletion_protection") {
+			request.GroupDeletionProtection = requests.NewBoolean(d.Get("group_deletion_protection").(bool))
+		}
+	}
+
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.ModifyScalingGroup(request)
 	})
@@ -241,13 +337,19 @@ func resourceAlibabacloudStackEssScalingGroupUpdate(d *schema.ResourceData, meta
 		}
 		//d.SetPartial("db_instance_ids")
 	}
+
+	if !d.IsNewResource() && d.HasChanges("launch_template_id", "launch_template_version", "active_scaling_configuration_id") {
+		if err := refreshEssScalingGroupInstances(ctx, d, meta); err != nil {
+			return WrapError(err)
+		}
+	}
 	d.Partial(false)
-	return resourceAlibabacloudStackEssScalingGroupRead(d, meta)
+	return resourceAlibabacloudStackEssScalingGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	request := ess.CreateDeleteScalingGroupRequest()
 	request.RegionId = client.RegionId
@@ -257,7 +359,7 @@ func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScalingGroupId = d.Id()
 	request.ForceDelete = requests.NewBoolean(true)
@@ -277,9 +379,9 @@ func resourceAlibabacloudStackEssScalingGroupDelete(d *schema.ResourceData, meta
 	return WrapError(essService.WaitForEssScalingGroup(d.Id(), Deleted, DefaultTimeout))
 }
 
-func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupRequest, error) {
+func buildAlibabacloudStackEssScalingGroupArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*ess.CreateScalingGroupRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	request := ess.CreateCreateScalingGroupRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -288,7 +390,7 @@ func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta inte
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.MinSize = requests.NewInteger(d.Get("min_size").(int))
 	request.MaxSize = requests.NewInteger(d.Get("max_size").(int))
@@ -316,5 +418,148 @@ func buildAlibabacloudStackEssScalingGroupArgs(d *schema.ResourceData, meta inte
 		request.LoadBalancerIds = convertListToJsonString(lbs.(*schema.Set).List())
 	}
 
+	if v, ok := d.GetOk("launch_template_id"); ok {
+		request.LaunchTemplateId = v.(string)
+	}
+
+	if v, ok := d.GetOk("launch_template_version"); ok {
+		request.LaunchTemplateVersion = v.(string)
+	}
+
+	if v, ok := d.GetOk("multi_az_policy"); ok {
+		request.MultiAZPolicy = v.(string)
+	}
+
+	if v, ok := d.GetOk("health_check_type"); ok {
+		request.HealthCheckType = v.(string)
+	}
+
+	if v, ok := d.GetOkExists("desired_capacity"); ok {
+		request.DesiredCapacity = requests.NewInteger(v.(int))
+	}
+
+	request.GroupDeletionProtection = requests.NewBoolean(d.Get("group_deletion_protection").(bool))
+
 	return request, nil
 }
+
+// refreshEssScalingGroupInstances replaces the instances the scaling group has launched before its active scaling configuration
+// or launch template changed, batch by batch: it raises the capacity to launch a batch of instances from the new active one,
+// waits for them to be in service and then removes as many of the outdated instances.
+// essActiveLaunchTemplateVersion returns the number of the launch template version the scaling group launches its
+// instances from, the Default and Latest versions are resolved through the launch template.
+func essActiveLaunchTemplateVersion(ctx context.Context, group ess.ScalingGroup, meta interface{}) (string, error) {
+	if group.LaunchTemplateId == "" {
+		return "", nil
+	}
+	version := group.LaunchTemplateVersion
+	if version != "" && version != "Default" && version != "Latest" {
+		return version, nil
+	}
+	ecsService := EcsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
+	template, err := ecsService.DescribeLaunchTemplate(group.LaunchTemplateId)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	if version == "Latest" {
+		return strconv.FormatInt(template.LatestVersionNumber, 10), nil
+	}
+	return strconv.FormatInt(template.DefaultVersionNumber, 10), nil
+}
+
+// essScalingInstanceOutdated reports whether the scaling group launched the instance from another launch template
+// version or scaling configuration than its active one. The instances attached to the scaling group are never refreshed.
+func essScalingInstanceOutdated(instance ess.ScalingInstance, group ess.ScalingGroup, templateVersion string) bool {
+	if instance.CreationType != "AutoCreated" {
+		return false
+	}
+	if group.LaunchTemplateId != "" {
+		return instance.LaunchTemplateId != group.LaunchTemplateId || instance.LaunchTemplateVersion != templateVersion
+	}
+	return instance.ScalingConfigurationId != group.ActiveScalingConfigurationId
+}
+
+func refreshEssScalingGroupInstances(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	refreshes := d.Get("instance_refresh").([]interface{})
+	if len(refreshes) < 1 || refreshes[0] == nil {
+		return nil
+	}
+	refresh := refreshes[0].(map[string]interface{})
+	batchSize := refresh["batch_size"].(int)
+	pauseTime := time.Duration(refresh["pause_time"].(int)) * time.Second
+
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	essService := EssService{client, ctx}
+	group, err := essService.DescribeEssScalingGroup(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	if group.LifecycleState != string(Active) {
+		return nil
+	}
+	instances, err := essService.DescribeEssScalingInstances(d.Id(), string(InService))
+	if err != nil {
+		return WrapError(err)
+	}
+	templateVersion, err := essActiveLaunchTemplateVersion(ctx, group, meta)
+	if err != nil {
+		return WrapError(err)
+	}
+	var outdated []string
+	for _, instance := range instances {
+		if essScalingInstanceOutdated(instance, group, templateVersion) {
+			outdated = append(outdated, instance.InstanceId)
+		}
+	}
+
+	for len(outdated) > 0 {
+		total := len(instances)
+		batch := batchSize
+		if batch > len(outdated) {
+			batch = len(outdated)
+		}
+		if batch > group.MaxSize-total {
+			batch = group.MaxSize - total
+		}
+		if batch < 1 {
+			return WrapError(Error("the scaling group %s has %d instances and can not launch more to refresh them, raise its max_size", d.Id(), total))
+		}
+
+		// The scaling group works either with the desired capacity or with the minimum size
+		minSize, desiredCapacity := total+batch, 0
+		if minSize < group.MinSize {
+			minSize = group.MinSize
+		}
+		if group.DesiredCapacity > 0 {
+			minSize, desiredCapacity = group.MinSize, group.DesiredCapacity+batch
+		}
+		if err := essService.ModifyEssScalingGroupCapacity(d.Id(), minSize, desiredCapacity); err != nil {
+			return WrapError(err)
+		}
+		stateConf := BuildStateConf([]string{string(Pending)}, []string{string(InService)}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, essService.EssScalingInstancesRefreshFunc(d.Id(), total+batch))
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return WrapErrorf(err, IdMsg, d.Id())
+		}
+		// The removed instances decrease the desired capacity back, the minimum size has to be restored before
+		// the removal or the scaling group would launch new instances in their place
+		if desiredCapacity == 0 {
+			if err := essService.ModifyEssScalingGroupCapacity(d.Id(), group.MinSize, 0); err != nil {
+				return WrapError(err)
+			}
+		}
+		if err := essService.EssRemoveInstances(d.Id(), outdated[:batch]); err != nil {
+			return WrapError(err)
+		}
+		outdated = outdated[batch:]
+
+		if len(outdated) > 0 {
+			if err := sleepContext(ctx, pauseTime); err != nil {
+				return WrapError(err)
+			}
+			if instances, err = essService.DescribeEssScalingInstances(d.Id(), string(InService)); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+	return nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -115,7 +116,7 @@ func TestAccAlibabacloudStackEssScalingGroup_basic(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -218,7 +219,7 @@ func TestAccAlibabacloudStackEssScalingGroup_vpc(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -315,13 +316,13 @@ func TestAccAlibabacloudStackEssScalingGroup_slb(t *testing.T) {
 
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rcSlb0 := resourceCheckInit("alibabacloudstack_slb.default.0", &slb, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rcSlb1 := resourceCheckInit("alibabacloudstack_slb.default.1", &slb, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -442,7 +443,7 @@ func TestAccAlibabacloudStackEssScalingGroup_slb(t *testing.T) {
 
 func testAccCheckEssScalingGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_scaling_group" {
@@ -1049,3 +1050,108 @@ func testAccEssScalingGroupModifyVSwitchIds(common string, rand int) string {
 		removal_policies = ["OldestInstance"]
 	}`, common, rand)
 }
+
+func TestUnitAlibabacloudStackEssScalingGroup_instanceRefresh(t *testing.T) {
+	scalingInstances := func(ids ...string) map[string]interface{} {
+		instances := make([]interface{}, 0, len(ids))
+		for _, id := range ids {
+			creationType, version := "AutoCreated", "2"
+			if id == "i-attached" {
+				creationType = "Attached"
+			} else if strings.HasPrefix(id, "i-old") {
+				version = "1"
+			}
+			instances = append(instances, map[string]interface{}{
+				"InstanceId":            id,
+				"ScalingGroupId":        "asg-mock0001",
+				"LifecycleState":        "InService",
+				"CreationType":          creationType,
+				"LaunchTemplateId":      "lt-mock0001",
+				"LaunchTemplateVersion": version,
+			})
+		}
+		return map[string]interface{}{"TotalCount": len(ids), "ScalingInstances": map[string]interface{}{"ScalingInstance": instances}}
+	}
+	server := newMockApiServer(t).on("DescribeScalingGroups", map[string]interface{}{
+		"ScalingGroups": map[string]interface{}{
+			"ScalingGroup": []interface{}{
+				map[string]interface{}{
+					"ScalingGroupId":          "asg-mock0001",
+					"ScalingGroupName":        "refresh",
+					"LifecycleState":          "Active",
+					"MinSize":                 2,
+					"MaxSize":                 6,
+					"DefaultCooldown":         300,
+					"LaunchTemplateId":        "lt-mock0001",
+					"LaunchTemplateVersion":   "2",
+					"MultiAZPolicy":           "BALANCE",
+					"HealthCheckType":         "ECS",
+					"GroupDeletionProtection": true,
+				},
+			},
+		},
+	}).on("ModifyScalingGroup", map[string]interface{}{}).
+		on("DescribeScalingInstances", scalingInstances("i-old1", "i-old2", "i-current", "i-attached")).
+		on("DescribeScalingInstances", scalingInstances("i-old1", "i-old2", "i-current", "i-attached", "i-new1", "i-new2")).
+		on("RemoveInstances", map[string]interface{}{"ScalingActivityId": "asa-mock0001"}).
+		after("RemoveInstances", "DescribeScalingInstances", scalingInstances())
+	client := server.client()
+	r := resourceAlibabacloudStackEssScalingGroup()
+
+	current := r.Data(nil)
+	current.SetId("asg-mock0001")
+	for key, value := range map[string]interface{}{
+		"min_size":                  2,
+		"max_size":                  6,
+		"scaling_group_name":        "refresh",
+		"default_cooldown":          300,
+		"launch_template_id":        "lt-mock0001",
+		"launch_template_version":   "1",
+		"multi_az_policy":           "BALANCE",
+		"health_check_type":         "ECS",
+		"group_deletion_protection": true,
+	} {
+		if err := current.Set(key, value); err != nil {
+			t.Fatalf("setting %s got an error: %#v", key, err)
+		}
+	}
+	config := map[string]interface{}{
+		"min_size":                  2,
+		"max_size":                  6,
+		"scaling_group_name":        "refresh",
+		"launch_template_id":        "lt-mock0001",
+		"launch_template_version":   "2",
+		"multi_az_policy":           "BALANCE",
+		"group_deletion_protection": true,
+		"instance_refresh":          []interface{}{map[string]interface{}{"batch_size": 2, "pause_time": 0}},
+	}
+	diff, err := r.Diff(context.Background(), current.State(), terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the scaling group got an error: %#v", err)
+	}
+	state, diags := r.Apply(context.Background(), current.State(), diff, client)
+	if diags.HasError() {
+		t.Fatalf("updating the scaling group got an error: %#v", diags)
+	}
+
+	var modified []map[string]string
+	for _, call := range server.calls {
+		if call.Action == "ModifyScalingGroup" {
+			modified = append(modified, call.Params)
+		}
+	}
+	if len(modified) != 3 || modified[0]["LaunchTemplateVersion"] != "2" || modified[1]["MinSize"] != "6" || modified[2]["MinSize"] != "2" {
+		t.Errorf("expected the launch template version to change and the minimum size to be raised and restored, got %v", modified)
+	}
+	if call, ok := server.lastCall("RemoveInstances"); !ok || call.Params["InstanceId.1"] != "i-old1" || call.Params["InstanceId.2"] != "i-old2" || call.Params["InstanceId.3"] != "" {
+		t.Errorf("expected the outdated instances to be removed and the current and attached ones to be kept, got %v", call.Params)
+	}
+	if state.Attributes["launch_template_version"] != "2" || state.Attributes["multi_az_policy"] != "BALANCE" || state.Attributes["group_deletion_protection"] != "true" {
+		t.Errorf("expected the scaling group to be read, got %v", state.Attributes)
+	}
+
+	unset := map[string]interface{}{"min_size": 2, "max_size": 6}
+	if _, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(unset), client); err == nil || !strings.Contains(err.Error(), `"launch_template_id" can not be removed once it has been set`) {
+		t.Errorf("expected the plan to fail when the launch template is removed, got %v", err)
+	}
+}
@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackEssScalingGroupVserverGroups() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssVserverGroupsCreate,
-		Read:   resourceAlibabacloudStackEssVserverGroupsRead,
-		Update: resourceAlibabacloudStackEssVserverGroupsUpdate,
-		Delete: resourceAlibabacloudStackEssVserverGroupsDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssVserverGroupsCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssVserverGroupsRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssVserverGroupsUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssVserverGroupsDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
@@ -83,15 +84,15 @@ func resourceAlibabacloudStackEssScalingGroupVserverGroups() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssVserverGroupsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	d.SetId(d.Get("scaling_group_id").(string))
-	return resourceAlibabacloudStackEssVserverGroupsUpdate(d, meta)
+	return resourceAlibabacloudStackEssVserverGroupsUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssVserverGroupsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
 		return WrapError(err)
@@ -107,9 +108,9 @@ func resourceAlibabacloudStackEssVserverGroupsRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssVserverGroupsUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	object, err := essService.DescribeEssScalingGroup(d.Id())
 	if err != nil {
 		return WrapError(err)
@@ -135,10 +136,10 @@ func resourceAlibabacloudStackEssVserverGroupsUpdate(d *schema.ResourceData, met
 	}
 	//d.SetPartial("vserver_groups")
 	d.Partial(false)
-	return resourceAlibabacloudStackEssVserverGroupsRead(d, meta)
+	return resourceAlibabacloudStackEssVserverGroupsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssVserverGroupsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssVserverGroupsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	vserverGroupsFromConfig := vserverGroupMapFromConfig(d.Get("vserver_groups").(*schema.Set))
 	_, detachMap := attachOrDetachVserverGroupMap(make(map[string]string, 0), vserverGroupsFromConfig)
@@ -252,7 +253,7 @@ func attachVserverGroups(d *schema.ResourceData, client *connectivity.Alibabaclo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ScalingGroupId = d.Id()
 		request.ForceAttach = requests.NewBoolean(force)
 		request.VServerGroup = &attachScalingGroupVserverGroups
@@ -295,7 +296,7 @@ func detachVserverGroups(d *schema.ResourceData, client *connectivity.Alibabaclo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.ScalingGroupId = d.Id()
 		request.ForceDetach = requests.NewBoolean(force)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -57,7 +58,7 @@ func TestAccAlibabacloudStackEssVserverGroups_basic(t *testing.T) {
 
 func testAccCheckEssVserverGroupsDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_scaling_group" {
 			continue
@@ -1,6 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,29 +16,47 @@ import (
 
 func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScalingRuleCreate,
-		Read:   resourceAlibabacloudStackEssScalingRuleRead,
-		Update: resourceAlibabacloudStackEssScalingRuleUpdate,
-		Delete: resourceAlibabacloudStackEssScalingRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScalingRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScalingRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: customizeDiffAll(
+			essScalingRuleTypeDiff,
+		),
 		Schema: map[string]*schema.Schema{
 			"scaling_group_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"scaling_rule_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(SimpleScalingRule),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(SimpleScalingRule),
+					string(StepScalingRule),
+					string(TargetTrackingScalingRule),
+					string(PredictiveScalingRule),
+				}, false),
+			},
 			"adjustment_type": {
-				Type: schema.TypeString,
-				//Optional:     true,
-				Required:     true,
+				Type:         schema.TypeString,
+				Optional:     true,
 				ValidateFunc: validation.StringInSlice([]string{"QuantityChangeInCapacity", "PercentChangeInCapacity", "TotalCapacity"}, false),
 			},
 			"adjustment_value": {
-				Type: schema.TypeInt,
-				//Optional: true,
-				Required: true,
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"min_adjustment_magnitude": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"scaling_rule_name": {
 				Type:         schema.TypeString,
@@ -52,11 +73,86 @@ func resourceAlibabacloudStackEssScalingRule() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 86400),
 			},
+			"step_adjustment": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_interval_lower_bound": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateStringConvertFloat64(),
+						},
+						"metric_interval_upper_bound": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateStringConvertFloat64(),
+						},
+						"scaling_adjustment": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"metric_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CpuUtilization", "ClassicInternetRx", "ClassicInternetTx", "VpcInternetRx", "VpcInternetTx", "IntranetRx", "IntranetTx",
+				}, false),
+			},
+			"target_value": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				ValidateFunc: validation.FloatAtLeast(0),
+			},
+			"disable_scale_in": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"estimated_instance_warmup": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 86400),
+			},
+			"predictive_scaling_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"PredictAndScale", "PredictOnly"}, false),
+			},
+			"initial_max_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"predictive_value_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MaxOverridePredictiveValue", "PredictiveValueOverrideMax", "PredictiveValueOverrideMaxWithBuffer"}, false),
+			},
+			"predictive_value_buffer": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"predictive_task_buffer_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 60),
+			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request, err := buildAlibabacloudStackEssScalingRuleArgs(d, meta)
 	if err != nil {
@@ -71,7 +167,7 @@ func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.CreateScalingRule(request)
@@ -83,10 +179,10 @@ func resourceAlibabacloudStackEssScalingRuleCreate(d *schema.ResourceData, meta
 	response, _ := raw.(*ess.CreateScalingRuleResponse)
 	d.SetId(response.ScalingRuleId)
 
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	//Compatible with older versions id
@@ -96,7 +192,7 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 	}
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	object, err := essService.DescribeEssScalingRule(d.Id())
 	if err != nil {
@@ -109,15 +205,34 @@ func resourceAlibabacloudStackEssScalingRuleRead(d *schema.ResourceData, meta in
 
 	d.Set("scaling_group_id", object.ScalingGroupId)
 	d.Set("ari", object.ScalingRuleAri)
-	d.Set("adjustment_type", object.AdjustmentType)
-	d.Set("adjustment_value", object.AdjustmentValue)
+	if object.ScalingRuleType != "" {
+		d.Set("scaling_rule_type", object.ScalingRuleType)
+	}
+	switch ScalingRuleType(d.Get("scaling_rule_type").(string)) {
+	case SimpleScalingRule, StepScalingRule:
+		d.Set("adjustment_type", object.AdjustmentType)
+		d.Set("adjustment_value", object.AdjustmentValue)
+		d.Set("min_adjustment_magnitude", object.MinAdjustmentMagnitude)
+	}
 	d.Set("scaling_rule_name", object.ScalingRuleName)
 	d.Set("cooldown", object.Cooldown)
+	d.Set("metric_name", object.MetricName)
+	d.Set("target_value", object.TargetValue)
+	d.Set("disable_scale_in", object.DisableScaleIn)
+	d.Set("estimated_instance_warmup", object.EstimatedInstanceWarmup)
+	d.Set("predictive_scaling_mode", object.PredictiveScalingMode)
+	d.Set("initial_max_size", object.InitialMaxSize)
+	d.Set("predictive_value_behavior", object.PredictiveValueBehavior)
+	d.Set("predictive_value_buffer", object.PredictiveValueBuffer)
+	d.Set("predictive_task_buffer_time", object.PredictiveTaskBufferTime)
+	if err := d.Set("step_adjustment", flattenEssStepAdjustments(object.StepAdjustments.StepAdjustment, d.Get("step_adjustment").([]interface{}))); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -126,7 +241,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 	}
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 	request := ess.CreateDeleteScalingRuleRequest()
 	request.ScalingRuleId = d.Id()
 	request.RegionId = client.RegionId
@@ -136,7 +251,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScalingRule(request)
@@ -151,7 +266,7 @@ func resourceAlibabacloudStackEssScalingRuleDelete(d *schema.ResourceData, meta
 	return WrapError(essService.WaitForEssScalingRule(d.Id(), Deleted, DefaultTimeout))
 }
 
-func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScalingRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	//Compatible with older versions id
 	if strings.Contains(d.Id(), COLON_SEPARATED) {
@@ -169,7 +284,7 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 	request.ScalingRuleId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("scaling_rule_name") {
 		request.ScalingRuleName = d.Get("scaling_rule_name").(string)
@@ -183,6 +298,43 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 	if d.HasChange("cooldown") {
 		request.Cooldown = requests.NewInteger(d.Get("cooldown").(int))
 	}
+	if d.HasChange("min_adjustment_magnitude") {
+		request.MinAdjustmentMagnitude = requests.NewInteger(d.Get("min_adjustment_magnitude").(int))
+	}
+	if d.HasChange("step_adjustment") {
+		steps := make([]ess.ModifyScalingRuleStepAdjustment, 0)
+		for _, step := range expandEssStepAdjustments(d.Get("step_adjustment").([]interface{})) {
+			steps = append(steps, ess.ModifyScalingRuleStepAdjustment(step))
+		}
+		request.StepAdjustment = &steps
+	}
+	if d.HasChange("metric_name") {
+		request.MetricName = d.Get("metric_name").(string)
+	}
+	if d.HasChange("target_value") {
+		request.TargetValue = requests.NewFloat(d.Get("target_value").(float64))
+	}
+	if d.HasChange("disable_scale_in") {
+		request.DisableScaleIn = requests.NewBoolean(d.Get("disable_scale_in").(bool))
+	}
+	if d.HasChange("estimated_instance_warmup") {
+		request.EstimatedInstanceWarmup = requests.NewInteger(d.Get("estimated_instance_warmup").(int))
+	}
+	if d.HasChange("predictive_scaling_mode") {
+		request.PredictiveScalingMode = d.Get("predictive_scaling_mode").(string)
+	}
+	if d.HasChange("initial_max_size") {
+		request.InitialMaxSize = requests.NewInteger(d.Get("initial_max_size").(int))
+	}
+	if d.HasChange("predictive_value_behavior") {
+		request.PredictiveValueBehavior = d.Get("predictive_value_behavior").(string)
+	}
+	if d.HasChange("predictive_value_buffer") {
+		request.PredictiveValueBuffer = requests.NewInteger(d.Get("predictive_value_buffer").(int))
+	}
+	if d.HasChange("predictive_task_buffer_time") {
+		request.PredictiveTaskBufferTime = requests.NewInteger(d.Get("predictive_task_buffer_time").(int))
+	}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.ModifyScalingRule(request)
@@ -191,7 +343,7 @@ func resourceAlibabacloudStackEssScalingRuleUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScalingRuleRead(d, meta)
+	return resourceAlibabacloudStackEssScalingRuleRead(ctx, d, meta)
 }
 
 func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta interface{}) (*ess.CreateScalingRuleRequest, error) {
@@ -204,7 +356,7 @@ func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta inter
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	// common params
 	request.ScalingGroupId = d.Get("scaling_group_id").(string)
@@ -212,15 +364,133 @@ func buildAlibabacloudStackEssScalingRuleArgs(d *schema.ResourceData, meta inter
 	if v, ok := d.GetOk("scaling_rule_name"); ok && v.(string) != "" {
 		request.ScalingRuleName = v.(string)
 	}
-	if v, ok := d.GetOk("adjustment_type"); ok && v.(string) != "" {
-		request.AdjustmentType = v.(string)
-	}
-	if v, ok := d.GetOkExists("adjustment_value"); ok {
-		request.AdjustmentValue = requests.NewInteger(v.(int))
+	ruleType := d.Get("scaling_rule_type").(string)
+	request.ScalingRuleType = ruleType
+	switch ScalingRuleType(ruleType) {
+	case SimpleScalingRule, StepScalingRule:
+		if v, ok := d.GetOk("adjustment_type"); ok && v.(string) != "" {
+			request.AdjustmentType = v.(string)
+		}
+		if v, ok := d.GetOk("min_adjustment_magnitude"); ok {
+			request.MinAdjustmentMagnitude = requests.NewInteger(v.(int))
+		}
 	}
-	if v, ok := d.GetOk("cooldown"); ok {
-		request.Cooldown = requests.NewInteger(v.(int))
+	switch ScalingRuleType(ruleType) {
+	case SimpleScalingRule:
+		request.AdjustmentValue = requests.NewInteger(d.Get("adjustment_value").(int))
+		if v, ok := d.GetOk("cooldown"); ok {
+			request.Cooldown = requests.NewInteger(v.(int))
+		}
+	case StepScalingRule:
+		steps := expandEssStepAdjustments(d.Get("step_adjustment").([]interface{}))
+		request.StepAdjustment = &steps
+		if v, ok := d.GetOk("estimated_instance_warmup"); ok {
+			request.EstimatedInstanceWarmup = requests.NewInteger(v.(int))
+		}
+	case TargetTrackingScalingRule:
+		request.MetricName = d.Get("metric_name").(string)
+		request.TargetValue = requests.NewFloat(d.Get("target_value").(float64))
+		request.DisableScaleIn = requests.NewBoolean(d.Get("disable_scale_in").(bool))
+		if v, ok := d.GetOk("estimated_instance_warmup"); ok {
+			request.EstimatedInstanceWarmup = requests.NewInteger(v.(int))
+		}
+	case PredictiveScalingRule:
+		request.MetricName = d.Get("metric_name").(string)
+		request.TargetValue = requests.NewFloat(d.Get("target_value").(float64))
+		if v, ok := d.GetOk("predictive_scaling_mode"); ok {
+			request.PredictiveScalingMode = v.(string)
+		}
+		if v, ok := d.GetOkExists("initial_max_size"); ok {
+			request.InitialMaxSize = requests.NewInteger(v.(int))
+		}
+		if v, ok := d.GetOk("predictive_value_behavior"); ok {
+			request.PredictiveValueBehavior = v.(string)
+		}
+		if v, ok := d.GetOkExists("predictive_value_buffer"); ok {
+			request.PredictiveValueBuffer = requests.NewInteger(v.(int))
+		}
+		if v, ok := d.GetOkExists("predictive_task_buffer_time"); ok {
+			request.PredictiveTaskBufferTime = requests.NewInteger(v.(int))
+		}
 	}
 
 	return request, nil
 }
+
+func expandEssStepAdjustments(configured []interface{}) []ess.CreateScalingRuleStepAdjustment {
+	steps := make([]ess.CreateScalingRuleStepAdjustment, 0, len(configured))
+	for _, raw := range configured {
+		step, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		steps = append(steps, ess.CreateScalingRuleStepAdjustment{
+			MetricIntervalLowerBound: step["metric_interval_lower_bound"].(string),
+			MetricIntervalUpperBound: step["metric_interval_upper_bound"].(string),
+			ScalingAdjustment:        strconv.Itoa(step["scaling_adjustment"].(int)),
+		})
+	}
+	return steps
+}
+
+// flattenEssStepAdjustments converts the steps returned by the api. An open interval bound
+// is returned as 0, so a bound which is not configured is kept empty when it reads as 0.
+func flattenEssStepAdjustments(steps []ess.StepAdjustment, configured []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(steps))
+	for i, step := range steps {
+		lower := strconv.FormatFloat(step.MetricIntervalLowerBound, 'f', -1, 64)
+		upper := strconv.FormatFloat(step.MetricIntervalUpperBound, 'f', -1, 64)
+		if i < len(configured) {
+			if old, ok := configured[i].(map[string]interface{}); ok {
+				if old["metric_interval_lower_bound"] == "" && step.MetricIntervalLowerBound == 0 {
+					lower = ""
+				}
+				if old["metric_interval_upper_bound"] == "" && step.MetricIntervalUpperBound == 0 {
+					upper = ""
+				}
+			}
+		}
+		result = append(result, map[string]interface{}{
+			"metric_interval_lower_bound": lower,
+			"metric_interval_upper_bound": upper,
+			"scaling_adjustment":          step.ScalingAdjustment,
+		})
+	}
+	return result
+}
+
+// essScalingRuleTypeDiff checks the arguments against the scaling rule type,
+// as the api silently ignores the arguments which do not apply to the type.
+func essScalingRuleTypeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("scaling_rule_type") {
+		return nil
+	}
+	ruleType := ScalingRuleType(d.Get("scaling_rule_type").(string))
+	required := map[ScalingRuleType][]string{
+		SimpleScalingRule:         {"adjustment_type"},
+		StepScalingRule:           {"adjustment_type", "step_adjustment"},
+		TargetTrackingScalingRule: {"metric_name", "target_value"},
+		PredictiveScalingRule:     {"metric_name", "target_value"},
+	}
+	unsupported := map[ScalingRuleType][]string{
+		SimpleScalingRule:         {"step_adjustment", "metric_name"},
+		StepScalingRule:           {"cooldown", "metric_name"},
+		TargetTrackingScalingRule: {"adjustment_type", "cooldown", "step_adjustment"},
+		PredictiveScalingRule:     {"adjustment_type", "cooldown", "step_adjustment"},
+	}
+	var messages []string
+	for _, key := range required[ruleType] {
+		if _, ok := d.GetOk(key); !ok && d.NewValueKnown(key) {
+			messages = append(messages, fmt.Sprintf("%q is required when %q is %s", key, "scaling_rule_type", ruleType))
+		}
+	}
+	for _, key := range unsupported[ruleType] {
+		if _, ok := d.GetOk(key); ok {
+			messages = append(messages, fmt.Sprintf("%q is not supported when %q is %s", key, "scaling_rule_type", ruleType))
+		}
+	}
+	if len(messages) > 0 {
+		return fmt.Errorf("%s", strings.Join(messages, "\n"))
+	}
+	return nil
+}
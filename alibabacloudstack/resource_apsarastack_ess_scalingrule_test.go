@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"strings"
 	"testing"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
@@ -23,7 +25,7 @@ func TestAccAlibabacloudStackEssScalingRule_basic(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -60,7 +62,7 @@ func TestAccAlibabacloudStackEssScalingRuleMulti(t *testing.T) {
 	}
 	ra := resourceAttrInit(resourceId, basicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -88,7 +90,7 @@ func TestAccAlibabacloudStackEssScalingRuleMulti(t *testing.T) {
 
 func testAccCheckEssScalingRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_scaling_rule" {
@@ -324,3 +326,104 @@ func testAccEssStepScalingRuleConfig(common string, rand int) string {
 	}
 	`, common, rand)
 }
+
+func TestUnitAlibabacloudStackEssScalingRule_types(t *testing.T) {
+	server := newMockApiServer(t).on("CreateScalingRule", map[string]interface{}{
+		"ScalingRuleId":  "asr-mock0001",
+		"ScalingRuleAri": "ari:acs:ess:cn-qingdao-env66-d01:1:scalingrule/asr-mock0001",
+	}).on("DescribeScalingRules", map[string]interface{}{
+		"ScalingRules": map[string]interface{}{
+			"ScalingRule": []interface{}{
+				map[string]interface{}{
+					"ScalingRuleId":           "asr-mock0001",
+					"ScalingGroupId":          "asg-mock0001",
+					"ScalingRuleName":         "cpu-60",
+					"ScalingRuleAri":          "ari:acs:ess:cn-qingdao-env66-d01:1:scalingrule/asr-mock0001",
+					"ScalingRuleType":         "TargetTrackingScalingRule",
+					"MetricName":              "CpuUtilization",
+					"TargetValue":             60,
+					"DisableScaleIn":          true,
+					"EstimatedInstanceWarmup": 300,
+				},
+			},
+		},
+	})
+	client := server.client()
+	r := resourceAlibabacloudStackEssScalingRule()
+	config := map[string]interface{}{
+		"scaling_group_id":          "asg-mock0001",
+		"scaling_rule_name":         "cpu-60",
+		"scaling_rule_type":         "TargetTrackingScalingRule",
+		"metric_name":               "CpuUtilization",
+		"target_value":              60,
+		"disable_scale_in":          true,
+		"estimated_instance_warmup": 300,
+	}
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the scaling rule got an error: %#v", err)
+	}
+	state, diags := r.Apply(context.Background(), nil, diff, client)
+	if diags.HasError() {
+		t.Fatalf("creating the scaling rule got an error: %#v", diags)
+	}
+	call, ok := server.lastCall("CreateScalingRule")
+	if !ok || call.Params["ScalingRuleType"] != "TargetTrackingScalingRule" || call.Params["MetricName"] != "CpuUtilization" ||
+		call.Params["TargetValue"] != "60.000000" || call.Params["DisableScaleIn"] != "true" || call.Params["EstimatedInstanceWarmup"] != "300" ||
+		call.Params["AdjustmentType"] != "" || call.Params["AdjustmentValue"] != "" {
+		t.Errorf("CreateScalingRule was not called with the expected parameters: %v", call.Params)
+	}
+	if state.ID != "asr-mock0001" || state.Attributes["target_value"] != "60" || state.Attributes["ari"] == "" {
+		t.Errorf("expected the target tracking rule to be read, got %v", state.Attributes)
+	}
+	if diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client); err != nil || !diff.Empty() {
+		t.Errorf("expected no changes after creating the scaling rule, got %v %v", diff, err)
+	}
+
+	steps := newMockApiResourceData(t, r, map[string]interface{}{
+		"scaling_group_id":  "asg-mock0001",
+		"scaling_rule_type": "StepScalingRule",
+		"adjustment_type":   "QuantityChangeInCapacity",
+		"step_adjustment": []interface{}{
+			map[string]interface{}{"metric_interval_upper_bound": "10", "scaling_adjustment": 1},
+			map[string]interface{}{"metric_interval_lower_bound": "10", "scaling_adjustment": 2},
+		},
+	})
+	request, err := buildAlibabacloudStackEssScalingRuleArgs(steps, client)
+	if err != nil {
+		t.Fatalf("building the step scaling rule got an error: %#v", err)
+	}
+	if request.StepAdjustment == nil || len(*request.StepAdjustment) != 2 || (*request.StepAdjustment)[0].MetricIntervalUpperBound != "10" ||
+		(*request.StepAdjustment)[0].MetricIntervalLowerBound != "" || (*request.StepAdjustment)[1].ScalingAdjustment != "2" {
+		t.Errorf("expected two step adjustments, got %#v", request.StepAdjustment)
+	}
+	flattened := flattenEssStepAdjustments([]ess.StepAdjustment{
+		{MetricIntervalUpperBound: 10, ScalingAdjustment: 1},
+		{MetricIntervalLowerBound: 10, ScalingAdjustment: 2},
+	}, steps.Get("step_adjustment").([]interface{}))
+	if flattened[0]["metric_interval_lower_bound"] != "" || flattened[0]["metric_interval_upper_bound"] != "10" || flattened[1]["metric_interval_upper_bound"] != "" {
+		t.Errorf("expected the open interval bounds to stay empty, got %v", flattened)
+	}
+
+	for _, invalid := range []struct {
+		config  map[string]interface{}
+		message string
+	}{
+		{
+			config:  map[string]interface{}{"scaling_group_id": "asg-mock0001", "scaling_rule_type": "StepScalingRule", "adjustment_type": "TotalCapacity"},
+			message: `"step_adjustment" is required when "scaling_rule_type" is StepScalingRule`,
+		},
+		{
+			config:  map[string]interface{}{"scaling_group_id": "asg-mock0001", "scaling_rule_type": "TargetTrackingScalingRule", "metric_name": "CpuUtilization", "target_value": 60, "cooldown": 60},
+			message: `"cooldown" is not supported when "scaling_rule_type" is TargetTrackingScalingRule`,
+		},
+		{
+			config:  map[string]interface{}{"scaling_group_id": "asg-mock0001", "adjustment_value": 1},
+			message: `"adjustment_type" is required when "scaling_rule_type" is SimpleScalingRule`,
+		},
+	} {
+		if _, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(invalid.config), client); err == nil || !strings.Contains(err.Error(), invalid.message) {
+			t.Errorf("expected the plan to fail with %s, got %v", invalid.message, err)
+		}
+	}
+}
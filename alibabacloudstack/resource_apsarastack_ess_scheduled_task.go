@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ess"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackEssScheduledTaskCreate,
-		Read:   resourceAlibabacloudStackEssScheduledTaskRead,
-		Update: resourceAlibabacloudStackEssScheduledTaskUpdate,
-		Delete: resourceAlibabacloudStackEssScheduledTaskDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackEssScheduledTaskRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackEssScheduledTaskDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -68,7 +69,7 @@ func resourceAlibabacloudStackEssScheduledTask() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	request := buildAlibabacloudStackEssScheduledTaskArgs(d)
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -79,7 +80,7 @@ func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.CreateScheduledTask(request)
@@ -91,14 +92,14 @@ func resourceAlibabacloudStackEssScheduledTaskCreate(d *schema.ResourceData, met
 	response, _ := raw.(*ess.CreateScheduledTaskResponse)
 	d.SetId(response.ScheduledTaskId)
 
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	object, err := essService.DescribeEssScheduledTask(d.Id())
 	if err != nil {
@@ -122,7 +123,7 @@ func resourceAlibabacloudStackEssScheduledTaskRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
@@ -134,7 +135,7 @@ func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScheduledTaskId = d.Id()
 	request.LaunchExpirationTime = requests.NewInteger(d.Get("launch_expiration_time").(int))
@@ -172,12 +173,12 @@ func resourceAlibabacloudStackEssScheduledTaskUpdate(d *schema.ResourceData, met
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackEssScheduledTaskRead(d, meta)
+	return resourceAlibabacloudStackEssScheduledTaskRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackEssScheduledTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, ctx}
 
 	request := ess.CreateDeleteScheduledTaskRequest()
 	request.RegionId = client.RegionId
@@ -187,12 +188,12 @@ func resourceAlibabacloudStackEssScheduledTaskDelete(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.ScheduledTaskId = d.Id()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DeleteScheduledTask(request)
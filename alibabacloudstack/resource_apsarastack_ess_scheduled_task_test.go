@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -47,7 +48,7 @@ func testSweepEssSchedules(region string) error {
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageNumber = requests.NewInteger(1)
 	for {
 		raw, err := client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
@@ -95,7 +96,7 @@ func testSweepEssSchedules(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "ess", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.QueryParams["Department"] = client.Department
 		req.QueryParams["ResourceGroup"] = client.ResourceGroup
 		req.ScheduledTaskId = id
@@ -114,7 +115,7 @@ func TestAccAlibabacloudStackEssScheduledTask_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ess_scheduled_task.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -225,7 +226,7 @@ func TestAccAlibabacloudStackEssScheduledTask_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_ess_scheduled_task.default.9"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -264,7 +265,7 @@ func TestAccAlibabacloudStackEssScheduledTask_multi(t *testing.T) {
 
 func testAccCheckEssScheduledTaskDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	essService := EssService{client}
+	essService := EssService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_ess_scheduled_task" {
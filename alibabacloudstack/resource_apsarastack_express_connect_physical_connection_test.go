@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackClientExpressConnectPhysicalConnection_domesic(t *t
 	resourceId := "alibabacloudstack_express_connect_physical_connection.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackClientExpressConnectPhysicalConnectionMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeExpressConnectPhysicalConnection")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackExpressConnectVirtualBorderRouter() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate,
-		Read:   resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead,
-		Update: resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate,
-		Delete: resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Update: schema.DefaultTimeout(2 * time.Minute),
@@ -120,7 +121,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouter() *schema.Resour
 	}
 }
 
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateVirtualBorderRouter"
@@ -170,10 +171,10 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			if NeedRetry(err) || IsExpectedErrors(err, []string{"TaskConflict"}) {
+			if IsExpectedErrors(err, []string{"TaskConflict"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -188,11 +189,11 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterCreate(d *schema.
 
 	d.SetId(fmt.Sprint(response["VbrId"]))
 
-	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d, meta)
+	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeExpressConnectVirtualBorderRouter(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -229,9 +230,9 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d *schema.Re
 	d.Set("route_table_id", object["RouteTableId"])
 	return nil
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	conn, err := client.NewVpcClient()
 	if err != nil {
 		return WrapError(err)
@@ -351,18 +352,7 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 		request["ClientToken"] = buildClientToken("ModifyVirtualBorderRouterAttribute")
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -387,24 +377,13 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 				request["ClientToken"] = buildClientToken("RecoverVirtualBorderRouter")
 				runtime := util.RuntimeOptions{}
 				runtime.SetAutoretry(true)
-				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-					response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-					if err != nil {
-						if NeedRetry(err) {
-							wait()
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 				addDebug(action, response, request)
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 				}
 				stateConf := BuildStateConf([]string{}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.ExpressConnectVirtualBorderRouterStateRefreshFunc(d.Id(), []string{}))
-				if _, err := stateConf.WaitForState(); err != nil {
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 					return WrapErrorf(err, IdMsg, d.Id())
 				}
 			}
@@ -419,33 +398,22 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterUpdate(d *schema.
 				request["ClientToken"] = buildClientToken("TerminateVirtualBorderRouter")
 				runtime := util.RuntimeOptions{}
 				runtime.SetAutoretry(true)
-				wait := incrementalWait(3*time.Second, 3*time.Second)
-				err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-					response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-					if err != nil {
-						if NeedRetry(err) {
-							wait()
-							return resource.RetryableError(err)
-						}
-						return resource.NonRetryableError(err)
-					}
-					return nil
-				})
+				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 				addDebug(action, response, request)
 				if err != nil {
 					return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 				}
 				stateConf := BuildStateConf([]string{}, []string{"terminated"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.ExpressConnectVirtualBorderRouterStateRefreshFunc(d.Id(), []string{}))
-				if _, err := stateConf.WaitForState(); err != nil {
+				if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 					return WrapErrorf(err, IdMsg, d.Id())
 				}
 			}
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(d, meta)
+	return resourceAlibabacloudStackExpressConnectVirtualBorderRouterRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteVirtualBorderRouter"
 	var response map[string]interface{}
@@ -464,10 +432,10 @@ func resourceAlibabacloudStackExpressConnectVirtualBorderRouterDelete(d *schema.
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			if NeedRetry(err) || IsExpectedErrors(err, []string{"DependencyViolation.BgpGroup"}) {
+			if IsExpectedErrors(err, []string{"DependencyViolation.BgpGroup"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -0,0 +1,159 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackFcAlias() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackFcAliasCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackFcAliasRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackFcAliasUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackFcAliasDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"additional_version_weights": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeFloat},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackFcAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	serviceName := d.Get("service").(string)
+	name := d.Get("name").(string)
+
+	request := fc.NewCreateAliasInput(serviceName).
+		WithAliasName(name).
+		WithVersionID(d.Get("version_id").(string)).
+		WithDescription(d.Get("description").(string)).
+		WithAdditionalVersionWeight(expandFcAliasVersionWeights(d.Get("additional_version_weights").(map[string]interface{})))
+
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.CreateAlias(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_fc_alias", "CreateAlias", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("CreateAlias", raw, requestInfo, request)
+	d.SetId(fmt.Sprintf("%s%s%s", serviceName, COLON_SEPARATED, name))
+
+	return resourceAlibabacloudStackFcAliasRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	object, err := fcService.DescribeFcAlias(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("service", parts[0])
+	d.Set("name", tea.StringValue(object.AliasName))
+	d.Set("version_id", tea.StringValue(object.VersionID))
+	d.Set("description", tea.StringValue(object.Description))
+	if err := d.Set("additional_version_weights", object.AdditionalVersionWeight); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackFcAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if d.HasChanges("version_id", "description", "additional_version_weights") {
+		request := fc.NewUpdateAliasInput(parts[0], parts[1]).
+			WithVersionID(d.Get("version_id").(string)).
+			WithDescription(d.Get("description").(string)).
+			WithAdditionalVersionWeight(expandFcAliasVersionWeights(d.Get("additional_version_weights").(map[string]interface{})))
+
+		var requestInfo *fc.Client
+		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.UpdateAlias(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateAlias", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("UpdateAlias", raw, requestInfo, request)
+	}
+
+	return resourceAlibabacloudStackFcAliasRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := fc.NewDeleteAliasInput(parts[0], parts[1])
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.DeleteAlias(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteAlias", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("DeleteAlias", raw, requestInfo, request)
+	return nil
+}
+
+func expandFcAliasVersionWeights(configured map[string]interface{}) map[string]float64 {
+	weights := make(map[string]float64)
+	for k, v := range configured {
+		weights[k] = v.(float64)
+	}
+	return weights
+}
@@ -0,0 +1,286 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackFcFunction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackFcFunctionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackFcFunctionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackFcFunctionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackFcFunctionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: fcFunctionCodeChecksumDiff,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"runtime": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"handler": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"initializer": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(1, 600),
+			},
+			"initialization_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 300),
+			},
+			"memory_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      128,
+				ValidateFunc: validation.IntBetween(128, 3072),
+			},
+			"instance_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"environment_variables": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"filename": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"oss_bucket", "oss_key"},
+			},
+			"oss_bucket": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+				RequiredWith:  []string{"oss_key"},
+			},
+			"oss_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"filename"},
+				RequiredWith:  []string{"oss_bucket"},
+			},
+			"code_checksum": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"function_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// fcFunctionCodeChecksumDiff plans an update of the code when the local code package no longer
+// matches the checksum of the code which was deployed. The code in oss is redeployed when its
+// object changes, or when the checksum of the new object content is set in code_checksum.
+func fcFunctionCodeChecksumDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	filename, ok := d.GetOk("filename")
+	if !ok {
+		return nil
+	}
+	checksum, err := fcFunctionCodeChecksum(filename.(string))
+	if err != nil {
+		return WrapError(err)
+	}
+	if d.Get("code_checksum").(string) != checksum {
+		return d.SetNew("code_checksum", checksum)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackFcFunctionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	serviceName := d.Get("service").(string)
+	name := d.Get("name").(string)
+
+	code, err := fcFunctionCode(d.Get("filename").(string), d.Get("oss_bucket").(string), d.Get("oss_key").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+	request := fc.NewCreateFunctionInput(serviceName).WithFunctionName(name)
+	request.Description = StringPointer(d.Get("description").(string))
+	request.Runtime = StringPointer(d.Get("runtime").(string))
+	request.Handler = StringPointer(d.Get("handler").(string))
+	request.Timeout = Int32Pointer(int32(d.Get("timeout").(int)))
+	request.MemorySize = Int32Pointer(int32(d.Get("memory_size").(int)))
+	request.Code = code
+	request.EnvironmentVariables = expandFcFunctionEnvironmentVariables(d.Get("environment_variables").(map[string]interface{}))
+	if v, ok := d.GetOk("initializer"); ok {
+		request.Initializer = StringPointer(v.(string))
+	}
+	if v, ok := d.GetOk("initialization_timeout"); ok {
+		request.InitializationTimeout = Int32Pointer(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("instance_concurrency"); ok {
+		request.InstanceConcurrency = Int32Pointer(int32(v.(int)))
+	}
+
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.CreateFunction(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_fc_function", "CreateFunction", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("CreateFunction", raw, requestInfo, request)
+	d.SetId(fmt.Sprintf("%s%s%s", serviceName, COLON_SEPARATED, name))
+
+	return resourceAlibabacloudStackFcFunctionRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcFunctionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	object, err := fcService.DescribeFcFunction(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("service", parts[0])
+	d.Set("name", tea.StringValue(object.FunctionName))
+	d.Set("description", tea.StringValue(object.Description))
+	d.Set("runtime", tea.StringValue(object.Runtime))
+	d.Set("handler", tea.StringValue(object.Handler))
+	d.Set("initializer", tea.StringValue(object.Initializer))
+	d.Set("timeout", int(tea.Int32Value(object.Timeout)))
+	d.Set("initialization_timeout", int(tea.Int32Value(object.InitializationTimeout)))
+	d.Set("memory_size", int(tea.Int32Value(object.MemorySize)))
+	d.Set("instance_concurrency", int(tea.Int32Value(object.InstanceConcurrency)))
+	d.Set("environment_variables", object.EnvironmentVariables)
+	d.Set("code_checksum", tea.StringValue(object.CodeChecksum))
+	d.Set("function_id", tea.StringValue(object.FunctionID))
+	d.Set("last_modified", tea.StringValue(object.LastModifiedTime))
+	return nil
+}
+
+func resourceAlibabacloudStackFcFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := fc.NewUpdateFunctionInput(parts[0], parts[1])
+	update := false
+	if d.HasChanges("description", "runtime", "handler", "initializer", "timeout", "initialization_timeout", "memory_size", "instance_concurrency", "environment_variables") {
+		update = true
+	}
+	request.Description = StringPointer(d.Get("description").(string))
+	request.Runtime = StringPointer(d.Get("runtime").(string))
+	request.Handler = StringPointer(d.Get("handler").(string))
+	request.Initializer = StringPointer(d.Get("initializer").(string))
+	request.Timeout = Int32Pointer(int32(d.Get("timeout").(int)))
+	request.MemorySize = Int32Pointer(int32(d.Get("memory_size").(int)))
+	request.EnvironmentVariables = expandFcFunctionEnvironmentVariables(d.Get("environment_variables").(map[string]interface{}))
+	if v, ok := d.GetOk("initialization_timeout"); ok {
+		request.InitializationTimeout = Int32Pointer(int32(v.(int)))
+	}
+	if v, ok := d.GetOk("instance_concurrency"); ok {
+		request.InstanceConcurrency = Int32Pointer(int32(v.(int)))
+	}
+	if d.HasChanges("filename", "oss_bucket", "oss_key", "code_checksum") {
+		update = true
+		code, err := fcFunctionCode(d.Get("filename").(string), d.Get("oss_bucket").(string), d.Get("oss_key").(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		request.Code = code
+	}
+
+	if update {
+		var requestInfo *fc.Client
+		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.UpdateFunction(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateFunction", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("UpdateFunction", raw, requestInfo, request)
+	}
+
+	return resourceAlibabacloudStackFcFunctionRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcFunctionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := fc.NewDeleteFunctionInput(parts[0], parts[1])
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.DeleteFunction(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteFunction", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("DeleteFunction", raw, requestInfo, request)
+	return nil
+}
+
+func expandFcFunctionEnvironmentVariables(configured map[string]interface{}) map[string]string {
+	variables := make(map[string]string)
+	for k, v := range configured {
+		variables[k] = v.(string)
+	}
+	return variables
+}
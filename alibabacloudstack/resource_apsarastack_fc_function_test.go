@@ -0,0 +1,74 @@
+package alibabacloudstack
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+func TestUnitAlibabacloudStackFcFunctionCodeChecksum(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tf-fc-function")
+	if err != nil {
+		t.Fatalf("creating the temporary directory got an error: %#v", err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "code.zip")
+	if err := ioutil.WriteFile(filename, []byte("function code"), 0644); err != nil {
+		t.Fatalf("writing the code package got an error: %#v", err)
+	}
+	checksum, err := fcFunctionCodeChecksum(filename)
+	if err != nil {
+		t.Fatalf("computing the code checksum got an error: %#v", err)
+	}
+
+	r := resourceAlibabacloudStackFcFunction()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"service":  "tf-mock-service",
+		"name":     "tf-mock-function",
+		"runtime":  "python3",
+		"handler":  "index.handler",
+		"filename": filename,
+	})
+	code, err := fcFunctionCode(d.Get("filename").(string), d.Get("oss_bucket").(string), d.Get("oss_key").(string))
+	if err != nil {
+		t.Fatalf("building the code of the function got an error: %#v", err)
+	}
+	if content, _ := base64.StdEncoding.DecodeString(tea.StringValue(code.ZipFile)); string(content) != "function code" {
+		t.Errorf("expected the code of the function to be read from %s, got %q", filename, content)
+	}
+	if _, err := fcFunctionCode("", "bucket", ""); err == nil {
+		t.Errorf("expected an error for the code of the function without the oss key")
+	}
+
+	if err := ioutil.WriteFile(filename, []byte("new function code"), 0644); err != nil {
+		t.Fatalf("rewriting the code package got an error: %#v", err)
+	}
+	changed, err := fcFunctionCodeChecksum(filename)
+	if err != nil {
+		t.Fatalf("computing the code checksum got an error: %#v", err)
+	}
+	if changed == checksum {
+		t.Errorf("expected the code checksum to change with the code package, got %s for both", checksum)
+	}
+}
+
+func TestUnitAlibabacloudStackFcTriggerConfigDiffSuppress(t *testing.T) {
+	for _, c := range []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"cronExpression":"@every 1m","enable":true,"payload":""}`, `{"cronExpression":"@every 1m","enable":true}`, true},
+		{`{"cronExpression":"@every 1m","enable":true,"payload":""}`, `{"enable":true,"cronExpression":"@every 1m"}`, true},
+		{`{"cronExpression":"@every 1m","enable":true,"payload":""}`, `{"cronExpression":"@every 5m","enable":true}`, false},
+		{`{"authType":"anonymous","methods":["GET"]}`, `{"authType":"anonymous","methods":["GET","POST"]}`, false},
+		{"", `{"authType":"anonymous","methods":["GET"]}`, false},
+	} {
+		if suppress := fcTriggerConfigDiffSuppressFunc("config", c.old, c.new, nil); suppress != c.suppress {
+			t.Errorf("expected the diff from %s to %s to be suppressed %t, got %t", c.old, c.new, c.suppress, suppress)
+		}
+	}
+}
@@ -0,0 +1,292 @@
+package alibabacloudstack
+
+import (
+	"context"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackFcService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackFcServiceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackFcServiceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackFcServiceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackFcServiceDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"internet_access": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"log_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"project": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"vpc_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vswitch_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"vpc_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"nas_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"user_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"group_id": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"mount_points": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"server_addr": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"mount_dir": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"service_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackFcServiceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	name := d.Get("name").(string)
+
+	request := fc.NewCreateServiceInput().WithServiceName(name)
+	request.Description = StringPointer(d.Get("description").(string))
+	request.Role = StringPointer(d.Get("role").(string))
+	request.InternetAccess = BoolPointer(d.Get("internet_access").(bool))
+	request.LogConfig = expandFcServiceLogConfig(d.Get("log_config").([]interface{}))
+	request.VPCConfig = expandFcServiceVpcConfig(d.Get("vpc_config").([]interface{}))
+	request.NASConfig = expandFcServiceNasConfig(d.Get("nas_config").([]interface{}))
+
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.CreateService(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_fc_service", "CreateService", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("CreateService", raw, requestInfo, request)
+	d.SetId(name)
+
+	return resourceAlibabacloudStackFcServiceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcServiceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	object, err := fcService.DescribeFcService(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("name", tea.StringValue(object.ServiceName))
+	d.Set("description", tea.StringValue(object.Description))
+	d.Set("role", tea.StringValue(object.Role))
+	d.Set("internet_access", tea.BoolValue(object.InternetAccess))
+	d.Set("service_id", tea.StringValue(object.ServiceID))
+	d.Set("last_modified", tea.StringValue(object.LastModifiedTime))
+
+	var logConfigs []map[string]interface{}
+	if logConfig := object.LogConfig; logConfig != nil && logConfig.Project != nil && *logConfig.Project != "" {
+		logConfigs = append(logConfigs, map[string]interface{}{
+			"project":  tea.StringValue(logConfig.Project),
+			"logstore": tea.StringValue(logConfig.Logstore),
+		})
+	}
+	if err := d.Set("log_config", logConfigs); err != nil {
+		return WrapError(err)
+	}
+
+	var vpcConfigs []map[string]interface{}
+	if vpcConfig := object.VPCConfig; vpcConfig != nil && len(vpcConfig.VSwitchIDs) > 0 {
+		vpcConfigs = append(vpcConfigs, map[string]interface{}{
+			"vswitch_ids":       vpcConfig.VSwitchIDs,
+			"security_group_id": tea.StringValue(vpcConfig.SecurityGroupID),
+			"vpc_id":            tea.StringValue(vpcConfig.VPCID),
+		})
+	}
+	if err := d.Set("vpc_config", vpcConfigs); err != nil {
+		return WrapError(err)
+	}
+
+	var nasConfigs []map[string]interface{}
+	if nasConfig := object.NASConfig; nasConfig != nil && len(nasConfig.MountPoints) > 0 {
+		var mountPoints []map[string]interface{}
+		for _, mountPoint := range nasConfig.MountPoints {
+			mountPoints = append(mountPoints, map[string]interface{}{
+				"server_addr": mountPoint.ServerAddr,
+				"mount_dir":   mountPoint.MountDir,
+			})
+		}
+		nasConfigs = append(nasConfigs, map[string]interface{}{
+			"user_id":      int(tea.Int32Value(nasConfig.UserID)),
+			"group_id":     int(tea.Int32Value(nasConfig.GroupID)),
+			"mount_points": mountPoints,
+		})
+	}
+	if err := d.Set("nas_config", nasConfigs); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackFcServiceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+
+	if d.HasChanges("description", "role", "internet_access", "log_config", "vpc_config", "nas_config") {
+		// UpdateService replaces the whole configuration of the service, the unset blocks are sent empty to remove them.
+		request := fc.NewUpdateServiceInput(d.Id())
+		request.Description = StringPointer(d.Get("description").(string))
+		request.Role = StringPointer(d.Get("role").(string))
+		request.InternetAccess = BoolPointer(d.Get("internet_access").(bool))
+		request.LogConfig = expandFcServiceLogConfig(d.Get("log_config").([]interface{}))
+		request.VPCConfig = expandFcServiceVpcConfig(d.Get("vpc_config").([]interface{}))
+		request.NASConfig = expandFcServiceNasConfig(d.Get("nas_config").([]interface{}))
+
+		var requestInfo *fc.Client
+		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.UpdateService(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateService", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("UpdateService", raw, requestInfo, request)
+	}
+
+	return resourceAlibabacloudStackFcServiceRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcServiceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+
+	request := fc.NewDeleteServiceInput(d.Id())
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.DeleteService(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteService", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("DeleteService", raw, requestInfo, request)
+	return nil
+}
+
+func expandFcServiceLogConfig(configured []interface{}) *fc.LogConfig {
+	logConfig := fc.NewLogConfig().WithProject("").WithLogstore("")
+	if len(configured) > 0 && configured[0] != nil {
+		config := configured[0].(map[string]interface{})
+		logConfig.WithProject(config["project"].(string)).WithLogstore(config["logstore"].(string))
+	}
+	return logConfig
+}
+
+func expandFcServiceVpcConfig(configured []interface{}) *fc.VPCConfig {
+	vpcConfig := fc.NewVPCConfig().WithVSwitchIDs([]string{}).WithSecurityGroupID("")
+	if len(configured) > 0 && configured[0] != nil {
+		config := configured[0].(map[string]interface{})
+		vpcConfig.WithVSwitchIDs(expandStringList(config["vswitch_ids"].(*schema.Set).List())).
+			WithSecurityGroupID(config["security_group_id"].(string))
+	}
+	return vpcConfig
+}
+
+func expandFcServiceNasConfig(configured []interface{}) *fc.NASConfig {
+	nasConfig := fc.NewNASConfig().WithUserID(-1).WithGroupID(-1).WithMountPoints([]fc.NASMountConfig{})
+	if len(configured) > 0 && configured[0] != nil {
+		config := configured[0].(map[string]interface{})
+		var mountPoints []fc.NASMountConfig
+		for _, v := range config["mount_points"].([]interface{}) {
+			mountPoint := v.(map[string]interface{})
+			mountPoints = append(mountPoints, fc.NewNASMountConfig(mountPoint["server_addr"].(string), mountPoint["mount_dir"].(string)))
+		}
+		nasConfig.WithUserID(int32(config["user_id"].(int))).WithGroupID(int32(config["group_id"].(int))).WithMountPoints(mountPoints)
+	}
+	return nasConfig
+}
@@ -0,0 +1,227 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackFcTrigger() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackFcTriggerCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackFcTriggerRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackFcTriggerUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackFcTriggerDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"function": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{fc.TRIGGER_TYPE_OSS, fc.TRIGGER_TYPE_TIMER, fc.TRIGGER_TYPE_HTTP, fc.TRIGGER_TYPE_LOG}, false),
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"qualifier": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"config": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: fcTriggerConfigDiffSuppressFunc,
+			},
+			"trigger_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// fcTriggerConfigDiffSuppressFunc ignores the fields which Function Compute fills in with their defaults
+// when the trigger is created, the diff is only kept when a configured field differs from the trigger.
+func fcTriggerConfigDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return false
+	}
+	var oldConfig, newConfig map[string]interface{}
+	if err := json.Unmarshal([]byte(old), &oldConfig); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newConfig); err != nil {
+		return false
+	}
+	for key, value := range newConfig {
+		if !reflect.DeepEqual(oldConfig[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceAlibabacloudStackFcTriggerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	serviceName := d.Get("service").(string)
+	functionName := d.Get("function").(string)
+	name := d.Get("name").(string)
+
+	request := fc.NewCreateTriggerInput(serviceName, functionName).
+		WithTriggerName(name).
+		WithTriggerType(d.Get("type").(string)).
+		WithTriggerConfig(json.RawMessage(d.Get("config").(string)))
+	if v, ok := d.GetOk("description"); ok {
+		request.WithDescription(v.(string))
+	}
+	if v, ok := d.GetOk("role"); ok {
+		request.WithInvocationRole(v.(string))
+	}
+	if v, ok := d.GetOk("source_arn"); ok {
+		request.WithSourceARN(v.(string))
+	}
+	if v, ok := d.GetOk("qualifier"); ok {
+		request.WithQualifier(v.(string))
+	}
+
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.CreateTrigger(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_fc_trigger", "CreateTrigger", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("CreateTrigger", raw, requestInfo, request)
+	d.SetId(fmt.Sprintf("%s%s%s%s%s", serviceName, COLON_SEPARATED, functionName, COLON_SEPARATED, name))
+
+	return resourceAlibabacloudStackFcTriggerRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcTriggerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	object, err := fcService.DescribeFcTrigger(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	config, err := fcTriggerConfigString(object.RawTriggerConfig)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("service", parts[0])
+	d.Set("function", parts[1])
+	d.Set("name", tea.StringValue(object.TriggerName))
+	d.Set("type", tea.StringValue(object.TriggerType))
+	d.Set("description", tea.StringValue(object.Description))
+	d.Set("role", tea.StringValue(object.InvocationRole))
+	d.Set("source_arn", tea.StringValue(object.SourceARN))
+	d.Set("qualifier", tea.StringValue(object.Qualifier))
+	d.Set("config", config)
+	d.Set("trigger_id", tea.StringValue(object.TriggerID))
+	d.Set("last_modified", tea.StringValue(object.LastModifiedTime))
+	return nil
+}
+
+func resourceAlibabacloudStackFcTriggerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if d.HasChanges("description", "role", "qualifier", "config") {
+		request := fc.NewUpdateTriggerInput(parts[0], parts[1], parts[2]).
+			WithDescription(d.Get("description").(string)).
+			WithInvocationRole(d.Get("role").(string)).
+			WithTriggerConfig(json.RawMessage(d.Get("config").(string)))
+		if v, ok := d.GetOk("qualifier"); ok {
+			request.WithQualifier(v.(string))
+		}
+
+		var requestInfo *fc.Client
+		raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.UpdateTrigger(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateTrigger", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("UpdateTrigger", raw, requestInfo, request)
+	}
+
+	return resourceAlibabacloudStackFcTriggerRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcTriggerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := fc.NewDeleteTriggerInput(parts[0], parts[1], parts[2])
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.DeleteTrigger(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteTrigger", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("DeleteTrigger", raw, requestInfo, request)
+	return nil
+}
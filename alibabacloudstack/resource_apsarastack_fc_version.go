@@ -0,0 +1,116 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// A published version is immutable, every change of its arguments publishes a new version.
+func resourceAlibabacloudStackFcVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackFcVersionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackFcVersionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackFcVersionDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackFcVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	serviceName := d.Get("service").(string)
+
+	request := fc.NewPublishServiceVersionInput(serviceName).WithDescription(d.Get("description").(string))
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.PublishServiceVersion(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_fc_version", "PublishServiceVersion", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("PublishServiceVersion", raw, requestInfo, request)
+	response := raw.(*fc.PublishServiceVersionOutput)
+	d.SetId(fmt.Sprintf("%s%s%s", serviceName, COLON_SEPARATED, tea.StringValue(response.VersionID)))
+
+	return resourceAlibabacloudStackFcVersionRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackFcVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	fcService := FcService{client, ctx}
+
+	object, err := fcService.DescribeFcVersion(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("service", parts[0])
+	d.Set("version_id", object["version_id"])
+	d.Set("description", object["description"])
+	d.Set("created_time", object["created_time"])
+	d.Set("last_modified", object["last_modified"])
+	return nil
+}
+
+func resourceAlibabacloudStackFcVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := fc.NewDeleteServiceVersionInput(parts[0], parts[1])
+	var requestInfo *fc.Client
+	raw, err := client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.DeleteServiceVersion(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteServiceVersion", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("DeleteServiceVersion", raw, requestInfo, request)
+	return nil
+}
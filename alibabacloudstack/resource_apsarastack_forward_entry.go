@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -12,10 +13,13 @@ import (
 
 func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackForwardEntryCreate,
-		Read:   resourceAlibabacloudStackForwardEntryRead,
-		Update: resourceAlibabacloudStackForwardEntryUpdate,
-		Delete: resourceAlibabacloudStackForwardEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackForwardEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackForwardEntryRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackForwardEntryUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackForwardEntryDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"forward_table_id": {
@@ -58,9 +62,9 @@ func resourceAlibabacloudStackForwardEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateForwardEntryRequest()
 	request.RegionId = string(client.Region)
@@ -70,7 +74,7 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardTableId = d.Get("forward_table_id").(string)
 	request.ExternalIp = d.Get("external_ip").(string)
 	request.ExternalPort = d.Get("external_port").(string)
@@ -82,7 +86,7 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 	}
 	var raw interface{}
 	var err error
-	if err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		ar := request
 		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateForwardEntry(ar)
@@ -104,13 +108,13 @@ func resourceAlibabacloudStackForwardEntryCreate(d *schema.ResourceData, meta in
 	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
 	}
@@ -135,9 +139,9 @@ func resourceAlibabacloudStackForwardEntryRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
 	}
@@ -155,7 +159,7 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardEntryId = parts[1]
 	request.ForwardTableId = parts[0]
 
@@ -192,10 +196,10 @@ func resourceAlibabacloudStackForwardEntryUpdate(d *schema.ResourceData, meta in
 	if err := vpcService.WaitForForwardEntry(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackForwardEntryRead(d, meta)
+	return resourceAlibabacloudStackForwardEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackForwardEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if !strings.Contains(d.Id(), COLON_SEPARATED) {
 		d.SetId(d.Get("forward_table_id").(string) + COLON_SEPARATED + d.Id())
 	}
@@ -204,7 +208,7 @@ func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta in
 		return WrapError(err)
 	}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := vpc.CreateDeleteForwardEntryRequest()
 	request.RegionId = string(client.Region)
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -213,11 +217,11 @@ func resourceAlibabacloudStackForwardEntryDelete(d *schema.ResourceData, meta in
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ForwardTableId = parts[0]
 	request.ForwardEntryId = parts[1]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteForwardEntry(request)
 		})
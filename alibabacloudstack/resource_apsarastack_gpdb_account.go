@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -10,18 +11,17 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbAccountCreate,
-		Read:   resourceAlibabacloudStackGpdbAccountRead,
-		Update: resourceAlibabacloudStackGpdbAccountUpdate,
-		Delete: resourceAlibabacloudStackGpdbAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -57,7 +57,7 @@ func resourceAlibabacloudStackGpdbAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAccount"
@@ -75,35 +75,24 @@ func resourceAlibabacloudStackGpdbAccountCreate(d *schema.ResourceData, meta int
 	request["AccountName"] = d.Get("account_name")
 	request["DBInstanceId"] = d.Get("db_instance_id")
 	request["AccountPassword"] = d.Get("account_password")
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_gpdb_account", action, AlibabacloudStackSdkGoERROR)
 	}
 
 	d.SetId(fmt.Sprint(request["DBInstanceId"], ":", request["AccountName"]))
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 	stateConf := BuildStateConf([]string{}, []string{"1"}, d.Timeout(schema.TimeoutCreate), 60*time.Second, gpdbService.GpdbAccountStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 	object, err := gpdbService.DescribeGpdbAccount(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -123,7 +112,7 @@ func resourceAlibabacloudStackGpdbAccountRead(d *schema.ResourceData, meta inter
 	d.Set("status", convertGpdbAccountStatusResponse(object["AccountStatus"]))
 	return nil
 }
-func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -153,28 +142,16 @@ func resourceAlibabacloudStackGpdbAccountUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-05-03"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
 
-	return resourceAlibabacloudStackGpdbAccountRead(d, meta)
+	return resourceAlibabacloudStackGpdbAccountRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackGpdbAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[WARN] Cannot destroy resourcealibabacloudstackGpdbAccount. Terraform will remove this resource from the state file, however resources may remain.")
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackGpdbConnectionCreate,
-		Read:   resourceAlibabacloudStackGpdbConnectionRead,
-		Update: resourceAlibabacloudStackGpdbConnectionUpdate,
-		Delete: resourceAlibabacloudStackGpdbConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -61,9 +62,9 @@ func resourceAlibabacloudStackGpdbConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 	instanceId := d.Get("instance_id").(string)
 	prefix := d.Get("connection_prefix").(string)
 	if prefix == "" {
@@ -73,7 +74,7 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	request.Headers["x-ascm-product-name"] = "Gpdb"
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.ConnectionStringPrefix = prefix
 	request.Port = d.Get("port").(string)
@@ -85,7 +86,7 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	if client.Config.Insecure {
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
-	err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.AllocateInstancePublicConnection(request)
 		})
@@ -106,14 +107,14 @@ func resourceAlibabacloudStackGpdbConnectionCreate(d *schema.ResourceData, meta
 	// wait instance running after allocating
 	stateConf := BuildStateConf([]string{"Creating", "NetAddressCreating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(instanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -121,7 +122,7 @@ func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta in
 	}
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 	object, err := gpdbService.DescribeGpdbConnection(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -140,7 +141,7 @@ func resourceAlibabacloudStackGpdbConnectionRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -148,12 +149,12 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 
 	if d.HasChange("port") {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		gpdbService := GpdbService{client}
+		gpdbService := GpdbService{client, ctx}
 
 		request := gpdb.CreateModifyDBInstanceConnectionStringRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = parts[0]
 		object, err := gpdbService.DescribeGpdbConnection(d.Id())
 		if err != nil {
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 		request.ConnectionStringPrefix = parts[1]
 		request.Port = d.Get("port").(string)
 
-		if err := resource.Retry(8*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 8*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 				return gpdbClient.ModifyDBInstanceConnectionString(request)
 			})
@@ -182,14 +183,14 @@ func resourceAlibabacloudStackGpdbConnectionUpdate(d *schema.ResourceData, meta
 		// wait instance running after modifying
 		stateConf := BuildStateConf([]string{"NET_MODIFYING"}, []string{"Running"}, d.Timeout(schema.TimeoutUpdate), 3*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	return resourceAlibabacloudStackGpdbConnectionRead(d, meta)
+	return resourceAlibabacloudStackGpdbConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -198,12 +199,12 @@ func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta
 
 	request := gpdb.CreateReleaseInstancePublicConnectionRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 	request.RegionId = client.RegionId
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := gpdbService.DescribeGpdbConnection(d.Id())
 		if err != nil {
 			return resource.NonRetryableError(WrapError(err))
@@ -231,7 +232,7 @@ func resourceAlibabacloudStackGpdbConnectionDelete(d *schema.ResourceData, meta
 	}
 	stateConf := BuildStateConf([]string{"NetAddressDeleting"}, []string{"Running"}, d.Timeout(schema.TimeoutDelete), 5*time.Second, gpdbService.GpdbInstanceStateRefreshFunc(request.DBInstanceId, []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return WrapError(gpdbService.WaitForGpdbConnection(d.Id(), Deleted, DefaultTimeoutMedium))
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -22,7 +23,7 @@ func TestAccAlibabacloudStackGpdbConnectionUpdate(t *testing.T) {
 
 	resourceId := "alibabacloudstack_gpdb_connection.default"
 	serverFunc := func() interface{} {
-		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeGpdbConnection")
 	ra := resourceAttrInit(resourceId, basicMap)
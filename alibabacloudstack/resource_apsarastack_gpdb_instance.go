@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,18 +16,19 @@ import (
 
 func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 	return &schema.Resource{
-		Read:   resourceAlibabacloudStackGpdbInstanceRead,
-		Create: resourceAlibabacloudStackGpdbInstanceCreate,
-		Update: resourceAlibabacloudStackGpdbInstanceUpdate,
-		Delete: resourceAlibabacloudStackGpdbInstanceDelete,
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackGpdbInstanceRead),
+		CreateContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceCreate),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackGpdbInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -113,15 +115,16 @@ func resourceAlibabacloudStackGpdbInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 
 	instance, err := gpdbService.DescribeGpdbInstance(d.Id())
 	if err != nil {
@@ -149,18 +152,20 @@ func resourceAlibabacloudStackGpdbInstanceRead(d *schema.ResourceData, meta inte
 	d.Set("security_ip_list", security_ips)
 	//d.Set("create_time", instance.CreationTime)
 	d.Set("instance_charge_type", instance.PayType)
-	d.Set("tags", gpdbService.tagsToMap(instance.Tags.Tag))
+	if err := setResourceTags(d, meta, gpdbService.tagsToMap(instance.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 	d.Set("instance_inner_connection", instance.ConnectionString)
 	d.Set("instance_inner_port", instance.Port)
 	d.Set("instance_vpc_id", instance.VpcId)
 	return nil
 }
 
-func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 
-	request, err := buildGpdbCreateRequest(d, meta)
+	request, err := buildGpdbCreateRequest(ctx, d, meta)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -170,12 +175,12 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if err != nil {
 		return WrapError(err)
 	}
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.CreateDBInstance(request)
 		})
@@ -196,15 +201,15 @@ func resourceAlibabacloudStackGpdbInstanceCreate(d *schema.ResourceData, meta in
 
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Minute, gpdbService.GpdbInstanceStateRefreshFunc(d.Id(), []string{"Deleting"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackGpdbInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	gpdbService := GpdbService{client}
+	gpdbService := GpdbService{client, ctx}
 
 	// Begin Update
 	d.Partial(true)
@@ -214,7 +219,7 @@ func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta in
 		request := gpdb.CreateModifyDBInstanceDescriptionRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("description").(string)
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
@@ -248,19 +253,19 @@ func resourceAlibabacloudStackGpdbInstanceUpdate(d *schema.ResourceData, meta in
 	// Finish Update
 	d.Partial(false)
 
-	return resourceAlibabacloudStackGpdbInstanceRead(d, meta)
+	return resourceAlibabacloudStackGpdbInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackGpdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := gpdb.CreateDeleteDBInstanceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err := resource.Retry(10*5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithGpdbClient(func(client *gpdb.Client) (interface{}, error) {
 			return client.DeleteDBInstance(request)
 		})
@@ -284,12 +289,12 @@ func resourceAlibabacloudStackGpdbInstanceDelete(d *schema.ResourceData, meta in
 	return nil
 }
 
-func buildGpdbCreateRequest(d *schema.ResourceData, meta interface{}) (*gpdb.CreateDBInstanceRequest, error) {
+func buildGpdbCreateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) (*gpdb.CreateDBInstanceRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := gpdb.CreateCreateDBInstanceRequest()
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId, "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string))}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string)), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "ZoneId": Trim(d.Get("availability_zone").(string)), "EngineVersion": Trim(d.Get("engine").(string)), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ZoneId = Trim(d.Get("availability_zone").(string))
 	request.PayType = d.Get("instance_charge_type").(string)
 	request.VSwitchId = Trim(d.Get("vswitch_id").(string))
@@ -303,7 +308,7 @@ func buildGpdbCreateRequest(d *schema.ResourceData, meta interface{}) (*gpdb.Cre
 	request.InstanceNetworkType = string(Classic)
 	if request.VSwitchId != "" {
 		// check vswitchId in zone
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, ctx}
 		object, err := vpcService.DescribeVSwitch(request.VSwitchId)
 		if err != nil {
 			return nil, WrapError(err)
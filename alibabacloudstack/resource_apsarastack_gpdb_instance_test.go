@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -35,7 +36,7 @@ func testSweepGpdbInstances(region string) error {
 	var instances []gpdb.DBInstance
 	request := gpdb.CreateDescribeDBInstancesRequest()
 	request.RegionId = client.RegionId
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	for {
@@ -64,7 +65,7 @@ func testSweepGpdbInstances(region string) error {
 	}
 
 	sweeper := false
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, v := range instances {
 		id := v.DBInstanceId
 		description := v.DBInstanceDescription
@@ -89,7 +90,7 @@ func testSweepGpdbInstances(region string) error {
 		// Delete Instance
 		request := gpdb.CreateDeleteDBInstanceRequest()
 		request.DBInstanceId = id
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "gpdb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		raw, err := client.WithGpdbClient(func(gpdbClient *gpdb.Client) (interface{}, error) {
 			return gpdbClient.DeleteDBInstance(request)
 		})
@@ -112,7 +113,7 @@ func TestAccAlibabacloudStackGpdbInstance_classic(t *testing.T) {
 	var v gpdb.DBInstanceAttribute
 	resourceId := "alibabacloudstack_gpdb_instance.default"
 	serverFunc := func() interface{} {
-		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeGpdbInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -176,7 +177,7 @@ func TestAccAlibabacloudStackGpdbInstance_vpc(t *testing.T) {
 	var v gpdb.DBInstanceAttribute
 	resourceId := "alibabacloudstack_gpdb_instance.default"
 	serverFunc := func() interface{} {
-		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &GpdbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeGpdbInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,18 +16,19 @@ import (
 
 func resourceAlibabacloudStackHBaseInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackHBaseInstanceCreate,
-		Read:   resourceAlibabacloudStackHBaseInstanceRead,
-		Update: resourceAlibabacloudStackHBaseInstanceUpdate,
-		Delete: resourceAlibabacloudStackHBaseInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackHBaseInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackHBaseInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Update: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(30 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -134,7 +136,8 @@ func resourceAlibabacloudStackHBaseInstance() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"account": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -229,9 +232,9 @@ func checkParams(request *hbase.CreateClusterRequest) error {
 	return nil
 }
 
-func buildHBaseCreateRequest(d *schema.ResourceData, meta interface{}) (*hbase.CreateClusterRequest, error) {
+func buildHBaseCreateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) (*hbase.CreateClusterRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := hbase.CreateCreateClusterRequest()
 	request.ClusterName = Trim(d.Get("name").(string))
 	request.RegionId = string(client.Region)
@@ -288,11 +291,11 @@ func buildHBaseCreateRequest(d *schema.ResourceData, meta interface{}) (*hbase.C
 	return request, checkParams(request)
 }
 
-func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	hBaseService := HBaseService{client}
+	hBaseService := HBaseService{client, ctx}
 
-	request, err := buildHBaseCreateRequest(d, meta)
+	request, err := buildHBaseCreateRequest(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -300,14 +303,13 @@ func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta i
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "HBase",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateCluster",
-		"Version":         "2019-01-01",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "HBase",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateCluster",
+		"Version":       "2019-01-01",
 	}
 
 	raw, err := client.WithHbaseClient(func(client *hbase.Client) (interface{}, error) {
@@ -325,16 +327,16 @@ func resourceAlibabacloudStackHBaseInstanceCreate(d *schema.ResourceData, meta i
 
 	stateConf := BuildStateConf([]string{Hb_LAUNCHING, Hb_CREATING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutCreate),
 		5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_CREATE_FAILED}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackHBaseInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	hbaseService := HBaseService{client}
+	hbaseService := HBaseService{client, ctx}
 
 	instance, err := hbaseService.DescribeHBaseInstance(d.Id())
 	if err != nil {
@@ -374,7 +376,9 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 	d.Set("maintain_start_time", instance["MaintainStartTime"])
 	d.Set("maintain_end_time", instance["MaintainEndTime"])
 	d.Set("deletion_protection", instance["IsDeletionProtection"])
-	d.Set("tags", tagsToMap(instance["Tags"]))
+	if err := setResourceTags(d, meta, tagsToMap(instance["Tags"])); err != nil {
+		return WrapError(err)
+	}
 
 	ipWhitelist, err := hbaseService.DescribeIpWhitelist(d.Id())
 	if err != nil {
@@ -432,9 +436,9 @@ func resourceAlibabacloudStackHBaseInstanceRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	hBaseService := HBaseService{client}
+	hBaseService := HBaseService{client, ctx}
 	d.Partial(true)
 	if d.HasChange("pay_type") {
 		object, err := hBaseService.DescribeHBaseInstance(d.Id())
@@ -448,14 +452,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 				"RegionId": client.RegionId,
 			}
 			request.QueryParams = map[string]string{
-				"AccessKeySecret": client.SecretKey,
-				"AccessKeyId":     client.AccessKey,
-				"Product":         "HBase",
-				"RegionId":        client.RegionId,
-				"Department":      client.Department,
-				"ResourceGroup":   client.ResourceGroup,
-				"Action":          "ConvertInstance",
-				"Version":         "2019-01-01",
+				"AccessKeyId":   client.AccessKey,
+				"Product":       "HBase",
+				"RegionId":      client.RegionId,
+				"Department":    client.Department,
+				"ResourceGroup": client.ResourceGroup,
+				"Action":        "ConvertInstance",
+				"Version":       "2019-01-01",
 			}
 			request.ClusterId = d.Id()
 			request.PayType = string(Postpaid)
@@ -478,7 +481,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			}
 			stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapErrorf(err, IdMsg, d.Id())
 			}
 			d.SetPartial("pay_type")
@@ -491,14 +494,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyIpWhitelist",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyIpWhitelist",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.IpList = d.Get("ip_white").(string)
@@ -520,14 +522,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifySecurityGroups",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifySecurityGroups",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		securityGroups := d.Get("security_groups").(*schema.Set).List()
@@ -545,7 +546,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		}
 		stateConf := BuildStateConf([]string{}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		d.SetPartial("security_groups")
@@ -557,14 +558,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceMaintainTime",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyInstanceMaintainTime",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.MaintainStartTime = d.Get("maintain_start_time").(string)
@@ -598,14 +598,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyUIAccountPassword",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyUIAccountPassword",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.AccountName = d.Get("account").(string)
@@ -624,7 +623,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+		return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("name") {
@@ -633,14 +632,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceName",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyInstanceName",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.ClusterName = d.Get("name").(string)
@@ -661,14 +659,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeNodeCount",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ResizeNodeCount",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.NodeCount = requests.NewInteger(d.Get("core_instance_quantity").(int))
@@ -683,7 +680,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_NODE_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_NODE_RESIZING_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -696,14 +693,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ModifyInstanceType",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ModifyInstanceType",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		typeChange := false
@@ -729,7 +725,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			// wait instance status is running after modifying
 			stateConf := BuildStateConf([]string{Hb_LEVEL_MODIFY}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 				5*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_LEVEL_MODIFY_FAILED}))
-			if _, err := stateConf.WaitForState(); err != nil {
+			if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 				return WrapError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -744,14 +740,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeDiskSize",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ResizeDiskSize",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.NodeDiskSize = requests.NewInteger(d.Get("core_disk_size").(int))
@@ -766,7 +761,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_DISK_RESIZING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			2*time.Minute, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -779,14 +774,13 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 			"RegionId": client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
-			"AccessKeyId":     client.AccessKey,
-			"Product":         "HBase",
-			"RegionId":        client.RegionId,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Action":          "ResizeColdStorageSize",
-			"Version":         "2019-01-01",
+			"AccessKeyId":   client.AccessKey,
+			"Product":       "HBase",
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Action":        "ResizeColdStorageSize",
+			"Version":       "2019-01-01",
 		}
 		request.ClusterId = d.Id()
 		request.ColdStorageSize = requests.NewInteger(d.Get("cold_storage_size").(int))
@@ -801,7 +795,7 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 		// wait instance status is running after modifying
 		stateConf := BuildStateConf([]string{Hb_HBASE_COLD_EXPANDING}, []string{Hb_ACTIVATION}, d.Timeout(schema.TimeoutUpdate),
 			10*time.Second, hBaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{Hb_DISK_RESIZE_FAILED}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -809,26 +803,25 @@ func resourceAlibabacloudStackHBaseInstanceUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackHBaseInstanceRead(d, meta)
+	return resourceAlibabacloudStackHBaseInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackHBaseInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	hbaseService := HBaseService{client}
+	hbaseService := HBaseService{client, ctx}
 
 	request := hbase.CreateDeleteInstanceRequest()
 	request.Headers = map[string]string{
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "HBase",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "DeleteInstance",
-		"Version":         "2019-01-01",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "HBase",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "DeleteInstance",
+		"Version":       "2019-01-01",
 	}
 	request.ClusterId = d.Id()
 	if v, ok := d.GetOk("immediate_delete_flag"); ok {
@@ -849,6 +842,6 @@ func resourceAlibabacloudStackHBaseInstanceDelete(d *schema.ResourceData, meta i
 	}
 
 	stateConf := BuildStateConf([]string{Hb_DELETING}, []string{}, d.Timeout(schema.TimeoutDelete), 1*time.Minute, hbaseService.HBaseClusterStateRefreshFunc(d.Id(), []string{}))
-	_, err = stateConf.WaitForState()
+	_, err = stateConf.WaitForStateContext(ctx)
 	return WrapError(err)
 }
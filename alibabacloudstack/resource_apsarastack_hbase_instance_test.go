@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -65,7 +66,7 @@ func testSweepHBaseInstances(region string) error {
 	}
 
 	sweeped := false
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, v := range insts {
 		name := v.InstanceName
 		id := v.InstanceId
@@ -143,7 +144,7 @@ func TestAccAlibabacloudStackHBaseInstanceVpc(t *testing.T) {
 	resourceId := "alibabacloudstack_hbase_instance.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &HBaseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &HBaseService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeHBaseInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,18 +17,19 @@ import (
 
 func resourceAlibabacloudStackImage() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCreate,
-		Read:   resourceAlibabacloudStackImageRead,
-		Update: resourceAlibabacloudStackImageUpdate,
-		Delete: resourceAlibabacloudStackImageDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(30 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 
 			"instance_id": {
@@ -82,13 +84,14 @@ func resourceAlibabacloudStackImage() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
-func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	// Make sure the instance status is Running or Stopped
 	if v, ok := d.GetOk("instance_id"); ok {
@@ -125,7 +128,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if instanceId, ok := d.GetOk("instance_id"); ok {
 		request.InstanceId = instanceId.(string)
 	}
@@ -145,7 +148,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 		}
 	}
 
-	tags := d.Get("tags").(map[string]interface{})
+	tags := d.Get("tags_all").(map[string]interface{})
 	if tags != nil && len(tags) > 0 {
 		imageTags := make([]ecs.CreateImageTag, 0, len(tags))
 		for k, v := range tags {
@@ -164,7 +167,7 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	request.ImageName = d.Get("image_name").(string)
 	request.Description = d.Get("description").(string)
 
-	err := resource.Retry(30*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 30*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.CreateImage(request)
 		})
@@ -186,25 +189,25 @@ func resourceAlibabacloudStackImageCreate(d *schema.ResourceData, meta interface
 	}
 
 	stateConf := BuildStateConf([]string{"Creating", ""}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 20*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	err := ecsService.updateImage(d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeImageById(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -219,14 +222,14 @@ func resourceAlibabacloudStackImageRead(d *schema.ResourceData, meta interface{}
 	d.Set("disk_device_mapping", FlattenImageDiskDeviceMappings(object.DiskDeviceMappings.DiskDeviceMapping))
 	tags := object.Tags.Tag
 	if len(tags) > 0 {
-		err = d.Set("tags", ecsService.tagsToMap(tags))
+		err = setResourceTags(d, meta, ecsService.tagsToMap(tags))
 	}
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	return ecsService.deleteImage(d)
 }
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackImageCopy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageCopyCreate,
-		Read:   resourceAlibabacloudStackImageCopyRead,
-		Update: resourceAlibabacloudStackImageCopyUpdate,
-		Delete: resourceAlibabacloudStackImageCopyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageCopyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageCopyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageCopyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageCopyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -53,9 +54,9 @@ func resourceAlibabacloudStackImageCopy() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateCopyImageRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -65,7 +66,7 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ImageId = d.Get("source_image_id").(string)
 	request.DestinationRegionId = d.Get("destination_region_id").(string)
 	request.DestinationImageName = d.Get("image_name").(string)
@@ -83,25 +84,25 @@ func resourceAlibabacloudStackImageCopyCreate(d *schema.ResourceData, meta inter
 	d.SetId(response.ImageId)
 	log.Printf("[DEBUG] state %#v", d.Id())
 	stateConf := BuildStateConf([]string{"Creating"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	err := ecsService.updateImage(d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageRead(d, meta)
+	return resourceAlibabacloudStackImageRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeImage(d.Id(), d.Get("destination_region_id").(string))
 	if err != nil {
 		if NotFoundError(err) {
@@ -118,16 +119,16 @@ func resourceAlibabacloudStackImageCopyRead(d *schema.ResourceData, meta interfa
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageCopyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageCopyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	err := ecsService.deleteImageforDest(d, d.Get("destination_region_id").(string))
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{"Available", "CreateFailed"}, []string{"Deprecated", "UnAvailable"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFuncforcopy(d.Id(), d.Get("destination_region_id").(string), []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageCopyRead(d, meta)
+	return resourceAlibabacloudStackImageCopyRead(ctx, d, meta)
 }
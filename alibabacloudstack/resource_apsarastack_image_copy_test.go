@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -111,7 +112,7 @@ func testAccCheckImageExistsWithProviders(n string, image *ecs.Image, providers
 			}
 
 			client := provider.Meta().(*connectivity.AlibabacloudStackClient)
-			ecsService := EcsService{client}
+			ecsService := EcsService{client, context.Background()}
 
 			resp, err := ecsService.DescribeImageById(rs.Primary.ID)
 			if err != nil {
@@ -145,7 +146,7 @@ func testAccCheckImageDestroyWithProviders(providers *[]*schema.Provider) resour
 func testAccCheckImageDestroyWithProvider(s *terraform.State, provider *schema.Provider) error {
 
 	client := provider.Meta().(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_copy_image" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,9 +15,12 @@ import (
 
 func resourceAlibabacloudStackImageExport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageExportCreate,
-		Read:   resourceAlibabacloudStackImageExportRead,
-		Delete: resourceAlibabacloudStackImageExportDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageExportCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageExportRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageExportDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackImageExportImport,
+		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 		},
@@ -40,9 +44,9 @@ func resourceAlibabacloudStackImageExport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client: client}
+	ecsService := EcsService{client: client, ctx: ctx}
 
 	request := ecs.CreateExportImageRequest()
 	request.RegionId = client.RegionId
@@ -52,7 +56,7 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ImageId = d.Get("image_id").(string)
 	request.OSSBucket = d.Get("oss_bucket").(string)
 	request.OSSPrefix = d.Get("oss_prefix").(string)
@@ -68,17 +72,17 @@ func resourceAlibabacloudStackImageExportCreate(d *schema.ResourceData, meta int
 	taskId := response.TaskId
 	d.SetId(request.ImageId)
 	stateConf := BuildStateConf([]string{"ExportImage", "Waiting", "Processing"}, []string{"Finished"}, d.Timeout(schema.TimeoutCreate), 2*time.Minute, ecsService.TaskStateRefreshFunc(taskId, []string{"CreateFailed", "UnAvailable"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageExportRead(d, meta)
+	return resourceAlibabacloudStackImageExportRead(ctx, d, meta)
 
 }
 
-func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client: client}
+	ecsService := EcsService{client: client, ctx: ctx}
 
 	object, err := ecsService.DescribeImageById(d.Id())
 	if err != nil {
@@ -92,9 +96,9 @@ func resourceAlibabacloudStackImageExportRead(d *schema.ResourceData, meta inter
 	return WrapError(err)
 }
 
-func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageExportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClient(func(ossClient *oss.Client) (interface{}, error) {
 		requestInfo = ossClient
@@ -115,16 +119,15 @@ func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta int
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "OneRouter",
-		"Action":          "DoApi",
-		"AppAction":       "DeleteObjects",
-		"AppName":         "one-console-app-oss",
-		"Version":         "2018-12-12",
-		"Params":          "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + d.Get("oss_bucket").(string) + "\",\"objects\":[\"" + objectName + "\"]}}",
-		"AccountInfo":     "",
+		"RegionId":    client.RegionId,
+		"AccessKeyId": client.AccessKey,
+		"Product":     "OneRouter",
+		"Action":      "DoApi",
+		"AppAction":   "DeleteObjects",
+		"AppName":     "one-console-app-oss",
+		"Version":     "2018-12-12",
+		"Params":      "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + d.Get("oss_bucket").(string) + "\",\"objects\":[\"" + objectName + "\"]}}",
+		"AccountInfo": "",
 	}
 	request.Method = "POST"
 	request.Product = "OneRouter"
@@ -157,3 +160,16 @@ func resourceAlibabacloudStackImageExportDelete(d *schema.ResourceData, meta int
 
 	return WrapError(ossService.WaitForOssBucketObject(bucket, d.Id(), Deleted, DefaultTimeoutMedium))
 }
+
+// resourceAlibabacloudStackImageExportImport imports the export of an image, whose bucket and prefix
+// the api does not return, from an id formulated as <image_id>:<oss_bucket>:<oss_prefix>.
+func resourceAlibabacloudStackImageExportImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(parts[0])
+	d.Set("oss_bucket", parts[1])
+	d.Set("oss_prefix", parts[2])
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -15,7 +16,7 @@ func TestAccAlibabacloudStackImageExport(t *testing.T) {
 	resourceId := "alibabacloudstack_image_export.default"
 	ra := resourceAttrInit(resourceId, testAccExportImageCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeImageById")
@@ -96,3 +97,14 @@ resource "alibabacloudstack_oss_bucket" "default" {
 }
 `, DataAlibabacloudstackVswitchZones, DataAlibabacloudstackInstanceTypes, DataAlibabacloudstackImages, name)
 }
+
+func TestUnitAlibabacloudStackImageExport_import(t *testing.T) {
+	d := resourceAlibabacloudStackImageExport().TestResourceData()
+	d.SetId("m-abc12345678:tf-testbucket:")
+	if _, err := resourceAlibabacloudStackImageExportImport(context.Background(), d, nil); err != nil {
+		t.Fatalf("expected the import to succeed, got %v", err)
+	}
+	if d.Id() != "m-abc12345678" || d.Get("oss_bucket").(string) != "tf-testbucket" || d.Get("oss_prefix").(string) != "" {
+		t.Fatalf("unexpected import result: id %s, oss_bucket %v, oss_prefix %v", d.Id(), d.Get("oss_bucket"), d.Get("oss_prefix"))
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackImageImport() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackImageImportCreate,
-		Read:   resourceAlibabacloudStackImageImportRead,
-		Update: resourceAlibabacloudStackImageImportUpdate,
-		Delete: resourceAlibabacloudStackImageImportDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackImageImportCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackImageImportRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackImageImportUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackImageImportDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -124,9 +125,9 @@ func resourceAlibabacloudStackImageImport() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client: client}
+	ecsService := EcsService{client: client, ctx: ctx}
 
 	request := ecs.CreateImportImageRequest()
 	request.RegionId = client.RegionId
@@ -136,7 +137,7 @@ func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.Architecture = d.Get("architecture").(string)
 	request.Description = d.Get("description").(string)
 	request.ImageName = d.Get("image_name").(string)
@@ -171,16 +172,16 @@ func resourceAlibabacloudStackImageImportCreate(d *schema.ResourceData, meta int
 	resp, _ := raw.(*ecs.ImportImageResponse)
 	d.SetId(resp.ImageId)
 	stateConf := BuildStateConfByTimes([]string{"Waiting"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 1*time.Minute, ecsService.ImageStateRefreshFunc(d.Id(), []string{"CreateFailed", "UnAvailable"}), 200)
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
-	return resourceAlibabacloudStackImageImportRead(d, meta)
+	return resourceAlibabacloudStackImageImportRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client: client}
+	ecsService := EcsService{client: client, ctx: ctx}
 
 	object, err := ecsService.DescribeImageById(d.Id())
 	if err != nil {
@@ -200,19 +201,19 @@ func resourceAlibabacloudStackImageImportRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackImageImportUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	err := ecsService.updateImage(d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackImageImportRead(d, meta)
+	return resourceAlibabacloudStackImageImportRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackImageImportDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackImageImportDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	return ecsService.deleteImage(d)
 }
 
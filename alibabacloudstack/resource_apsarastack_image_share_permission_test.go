@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"testing"
@@ -15,7 +16,7 @@ func TestAccAlibabacloudStackImageSharePermission(t *testing.T) {
 	resourceId := "alibabacloudstack_image_share_permission.default"
 	ra := resourceAttrInit(resourceId, testAccImageSharePermissionCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeImageShareByImageId")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -22,12 +23,12 @@ import (
 
 func resourceAlibabacloudStackInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceCreate,
-		Read:   resourceAlibabacloudStackInstanceRead,
-		Update: resourceAlibabacloudStackInstanceUpdate,
-		Delete: resourceAlibabacloudStackInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -36,6 +37,21 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 			Delete: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: customizeDiffAll(
+			setTagsAllDiff,
+			vswitchZoneDiff("vswitch_id", "availability_zone"),
+			cannotUnsetDiff("vswitch_id"),
+			// ModifyInstanceVpcAttribute only moves the instance to a vswitch in the same zone
+			forceNewIf("vswitch_id", vswitchZoneChanged("vswitch_id", "availability_zone"), "availability_zone"),
+			// ResizeDisk only expands the system disk, shrinking it takes a ReplaceSystemDisk with a new image
+			customizeDiffIf(diffValueDecreased("system_disk_size"), func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				if d.Id() == "" || d.HasChange("image_id") {
+					return nil
+				}
+				return fmt.Errorf("%q can only be decreased together with a change of %q", "system_disk_size", "image_id")
+			}),
+		),
+
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -218,8 +234,15 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 			},
 
 			"status": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{string(Running), string(Stopped)}, false),
+			},
+			"stopped_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"KeepCharging", "StopCharging"}, false),
 			},
 
 			"user_data": {
@@ -263,16 +286,103 @@ func resourceAlibabacloudStackInstance() *schema.Resource {
 				}, false),
 			},
 
-			"tags": tagsSchema(),
+			"instance_charge_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      string(PostPaid),
+				ValidateFunc: validation.StringInSlice([]string{string(PrePaid), string(PostPaid)}, false),
+			},
+			"period": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          1,
+				ValidateFunc:     validation.IntAtLeast(1),
+				DiffSuppressFunc: PostPaidDiffSuppressFunc,
+			},
+			"period_unit": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(Month),
+				ValidateFunc:     validation.StringInSlice([]string{string(Week), string(Month)}, false),
+				DiffSuppressFunc: PostPaidDiffSuppressFunc,
+			},
+			"spot_strategy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(NoSpot),
+					string(SpotWithPriceLimit),
+					string(SpotAsPriceGo),
+				}, false),
+			},
+			"spot_price_limit": {
+				Type:             schema.TypeFloat,
+				Optional:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: ecsSpotPriceLimitDiffSuppressFunc,
+			},
+			"deletion_protection": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"ipv6_address_count": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validation.IntBetween(0, 10),
+				ConflictsWith: []string{"ipv6_addresses"},
+			},
+			"ipv6_addresses": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				MaxItems:      10,
+				ConflictsWith: []string{"ipv6_address_count"},
+			},
+			"secondary_private_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"dedicated_host_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"deployment_set_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"launch_template_version": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				ForceNew: true,
+			},
+
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
-	request, err := buildAlibabacloudStackInstanceArgs(d, meta)
+	request, err := buildAlibabacloudStackInstanceArgs(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -286,16 +396,11 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 	} else {
 		request.Scheme = "http"
 	}
-	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.RunInstances(request)
 		})
 		if err != nil {
-			if IsThrottling(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -310,9 +415,9 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_instance", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 120*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
+	stateConf := BuildStateConf([]string{"Pending", "Starting", "Stopped"}, []string{"Running"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{"Stopping"}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	if v, ok := d.GetOk("security_groups"); ok {
@@ -324,13 +429,13 @@ func resourceAlibabacloudStackInstanceCreate(d *schema.ResourceData, meta interf
 			}
 		}
 	}
-	return resourceAlibabacloudStackInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	instance, err := ecsService.DescribeInstance(d.Id())
 	if err != nil {
@@ -368,7 +473,29 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 	d.Set("key_name", instance.KeyPairName)
 
 	d.Set("hpc_cluster_id", instance.HpcClusterId)
-	d.Set("tags", ecsService.tagsToMap(instance.Tags.Tag))
+	d.Set("instance_charge_type", instance.InstanceChargeType)
+	d.Set("spot_strategy", instance.SpotStrategy)
+	d.Set("spot_price_limit", instance.SpotPriceLimit)
+	d.Set("deletion_protection", instance.DeletionProtection)
+	d.Set("dedicated_host_id", instance.DedicatedHostAttribute.DedicatedHostId)
+	d.Set("deployment_set_id", instance.DeploymentSetId)
+	if instance.InstanceChargeType == string(PrePaid) {
+		period, err := computePeriodByUnit(instance.CreationTime, instance.ExpiredTime, d.Get("period").(int), d.Get("period_unit").(string))
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("period", period)
+	}
+
+	if len(instance.VpcAttributes.VSwitchId) > 0 {
+		if err := setInstanceNetworkInterfaceAddresses(d, ecsService); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if err := setResourceTags(d, meta, ecsService.tagsToMap(instance.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 
 	d.Set("vswitch_id", instance.VpcAttributes.VSwitchId)
 
@@ -395,7 +522,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		dataRequest.RegionId = client.RegionId
 		dataRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		dataRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		dataRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		dataRequest.InstanceId = d.Id()
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeUserData(dataRequest)
@@ -430,7 +557,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceIds = convertListToJsonString([]interface{}{d.Id()})
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceRamRole(request)
@@ -456,7 +583,7 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceAutoRenewAttribute(request)
@@ -470,14 +597,14 @@ func resourceAlibabacloudStackInstanceRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	d.Partial(true)
 
 	if !d.IsNewResource() {
-		err := setTags(client, TagResourceInstance, d)
+		err := setTags(ctx, client, TagResourceInstance, d)
 		if err != nil {
 			return WrapError(err)
 		}
@@ -510,126 +637,90 @@ func resourceAlibabacloudStackInstanceUpdate(d *schema.ResourceData, meta interf
 	}
 
 	run := false
-	imageUpdate, err := modifyInstanceImage(d, meta, run)
+	imageUpdate, err := modifyInstanceImage(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	vpcUpdate, err := modifyVpcAttribute(d, meta, run)
+	vpcUpdate, err := modifyVpcAttribute(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	passwordUpdate, err := modifyInstanceAttribute(d, meta)
+	passwordUpdate, err := modifyInstanceAttribute(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
 
-	typeUpdate, err := modifyInstanceType(d, meta, run)
+	typeUpdate, err := modifyInstanceType(ctx, d, meta, run)
 	if err != nil {
 		return WrapError(err)
 	}
 	if imageUpdate || vpcUpdate || passwordUpdate || typeUpdate {
 		run = true
-		instance, errDesc := ecsService.DescribeInstance(d.Id())
-		if errDesc != nil {
-			return WrapError(errDesc)
+		stoppedMode := ""
+		if d.Get("status").(string) == string(Stopped) {
+			stoppedMode = d.Get("stopped_mode").(string)
 		}
-		if instance.Status == string(Running) {
-			stopRequest := ecs.CreateStopInstanceRequest()
-			if strings.ToLower(client.Config.Protocol) == "https" {
-				stopRequest.Scheme = "https"
-			} else {
-				stopRequest.Scheme = "http"
-			}
-			stopRequest.RegionId = client.RegionId
-			stopRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			stopRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-
-			stopRequest.InstanceId = d.Id()
-			stopRequest.ForceStop = requests.NewBoolean(false)
-			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.StopInstance(stopRequest)
-			})
-			if err != nil {
-				return WrapErrorf(err, DefaultErrorMsg, d.Id(), stopRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
-			}
-			addDebug(stopRequest.GetActionName(), raw)
-		}
-
-		stateConf := BuildStateConf([]string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
-
-		if _, err = stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
+		if err := stopAlibabacloudStackInstance(ctx, d, meta, stoppedMode); err != nil {
+			return WrapError(err)
 		}
 
-		if _, err := modifyInstanceImage(d, meta, run); err != nil {
+		if _, err := modifyInstanceImage(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
-		if _, err := modifyVpcAttribute(d, meta, run); err != nil {
+		if _, err := modifyVpcAttribute(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
-		if _, err := modifyInstanceType(d, meta, run); err != nil {
+		if _, err := modifyInstanceType(ctx, d, meta, run); err != nil {
 			return WrapError(err)
 		}
 
-		startRequest := ecs.CreateStartInstanceRequest()
-		if strings.ToLower(client.Config.Protocol) == "https" {
-			startRequest.Scheme = "https"
-		} else {
-			startRequest.Scheme = "http"
+		if d.Get("status").(string) != string(Stopped) {
+			if err := startAlibabacloudStackInstance(ctx, d, meta); err != nil {
+				return WrapError(err)
+			}
 		}
-		startRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		startRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-		startRequest.InstanceId = d.Id()
+	}
 
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
-			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-				return ecsClient.StartInstance(startRequest)
-			})
-			if err != nil {
-				if IsExpectedErrors(err, []string{"IncorrectInstanceStatus"}) {
-					time.Sleep(time.Second)
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
+	if d.HasChange("status") {
+		switch d.Get("status").(string) {
+		case string(Stopped):
+			if err := stopAlibabacloudStackInstance(ctx, d, meta, d.Get("stopped_mode").(string)); err != nil {
+				return WrapError(err)
+			}
+		case string(Running):
+			if err := startAlibabacloudStackInstance(ctx, d, meta); err != nil {
+				return WrapError(err)
 			}
-			addDebug(startRequest.GetActionName(), raw)
-			return nil
-		})
-
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), startRequest.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
+	}
 
-		// Start instance sometimes costs more than 8 minutes when os type is centos.
-		stateConf = &resource.StateChangeConf{
-			Pending:    []string{"Pending", "Starting", "Stopped"},
-			Target:     []string{"Running"},
-			Refresh:    ecsService.InstanceStateRefreshFunc(d.Id(), []string{}),
-			Timeout:    d.Timeout(schema.TimeoutUpdate),
-			Delay:      5 * time.Second,
-			MinTimeout: 3 * time.Second,
-		}
+	if err := modifyInstanceSystemDiskSize(ctx, d, meta); err != nil {
+		return WrapError(err)
+	}
 
-		if _, err = stateConf.WaitForState(); err != nil {
-			return WrapErrorf(err, IdMsg, d.Id())
-		}
+	if err := modifyInstanceNetworkSpec(ctx, d, meta); err != nil {
+		return WrapError(err)
+	}
+
+	if err := modifyInstanceChargeType(ctx, d, meta); err != nil {
+		return WrapError(err)
 	}
 
-	if err := modifyInstanceNetworkSpec(d, meta); err != nil {
+	if err := modifyInstanceNetworkInterfaceAddresses(ctx, d, meta); err != nil {
 		return WrapError(err)
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackInstanceRead(d, meta)
+	return resourceAlibabacloudStackInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	stopRequest := ecs.CreateStopInstanceRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -638,7 +729,7 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 		stopRequest.Scheme = "http"
 	}
 	stopRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	stopRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	stopRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	stopRequest.InstanceId = d.Id()
 	stopRequest.ForceStop = requests.NewBoolean(true)
 
@@ -649,12 +740,12 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 		deleteRequest.Scheme = "http"
 	}
 	deleteRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	deleteRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	deleteRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	deleteRequest.InstanceId = d.Id()
 	deleteRequest.Force = requests.NewBoolean(true)
 
 	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteInstance(deleteRequest)
 		})
@@ -662,7 +753,7 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 			if IsExpectedErrors(err, []string{"IncorrectInstanceStatus", "DependencyViolation.RouteEntry", "IncorrectInstanceStatus.Initializing"}) {
 				return resource.RetryableError(err)
 			}
-			if IsExpectedErrors(err, []string{Throttling, "LastTokenProcessing"}) {
+			if IsExpectedErrors(err, []string{"LastTokenProcessing"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -680,13 +771,13 @@ func resourceAlibabacloudStackInstanceDelete(d *schema.ResourceData, meta interf
 
 	stateConf := BuildStateConf([]string{"Pending", "Running", "Stopped", "Stopping"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
 
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}) (*ecs.RunInstancesRequest, error) {
+func buildAlibabacloudStackInstanceArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*ecs.RunInstancesRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateRunInstancesRequest()
@@ -697,7 +788,7 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceType = d.Get("instance_type").(string)
 	request.SystemDiskDiskName = d.Get("system_disk_name").(string)
 	imageID := d.Get("image_id").(string)
@@ -742,7 +833,7 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 	}
 
 	if v := d.Get("kms_encrypted_password").(string); v != "" {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return request, WrapError(err)
@@ -792,7 +883,44 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 		request.SecurityEnhancementStrategy = v.(string)
 	}
 
-	v, ok := d.GetOk("tags")
+	request.InstanceChargeType = d.Get("instance_charge_type").(string)
+	if request.InstanceChargeType == string(PrePaid) {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+		request.PeriodUnit = d.Get("period_unit").(string)
+	} else if v, ok := d.GetOk("spot_strategy"); ok && v.(string) != "" {
+		request.SpotStrategy = v.(string)
+		if request.SpotStrategy == string(SpotWithPriceLimit) {
+			request.SpotPriceLimit = requests.NewFloat(d.Get("spot_price_limit").(float64))
+		}
+	}
+
+	if d.Get("deletion_protection").(bool) {
+		request.DeletionProtection = requests.NewBoolean(true)
+	}
+
+	if v, ok := d.GetOk("ipv6_addresses"); ok && v.(*schema.Set).Len() > 0 {
+		ipv6Addresses := expandStringList(v.(*schema.Set).List())
+		request.Ipv6Address = &ipv6Addresses
+	} else if v, ok := d.GetOk("ipv6_address_count"); ok {
+		request.Ipv6AddressCount = requests.NewInteger(v.(int))
+	}
+
+	if v, ok := d.GetOk("dedicated_host_id"); ok && v.(string) != "" {
+		request.DedicatedHostId = v.(string)
+	}
+
+	if v, ok := d.GetOk("deployment_set_id"); ok && v.(string) != "" {
+		request.DeploymentSetId = v.(string)
+	}
+
+	if v, ok := d.GetOk("launch_template_id"); ok && v.(string) != "" {
+		request.LaunchTemplateId = v.(string)
+		if version, ok := d.GetOk("launch_template_version"); ok {
+			request.LaunchTemplateVersion = requests.NewInteger(version.(int))
+		}
+	}
+
+	v, ok := d.GetOk("tags_all")
 	if ok && len(v.(map[string]interface{})) > 0 {
 		tags := make([]ecs.RunInstancesTag, 0)
 		for key, value := range v.(map[string]interface{}) {
@@ -852,15 +980,15 @@ func buildAlibabacloudStackInstanceArgs(d *schema.ResourceData, meta interface{}
 	return request, nil
 }
 
-func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceImage(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
 	}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	update := false
-	if d.HasChange("image_id") || d.HasChange("system_disk_size") {
+	if d.HasChange("image_id") {
 		update = true
 		if !run {
 			return update, nil
@@ -877,7 +1005,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.ImageId = d.Get("image_id").(string)
 		request.SystemDiskSize = requests.NewInteger(d.Get("system_disk_size").(int))
@@ -897,7 +1025,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 				return update, WrapError(errDesc)
 			}
 			var disk ecs.Disk
-			err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 				disk, err = ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
 				if err != nil {
 					if NotFoundError(err) {
@@ -935,7 +1063,7 @@ func modifyInstanceImage(d *schema.ResourceData, meta interface{}, run bool) (bo
 	return update, nil
 }
 
-func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, error) {
+func modifyInstanceAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -952,7 +1080,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("instance_name") {
 		//d.SetPartial("instance_name")
@@ -985,6 +1113,11 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 		}
 	}
 
+	if d.HasChange("deletion_protection") {
+		request.DeletionProtection = requests.NewBoolean(d.Get("deletion_protection").(bool))
+		update = true
+	}
+
 	if d.HasChange("host_name") {
 		//d.SetPartial("host_name")
 		request.HostName = d.Get("host_name").(string)
@@ -1000,7 +1133,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 			reboot = true
 		}
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return reboot, WrapError(err)
@@ -1014,7 +1147,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	}
 
 	if update {
-		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceAttribute(request)
 			})
@@ -1035,7 +1168,7 @@ func modifyInstanceAttribute(d *schema.ResourceData, meta interface{}) (bool, er
 	return reboot, nil
 }
 
-func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyVpcAttribute(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1052,7 +1185,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	request.InstanceId = d.Id()
 	request.VSwitchId = d.Get("vswitch_id").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("vswitch_id") {
 		update = true
@@ -1083,7 +1216,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 
 	if update {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceVpcAttribute(request)
 			})
@@ -1101,7 +1234,7 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 		if err != nil {
 			return update, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, ctx}
 		if err := ecsService.WaitForVpcAttributesChanged(d.Id(), request.VSwitchId, request.PrivateIpAddress); err != nil {
 			return update, WrapError(err)
 		}
@@ -1109,13 +1242,13 @@ func modifyVpcAttribute(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
+func modifyInstanceType(ctx context.Context, d *schema.ResourceData, meta interface{}, run bool) (bool, error) {
 	if d.IsNewResource() {
 		d.Partial(false)
 		return false, nil
 	}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	update := false
 	if d.HasChange("instance_type") {
 		update = true
@@ -1132,20 +1265,16 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceType = d.Get("instance_type").(string)
 		request.ClientToken = buildClientToken(request.GetActionName())
 
-		err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 			args := *request
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceSpec(&args)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{Throttling}) {
-					time.Sleep(10 * time.Second)
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1180,7 +1309,7 @@ func modifyInstanceType(d *schema.ResourceData, meta interface{}, run bool) (boo
 	return update, nil
 }
 
-func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
+func modifyInstanceNetworkSpec(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.IsNewResource() {
 		d.Partial(false)
@@ -1198,7 +1327,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	request.InstanceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("internet_max_bandwidth_out") {
 		o, n := d.GetChange("internet_max_bandwidth_out")
@@ -1220,12 +1349,12 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	wait := incrementalWait(2*time.Second, 2*time.Second)
 
 	if update {
-		if err := resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.ModifyInstanceNetworkSpec(request)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{Throttling, "LastOrderProcessing", "LastRequestProcessing", "LastTokenProcessing"}) {
+				if IsExpectedErrors(err, []string{"LastOrderProcessing", "LastRequestProcessing", "LastTokenProcessing"}) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -1239,7 +1368,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 		}); err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
-		ecsService := EcsService{client: client}
+		ecsService := EcsService{client: client, ctx: ctx}
 
 		deadline := time.Now().Add(DefaultTimeout * time.Second)
 		for {
@@ -1273,7 +1402,7 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 			}
 			request.InstanceId = d.Id()
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.AllocatePublicIpAddress(request)
 			})
@@ -1285,3 +1414,457 @@ func modifyInstanceNetworkSpec(d *schema.ResourceData, meta interface{}) error {
 	}
 	return nil
 }
+
+// stopAlibabacloudStackInstance stops a running instance and waits until it is stopped.
+// An empty stoppedMode leaves the choice to the api, which keeps the instance charged.
+func stopAlibabacloudStackInstance(ctx context.Context, d *schema.ResourceData, meta interface{}, stoppedMode string) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client, ctx}
+
+	instance, err := ecsService.DescribeInstance(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	if instance.Status == string(Stopped) {
+		return nil
+	}
+	if instance.Status == string(Running) {
+		request := ecs.CreateStopInstanceRequest()
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.RegionId = client.RegionId
+		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.InstanceId = d.Id()
+		request.ForceStop = requests.NewBoolean(false)
+		request.StoppedMode = stoppedMode
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.StopInstance(request)
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	}
+
+	stateConf := BuildStateConf([]string{"Pending", "Running", "Stopping"}, []string{"Stopped"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, ecsService.InstanceStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
+// startAlibabacloudStackInstance starts a stopped instance and waits until it is running.
+func startAlibabacloudStackInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client, ctx}
+
+	instance, err := ecsService.DescribeInstance(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	if instance.Status == string(Running) {
+		return nil
+	}
+	if instance.Status == string(Stopped) {
+		request := ecs.CreateStartInstanceRequest()
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.RegionId = client.RegionId
+		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.InstanceId = d.Id()
+
+		err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
+			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.StartInstance(request)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, []string{"IncorrectInstanceStatus"}) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+			return nil
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	// Start instance sometimes costs more than 8 minutes when os type is centos.
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"Pending", "Starting", "Stopped"},
+		Target:     []string{"Running"},
+		Refresh:    ecsService.InstanceStateRefreshFunc(d.Id(), []string{}),
+		Timeout:    d.Timeout(schema.TimeoutUpdate),
+		Delay:      5 * time.Second,
+		MinTimeout: 3 * time.Second,
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
+
+// modifyInstanceSystemDiskSize expands the system disk in place. Replacing the image
+// resizes the system disk as well, so it only applies when the image is unchanged.
+func modifyInstanceSystemDiskSize(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() || d.HasChange("image_id") || !d.HasChange("system_disk_size") {
+		return nil
+	}
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client, ctx}
+
+	instance, err := ecsService.DescribeInstance(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	disk, err := ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
+	if err != nil {
+		return WrapError(err)
+	}
+	size := d.Get("system_disk_size").(int)
+
+	request := ecs.CreateResizeDiskRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.DiskId = disk.DiskId
+	request.NewSize = requests.NewInteger(size)
+	request.Type = string(DiskResizeTypeOnline)
+	if instance.Status == string(Stopped) {
+		request.Type = string(DiskResizeTypeOffline)
+	}
+	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ResizeDisk(request)
+	})
+	if request.Type == string(DiskResizeTypeOnline) && IsExpectedErrors(err, DiskNotSupportOnlineChangeErrors) {
+		// The expansion takes effect the next time the instance is started.
+		request.Type = string(DiskResizeTypeOffline)
+		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ResizeDisk(request)
+		})
+	}
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	deadline := time.Now().Add(DefaultTimeout * time.Second)
+	for {
+		disk, err := ecsService.DescribeInstanceSystemDisk(d.Id(), instance.ResourceGroupId)
+		if err != nil {
+			return WrapError(err)
+		}
+		if disk.Size == size {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return WrapErrorf(err, WaitTimeoutMsg, d.Id(), GetFunc(1), DefaultTimeout, disk.Size, size, ProviderERROR)
+		}
+		if err := sleepContext(ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
+	}
+}
+
+// setInstanceNetworkInterfaceAddresses sets the secondary private IPs and the IPv6 addresses of the primary network
+// interface of the instance.
+func setInstanceNetworkInterfaceAddresses(d *schema.ResourceData, ecsService EcsService) error {
+	eni, err := ecsService.DescribeInstancePrimaryNetworkInterface(d.Id())
+	if err != nil && !NotFoundError(err) {
+		return WrapError(err)
+	}
+	secondaryPrivateIps := make([]string, 0, len(eni.PrivateIpSets.PrivateIpSet))
+	for _, ip := range eni.PrivateIpSets.PrivateIpSet {
+		if !ip.Primary {
+			secondaryPrivateIps = append(secondaryPrivateIps, ip.PrivateIpAddress)
+		}
+	}
+	if err := d.Set("secondary_private_ips", secondaryPrivateIps); err != nil {
+		return WrapError(err)
+	}
+	ipv6Addresses := make([]string, 0, len(eni.Ipv6Sets.Ipv6Set))
+	for _, ip := range eni.Ipv6Sets.Ipv6Set {
+		ipv6Addresses = append(ipv6Addresses, ip.Ipv6Address)
+	}
+	if err := d.Set("ipv6_addresses", ipv6Addresses); err != nil {
+		return WrapError(err)
+	}
+	d.Set("ipv6_address_count", len(ipv6Addresses))
+	return nil
+}
+
+func modifyInstanceChargeType(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if d.IsNewResource() {
+		return nil
+	}
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client, ctx}
+	if !d.HasChange("instance_charge_type") {
+		return nil
+	}
+
+	request := ecs.CreateModifyInstanceChargeTypeRequest()
+	if strings.ToLower(client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = client.RegionId
+	request.Headers = map[string]string{"RegionId": client.RegionId}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.InstanceIds = convertListToJsonString([]interface{}{d.Id()})
+	request.InstanceChargeType = d.Get("instance_charge_type").(string)
+	if request.InstanceChargeType == string(PrePaid) {
+		request.Period = requests.NewInteger(d.Get("period").(int))
+		request.PeriodUnit = d.Get("period_unit").(string)
+	}
+	request.IncludeDataDisks = requests.NewBoolean(true)
+	request.AutoPay = requests.NewBoolean(true)
+	request.ClientToken = buildClientToken(request.GetActionName())
+
+	wait := incrementalWait(2*time.Second, 2*time.Second)
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+			return ecsClient.ModifyInstanceChargeType(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"LastOrderProcessing", "LastRequestProcessing", "LastTokenProcessing"}) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	// The charge type is switched asynchronously after the order has been paid.
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	deadline := time.Now().Add(timeout)
+	for {
+		instance, err := ecsService.DescribeInstance(d.Id())
+		if err != nil {
+			return WrapError(err)
+		}
+		if instance.InstanceChargeType == request.InstanceChargeType {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return WrapErrorf(err, WaitTimeoutMsg, d.Id(), GetFunc(1), int(timeout.Seconds()), instance.InstanceChargeType, request.InstanceChargeType, ProviderERROR)
+		}
+		if err := sleepContext(ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
+	}
+}
+
+// modifyInstanceNetworkInterfaceAddresses keeps the secondary private IPs and
+// IPv6 addresses of the instance's primary ENI in line with the configuration.
+// IPv6 addresses requested at launch are allocated by RunInstances, while
+// secondary private IPs can only be assigned once the instance exists.
+func modifyInstanceNetworkInterfaceAddresses(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	ecsService := EcsService{client, ctx}
+
+	changed := d.HasChange("secondary_private_ips")
+	if !d.IsNewResource() {
+		changed = changed || d.HasChange("ipv6_addresses") || d.HasChange("ipv6_address_count")
+	}
+	if !changed {
+		return nil
+	}
+	if d.Get("vswitch_id").(string) == "" && d.Get("subnet_id").(string) == "" {
+		return WrapError(Error("Field 'vswitch_id' is required when assigning secondary private IPs or IPv6 addresses to the instance."))
+	}
+	eni, err := ecsService.DescribeInstancePrimaryNetworkInterface(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	eniId := eni.NetworkInterfaceId
+
+	if d.HasChange("secondary_private_ips") {
+		oldIps, newIps := d.GetChange("secondary_private_ips")
+		oldIpsSet := oldIps.(*schema.Set)
+		newIpsSet := newIps.(*schema.Set)
+
+		if unAssignIps := oldIpsSet.Difference(newIpsSet); unAssignIps.Len() > 0 {
+			unAssignIpList := expandStringList(unAssignIps.List())
+			request := ecs.CreateUnassignPrivateIpAddressesRequest()
+			if strings.ToLower(client.Config.Protocol) == "https" {
+				request.Scheme = "https"
+			} else {
+				request.Scheme = "http"
+			}
+			request.RegionId = client.RegionId
+			request.Headers = map[string]string{"RegionId": client.RegionId}
+			request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.NetworkInterfaceId = eniId
+			request.PrivateIpAddress = &unAssignIpList
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
+				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+					return ecsClient.UnassignPrivateIpAddresses(request)
+				})
+				if err != nil {
+					if IsExpectedErrors(err, NetworkInterfaceInvalidOperations) {
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+				return nil
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+		}
+
+		if assignIps := newIpsSet.Difference(oldIpsSet); assignIps.Len() > 0 {
+			assignIpList := expandStringList(assignIps.List())
+			request := ecs.CreateAssignPrivateIpAddressesRequest()
+			if strings.ToLower(client.Config.Protocol) == "https" {
+				request.Scheme = "https"
+			} else {
+				request.Scheme = "http"
+			}
+			request.RegionId = client.RegionId
+			request.Headers = map[string]string{"RegionId": client.RegionId}
+			request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.NetworkInterfaceId = eniId
+			request.PrivateIpAddress = &assignIpList
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
+				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+					return ecsClient.AssignPrivateIpAddresses(request)
+				})
+				if err != nil {
+					if IsExpectedErrors(err, NetworkInterfaceInvalidOperations) {
+						return resource.RetryableError(err)
+					}
+					return resource.NonRetryableError(err)
+				}
+				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+				return nil
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+		}
+
+		if err := ecsService.WaitForPrivateIpsListChanged(eniId, expandStringList(newIpsSet.List())); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.IsNewResource() {
+		return nil
+	}
+
+	var assignIpv6 []string
+	var unAssignIpv6 []string
+	assignIpv6Count := 0
+	expected := 0
+	if d.HasChange("ipv6_addresses") {
+		oldIps, newIps := d.GetChange("ipv6_addresses")
+		oldIpsSet := oldIps.(*schema.Set)
+		newIpsSet := newIps.(*schema.Set)
+		unAssignIpv6 = expandStringList(oldIpsSet.Difference(newIpsSet).List())
+		assignIpv6 = expandStringList(newIpsSet.Difference(oldIpsSet).List())
+		expected = newIpsSet.Len()
+	} else if d.HasChange("ipv6_address_count") {
+		current := expandStringList(d.Get("ipv6_addresses").(*schema.Set).List())
+		expected = d.Get("ipv6_address_count").(int)
+		if diff := expected - len(current); diff > 0 {
+			assignIpv6Count = diff
+		} else if diff < 0 {
+			unAssignIpv6 = current[:-diff]
+		}
+	}
+
+	if len(unAssignIpv6) > 0 {
+		request := ecs.CreateUnassignIpv6AddressesRequest()
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.RegionId = client.RegionId
+		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.NetworkInterfaceId = eniId
+		request.Ipv6Address = &unAssignIpv6
+		err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
+			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.UnassignIpv6Addresses(request)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, NetworkInterfaceInvalidOperations) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+			return nil
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if len(assignIpv6) > 0 || assignIpv6Count > 0 {
+		request := ecs.CreateAssignIpv6AddressesRequest()
+		if strings.ToLower(client.Config.Protocol) == "https" {
+			request.Scheme = "https"
+		} else {
+			request.Scheme = "http"
+		}
+		request.RegionId = client.RegionId
+		request.Headers = map[string]string{"RegionId": client.RegionId}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.NetworkInterfaceId = eniId
+		if len(assignIpv6) > 0 {
+			request.Ipv6Address = &assignIpv6
+		} else {
+			request.Ipv6AddressCount = requests.NewInteger(assignIpv6Count)
+		}
+		err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
+			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+				return ecsClient.AssignIpv6Addresses(request)
+			})
+			if err != nil {
+				if IsExpectedErrors(err, NetworkInterfaceInvalidOperations) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(err)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+			return nil
+		})
+		if err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if len(unAssignIpv6) > 0 || len(assignIpv6) > 0 || assignIpv6Count > 0 {
+		if err := ecsService.WaitForIpv6AddressesCountChanged(eniId, expected); err != nil {
+			return WrapError(err)
+		}
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"log"
+	"net/http"
 	"testing"
 
 	"strings"
@@ -13,6 +15,8 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func init() {
@@ -42,7 +46,7 @@ func testSweepInstances(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.RegionId = client.RegionId
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
@@ -71,7 +75,7 @@ func testSweepInstances(region string) error {
 	}
 
 	sweeped := false
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, v := range insts {
 		name := v.InstanceName
 		id := v.InstanceId
@@ -161,7 +165,7 @@ func TestAccAlibabacloudStackInstanceBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -238,7 +242,7 @@ func TestAccAlibabacloudStackInstanceVpc(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -412,7 +416,7 @@ func TestAccAlibabacloudStackInstanceDataDisks(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -493,7 +497,7 @@ func TestAccAlibabacloudStackInstanceTypeUpdate(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -545,7 +549,7 @@ func TestAccAlibabacloudStackInstanceMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_instance.default.2"
 	ra := resourceAttrInit(resourceId, testAccInstanceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -813,3 +817,429 @@ var testAccInstanceCheckMap = map[string]string{
 	"internet_max_bandwidth_out": "0",
 	"force_delete":               NOSET,
 }
+
+func TestUnitAlibabacloudStackInstance_customizeDiff(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeVSwitchAttributes", map[string]interface{}{
+		"VSwitchId": "vsw-mock0002",
+		"VpcId":     "vpc-mock0001",
+		"ZoneId":    "cn-qingdao-env66-d01-b",
+		"Status":    "Available",
+	})
+	client := server.client()
+	r := resourceAlibabacloudStackInstance()
+	config := map[string]interface{}{
+		"image_id":          "centos_7_mock.vhd",
+		"instance_type":     "ecs.n4.large",
+		"security_groups":   []interface{}{"sg-mock0001"},
+		"availability_zone": "cn-qingdao-env66-d01-a",
+		"vswitch_id":        "vsw-mock0002",
+	}
+	_, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err == nil || !strings.Contains(err.Error(), "is in the zone cn-qingdao-env66-d01-b") {
+		t.Fatalf("expected a vswitch outside of availability_zone to fail the plan, got %v", err)
+	}
+
+	state := &terraform.InstanceState{
+		ID: "i-mock0001",
+		Attributes: map[string]string{
+			"id":                   "i-mock0001",
+			"image_id":             "centos_7_mock.vhd",
+			"instance_type":        "ecs.n4.large",
+			"security_groups.#":    "1",
+			"security_groups.0":    "sg-mock0001",
+			"availability_zone":    "cn-qingdao-env66-d01-a",
+			"vswitch_id":           "vsw-mock0001",
+			"system_disk_size":     "40",
+			"system_disk_category": "cloud_efficiency",
+		},
+	}
+	delete(config, "availability_zone")
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the vswitch change got an error: %#v", err)
+	}
+	if attr := diff.Attributes["vswitch_id"]; attr == nil || !attr.RequiresNew {
+		t.Errorf("expected moving the instance to a vswitch in another zone to replace it, got %#v", attr)
+	}
+	if attr := diff.Attributes["availability_zone"]; attr == nil || !attr.NewComputed {
+		t.Errorf("expected the availability_zone to be recomputed, got %#v", attr)
+	}
+}
+
+func TestUnitAlibabacloudStackInstance_placementAndAddresses(t *testing.T) {
+	server := newMockApiServer(t).on("DescribeInstances", map[string]interface{}{
+		"Instances": map[string]interface{}{
+			"Instance": []interface{}{
+				map[string]interface{}{
+					"InstanceId":         "i-mock0001",
+					"Status":             "Running",
+					"InstanceType":       "ecs.n4.large",
+					"InstanceChargeType": "PostPaid",
+					"SpotStrategy":       "SpotWithPriceLimit",
+					"SpotPriceLimit":     0.5,
+					"DeletionProtection": true,
+					"DeploymentSetId":    "ds-mock0001",
+					"DedicatedHostAttribute": map[string]interface{}{
+						"DedicatedHostId": "dh-mock0001",
+					},
+					"VpcAttributes": map[string]interface{}{
+						"VSwitchId": "vsw-mock0001",
+						"PrivateIpAddress": map[string]interface{}{
+							"IpAddress": []interface{}{"192.168.0.10"},
+						},
+					},
+				},
+			},
+		},
+	}).on("DescribeDisks", map[string]interface{}{
+		"Disks": map[string]interface{}{
+			"Disk": []interface{}{
+				map[string]interface{}{
+					"DiskId":     "d-mock0001",
+					"InstanceId": "i-mock0001",
+					"Type":       "system",
+					"Category":   "cloud_efficiency",
+					"Size":       40,
+				},
+			},
+		},
+	}).on("DescribeNetworkInterfaces", map[string]interface{}{
+		"NetworkInterfaceSets": map[string]interface{}{
+			"NetworkInterfaceSet": []interface{}{
+				map[string]interface{}{
+					"NetworkInterfaceId": "eni-mock0001",
+					"InstanceId":         "i-mock0001",
+					"Type":               "Primary",
+					"PrivateIpSets": map[string]interface{}{
+						"PrivateIpSet": []interface{}{
+							map[string]interface{}{"PrivateIpAddress": "192.168.0.10", "Primary": true},
+							map[string]interface{}{"PrivateIpAddress": "192.168.0.11", "Primary": false},
+						},
+					},
+					"Ipv6Sets": map[string]interface{}{
+						"Ipv6Set": []interface{}{
+							map[string]interface{}{"Ipv6Address": "2408:4002:10c4:4e03::1"},
+						},
+					},
+				},
+			},
+		},
+	})
+	client := server.client()
+	r := resourceAlibabacloudStackInstance()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"image_id":            "centos_7_mock.vhd",
+		"instance_type":       "ecs.n4.large",
+		"security_groups":     []interface{}{"sg-mock0001"},
+		"vswitch_id":          "vsw-mock0001",
+		"spot_strategy":       "SpotWithPriceLimit",
+		"spot_price_limit":    0.5,
+		"deletion_protection": true,
+		"ipv6_address_count":  1,
+		"dedicated_host_id":   "dh-mock0001",
+		"deployment_set_id":   "ds-mock0001",
+		"launch_template_id":  "lt-mock0001",
+	})
+
+	request, err := buildAlibabacloudStackInstanceArgs(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("building the RunInstances request got an error: %#v", err)
+	}
+	if request.InstanceChargeType != "PostPaid" || request.SpotStrategy != "SpotWithPriceLimit" || request.SpotPriceLimit != requests.NewFloat(0.5) {
+		t.Errorf("expected a PostPaid spot instance with a price limit, got %q %q %q", request.InstanceChargeType, request.SpotStrategy, request.SpotPriceLimit)
+	}
+	if request.DeletionProtection != requests.NewBoolean(true) || request.Ipv6AddressCount != requests.NewInteger(1) {
+		t.Errorf("expected deletion protection and one IPv6 address, got %q %q", request.DeletionProtection, request.Ipv6AddressCount)
+	}
+	if request.DedicatedHostId != "dh-mock0001" || request.DeploymentSetId != "ds-mock0001" || request.LaunchTemplateId != "lt-mock0001" {
+		t.Errorf("expected the placement and launch template to be passed through, got %q %q %q", request.DedicatedHostId, request.DeploymentSetId, request.LaunchTemplateId)
+	}
+
+	d.SetId("i-mock0001")
+	if err := resourceAlibabacloudStackInstanceRead(context.Background(), d, client); err != nil {
+		t.Fatalf("reading the instance got an error: %#v", err)
+	}
+	call, ok := server.lastCall("DescribeNetworkInterfaces")
+	if !ok || call.Params["InstanceId"] != "i-mock0001" || call.Params["Type"] != "Primary" {
+		t.Errorf("expected the primary network interface of the instance to be described, got %#v", call.Params)
+	}
+	if !d.Get("deletion_protection").(bool) || d.Get("dedicated_host_id").(string) != "dh-mock0001" || d.Get("deployment_set_id").(string) != "ds-mock0001" {
+		t.Errorf("expected deletion protection and placement to be read, got %v %q %q", d.Get("deletion_protection"), d.Get("dedicated_host_id"), d.Get("deployment_set_id"))
+	}
+	if ips := expandStringList(d.Get("secondary_private_ips").(*schema.Set).List()); len(ips) != 1 || ips[0] != "192.168.0.11" {
+		t.Errorf("expected the secondary private IP 192.168.0.11, got %v", ips)
+	}
+	if ips := expandStringList(d.Get("ipv6_addresses").(*schema.Set).List()); len(ips) != 1 || d.Get("ipv6_address_count").(int) != 1 {
+		t.Errorf("expected one IPv6 address, got %v", ips)
+	}
+}
+
+func TestUnitAlibabacloudStackInstance_lifecycle(t *testing.T) {
+	instance := func(status string) map[string]interface{} {
+		return map[string]interface{}{
+			"Instances": map[string]interface{}{
+				"Instance": []interface{}{
+					map[string]interface{}{
+						"InstanceId":         "i-mock0001",
+						"InstanceName":       "ECS-Instance",
+						"Status":             status,
+						"ImageId":            "centos_7_mock.vhd",
+						"InstanceType":       "ecs.n4.large",
+						"InstanceChargeType": "PostPaid",
+						"ZoneId":             "cn-qingdao-env66-d01-a",
+						"SecurityGroupIds": map[string]interface{}{
+							"SecurityGroupId": []interface{}{"sg-mock0001"},
+						},
+					},
+				},
+			},
+		}
+	}
+	disk := func(size int) map[string]interface{} {
+		return map[string]interface{}{
+			"Disks": map[string]interface{}{
+				"Disk": []interface{}{
+					map[string]interface{}{
+						"DiskId":     "d-mock0001",
+						"InstanceId": "i-mock0001",
+						"Type":       "system",
+						"Category":   "cloud_efficiency",
+						"Size":       size,
+					},
+				},
+			},
+		}
+	}
+	server := newMockApiServer(t).
+		on("DescribeInstances", instance("Running")).
+		on("StopInstance", map[string]interface{}{}).
+		after("StopInstance", "DescribeInstances", instance("Stopped")).
+		on("DescribeDisks", disk(40)).
+		on("ResizeDisk", map[string]interface{}{}).
+		after("ResizeDisk", "DescribeDisks", disk(60)).
+		on("DescribeUserData", map[string]interface{}{"UserData": ""})
+	client := server.client()
+	r := resourceAlibabacloudStackInstance()
+	state := &terraform.InstanceState{
+		ID: "i-mock0001",
+		Attributes: map[string]string{
+			"id":                         "i-mock0001",
+			"image_id":                   "centos_7_mock.vhd",
+			"instance_type":              "ecs.n4.large",
+			"instance_name":              "ECS-Instance",
+			"security_groups.#":          "1",
+			"security_groups.0":          "sg-mock0001",
+			"availability_zone":          "cn-qingdao-env66-d01-a",
+			"status":                     "Running",
+			"system_disk_size":           "40",
+			"system_disk_category":       "cloud_efficiency",
+			"instance_charge_type":       "PostPaid",
+			"period":                     "1",
+			"period_unit":                "Month",
+			"deletion_protection":        "false",
+			"internet_max_bandwidth_out": "0",
+		},
+	}
+	config := map[string]interface{}{
+		"image_id":          "centos_7_mock.vhd",
+		"instance_type":     "ecs.n4.large",
+		"security_groups":   []interface{}{"sg-mock0001"},
+		"availability_zone": "cn-qingdao-env66-d01-a",
+		"status":            "Stopped",
+		"stopped_mode":      "StopCharging",
+		"system_disk_size":  60,
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the instance got an error: %#v", err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected the instance to be updated in place, got %#v", diff.Attributes)
+	}
+	state, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("updating the instance got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("StopInstance"); !ok || call.Params["StoppedMode"] != "StopCharging" || call.Params["ForceStop"] != "false" {
+		t.Errorf("StopInstance was not called with the expected parameters: %v", call.Params)
+	}
+	if call, ok := server.lastCall("ResizeDisk"); !ok || call.Params["DiskId"] != "d-mock0001" || call.Params["NewSize"] != "60" || call.Params["Type"] != "offline" {
+		t.Errorf("ResizeDisk was not called with the expected parameters: %v", call.Params)
+	}
+	if server.callCount("ReplaceSystemDisk") != 0 || server.callCount("StartInstance") != 0 {
+		t.Errorf("expected the instance to stay stopped without replacing its system disk")
+	}
+	if state.Attributes["status"] != "Stopped" || state.Attributes["system_disk_size"] != "60" {
+		t.Errorf("expected a stopped instance with a 60 GiB system disk, got %v", state.Attributes)
+	}
+
+	config["system_disk_size"] = 40
+	if _, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client); err == nil || !strings.Contains(err.Error(), `"system_disk_size" can only be decreased`) {
+		t.Errorf("expected shrinking the system disk without a new image to fail the plan, got %v", err)
+	}
+}
+
+func TestUnitAlibabacloudStackInstance_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("instance")
+	client := server.client()
+
+	r := resourceAlibabacloudStackInstance()
+	config := map[string]interface{}{
+		"image_id":        "centos_7_mock.vhd",
+		"instance_type":   "ecs.n4.large",
+		"security_groups": []interface{}{"sg-mock0001"},
+		"vswitch_id":      "vsw-mock0001",
+		"instance_name":   "tf-testAccInstanceMock",
+		"description":     "mock instance",
+		"host_name":       "tf-mock-host",
+		"tags":            map[string]interface{}{"Created": "TF"},
+	}
+	d := newMockApiResourceData(t, r, config)
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the instance got an error: %#v", diags)
+	}
+	if d.Id() != "i-mock0001" {
+		t.Fatalf("expected the instance id i-mock0001, got %q", d.Id())
+	}
+	call, ok := server.lastCall("RunInstances")
+	if !ok || call.Params["ImageId"] != "centos_7_mock.vhd" || call.Params["InstanceType"] != "ecs.n4.large" || call.Params["VSwitchId"] != "vsw-mock0001" || call.Params["SecurityGroupId"] != "sg-mock0001" {
+		t.Errorf("RunInstances was not called with the expected parameters: %v", call.Params)
+	}
+	if d.Get("status").(string) != "Running" || d.Get("availability_zone").(string) != "cn-qingdao-env66-d01-a" || d.Get("private_ip").(string) != "172.16.0.10" {
+		t.Errorf("expected a running instance with its zone and private ip, got %q %q %q", d.Get("status"), d.Get("availability_zone"), d.Get("private_ip"))
+	}
+	if d.Get("system_disk_size").(int) != 40 || d.Get("system_disk_category").(string) != "cloud_efficiency" {
+		t.Errorf("expected the 40 GiB cloud_efficiency system disk, got %v %q", d.Get("system_disk_size"), d.Get("system_disk_category"))
+	}
+	if value := d.Get("tags.Created"); value != "TF" {
+		t.Errorf("expected the tag Created TF, got %v", value)
+	}
+
+	state := d.State()
+	if diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client); err != nil || !diff.Empty() {
+		t.Errorf("expected the created instance to plan no changes, got %v %#v", err, diff)
+	}
+
+	config["instance_name"] = "tf-testAccInstanceMockRenamed"
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the renamed instance got an error: %#v", err)
+	}
+	if diff.RequiresNew() {
+		t.Fatalf("expected the instance to be renamed in place, got %#v", diff.Attributes)
+	}
+	state, diags := r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("renaming the instance got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("ModifyInstanceAttribute"); !ok || call.Params["InstanceId"] != "i-mock0001" || call.Params["InstanceName"] != "tf-testAccInstanceMockRenamed" {
+		t.Errorf("ModifyInstanceAttribute was not called with the expected parameters: %v", call.Params)
+	}
+	if state.Attributes["instance_name"] != "tf-testAccInstanceMockRenamed" {
+		t.Errorf("expected the renamed instance to be read, got %q", state.Attributes["instance_name"])
+	}
+	if server.callCount("StopInstance") != 0 || server.callCount("RebootInstance") != 0 {
+		t.Errorf("expected the instance to be renamed without being stopped")
+	}
+
+	d = r.Data(state)
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the instance got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("DeleteInstance"); !ok || call.Params["InstanceId"] != "i-mock0001" || call.Params["Force"] != "true" {
+		t.Errorf("DeleteInstance was not called with the expected parameters: %v", call.Params)
+	}
+}
+
+func TestUnitAlibabacloudStackInstance_mockWithTerraform(t *testing.T) {
+	testAccPreCheckWithTerraform(t)
+	server := newMockApiServer(t).loadFixture("instance")
+	unsetMockApiEnvironments(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"alibabacloudstack": func() (*schema.Provider, error) {
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerBlock() + `
+resource "alibabacloudstack_instance" "default" {
+  image_id        = "centos_7_mock.vhd"
+  instance_type   = "ecs.n4.large"
+  security_groups = ["sg-mock0001"]
+  vswitch_id      = "vsw-mock0001"
+  instance_name   = "tf-testAccInstanceMock"
+  description     = "mock instance"
+  host_name       = "tf-mock-host"
+  tags = {
+    Created = "TF"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alibabacloudstack_instance.default", "id", "i-mock0001"),
+					resource.TestCheckResourceAttr("alibabacloudstack_instance.default", "status", "Running"),
+					resource.TestCheckResourceAttr("alibabacloudstack_instance.default", "availability_zone", "cn-qingdao-env66-d01-a"),
+					resource.TestCheckResourceAttr("alibabacloudstack_instance.default", "private_ip", "172.16.0.10"),
+				),
+			},
+			{
+				Config: server.providerBlock() + `
+resource "alibabacloudstack_instance" "default" {
+  image_id        = "centos_7_mock.vhd"
+  instance_type   = "ecs.n4.large"
+  security_groups = ["sg-mock0001"]
+  vswitch_id      = "vsw-mock0001"
+  instance_name   = "tf-testAccInstanceMockRenamed"
+  description     = "mock instance"
+  host_name       = "tf-mock-host"
+  tags = {
+    Created = "TF"
+  }
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alibabacloudstack_instance.default", "instance_name", "tf-testAccInstanceMockRenamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAlibabacloudStackInstance_startIncorrectStatus(t *testing.T) {
+	instance := func(status string) map[string]interface{} {
+		return map[string]interface{}{
+			"Instances": map[string]interface{}{
+				"Instance": []interface{}{
+					map[string]interface{}{"InstanceId": "i-mock0001", "Status": status},
+				},
+			},
+		}
+	}
+	server := newMockApiServer(t).
+		on("DescribeInstances", instance("Stopped")).
+		add(&mockApiResponse{Action: "StartInstance", Status: http.StatusBadRequest, Body: mockApiErrorBody("IncorrectInstanceStatus")}).
+		on("StartInstance", map[string]interface{}{}).
+		after("StartInstance", "DescribeInstances", instance("Running"))
+	client := server.client()
+	d := newMockApiResourceData(t, resourceAlibabacloudStackInstance(), map[string]interface{}{})
+	d.SetId("i-mock0001")
+
+	if err := startAlibabacloudStackInstance(context.Background(), d, client); err != nil {
+		t.Fatalf("starting the instance got an error: %#v", err)
+	}
+	if count := server.callCount("StartInstance"); count != 2 {
+		t.Errorf("expected StartInstance to be sent again once the instance status allows it, it was sent %d times", count)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	server = newMockApiServer(t).
+		on("DescribeInstances", instance("Stopped")).
+		onError("StartInstance", http.StatusBadRequest, "IncorrectInstanceStatus")
+	if err := startAlibabacloudStackInstance(ctx, d, server.client()); err == nil {
+		t.Errorf("expected starting the instance with a cancelled context to fail")
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"strings"
@@ -19,14 +20,15 @@ import (
 
 func resourceAlibabacloudStackKeyPair() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairCreate,
-		Read:   resourceAlibabacloudStackKeyPairRead,
-		Update: resourceAlibabacloudStackKeyPairUpdate,
-		Delete: resourceAlibabacloudStackKeyPairDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKeyPairCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKeyPairRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKeyPairUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKeyPairDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"key_name": {
 				Type:     schema.TypeString,
@@ -65,12 +67,13 @@ func resourceAlibabacloudStackKeyPair() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	var keyName string
@@ -95,12 +98,11 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 			"Department": client.Department,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeyId":     client.AccessKey,
-			"AccessKeySecret": client.SecretKey,
-			"SecurityToken":   client.Config.SecurityToken,
-			"Product":         "ecs",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup}
+			"AccessKeyId":   client.AccessKey,
+			"SecurityToken": client.Config.SecurityToken,
+			"Product":       "ecs",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup}
 		request.KeyPairName = keyName
 		request.PublicKeyBody = publicKey.(string)
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -123,12 +125,11 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeyId":     client.AccessKey,
-			"AccessKeySecret": client.SecretKey,
-			"SecurityToken":   client.Config.SecurityToken,
-			"Product":         "ecs",
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
+			"AccessKeyId":   client.AccessKey,
+			"SecurityToken": client.Config.SecurityToken,
+			"Product":       "ecs",
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
 		}
 		request.KeyPairName = keyName
 		fmt.Println(request)
@@ -147,21 +148,21 @@ func resourceAlibabacloudStackKeyPairCreate(d *schema.ResourceData, meta interfa
 		}
 	}
 
-	return resourceAlibabacloudStackKeyPairUpdate(d, meta)
+	return resourceAlibabacloudStackKeyPairUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKeyPairUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	err := setTags(client, TagResourceKeypair, d)
+	err := setTags(ctx, client, TagResourceKeypair, d)
 	if err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackKeyPairRead(d, meta)
+	return resourceAlibabacloudStackKeyPairRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	keyPair, err := ecsService.DescribeKeyPair(d.Id())
 	if err != nil {
@@ -175,14 +176,14 @@ func resourceAlibabacloudStackKeyPairRead(d *schema.ResourceData, meta interface
 	d.Set("finger_print", keyPair.KeyPairFingerPrint)
 	tags := keyPair.Tags.Tag
 	if len(tags) > 0 {
-		err = d.Set("tags", ecsService.tagsToMap(tags))
+		err = setResourceTags(d, meta, ecsService.tagsToMap(tags))
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDeleteKeyPairsRequest()
 	request.RegionId = client.RegionId
@@ -193,15 +194,14 @@ func resourceAlibabacloudStackKeyPairDelete(d *schema.ResourceData, meta interfa
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     client.AccessKey,
-		"AccessKeySecret": client.SecretKey,
-		"SecurityToken":   client.Config.SecurityToken,
-		"Product":         "ecs",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup}
+		"AccessKeyId":   client.AccessKey,
+		"SecurityToken": client.Config.SecurityToken,
+		"Product":       "ecs",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup}
 	request.KeyPairNames = convertListToJsonString(append(make([]interface{}, 0, 1), d.Id()))
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteKeyPairs(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,11 +17,11 @@ import (
 
 func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKeyPairAttachmentCreate,
-		Read:   resourceAlibabacloudStackKeyPairAttachmentRead,
-		Delete: resourceAlibabacloudStackKeyPairAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKeyPairAttachmentDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -45,9 +46,9 @@ func resourceAlibabacloudStackKeyPairAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	keyName := d.Get("key_name").(string)
 	instanceIds := d.Get("instance_ids").(*schema.Set).List()
 	force := d.Get("force").(bool)
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 		} else {
 			request.Scheme = "http"
 		}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ForceStop = requests.NewBoolean(true)
 		for _, id := range newIds {
 			request.InstanceId = id
@@ -103,13 +104,13 @@ func resourceAlibabacloudStackKeyPairAttachmentCreate(d *schema.ResourceData, me
 
 	d.SetId(keyName + ":" + convertListToJsonString(instanceIds))
 
-	return resourceAlibabacloudStackKeyPairAttachmentRead(d, meta)
+	return resourceAlibabacloudStackKeyPairAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	keyName := strings.Split(d.Id(), ":")[0]
 	object, err := ecsService.DescribeKeyPairAttachment(d.Id())
 
@@ -134,9 +135,9 @@ func resourceAlibabacloudStackKeyPairAttachmentRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKeyPairAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	keyName := strings.Split(d.Id(), ":")[0]
 	instanceIds := strings.Split(d.Id(), ":")[1]
 
@@ -148,10 +149,10 @@ func resourceAlibabacloudStackKeyPairAttachmentDelete(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.KeyPairName = keyName
 
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request.InstanceIds = instanceIds
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachKeyPair(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"log"
@@ -47,7 +48,7 @@ func testSweepKeyPairs(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -95,7 +96,7 @@ func testSweepKeyPairs(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.KeyPairNames = convertListToJsonString(append(make([]interface{}, 0, 1), name))
 		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteKeyPairs(req)
@@ -120,7 +121,7 @@ func testAccCheckKeyPairExists(n string, keypair *ecs.KeyPair) resource.TestChec
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 
 		response, err := ecsService.DescribeKeyPair(rs.Primary.ID)
 
@@ -143,7 +144,7 @@ func testAccCheckKeyPairDestroy(s *terraform.State) error {
 
 		// Try to find the Disk
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 
 		response, err := ecsService.DescribeKeyPair(rs.Primary.ID)
 		os.Remove(rs.Primary.Attributes["key_file"])
@@ -168,7 +169,7 @@ func TestAccAlibabacloudStackKeyPairBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_key_pair.default"
 	ra := resourceAttrInit(resourceId, testAccCheckKeyPairBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -226,7 +227,7 @@ func TestAccAlibabacloudStackKeyPairMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_key_pair.default.9"
 	ra := resourceAttrInit(resourceId, testAccCheckKeyPairBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -8,12 +9,12 @@ import (
 
 func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsAliasCreate,
-		Read:   resourceAlibabacloudStackKmsAliasRead,
-		Update: resourceAlibabacloudStackKmsAliasUpdate,
-		Delete: resourceAlibabacloudStackKmsAliasDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsAliasCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsAliasRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsAliasUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsAliasDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"alias_name": {
@@ -29,12 +30,12 @@ func resourceAlibabacloudStackKmsAlias() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateAliasRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.AliasName = d.Get("alias_name").(string)
 	request.KeyId = d.Get("key_id").(string)
@@ -47,12 +48,12 @@ func resourceAlibabacloudStackKmsAliasCreate(d *schema.ResourceData, meta interf
 	addDebug(request.GetActionName(), raw)
 	d.SetId(request.AliasName)
 
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kmsService := KmsService{client}
+	kmsService := KmsService{client, ctx}
 	object, err := kmsService.DescribeKmsAlias(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -66,12 +67,12 @@ func resourceAlibabacloudStackKmsAliasRead(d *schema.ResourceData, meta interfac
 	d.Set("key_id", object.KeyId)
 	return nil
 }
-func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("key_id") {
 		request := kms.CreateUpdateAliasRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.AliasName = d.Id()
 		request.KeyId = d.Get("key_id").(string)
@@ -83,13 +84,13 @@ func resourceAlibabacloudStackKmsAliasUpdate(d *schema.ResourceData, meta interf
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackKmsAliasRead(d, meta)
+	return resourceAlibabacloudStackKmsAliasRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsAliasDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsAliasDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateDeleteAliasRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.AliasName = d.Id()
 	raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestAccAlibabacloudStackKmsAlias_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, kmsAliasBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
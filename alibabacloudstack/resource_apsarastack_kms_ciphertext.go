@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"time"
 
@@ -11,12 +12,12 @@ import (
 
 func resourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsCiphertextCreate,
-		Read:   schema.Noop,
-		Delete: resourceAlibabacloudStackKmsCiphertextDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsCiphertextCreate),
+		ReadContext:   schema.NoopContext,
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsCiphertextDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -47,7 +48,7 @@ func resourceAlibabacloudStackKmsCiphertext() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsCiphertextCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	// Since a ciphertext has no ID, we create an ID based on
@@ -56,7 +57,7 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 
 	request := kms.CreateEncryptRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.Plaintext = d.Get("plaintext").(string)
 	request.KeyId = d.Get("key_id").(string)
@@ -84,6 +85,6 @@ func resourceAlibabacloudStackKmsCiphertextCreate(d *schema.ResourceData, meta i
 	return nil
 }
 
-func resourceAlibabacloudStackKmsCiphertextDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsCiphertextDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKmsKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsKeyCreate,
-		Read:   resourceAlibabacloudStackKmsKeyRead,
-		Update: resourceAlibabacloudStackKmsKeyUpdate,
-		Delete: resourceAlibabacloudStackKmsKeyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsKeyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsKeyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsKeyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsKeyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"arn": {
@@ -114,12 +115,12 @@ func resourceAlibabacloudStackKmsKey() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateKeyRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("automatic_rotation"); ok {
 		request.EnableAutomaticRotation = requests.NewBoolean(convertAutomaticRotationRequest(v.(string)))
@@ -148,16 +149,16 @@ func resourceAlibabacloudStackKmsKeyCreate(d *schema.ResourceData, meta interfac
 	addDebug(request.GetActionName(), raw)
 	response, _ := raw.(*kms.CreateKeyResponse)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	d.SetId(fmt.Sprintf("%v", response.KeyMetadata.KeyId))
 
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kmsService := KmsService{client}
+	kmsService := KmsService{client, ctx}
 	object, err := kmsService.DescribeKmsKey(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -184,15 +185,15 @@ func resourceAlibabacloudStackKmsKeyRead(d *schema.ResourceData, meta interface{
 	d.Set("rotation_interval", object.RotationInterval)
 	return nil
 }
-func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kmsService := KmsService{client}
+	kmsService := KmsService{client, ctx}
 	d.Partial(true)
 
 	if d.HasChange("description") {
 		request := kms.CreateUpdateKeyDescriptionRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.KeyId = d.Id()
 		request.Description = d.Get("description").(string)
@@ -208,7 +209,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 	update := false
 	request := kms.CreateUpdateRotationPolicyRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.KeyId = d.Id()
 	if d.HasChange("automatic_rotation") {
@@ -252,7 +253,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 			if target == "Disabled" {
 				request := kms.CreateDisableKeyRequest()
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.KeyId = d.Id()
 				raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -268,7 +269,7 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 			if target == "Enabled" {
 				request := kms.CreateEnableKeyRequest()
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 				request.KeyId = d.Id()
 				raw, err := client.WithKmsClient(func(kmsClient *kms.Client) (interface{}, error) {
@@ -284,13 +285,13 @@ func resourceAlibabacloudStackKmsKeyUpdate(d *schema.ResourceData, meta interfac
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsKeyRead(d, meta)
+	return resourceAlibabacloudStackKmsKeyRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateScheduleKeyDeletionRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.KeyId = d.Id()
 	if v, ok := d.GetOk("pending_window_in_days"); ok {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"testing"
@@ -34,7 +35,7 @@ func testSweepKmsKey(region string) error {
 
 	req := kms.CreateListKeysRequest()
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
 
@@ -48,7 +49,7 @@ func testSweepKmsKey(region string) error {
 	swept := false
 
 	for _, v := range keys.Keys.Key {
-		kmsService := &KmsService{client: client}
+		kmsService := &KmsService{client: client, ctx: context.Background()}
 		key, err := kmsService.DescribeKmsKey(v.KeyId)
 		if err != nil {
 			if NotFoundError(err) {
@@ -64,7 +65,7 @@ func testSweepKmsKey(region string) error {
 			if strings.HasPrefix(strings.ToLower(key.Description), strings.ToLower(description)) {
 				req := kms.CreateScheduleKeyDeletionRequest()
 				req.Headers = map[string]string{"RegionId": client.RegionId}
-				req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				req.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				req.QueryParams["Department"] = client.Department
 				req.QueryParams["ResourceGroup"] = client.ResourceGroup
 
@@ -92,7 +93,7 @@ func TestAccAlibabacloudStackKMSKey_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_kms_key.default"
 	ra := resourceAttrInit(resourceId, KmsKeyMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeKmsKey")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
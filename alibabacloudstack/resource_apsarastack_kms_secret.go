@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -12,13 +13,14 @@ import (
 
 func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKmsSecretCreate,
-		Read:   resourceAlibabacloudStackKmsSecretRead,
-		Update: resourceAlibabacloudStackKmsSecretUpdate,
-		Delete: resourceAlibabacloudStackKmsSecretDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKmsSecretCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKmsSecretRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKmsSecretUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKmsSecretDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -66,7 +68,8 @@ func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"version_id": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -83,13 +86,13 @@ func resourceAlibabacloudStackKmsSecret() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := kms.CreateCreateSecretRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if v, ok := d.GetOk("description"); ok {
 		request.Description = v.(string)
@@ -102,7 +105,7 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 		request.SecretDataType = v.(string)
 	}
 	request.SecretName = d.Get("secret_name").(string)
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		addTags := make([]JsonTag, 0)
 		for key, value := range v.(map[string]interface{}) {
 			addTags = append(addTags, JsonTag{
@@ -127,12 +130,12 @@ func resourceAlibabacloudStackKmsSecretCreate(d *schema.ResourceData, meta inter
 	response, _ := raw.(*kms.CreateSecretResponse)
 	d.SetId(response.SecretName)
 
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kmsService := KmsService{client}
+	kmsService := KmsService{client, ctx}
 	object, err := kmsService.DescribeKmsSecret(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -152,7 +155,9 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 	for _, t := range object.Tags.Tag {
 		tags[t.TagKey] = t.TagValue
 	}
-	d.Set("tags", tags)
+	if err := setResourceTags(d, meta, tags); err != nil {
+		return WrapError(err)
+	}
 
 	getSecretValueObject, err := kmsService.GetSecretValue(d.Id())
 	if err != nil {
@@ -164,12 +169,12 @@ func resourceAlibabacloudStackKmsSecretRead(d *schema.ResourceData, meta interfa
 	d.Set("version_stages", getSecretValueObject.VersionStages.VersionStage)
 	return nil
 }
-func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kmsService := KmsService{client}
+	kmsService := KmsService{client, ctx}
 	d.Partial(true)
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := kmsService.setResourceTags(d, "secret"); err != nil {
 			return WrapError(err)
 		}
@@ -178,7 +183,7 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 	if d.HasChange("description") {
 		request := kms.CreateUpdateSecretRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.SecretName = d.Id()
 		request.Description = d.Get("description").(string)
@@ -194,7 +199,7 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 	update := false
 	request := kms.CreatePutSecretValueRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SecretName = d.Id()
 	if d.HasChange("secret_data") {
@@ -227,13 +232,13 @@ func resourceAlibabacloudStackKmsSecretUpdate(d *schema.ResourceData, meta inter
 		//d.SetPartial("version_stages")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackKmsSecretRead(d, meta)
+	return resourceAlibabacloudStackKmsSecretRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKmsSecretDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKmsSecretDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := kms.CreateDeleteSecretRequest()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.SecretName = d.Id()
 	if v, ok := d.GetOkExists("force_delete_without_recovery"); ok {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -32,7 +33,7 @@ func testSweepKmsSecret(region string) error {
 
 	req := kms.CreateListSecretsRequest()
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.QueryParams["Department"] = client.Department
 	req.QueryParams["ResourceGroup"] = client.ResourceGroup
 
@@ -49,7 +50,7 @@ func testSweepKmsSecret(region string) error {
 			req := kms.CreateDeleteSecretRequest()
 			req.SecretName = v.SecretName
 			req.Headers = map[string]string{"RegionId": client.RegionId}
-			req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			req.QueryParams = map[string]string{"Product": "kms", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			req.ForceDeleteWithoutRecovery = "true"
 			raw, err = client.WithKmsClient(func(kmsclient *kms.Client) (interface{}, error) {
 				return kmsclient.DeleteSecret(req)
@@ -80,7 +81,7 @@ func TestAccAlibabacloudStackKmsSecret_Basic(t *testing.T) {
 	})
 
 	serviceFunc := func() interface{} {
-		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeKmsSecret")
 
@@ -211,7 +212,7 @@ func TestAccAlibabacloudStackKmsSecret_WithKey(t *testing.T) {
 	})
 
 	serviceFunc := func() interface{} {
-		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KmsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeKmsSecret")
 
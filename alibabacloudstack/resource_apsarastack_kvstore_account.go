@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreAccountCreate,
-		Read:   resourceAlibabacloudStackKVStoreAccountRead,
-		Update: resourceAlibabacloudStackKVStoreAccountUpdate,
-		Delete: resourceAlibabacloudStackKVStoreAccountDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKVStoreAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKVStoreAccountDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -74,13 +75,13 @@ func resourceAlibabacloudStackKVstoreAccount() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, ctx}
 	request := r_kvstore.CreateCreateAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Get("instance_id").(string)
 	request.AccountName = d.Get("account_name").(string)
 	request.AccountPrivilege = d.Get("account_privilege").(string)
@@ -93,7 +94,7 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 	if password != "" {
 		request.AccountPassword = password
 	} else {
-		kmsService := KmsService{client}
+		kmsService := KmsService{client, ctx}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -109,7 +110,7 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 	if err := kvstoreService.WaitForKVstoreInstance(request.InstanceId, Normal, DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithRkvClient(func(rkvClient *r_kvstore.Client) (interface{}, error) {
 			return rkvClient.CreateAccount(request)
 		})
@@ -133,13 +134,13 @@ func resourceAlibabacloudStackKVStoreAccountCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, ctx}
 	object, err := kvstoreService.DescribeKVstoreAccount(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -158,9 +159,9 @@ func resourceAlibabacloudStackKVStoreAccountRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, ctx}
 	d.Partial(true)
 	parts := strings.Split(d.Id(), COLON_SEPARATED)
 	instanceId := parts[0]
@@ -173,7 +174,7 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 		request := r_kvstore.CreateModifyAccountDescriptionRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountDescription = d.Get("description").(string)
@@ -195,7 +196,7 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 		request := r_kvstore.CreateGrantAccountPrivilegeRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 		request.AccountPrivilege = d.Get("account_privilege").(string)
@@ -217,7 +218,7 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 		request := r_kvstore.CreateResetAccountPasswordRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = instanceId
 		request.AccountName = accountName
 
@@ -231,7 +232,7 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 		if password != "" {
 			request.AccountPassword = password
 		} else {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -254,12 +255,12 @@ func resourceAlibabacloudStackKVStoreAccountUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackKVStoreAccountRead(d, meta)
+	return resourceAlibabacloudStackKVStoreAccountRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreAccountDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -267,7 +268,7 @@ func resourceAlibabacloudStackKVStoreAccountDelete(d *schema.ResourceData, meta
 	request := r_kvstore.CreateDeleteAccountRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = parts[0]
 	request.AccountName = parts[1]
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -24,7 +25,7 @@ func TestAccAlibabacloudStackKVStoreAccountUpdateV4(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_account.default"
 	ra := resourceAttrInit(resourceId, basicMap)
 	serviceFunc := func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeKVstoreAccount")
 	rac := resourceAttrCheckInit(rc, ra)
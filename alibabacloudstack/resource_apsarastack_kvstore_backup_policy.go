@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKVStoreBackupPolicyCreate,
-		Read:   resourceAlibabacloudStackKVStoreBackupPolicyRead,
-		Update: resourceAlibabacloudStackKVStoreBackupPolicyUpdate,
-		Delete: resourceAlibabacloudStackKVStoreBackupPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKVStoreBackupPolicyDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
@@ -43,17 +44,17 @@ func resourceAlibabacloudStackKVStoreBackupPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("instance_id").(string))
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, ctx}
 
 	object, err := kvstoreService.DescribeKVstoreBackupPolicy(d.Id())
 	if err != nil {
@@ -71,15 +72,15 @@ func resourceAlibabacloudStackKVStoreBackupPolicyRead(d *schema.ResourceData, me
 	return nil
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		kvstoreService := KvstoreService{client}
+		kvstoreService := KvstoreService{client, ctx}
 
 		request := r_kvstore.CreateModifyBackupPolicyRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		request.PreferredBackupTime = d.Get("backup_time").(string)
 		periodList := expandStringList(d.Get("backup_period").(*schema.Set).List())
@@ -99,16 +100,16 @@ func resourceAlibabacloudStackKVStoreBackupPolicyUpdate(d *schema.ResourceData,
 		}
 	}
 
-	return resourceAlibabacloudStackKVStoreBackupPolicyRead(d, meta)
+	return resourceAlibabacloudStackKVStoreBackupPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackKVStoreBackupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKVStoreBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// In case of a delete we are resetting to default values which is Monday - Sunday each 3am-4am
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := r_kvstore.CreateModifyBackupPolicyRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "R-kvstore", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = d.Id()
 
 	request.PreferredBackupTime = "01:00Z-02:00Z"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackKVStoreRedisBackupPolicy_classic(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_backup_policy.default"
 	ra := resourceAttrInit(resourceId, kvStoreMap)
 	serviceFunc := func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &policy, serviceFunc, "DescribeKVstoreBackupPolicy")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -143,7 +144,7 @@ func TestAccAlibabacloudStackKVStoreRedisBackupPolicy_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_backup_policy.default"
 	ra := resourceAttrInit(resourceId, kvStoreMap)
 	serviceFunc := func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &policy, serviceFunc, "DescribeKVstoreBackupPolicy")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -206,7 +207,7 @@ func TestAccAlibabacloudStackKVStoreMemcacheBackupPolicy_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_backup_policy.default"
 	ra := resourceAttrInit(resourceId, kvStoreMap)
 	serviceFunc := func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &policy, serviceFunc, "DescribeKVstoreBackupPolicy")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -261,7 +262,7 @@ func TestAccAlibabacloudStackKVStoreMemcacheBackupPolicy_vpc(t *testing.T) {
 }
 func testAccCheckKVStoreBackupPolicyDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_kvstore_instance" {
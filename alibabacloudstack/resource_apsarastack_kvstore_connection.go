@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackKvstoreConnectionCreate,
-		Read:   resourceAlibabacloudStackKvstoreConnectionRead,
-		Update: resourceAlibabacloudStackKvstoreConnectionUpdate,
-		Delete: resourceAlibabacloudStackKvstoreConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackKvstoreConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackKvstoreConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(2 * time.Minute),
@@ -46,9 +47,9 @@ func resourceAlibabacloudStackKvstoreConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	r_kvstoreService := KvstoreService{client}
+	r_kvstoreService := KvstoreService{client, ctx}
 
 	request := r_kvstore.CreateAllocateInstancePublicConnectionRequest()
 	request.ConnectionStringPrefix = d.Get("connection_string_prefix").(string)
@@ -59,14 +60,13 @@ func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, me
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "AllocateInstancePublicConnection",
-		"Version":         "2015-01-01",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "R-kvstore",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "AllocateInstancePublicConnection",
+		"Version":       "2015-01-01",
 	}
 	raw, err := client.WithRkvClient(func(r_kvstoreClient *r_kvstore.Client) (interface{}, error) {
 		return r_kvstoreClient.AllocateInstancePublicConnection(request)
@@ -77,15 +77,15 @@ func resourceAlibabacloudStackKvstoreConnectionCreate(d *schema.ResourceData, me
 	addDebug(request.GetActionName(), raw)
 	d.SetId(fmt.Sprintf("%v", request.InstanceId))
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutCreate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackKvstoreConnectionUpdate(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	r_kvstoreService := KvstoreService{client}
+	r_kvstoreService := KvstoreService{client, ctx}
 	object, err := r_kvstoreService.DescribeKvstoreConnection(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -105,9 +105,9 @@ func resourceAlibabacloudStackKvstoreConnectionRead(d *schema.ResourceData, meta
 	}
 	return nil
 }
-func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	r_kvstoreService := KvstoreService{client}
+	r_kvstoreService := KvstoreService{client, ctx}
 	update := false
 	request := r_kvstore.CreateModifyDBInstanceConnectionStringRequest()
 	request.DBInstanceId = d.Id()
@@ -122,14 +122,13 @@ func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, me
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ModifyDBInstanceConnectionString",
-		"Version":         "2015-01-01",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "R-kvstore",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "ModifyDBInstanceConnectionString",
+		"Version":       "2015-01-01",
 	}
 	if !d.IsNewResource() && d.HasChange("port") {
 		update = true
@@ -144,29 +143,28 @@ func resourceAlibabacloudStackKvstoreConnectionUpdate(d *schema.ResourceData, me
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutUpdate), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 	}
-	return resourceAlibabacloudStackKvstoreConnectionRead(d, meta)
+	return resourceAlibabacloudStackKvstoreConnectionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackKvstoreConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	r_kvstoreService := KvstoreService{client}
+	r_kvstoreService := KvstoreService{client, ctx}
 	request := r_kvstore.CreateReleaseInstancePublicConnectionRequest()
 	request.InstanceId = d.Id()
 	request.Headers = map[string]string{
 		"RegionId": client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "R-kvstore",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "ReleaseInstancePublicConnection",
-		"Version":         "2015-01-01",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "R-kvstore",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "ReleaseInstancePublicConnection",
+		"Version":       "2015-01-01",
 	}
 	request.CurrentConnectionString = d.Get("connection_string").(string)
 	raw, err := client.WithRkvClient(func(r_kvstoreClient *r_kvstore.Client) (interface{}, error) {
@@ -180,7 +178,7 @@ func resourceAlibabacloudStackKvstoreConnectionDelete(d *schema.ResourceData, me
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"Normal"}, d.Timeout(schema.TimeoutDelete), 30*time.Second, r_kvstoreService.RdsKvstoreInstanceStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -112,7 +113,7 @@ func TestAccAlibabacloudStackKVStoreRedisInstance_classictest(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_instance.default"
 	ra := resourceAttrInit(resourceId, KVStoreInstanceCheckMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeKVstoreInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -181,7 +182,7 @@ func TestAccAlibabacloudStackKVStoreRedisInstance_vpctest(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_instance.default"
 	ra := resourceAttrInit(resourceId, KVStoreInstanceCheckMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeKVstoreInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -302,7 +303,7 @@ func TestAccAlibabacloudStackKVStoreRedisInstance_vpcmulti(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_instance.default.2"
 	ra := resourceAttrInit(resourceId, KVStoreInstanceCheckMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeKVstoreInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -333,7 +334,7 @@ func TestAccAlibabacloudStackKVStoreRedisInstance_classicmulti(t *testing.T) {
 	resourceId := "alibabacloudstack_kvstore_instance.default.2"
 	ra := resourceAttrInit(resourceId, KVStoreInstanceCheckMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &instance, func() interface{} {
-		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &KvstoreService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeKVstoreInstance")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -361,7 +362,7 @@ func TestAccAlibabacloudStackKVStoreRedisInstance_classicmulti(t *testing.T) {
 
 func testAccCheckKVStoreInstanceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	kvstoreService := KvstoreService{client}
+	kvstoreService := KvstoreService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_kvstore_instance" {
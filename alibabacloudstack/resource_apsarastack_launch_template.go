@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -18,14 +19,15 @@ import (
 
 func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLaunchTemplateCreate,
-		Read:   resourceAlibabacloudStackLaunchTemplateRead,
-		Update: resourceAlibabacloudStackLaunchTemplateUpdate,
-		Delete: resourceAlibabacloudStackLaunchTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLaunchTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLaunchTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -171,6 +173,7 @@ func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 
 			"resource_group_id": {
 				Type:     schema.TypeString,
@@ -269,7 +272,7 @@ func resourceAlibabacloudStackLaunchTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateLaunchTemplateRequest()
@@ -280,7 +283,7 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateName = d.Get("name").(string)
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
@@ -347,7 +350,7 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := d.Get("tags_all").(map[string]interface{})
 	var tags []ecs.CreateLaunchTemplateTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateTag{
@@ -368,13 +371,13 @@ func resourceAlibabacloudStackLaunchTemplateCreate(d *schema.ResourceData, meta
 
 	d.SetId(response.LaunchTemplateId)
 
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeLaunchTemplate(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -456,12 +459,14 @@ func resourceAlibabacloudStackLaunchTemplateRead(d *schema.ResourceData, meta in
 	for _, tag := range latestVersion.LaunchTemplateData.Tags.InstanceTag {
 		tags[tag.Key] = tag.Value
 	}
-	d.Set("tags", tags)
+	if err := setResourceTags(d, meta, tags); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	versions, err := getLaunchTemplateVersions(d.Id(), meta)
 	if err != nil {
 		return WrapError(err)
@@ -484,13 +489,13 @@ func resourceAlibabacloudStackLaunchTemplateUpdate(d *schema.ResourceData, meta
 
 }
 
-func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateDeleteLaunchTemplateRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -504,11 +509,11 @@ func resourceAlibabacloudStackLaunchTemplateDelete(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetAcceptFormat(), raw, request.RpcRequest, request)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	if err := ecsService.WaitForLaunchTemplate(d.Id(), Deleted, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackLaunchTemplateRead(d, meta)
+	return resourceAlibabacloudStackLaunchTemplateRead(ctx, d, meta)
 }
 
 func getLaunchTemplateVersions(id string, meta interface{}) ([]ecs.LaunchTemplateVersionSet, error) {
@@ -521,7 +526,7 @@ func getLaunchTemplateVersions(id string, meta interface{}) ([]ecs.LaunchTemplat
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.PageSize = requests.NewInteger(50)
 	request.LaunchTemplateId = id
 	raw, err := client.WithEcsClient(func(client *ecs.Client) (interface{}, error) {
@@ -549,7 +554,7 @@ func deleteLaunchTemplateVersion(id string, version int, meta interface{}) error
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = id
 	request.DeleteVersion = &[]string{strconv.FormatInt(int64(version), 10)}
 	raw, err := client.WithEcsClient(func(client *ecs.Client) (interface{}, error) {
@@ -572,7 +577,7 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LaunchTemplateId = d.Id()
 	request.Description = d.Get("description").(string)
 	request.HostName = d.Get("host_name").(string)
@@ -639,7 +644,7 @@ func createLaunchTemplateVersion(d *schema.ResourceData, meta interface{}) error
 
 		request.DataDisk = &disks
 	}
-	tagsRaw := d.Get("tags").(map[string]interface{})
+	tagsRaw := d.Get("tags_all").(map[string]interface{})
 	var tags []ecs.CreateLaunchTemplateVersionTag
 	for key, value := range tagsRaw {
 		tags = append(tags, ecs.CreateLaunchTemplateVersionTag{
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"log"
@@ -33,7 +34,7 @@ func testAlibabacloudStackLaunchTemplate(region string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeLaunchTemplates(request)
 	})
@@ -58,7 +59,7 @@ func testAlibabacloudStackLaunchTemplate(region string) error {
 			templateRequest.Scheme = "http"
 		}
 		templateRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		templateRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		templateRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		templateRequest.LaunchTemplateId = ids[i]
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteLaunchTemplate(templateRequest)
@@ -78,7 +79,7 @@ func TestAccAlibabacloudStackLaunchTemplateBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_launch_template.default"
 	ra := resourceAttrInit(resourceId, testAccLaunchTemplateCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -666,7 +667,7 @@ func TestAccAlibabacloudStackLaunchTemplateMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_launch_template.default.4"
 	ra := resourceAttrInit(resourceId, testAccLaunchTemplateCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -0,0 +1,369 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogAlertCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogAlertRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogAlertUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogAlertDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"alert_displayname": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"alert_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"condition": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"dashboard": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"mute_until": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"throttling": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"notify_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"schedule_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      sls.ScheduleTypeFixedRate,
+				ValidateFunc: validation.StringInSlice([]string{sls.ScheduleTypeFixedRate, sls.ScheduleTypeHourly, sls.ScheduleTypeDaily, sls.ScheduleTypeWeekly, sls.ScheduleTypeCron}, false),
+			},
+			"schedule_interval": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "60s",
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"query_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chart_title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"logstore": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"query": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"start": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "-60s",
+						},
+						"end": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "now",
+						},
+						"time_span_type": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "Custom",
+						},
+					},
+				},
+			},
+			"notification_list": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{sls.NotificationTypeSMS, sls.NotificationTypeEmail, sls.NotificationTypeDingTalk, sls.NotificationTypeWebhook, sls.NotificationTypeMessageCenter}, false),
+						},
+						"content": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"service_uri": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"mobile_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"email_list": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogAlertCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	projectName := d.Get("project_name").(string)
+	alert := buildLogAlert(d)
+
+	// The alert is shown on its dashboard, which has to exist before the alert is created. A dashboard created
+	// here is not deleted along with the alert, the ones to clean up are managed by alibabacloudstack_log_dashboard.
+	if err := logService.CreateLogDashboard(projectName, alert.Configuration.Dashboard); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_alert", "CreateDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+
+	var requestInfo *sls.Client
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateAlert(projectName, alert)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateAlert", raw, requestInfo, map[string]interface{}{
+				"project": projectName,
+				"alert":   alert,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_alert", "CreateAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, alert.Name))
+
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogAlert(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("alert_name", object.Name)
+	d.Set("alert_displayname", object.DisplayName)
+	d.Set("alert_description", object.Description)
+	d.Set("enabled", object.Status != "DISABLED")
+	if object.Schedule != nil {
+		d.Set("schedule_type", object.Schedule.Type)
+		d.Set("schedule_interval", object.Schedule.Interval)
+	}
+	if config := object.Configuration; config != nil {
+		d.Set("condition", config.Condition)
+		d.Set("dashboard", config.Dashboard)
+		d.Set("mute_until", int(config.MuteUntil))
+		d.Set("throttling", config.Throttling)
+		d.Set("notify_threshold", int(config.NotifyThreshold))
+
+		var queryList []map[string]interface{}
+		for _, query := range config.QueryList {
+			queryList = append(queryList, map[string]interface{}{
+				"chart_title":    query.ChartTitle,
+				"logstore":       query.LogStore,
+				"query":          query.Query,
+				"start":          query.Start,
+				"end":            query.End,
+				"time_span_type": query.TimeSpanType,
+			})
+		}
+		if err := d.Set("query_list", queryList); err != nil {
+			return WrapError(err)
+		}
+		var notificationList []map[string]interface{}
+		for _, notification := range config.NotificationList {
+			notificationList = append(notificationList, map[string]interface{}{
+				"type":        notification.Type,
+				"content":     notification.Content,
+				"service_uri": notification.ServiceUri,
+				"mobile_list": notification.MobileList,
+				"email_list":  notification.EmailList,
+			})
+		}
+		if err := d.Set("notification_list", notificationList); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceAlibabacloudStackLogAlertUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	alert := buildLogAlert(d)
+
+	var requestInfo *sls.Client
+	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		requestInfo = slsClient
+		return nil, slsClient.UpdateAlert(parts[0], alert)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+	if debugOn() {
+		addDebug("UpdateAlert", raw, requestInfo, map[string]interface{}{
+			"project": parts[0],
+			"alert":   alert,
+		})
+	}
+
+	return resourceAlibabacloudStackLogAlertRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogAlertDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteAlert(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteAlert", raw, requestInfo, map[string]interface{}{
+				"project":    parts[0],
+				"alert_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "JobNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteAlert", AlibabacloudStackLogGoSdkERROR)
+	}
+	return WrapError(logService.WaitForLogstoreAlert(d.Id(), Deleted, DefaultTimeout))
+}
+
+func buildLogAlert(d *schema.ResourceData) *sls.Alert {
+	var queryList []*sls.AlertQuery
+	for _, v := range d.Get("query_list").([]interface{}) {
+		query := v.(map[string]interface{})
+		queryList = append(queryList, &sls.AlertQuery{
+			ChartTitle:   query["chart_title"].(string),
+			LogStore:     query["logstore"].(string),
+			Query:        query["query"].(string),
+			Start:        query["start"].(string),
+			End:          query["end"].(string),
+			TimeSpanType: query["time_span_type"].(string),
+		})
+	}
+	var notificationList []*sls.Notification
+	for _, v := range d.Get("notification_list").([]interface{}) {
+		notification := v.(map[string]interface{})
+		notificationList = append(notificationList, &sls.Notification{
+			Type:       notification["type"].(string),
+			Content:    notification["content"].(string),
+			ServiceUri: notification["service_uri"].(string),
+			MobileList: expandStringList(notification["mobile_list"].([]interface{})),
+			EmailList:  expandStringList(notification["email_list"].([]interface{})),
+		})
+	}
+	status := "ENABLED"
+	if !d.Get("enabled").(bool) {
+		status = "DISABLED"
+	}
+
+	return &sls.Alert{
+		Name:        d.Get("alert_name").(string),
+		DisplayName: d.Get("alert_displayname").(string),
+		Description: d.Get("alert_description").(string),
+		State:       "Enabled",
+		Status:      status,
+		Configuration: &sls.AlertConfiguration{
+			Condition:        d.Get("condition").(string),
+			Dashboard:        d.Get("dashboard").(string),
+			QueryList:        queryList,
+			MuteUntil:        int64(d.Get("mute_until").(int)),
+			NotificationList: notificationList,
+			NotifyThreshold:  int32(d.Get("notify_threshold").(int)),
+			Throttling:       d.Get("throttling").(string),
+		},
+		Schedule: &sls.Schedule{
+			Type:     d.Get("schedule_type").(string),
+			Interval: d.Get("schedule_interval").(string),
+		},
+	}
+}
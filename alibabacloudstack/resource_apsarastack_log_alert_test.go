@@ -0,0 +1,166 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUnitAlibabacloudStackLogAlert_build(t *testing.T) {
+	d := newMockApiResourceData(t, resourceAlibabacloudStackLogAlert(), map[string]interface{}{
+		"project_name":      "tf-mock-project",
+		"alert_name":        "tf-mock-alert",
+		"alert_displayname": "tf mock alert",
+		"condition":         "count > 100",
+		"dashboard":         "tf-mock-dashboard",
+		"enabled":           false,
+		"query_list": []interface{}{
+			map[string]interface{}{
+				"chart_title": "chart",
+				"logstore":    "tf-mock-store",
+				"query":       "* | select count(1) as count",
+			},
+		},
+		"notification_list": []interface{}{
+			map[string]interface{}{
+				"type":        "Email",
+				"content":     "alert",
+				"email_list":  []interface{}{"admin@example.com"},
+				"mobile_list": []interface{}{},
+			},
+		},
+	})
+
+	alert := buildLogAlert(d)
+	if alert.Name != "tf-mock-alert" || alert.Status != "DISABLED" {
+		t.Errorf("expected a disabled alert named tf-mock-alert, got %#v", alert)
+	}
+	if alert.Schedule.Type != sls.ScheduleTypeFixedRate || alert.Schedule.Interval != "60s" {
+		t.Errorf("expected the default schedule, got %#v", alert.Schedule)
+	}
+	config := alert.Configuration
+	if config.Dashboard != "tf-mock-dashboard" || config.NotifyThreshold != 1 || config.Throttling != "60s" {
+		t.Errorf("expected the alert configuration to be built, got %#v", config)
+	}
+	if len(config.QueryList) != 1 || config.QueryList[0].Start != "-60s" || config.QueryList[0].End != "now" || config.QueryList[0].TimeSpanType != "Custom" {
+		t.Errorf("expected the query defaults to be applied, got %#v", config.QueryList)
+	}
+	if len(config.NotificationList) != 1 || len(config.NotificationList[0].EmailList) != 1 || config.NotificationList[0].EmailList[0] != "admin@example.com" {
+		t.Errorf("expected the email notification to be built, got %#v", config.NotificationList)
+	}
+}
+
+func TestAccAlibabacloudStackLogAlert_basic(t *testing.T) {
+	var v *sls.Alert
+	resourceId := "alibabacloudstack_log_alert.default"
+	ra := resourceAttrInit(resourceId, logAlertMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogalert-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogAlertConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name":      "${alibabacloudstack_log_project.default.name}",
+					"alert_name":        name,
+					"alert_displayname": name,
+					"condition":         "count > 100",
+					"dashboard":         "tf-dashboard",
+					"query_list": []map[string]interface{}{
+						{
+							"chart_title": "chart_title",
+							"logstore":    "${alibabacloudstack_log_store.default.name}",
+							"query":       "* AND aliyun | select count(1) as count",
+						},
+					},
+					"notification_list": []map[string]interface{}{
+						{
+							"type":       "Email",
+							"content":    "alert content",
+							"email_list": []string{"test@example.com"},
+						},
+					},
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"alert_name":          name,
+						"alert_displayname":   name,
+						"query_list.#":        "1",
+						"notification_list.#": "1",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"alert_displayname": name + "-update",
+					"condition":         "count > 1000",
+					"throttling":        "120s",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"alert_displayname": name + "-update",
+						"condition":         "count > 1000",
+						"throttling":        "120s",
+					}),
+				),
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"enabled": "false",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"enabled": "false",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogAlertConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	}
+	`, name)
+}
+
+var logAlertMap = map[string]string{
+	"project_name":      CHECKSET,
+	"dashboard":         "tf-dashboard",
+	"condition":         "count > 100",
+	"schedule_type":     "FixedRate",
+	"schedule_interval": "60s",
+}
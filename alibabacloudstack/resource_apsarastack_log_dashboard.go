@@ -0,0 +1,197 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogDashboard() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogDashboardCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogDashboardRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogDashboardUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogDashboardDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"dashboard_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"char_list": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					jsonString, _ := normalizeJsonString(v)
+					return jsonString
+				},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogDashboardCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	projectName := d.Get("project_name").(string)
+	dashboardName := d.Get("dashboard_name").(string)
+	dashboard, err := buildLogDashboardString(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateDashboardString(projectName, dashboard)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateDashboard", raw, requestInfo, map[string]interface{}{
+				"project":   projectName,
+				"dashboard": dashboard,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_dashboard", "CreateDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, dashboardName))
+
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogDashboard(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	charList, err := json.Marshal(object.ChartList)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("dashboard_name", object.DashboardName)
+	d.Set("display_name", object.DisplayName)
+	d.Set("char_list", string(charList))
+	return nil
+}
+
+func resourceAlibabacloudStackLogDashboardUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	dashboard, err := buildLogDashboardString(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		requestInfo = slsClient
+		return nil, slsClient.UpdateDashboardString(parts[0], parts[1], dashboard)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+	if debugOn() {
+		addDebug("UpdateDashboard", raw, requestInfo, map[string]interface{}{
+			"project":   parts[0],
+			"dashboard": dashboard,
+		})
+	}
+
+	return resourceAlibabacloudStackLogDashboardRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogDashboardDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteDashboard(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteDashboard", raw, requestInfo, map[string]interface{}{
+				"project":        parts[0],
+				"dashboard_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "DashboardNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteDashboard", AlibabacloudStackLogGoSdkERROR)
+	}
+	return WrapError(logService.WaitForLogDashboard(d.Id(), Deleted, DefaultTimeout))
+}
+
+// buildLogDashboardString renders the dashboard body, keeping char_list as the raw chart json
+// so that chart attributes unknown to the sdk are sent as they are written.
+func buildLogDashboardString(d *schema.ResourceData) (string, error) {
+	dashboard := map[string]interface{}{
+		"dashboardName": d.Get("dashboard_name").(string),
+		"displayName":   d.Get("display_name").(string),
+		"charts":        json.RawMessage(d.Get("char_list").(string)),
+	}
+	body, err := json.Marshal(dashboard)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,115 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUnitAlibabacloudStackLogDashboard_build(t *testing.T) {
+	d := newMockApiResourceData(t, resourceAlibabacloudStackLogDashboard(), map[string]interface{}{
+		"project_name":   "tf-mock-project",
+		"dashboard_name": "tf-mock-dashboard",
+		"display_name":   "tf mock dashboard",
+		"char_list":      `[{"title":"chart","type":"map","search":{"logstore":"tf-mock-store"},"display":{"xAxis":["ip"]},"action":{}}]`,
+	})
+
+	body, err := buildLogDashboardString(d)
+	if err != nil {
+		t.Fatalf("building the dashboard got an error: %v", err)
+	}
+	var dashboard map[string]interface{}
+	if err := json.Unmarshal([]byte(body), &dashboard); err != nil {
+		t.Fatalf("expected the dashboard to be a json object, got %q", body)
+	}
+	if dashboard["dashboardName"] != "tf-mock-dashboard" || dashboard["displayName"] != "tf mock dashboard" {
+		t.Errorf("expected the dashboard names to be sent, got %v", dashboard)
+	}
+	charts, ok := dashboard["charts"].([]interface{})
+	if !ok || len(charts) != 1 {
+		t.Fatalf("expected a single chart, got %v", dashboard["charts"])
+	}
+	if _, ok := charts[0].(map[string]interface{})["action"]; !ok {
+		t.Errorf("expected the chart attributes to be sent as they are written, got %v", charts[0])
+	}
+}
+
+func TestAccAlibabacloudStackLogDashboard_basic(t *testing.T) {
+	var v *sls.Dashboard
+	resourceId := "alibabacloudstack_log_dashboard.default"
+	ra := resourceAttrInit(resourceId, logDashboardMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogdashboard-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogDashboardConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name":   "${alibabacloudstack_log_project.default.name}",
+					"dashboard_name": name,
+					"char_list":      `[{\"title\":\"new_title\",\"type\":\"map\",\"search\":{\"logstore\":\"${alibabacloudstack_log_store.default.name}\",\"topic\":\"new_topic\",\"query\":\"* | SELECT COUNT(name) as ct_name, COUNT(product) as ct_product, name,product GROUP BY name,product\",\"start\":\"-86400s\",\"end\":\"now\"},\"display\":{\"xAxis\":[\"ct_name\"],\"yAxis\":[\"ct_product\"],\"xPos\":0,\"yPos\":0,\"width\":10,\"height\":12,\"displayName\":\"xixihaha911\"}}]`,
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"dashboard_name": name,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"display_name": name + "-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"display_name": name + "-update",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogDashboardConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	}
+	`, name)
+}
+
+var logDashboardMap = map[string]string{
+	"project_name": CHECKSET,
+	"char_list":    CHECKSET,
+}
@@ -0,0 +1,297 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogEtl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogEtlCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogEtlRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogEtlUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogEtlDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"etl_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"logstore": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"trigger_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(3, 600),
+			},
+			"max_retry_time": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      3,
+				ValidateFunc: validation.IntBetween(0, 100),
+			},
+			"function_provider": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "FunctionCompute",
+			},
+			"function_endpoint": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"service_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"function_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"function_parameter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					jsonString, _ := normalizeJsonString(v)
+					return jsonString
+				},
+			},
+			"log_project": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_logstore": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"log_endpoint": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"enable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogEtlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	projectName := d.Get("project").(string)
+	job, err := buildLogEtlJob(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, newLogProject(slsClient, projectName).CreateETLJob(job)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateETLJob", raw, requestInfo, map[string]interface{}{
+				"project": projectName,
+				"job":     job,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_etl", "CreateETLJob", AlibabacloudStackLogGoSdkERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, job.JobName))
+
+	return resourceAlibabacloudStackLogEtlRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogEtlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogEtl(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project", parts[0])
+	d.Set("etl_name", object.JobName)
+	d.Set("enable", object.Enable)
+	if object.SourceConfig != nil {
+		d.Set("logstore", object.SourceConfig.LogstoreName)
+	}
+	if object.TriggerConfig != nil {
+		d.Set("role_arn", object.TriggerConfig.RoleARN)
+		d.Set("trigger_interval", object.TriggerConfig.TriggerInterval)
+		d.Set("max_retry_time", object.TriggerConfig.MaxRetryTime)
+	}
+	if object.FunctionConfig != nil {
+		d.Set("function_provider", object.FunctionConfig.FunctionProvider)
+		d.Set("function_endpoint", object.FunctionConfig.Endpoint)
+		d.Set("account_id", object.FunctionConfig.AccountID)
+		d.Set("region_name", object.FunctionConfig.RegionName)
+		d.Set("service_name", object.FunctionConfig.ServiceName)
+		d.Set("function_name", object.FunctionConfig.FunctionName)
+	}
+	if object.LogConfig != nil {
+		d.Set("log_project", object.LogConfig.ProjectName)
+		d.Set("log_logstore", object.LogConfig.LogstoreName)
+		d.Set("log_endpoint", object.LogConfig.Endpoint)
+	}
+	if object.FunctionParameter != nil {
+		parameter, err := json.Marshal(object.FunctionParameter)
+		if err != nil {
+			return WrapError(err)
+		}
+		d.Set("function_parameter", string(parameter))
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackLogEtlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	job, err := buildLogEtlJob(d)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		requestInfo = slsClient
+		return nil, newLogProject(slsClient, parts[0]).UpdateETLJob(parts[1], job)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateETLJob", AlibabacloudStackLogGoSdkERROR)
+	}
+	if debugOn() {
+		addDebug("UpdateETLJob", raw, requestInfo, map[string]interface{}{
+			"project": parts[0],
+			"job":     job,
+		})
+	}
+
+	return resourceAlibabacloudStackLogEtlRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogEtlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, newLogProject(slsClient, parts[0]).DeleteETLJob(parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteETLJob", raw, requestInfo, map[string]interface{}{
+				"project":  parts[0],
+				"etl_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "JobNotExist", "ETLJobNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteETLJob", AlibabacloudStackLogGoSdkERROR)
+	}
+	return nil
+}
+
+func buildLogEtlJob(d *schema.ResourceData) (*sls.ETLJob, error) {
+	job := &sls.ETLJob{
+		JobName: d.Get("etl_name").(string),
+		SourceConfig: &sls.SourceConfig{
+			LogstoreName: d.Get("logstore").(string),
+		},
+		TriggerConfig: &sls.TriggerConfig{
+			MaxRetryTime:    d.Get("max_retry_time").(int),
+			TriggerInterval: d.Get("trigger_interval").(int),
+			RoleARN:         d.Get("role_arn").(string),
+		},
+		FunctionConfig: &sls.FunctionConfig{
+			FunctionProvider: d.Get("function_provider").(string),
+			Endpoint:         d.Get("function_endpoint").(string),
+			AccountID:        d.Get("account_id").(string),
+			RegionName:       d.Get("region_name").(string),
+			ServiceName:      d.Get("service_name").(string),
+			FunctionName:     d.Get("function_name").(string),
+		},
+		LogConfig: &sls.JobLogConfig{
+			Endpoint:     d.Get("log_endpoint").(string),
+			ProjectName:  d.Get("log_project").(string),
+			LogstoreName: d.Get("log_logstore").(string),
+		},
+		Enable: d.Get("enable").(bool),
+	}
+	if v, ok := d.GetOk("function_parameter"); ok {
+		var parameter map[string]interface{}
+		if err := json.Unmarshal([]byte(v.(string)), &parameter); err != nil {
+			return nil, err
+		}
+		job.FunctionParameter = parameter
+	}
+	return job, nil
+}
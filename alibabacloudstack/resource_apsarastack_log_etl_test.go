@@ -0,0 +1,42 @@
+package alibabacloudstack
+
+import (
+	"testing"
+)
+
+func TestUnitAlibabacloudStackLogEtl_build(t *testing.T) {
+	d := newMockApiResourceData(t, resourceAlibabacloudStackLogEtl(), map[string]interface{}{
+		"project":            "tf-mock-project",
+		"etl_name":           "tf-mock-etl",
+		"logstore":           "tf-mock-store",
+		"function_endpoint":  "http://fc.example.com",
+		"account_id":         "1234567890",
+		"region_name":        "cn-qingdao-env66-d01",
+		"service_name":       "tf-mock-service",
+		"function_name":      "tf-mock-function",
+		"function_parameter": `{"source":"sls","days":7}`,
+		"log_project":        "tf-mock-project",
+		"log_logstore":       "tf-mock-etl-log",
+	})
+
+	job, err := buildLogEtlJob(d)
+	if err != nil {
+		t.Fatalf("building the etl job got an error: %v", err)
+	}
+	if job.JobName != "tf-mock-etl" || job.SourceConfig.LogstoreName != "tf-mock-store" || !job.Enable {
+		t.Errorf("expected an enabled etl job reading tf-mock-store, got %#v", job)
+	}
+	if job.TriggerConfig.TriggerInterval != 60 || job.TriggerConfig.MaxRetryTime != 3 {
+		t.Errorf("expected the trigger defaults to be applied, got %#v", job.TriggerConfig)
+	}
+	if job.FunctionConfig.FunctionProvider != "FunctionCompute" || job.FunctionConfig.FunctionName != "tf-mock-function" {
+		t.Errorf("expected the function to be built, got %#v", job.FunctionConfig)
+	}
+	if job.LogConfig.LogstoreName != "tf-mock-etl-log" {
+		t.Errorf("expected the job log logstore to be built, got %#v", job.LogConfig)
+	}
+	parameter, ok := job.FunctionParameter.(map[string]interface{})
+	if !ok || parameter["source"] != "sls" || parameter["days"] != float64(7) {
+		t.Errorf("expected the function parameter to be decoded, got %#v", job.FunctionParameter)
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -15,7 +16,7 @@ func TestAccAlibabacloudStackLogMachineGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_log_machine_group.default"
 	ra := resourceAttrInit(resourceId, logMachineGroupMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -95,7 +96,7 @@ func TestAccAlibabacloudStackLogMachineGroup_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_log_machine_group.default.4"
 	ra := resourceAttrInit(resourceId, logMachineGroupMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
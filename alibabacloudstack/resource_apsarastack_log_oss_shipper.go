@@ -0,0 +1,266 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackLogOssShipper() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogOssShipperCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogOssShipperRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogOssShipperUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogOssShipperDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"logstore_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"shipper_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"oss_bucket": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oss_prefix": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_arn": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"buffer_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      300,
+				ValidateFunc: validation.IntBetween(300, 900),
+			},
+			"buffer_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				ValidateFunc: validation.IntBetween(5, 256),
+			},
+			"compress_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "none",
+				ValidateFunc: validation.StringInSlice([]string{"none", "snappy"}, false),
+			},
+			"path_format": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "%Y/%m/%d/%H/%M",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "json",
+				ValidateFunc: validation.StringInSlice([]string{"json"}, false),
+			},
+			"json_enable_tag": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogOssShipperCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	projectName := d.Get("project_name").(string)
+	logstoreName := d.Get("logstore_name").(string)
+	store, err := logService.DescribeLogStore(projectName + COLON_SEPARATED + logstoreName)
+	if err != nil {
+		return WrapError(err)
+	}
+	shipper := buildLogOssShipper(d)
+
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			return nil, store.CreateShipper(shipper)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateShipper", raw, map[string]interface{}{
+				"project":  projectName,
+				"logstore": logstoreName,
+				"shipper":  shipper,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_oss_shipper", "CreateShipper", AlibabacloudStackLogGoSdkERROR)
+	}
+	d.SetId(strings.Join([]string{projectName, logstoreName, shipper.ShipperName}, COLON_SEPARATED))
+
+	return resourceAlibabacloudStackLogOssShipperRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogOssShipperRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogOssShipper(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("logstore_name", parts[1])
+	d.Set("shipper_name", object.ShipperName)
+	config, ok := object.TargetConfiguration.(*sls.OSSShipperConfig)
+	if !ok {
+		return WrapError(fmt.Errorf("the shipper %s does not ship to oss", d.Id()))
+	}
+	d.Set("oss_bucket", config.OssBucket)
+	d.Set("oss_prefix", config.OssPrefix)
+	d.Set("role_arn", config.RoleArn)
+	d.Set("buffer_interval", config.BufferInterval)
+	d.Set("buffer_size", config.BufferSize)
+	d.Set("compress_type", config.CompressType)
+	d.Set("path_format", config.PathFormat)
+	if config.Storage.Format != "" {
+		d.Set("format", config.Storage.Format)
+	}
+	if detail, ok := config.Storage.Detail.(map[string]interface{}); ok {
+		if enableTag, ok := detail["enableTag"].(bool); ok {
+			d.Set("json_enable_tag", enableTag)
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackLogOssShipperUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	store, err := logService.DescribeLogStore(parts[0] + COLON_SEPARATED + parts[1])
+	if err != nil {
+		return WrapError(err)
+	}
+	shipper := buildLogOssShipper(d)
+
+	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		return nil, store.UpdateShipper(shipper)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateShipper", AlibabacloudStackLogGoSdkERROR)
+	}
+	if debugOn() {
+		addDebug("UpdateShipper", raw, map[string]interface{}{
+			"project":  parts[0],
+			"logstore": parts[1],
+			"shipper":  shipper,
+		})
+	}
+
+	return resourceAlibabacloudStackLogOssShipperRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogOssShipperDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return WrapError(err)
+	}
+	store, err := logService.DescribeLogStore(parts[0] + COLON_SEPARATED + parts[1])
+	if err != nil {
+		if NotFoundError(err) {
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			return nil, store.DeleteShipper(parts[2])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteShipper", raw, map[string]interface{}{
+				"project":      parts[0],
+				"logstore":     parts[1],
+				"shipper_name": parts[2],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "LogStoreNotExist", sls.SHIPPER_NOT_EXIST}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteShipper", AlibabacloudStackLogGoSdkERROR)
+	}
+	return nil
+}
+
+func buildLogOssShipper(d *schema.ResourceData) *sls.Shipper {
+	format := d.Get("format").(string)
+	return &sls.Shipper{
+		ShipperName: d.Get("shipper_name").(string),
+		TargetType:  sls.OSSShipperType,
+		TargetConfiguration: &sls.OSSShipperConfig{
+			OssBucket:      d.Get("oss_bucket").(string),
+			OssPrefix:      d.Get("oss_prefix").(string),
+			RoleArn:        d.Get("role_arn").(string),
+			BufferInterval: d.Get("buffer_interval").(int),
+			BufferSize:     d.Get("buffer_size").(int),
+			CompressType:   d.Get("compress_type").(string),
+			PathFormat:     d.Get("path_format").(string),
+			Format:         format,
+			Storage: sls.ShipperStorage{
+				Format: format,
+				Detail: sls.OssStorageJsonDetail{
+					EnableTag: d.Get("json_enable_tag").(bool),
+				},
+			},
+		},
+	}
+}
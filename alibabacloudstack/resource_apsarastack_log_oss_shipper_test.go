@@ -0,0 +1,133 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestUnitAlibabacloudStackLogOssShipper_build(t *testing.T) {
+	d := newMockApiResourceData(t, resourceAlibabacloudStackLogOssShipper(), map[string]interface{}{
+		"project_name":    "tf-mock-project",
+		"logstore_name":   "tf-mock-store",
+		"shipper_name":    "tf-mock-shipper",
+		"oss_bucket":      "tf-mock-bucket",
+		"oss_prefix":      "logs",
+		"compress_type":   "snappy",
+		"json_enable_tag": true,
+	})
+
+	shipper := buildLogOssShipper(d)
+	if shipper.ShipperName != "tf-mock-shipper" || shipper.TargetType != sls.OSSShipperType {
+		t.Errorf("expected an oss shipper named tf-mock-shipper, got %#v", shipper)
+	}
+	config, ok := shipper.TargetConfiguration.(*sls.OSSShipperConfig)
+	if !ok {
+		t.Fatalf("expected an oss shipper configuration, got %#v", shipper.TargetConfiguration)
+	}
+	if config.OssBucket != "tf-mock-bucket" || config.OssPrefix != "logs" || config.CompressType != "snappy" {
+		t.Errorf("expected the oss target to be built, got %#v", config)
+	}
+	if config.BufferInterval != 300 || config.BufferSize != 256 || config.PathFormat != "%Y/%m/%d/%H/%M" {
+		t.Errorf("expected the buffer defaults to be applied, got %#v", config)
+	}
+	detail, ok := config.Storage.Detail.(sls.OssStorageJsonDetail)
+	if config.Storage.Format != "json" || !ok || !detail.EnableTag {
+		t.Errorf("expected the json storage to enable tags, got %#v", config.Storage)
+	}
+}
+
+func TestAccAlibabacloudStackLogOssShipper_basic(t *testing.T) {
+	var v *sls.Shipper
+	resourceId := "alibabacloudstack_log_oss_shipper.default"
+	ra := resourceAttrInit(resourceId, logOssShipperMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogossshipper-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogOssShipperConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name":  "${alibabacloudstack_log_project.default.name}",
+					"logstore_name": "${alibabacloudstack_log_store.default.name}",
+					"shipper_name":  name,
+					"oss_bucket":    "${alibabacloudstack_oss_bucket.default.bucket}",
+					"oss_prefix":    "root",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"shipper_name": name,
+						"oss_prefix":   "root",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"buffer_interval": "400",
+					"compress_type":   "snappy",
+					"json_enable_tag": "true",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"buffer_interval": "400",
+						"compress_type":   "snappy",
+						"json_enable_tag": "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogOssShipperConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	}
+	resource "alibabacloudstack_oss_bucket" "default" {
+	    bucket = "${var.name}"
+	}
+	`, name)
+}
+
+var logOssShipperMap = map[string]string{
+	"project_name":    CHECKSET,
+	"logstore_name":   CHECKSET,
+	"oss_bucket":      CHECKSET,
+	"buffer_interval": "300",
+	"buffer_size":     "256",
+	"compress_type":   "none",
+	"path_format":     "%Y/%m/%d/%H/%M",
+	"format":          "json",
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strings"
@@ -11,18 +12,21 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackLogProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogProjectCreate,
-		Read:   resourceAlibabacloudStackLogProjectRead,
-		Update: resourceAlibabacloudStackLogProjectUpdate,
-		Delete: resourceAlibabacloudStackLogProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -33,13 +37,24 @@ func resourceAlibabacloudStackLogProject() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
+			"policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					policy, _ := normalizeJsonString(v)
+					return policy
+				},
+			},
 		},
 	}
 }
 
-func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	name := d.Get("name").(string)
 	request := requests.NewCommonRequest()
 	request.Method = "POST"
@@ -54,16 +69,15 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 	request.ApiName = "CreateProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "SLS",
-		"RegionId":        client.RegionId,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Action":          "CreateProject",
-		"Version":         "2020-03-31",
-		"projectName":     name,
-		"Description":     d.Get("description").(string),
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "SLS",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Action":        "CreateProject",
+		"Version":       "2020-03-31",
+		"projectName":   name,
+		"Description":   d.Get("description").(string),
 	}
 	raw, err := client.WithEcsClient(func(alidnsClient *ecs.Client) (interface{}, error) {
 		return alidnsClient.ProcessCommonRequest(request)
@@ -73,7 +87,7 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 	}
 	addDebug("LogProject", raw)
 
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		object, err := logService.DescribeLogProject(name)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -83,14 +97,17 @@ func resourceAlibabacloudStackLogProjectCreate(d *schema.ResourceData, meta inte
 		}
 		return resource.RetryableError(Error("Failed to describe log project"))
 	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_project", "GetProject", AlibabacloudStackLogGoSdkERROR)
+	}
 	d.SetId(name)
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	return resourceAlibabacloudStackLogProjectUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	object, err := logService.DescribeLogProject(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -102,15 +119,29 @@ func resourceAlibabacloudStackLogProjectRead(d *schema.ResourceData, meta interf
 	d.Set("name", object.ProjectName)
 	d.Set("description", object.Description)
 
+	tags, err := logService.DescribeLogProjectTags(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	if err := setResourceTags(d, meta, tags); err != nil {
+		return WrapError(err)
+	}
+	policy, err := logService.DescribeLogProjectPolicy(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Set("policy", policy)
+
 	return nil
 }
 
-func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
 	var requestInfo *sls.Client
 
 	name := d.Id()
-	if d.HasChange("description") {
+	if !d.IsNewResource() && d.HasChange("description") {
 		request := requests.NewCommonRequest()
 		request.Method = "POST"
 		request.Product = "SLS"
@@ -124,7 +155,6 @@ func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta inte
 		request.ApiName = "UpdateProject"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
 			"AccessKeyId":     client.AccessKey,
 			"Product":         "SLS",
 			"RegionId":        client.RegionId,
@@ -147,10 +177,52 @@ func resourceAlibabacloudStackLogProjectUpdate(d *schema.ResourceData, meta inte
 		addDebug("UpdateProject", raw, requestInfo, request)
 	}
 
-	return resourceAlibabacloudStackLogProjectRead(d, meta)
+	if d.HasChange("tags_all") {
+		added, removed := parsingTags(d)
+		removedKeys := make([]string, 0)
+		for _, key := range removed {
+			if !ignoredTags(key, "") {
+				removedKeys = append(removedKeys, key)
+			}
+		}
+		if len(removedKeys) > 0 {
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				return nil, slsClient.UnTagResources(name, sls.NewProjectUnTags(name, removedKeys))
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UnTagResources", AlibabacloudStackLogGoSdkERROR)
+			}
+			addDebug("UnTagResources", raw, removedKeys)
+		}
+		if len(added) > 0 {
+			tags := make([]sls.ResourceTag, 0, len(added))
+			for key, value := range added {
+				tags = append(tags, sls.ResourceTag{Key: key, Value: value.(string)})
+			}
+			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+				return nil, slsClient.TagResources(name, sls.NewProjectTags(name, tags))
+			})
+			if err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), "TagResources", AlibabacloudStackLogGoSdkERROR)
+			}
+			addDebug("TagResources", raw, tags)
+		}
+	}
+
+	if d.HasChange("policy") {
+		action, params := "UpdateProjectPolicy", map[string]string{"projectName": name, "policy": d.Get("policy").(string)}
+		if params["policy"] == "" {
+			action, params = "DeleteProjectPolicy", map[string]string{"projectName": name}
+		}
+		if _, err := logService.doLogProjectRequest(action, params); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackLogGoSdkERROR)
+		}
+	}
+
+	return resourceAlibabacloudStackLogProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *sls.Client
 	name := d.Get("name").(string)
@@ -167,7 +239,6 @@ func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta inte
 	request.ApiName = "DeleteProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
 		"AccessKeyId":     client.AccessKey,
 		"Product":         "SLS",
 		"Department":      client.Department,
@@ -179,7 +250,7 @@ func resourceAlibabacloudStackLogProjectDelete(d *schema.ResourceData, meta inte
 		"Version":         "2020-03-31",
 		"ProjectName":     name,
 	}
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -42,14 +43,13 @@ func testSweepLogProjects(region string) error {
 	request.ApiName = "ListProject"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "SLS",
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"RegionId":        client.RegionId,
-		"Action":          "ListProject",
-		"Version":         "2020-03-31",
+		"AccessKeyId":   client.AccessKey,
+		"Product":       "SLS",
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"RegionId":      client.RegionId,
+		"Action":        "ListProject",
+		"Version":       "2020-03-31",
 	}
 
 	raw, err := client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
@@ -83,7 +83,6 @@ func testSweepLogProjects(region string) error {
 		request.ApiName = "DeleteProject"
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": client.SecretKey,
 			"AccessKeyId":     client.AccessKey,
 			"Product":         "SLS",
 			"Department":      client.Department,
@@ -110,7 +109,7 @@ func TestAccAlibabacloudStackLogProject_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_log_project.default"
 	ra := resourceAttrInit(resourceId, logProjectMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -160,7 +159,7 @@ func TestAccAlibabacloudStackLogProject_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_log_project.default.2"
 	ra := resourceAttrInit(resourceId, logProjectMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
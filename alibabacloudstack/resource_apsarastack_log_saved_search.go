@@ -0,0 +1,188 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackLogSavedSearch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogSavedSearchCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogSavedSearchRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogSavedSearchUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogSavedSearchDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"project_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"search_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"search_query": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"logstore": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"topic": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackLogSavedSearchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	projectName := d.Get("project_name").(string)
+	savedSearch := buildLogSavedSearch(d)
+
+	var requestInfo *sls.Client
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.CreateSavedSearch(projectName, savedSearch)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("CreateSavedSearch", raw, requestInfo, map[string]interface{}{
+				"project":      projectName,
+				"saved_search": savedSearch,
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_saved_search", "CreateSavedSearch", AlibabacloudStackLogGoSdkERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", projectName, COLON_SEPARATED, savedSearch.SavedSearchName))
+
+	return resourceAlibabacloudStackLogSavedSearchRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogSavedSearchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	logService := LogService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	object, err := logService.DescribeLogSavedSearch(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("project_name", parts[0])
+	d.Set("search_name", object.SavedSearchName)
+	d.Set("search_query", object.SearchQuery)
+	d.Set("logstore", object.Logstore)
+	d.Set("topic", object.Topic)
+	d.Set("display_name", object.DisplayName)
+	return nil
+}
+
+func resourceAlibabacloudStackLogSavedSearchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	savedSearch := buildLogSavedSearch(d)
+
+	var requestInfo *sls.Client
+	raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+		requestInfo = slsClient
+		return nil, slsClient.UpdateSavedSearch(parts[0], savedSearch)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "UpdateSavedSearch", AlibabacloudStackLogGoSdkERROR)
+	}
+	if debugOn() {
+		addDebug("UpdateSavedSearch", raw, requestInfo, map[string]interface{}{
+			"project":      parts[0],
+			"saved_search": savedSearch,
+		})
+	}
+
+	return resourceAlibabacloudStackLogSavedSearchRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackLogSavedSearchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	var requestInfo *sls.Client
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
+		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return nil, slsClient.DeleteSavedSearch(parts[0], parts[1])
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("DeleteSavedSearch", raw, requestInfo, map[string]interface{}{
+				"project":     parts[0],
+				"search_name": parts[1],
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "SavedSearchNotExist"}) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteSavedSearch", AlibabacloudStackLogGoSdkERROR)
+	}
+	return nil
+}
+
+func buildLogSavedSearch(d *schema.ResourceData) *sls.SavedSearch {
+	displayName := d.Get("display_name").(string)
+	if displayName == "" {
+		displayName = d.Get("search_name").(string)
+	}
+	return &sls.SavedSearch{
+		SavedSearchName: d.Get("search_name").(string),
+		SearchQuery:     d.Get("search_query").(string),
+		Logstore:        d.Get("logstore").(string),
+		Topic:           d.Get("topic").(string),
+		DisplayName:     displayName,
+	}
+}
@@ -0,0 +1,93 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sls "github.com/aliyun/aliyun-log-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccAlibabacloudStackLogSavedSearch_basic(t *testing.T) {
+	var v *sls.SavedSearch
+	resourceId := "alibabacloudstack_log_saved_search.default"
+	ra := resourceAttrInit(resourceId, logSavedSearchMap)
+	serviceFunc := func() interface{} {
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
+	}
+	rc := resourceCheckInit(resourceId, &v, serviceFunc)
+	rac := resourceAttrCheckInit(rc, ra)
+	testAccCheck := rac.resourceAttrMapUpdateSet()
+	rand := acctest.RandIntRange(1000000, 9999999)
+	name := fmt.Sprintf("tf-testacclogsavedsearch-%d", rand)
+	testAccConfig := resourceTestAccConfigFunc(resourceId, name, resourceLogSavedSearchConfigDependence)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		IDRefreshName: resourceId,
+		Providers:     testAccProviders,
+		CheckDestroy:  rac.checkResourceDestroy(),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"project_name": "${alibabacloudstack_log_project.default.name}",
+					"logstore":     "${alibabacloudstack_log_store.default.name}",
+					"search_name":  name,
+					"search_query": "* | select count(1) as pv",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"search_name":  name,
+						"search_query": "* | select count(1) as pv",
+						"display_name": name,
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceId,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfig(map[string]interface{}{
+					"search_query": "status: 500",
+					"topic":        "terraform",
+					"display_name": name + "-update",
+				}),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheck(map[string]string{
+						"search_query": "status: 500",
+						"topic":        "terraform",
+						"display_name": name + "-update",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func resourceLogSavedSearchConfigDependence(name string) string {
+	return fmt.Sprintf(`
+	variable "name" {
+	    default = "%s"
+	}
+	resource "alibabacloudstack_log_project" "default" {
+	    name = "${var.name}"
+	    description = "tf unit test"
+	}
+	resource "alibabacloudstack_log_store" "default" {
+	    project = "${alibabacloudstack_log_project.default.name}"
+	    name = "${var.name}"
+	}
+	`, name)
+}
+
+var logSavedSearchMap = map[string]string{
+	"project_name": CHECKSET,
+	"logstore":     CHECKSET,
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackLogStore() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogStoreCreate,
-		Read:   resourceAlibabacloudStackLogStoreRead,
-		Update: resourceAlibabacloudStackLogStoreUpdate,
-		Delete: resourceAlibabacloudStackLogStoreDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogStoreCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogStoreRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogStoreUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogStoreDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -118,7 +119,7 @@ func resourceAlibabacloudStackLogStore() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	if v, ok := d.GetOk("encryption"); ok {
@@ -155,7 +156,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 			},
 		}
 
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestinfo = slsClient
@@ -179,10 +180,10 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_store", "CreateLogStoreV2", AlibabacloudStackLogGoSdkERROR)
 		}
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
-		return resourceAlibabacloudStackLogStoreUpdate(d, meta)
+		return resourceAlibabacloudStackLogStoreUpdate(ctx, d, meta)
 	}
 
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestinfo = slsClient
@@ -207,7 +208,7 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(fmt.Sprintf("%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("name").(string)))
 
-	return resourceAlibabacloudStackLogStoreUpdate(d, meta)
+	return resourceAlibabacloudStackLogStoreUpdate(ctx, d, meta)
 }
 
 //err := resource.Retry(3*time.Minute, func() *resource.RetryError {
@@ -237,10 +238,10 @@ func resourceAlibabacloudStackLogStoreCreate(d *schema.ResourceData, meta interf
 //
 //return resourceAlibabacloudStackLogStoreUpdate(d, meta)
 
-func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -259,7 +260,7 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	d.Set("retention_period", object.TTL)
 	d.Set("shard_count", object.ShardCount)
 	var shards []*sls.Shard
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		shards, err = object.ListShards()
 		if err != nil {
 			if IsExpectedErrors(err, []string{"InternalServerError"}) {
@@ -292,12 +293,12 @@ func resourceAlibabacloudStackLogStoreRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 
 	if d.IsNewResource() {
-		return resourceAlibabacloudStackLogStoreRead(d, meta)
+		return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 	}
 
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -355,10 +356,10 @@ func resourceAlibabacloudStackLogStoreUpdate(d *schema.ResourceData, meta interf
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackLogStoreRead(d, meta)
+	return resourceAlibabacloudStackLogStoreRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
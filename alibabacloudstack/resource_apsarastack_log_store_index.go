@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackLogStoreIndex() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogStoreIndexCreate,
-		Read:   resourceAlibabacloudStackLogStoreIndexRead,
-		Update: resourceAlibabacloudStackLogStoreIndexUpdate,
-		Delete: resourceAlibabacloudStackLogStoreIndexDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogStoreIndexCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogStoreIndexRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogStoreIndexUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogStoreIndexDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -131,9 +132,9 @@ func resourceAlibabacloudStackLogStoreIndex() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreIndexCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 
 	_, fullOk := d.GetOk("full_text")
 	_, fieldOk := d.GetOk("field_search")
@@ -147,7 +148,7 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := store.GetIndex()
 		if err != nil {
 			if IsExpectedErrors(err, []string{LogClientTimeout}) {
@@ -176,7 +177,7 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 		index.Keys = buildIndexKeys(d)
 	}
 
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		if e := store.CreateIndex(index); e != nil {
 			if IsExpectedErrors(e, []string{"InternalServerError", LogClientTimeout}) {
@@ -192,13 +193,13 @@ func resourceAlibabacloudStackLogStoreIndexCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprintf("%s%s%s", project, COLON_SEPARATED, store.Name))
 
-	return resourceAlibabacloudStackLogStoreIndexRead(d, meta)
+	return resourceAlibabacloudStackLogStoreIndexRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreIndexRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -259,7 +260,7 @@ func resourceAlibabacloudStackLogStoreIndexRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreIndexUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -267,7 +268,7 @@ func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	index, err := logService.DescribeLogStoreIndex(d.Id())
 	if err != nil {
 		return WrapError(err)
@@ -284,7 +285,7 @@ func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta i
 
 	if update {
 		var requestInfo *sls.Client
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 				requestInfo = slsClient
 				return nil, slsClient.UpdateIndex(parts[0], parts[1], *index)
@@ -309,12 +310,12 @@ func resourceAlibabacloudStackLogStoreIndexUpdate(d *schema.ResourceData, meta i
 		}
 	}
 
-	return resourceAlibabacloudStackLogStoreIndexRead(d, meta)
+	return resourceAlibabacloudStackLogStoreIndexRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogStoreIndexDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogStoreIndexDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -328,7 +329,7 @@ func resourceAlibabacloudStackLogStoreIndexDelete(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 	var requestInfo *sls.Client
-	if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteIndex(parts[0], parts[1])
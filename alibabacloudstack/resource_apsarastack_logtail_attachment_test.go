@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackLogtailAttachmentBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_attachment.default"
 	ra := resourceAttrInit(resourceId, logtailAttachmentMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -60,7 +61,7 @@ func TestAccAlibabacloudStackLogtailAttachmentMultipleGroup(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_attachment.default.1"
 	ra := resourceAttrInit(resourceId, logtailAttachmentMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -97,7 +98,7 @@ func TestAccAlibabacloudStackLogtailAttachmentMultipleConfig(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_attachment.default.1"
 	ra := resourceAttrInit(resourceId, logtailAttachmentMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackLogtailConfig() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackLogtailConfigCreate,
-		Read:   resourceAlibabacloudStackLogtailConfigRead,
-		Update: resourceAlibabacloudStackLogtailConfiglUpdate,
-		Delete: resourceAlibabacloudStackLogtailConfigDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackLogtailConfigCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackLogtailConfigRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackLogtailConfiglUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackLogtailConfigDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 
@@ -63,7 +64,7 @@ func resourceAlibabacloudStackLogtailConfig() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackLogtailConfigCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfigCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var inputConfigInputDetail = make(map[string]interface{})
 	data := d.Get("input_detail").(string)
@@ -100,13 +101,13 @@ func resourceAlibabacloudStackLogtailConfigCreate(d *schema.ResourceData, meta i
 		})
 	}
 	d.SetId(fmt.Sprintf("%s%s%s%s%s", d.Get("project").(string), COLON_SEPARATED, d.Get("logstore").(string), COLON_SEPARATED, d.Get("name").(string)))
-	return resourceAlibabacloudStackLogtailConfigRead(d, meta)
+	return resourceAlibabacloudStackLogtailConfigRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	split := strings.Split(d.Id(), COLON_SEPARATED)
 	config, err := logService.DescribeLogtailConfig(d.Id())
 	if err != nil {
@@ -142,7 +143,7 @@ func resourceAlibabacloudStackLogtailConfigRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfiglUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
 		return WrapError(err)
@@ -196,18 +197,18 @@ func resourceAlibabacloudStackLogtailConfiglUpdate(d *schema.ResourceData, meta
 			})
 		}
 	}
-	return resourceAlibabacloudStackLogtailConfigRead(d, meta)
+	return resourceAlibabacloudStackLogtailConfigRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackLogtailConfigDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackLogtailConfigDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	logService := LogService{client}
+	logService := LogService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 3)
 	if err != nil {
 		return WrapError(err)
 	}
 	var requestInfo *sls.Client
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return nil, slsClient.DeleteConfig(parts[0], parts[2])
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -84,7 +85,7 @@ func TestAccAlibabacloudStackLogTail_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_config.default"
 	ra := resourceAttrInit(resourceId, logTailMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -159,7 +160,7 @@ func TestAccAlibabacloudStackLogTail_plugin(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_config.default"
 	ra := resourceAttrInit(resourceId, logTailMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -208,7 +209,7 @@ func TestAccAlibabacloudStackLogTail_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_logtail_config.default.4"
 	ra := resourceAttrInit(resourceId, logTailMap)
 	serviceFunc := func() interface{} {
-		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &LogService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
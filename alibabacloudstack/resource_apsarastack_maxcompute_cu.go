@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeCuCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeCuRead,
-		Delete: resourceAlibabacloudStackMaxcomputeCuDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeCuCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMaxcomputeCuRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeCuDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(2 * time.Minute),
@@ -51,7 +52,7 @@ func resourceAlibabacloudStackMaxcomputeCu() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateUpdateOdpsCu"
@@ -71,19 +72,8 @@ func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta in
 	request["RegionName"] = client.RegionId
 	request["Share"] = "0"
 	request["Product"] = product
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_cu", action, AlibabacloudStackSdkGoERROR)
 	}
@@ -93,12 +83,12 @@ func resourceAlibabacloudStackMaxcomputeCuCreate(d *schema.ResourceData, meta in
 
 	d.Set("cu_name", request["CuName"])
 
-	return resourceAlibabacloudStackMaxcomputeCuRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeCuRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	maxcomputeService := MaxcomputeService{client}
+	maxcomputeService := MaxcomputeService{client, ctx}
 	object, err := maxcomputeService.DescribeMaxcomputeCu(d.Get("cu_name").(string))
 	if err != nil {
 		if NotFoundError(err) {
@@ -132,7 +122,7 @@ func resourceAlibabacloudStackMaxcomputeCuRead(d *schema.ResourceData, meta inte
 	}
 	return nil
 }
-func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeCuDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteOdpsCu"
 	var response map[string]interface{}
@@ -150,10 +140,10 @@ func resourceAlibabacloudStackMaxcomputeCuDelete(d *schema.ResourceData, meta in
 	}
 
 	wait := incrementalWait(3*time.Second, 10*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"500"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeProject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeProjectCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeProjectRead,
-		Update: resourceAlibabacloudStackMaxcomputeProjectUpdate,
-		Delete: resourceAlibabacloudStackMaxcomputeProjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeProjectCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMaxcomputeProjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeProjectUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeProjectDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(2 * time.Minute),
@@ -85,7 +86,7 @@ func resourceAlibabacloudStackMaxcomputeProject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeProjectCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -219,11 +220,11 @@ func resourceAlibabacloudStackMaxcomputeProjectCreate(d *schema.ResourceData, me
 	}
 	addDebug("MaxcomputeProjectCreate", raw, requestInfo, bresponse.GetHttpContentString())
 
-	return resourceAlibabacloudStackMaxcomputeProjectRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeProjectRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackMaxcomputeProjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	maxcomputeService := MaxcomputeService{client}
+	maxcomputeService := MaxcomputeService{client, ctx}
 	objects, err := maxcomputeService.DescribeMaxcomputeProject(d.Get("name").(string))
 	if err != nil {
 		if NotFoundError(err) {
@@ -239,7 +240,7 @@ func resourceAlibabacloudStackMaxcomputeProjectRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackMaxcomputeProjectUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	if d.HasChange("cluster") || d.HasChange("disk") {
@@ -295,10 +296,10 @@ func resourceAlibabacloudStackMaxcomputeProjectUpdate(d *schema.ResourceData, me
 		addDebug("UpdateOdpsQuota", raw, requestInfo, request)
 	}
 
-	return resourceAlibabacloudStackMaxcomputeProjectRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeProjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeProjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//不支持删除
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackMaxcomputeUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMaxcomputeUserCreate,
-		Read:   resourceAlibabacloudStackMaxcomputeUserRead,
-		Update: resourceAlibabacloudStackMaxcomputeUserUpdate,
-		Delete: resourceAlibabacloudStackMaxcomputeUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMaxcomputeUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMaxcomputeUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Delete: schema.DefaultTimeout(2 * time.Minute),
@@ -70,7 +71,7 @@ func resourceAlibabacloudStackMaxcomputeUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateOdpsUser"
@@ -91,19 +92,8 @@ func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta
 	request["RegionId"] = client.RegionId
 	request["RegionName"] = client.RegionId
 	request["Product"] = product
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequestWithOrg(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_maxcompute_project", action, AlibabacloudStackSdkGoERROR)
 	}
@@ -111,12 +101,12 @@ func resourceAlibabacloudStackMaxcomputeUserCreate(d *schema.ResourceData, meta
 		return WrapError(Error("CreateUpdateOdpsUser failed for "))
 	}
 
-	return resourceAlibabacloudStackMaxcomputeUserRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	maxcomputeService := MaxcomputeService{client}
+	maxcomputeService := MaxcomputeService{client, ctx}
 	object, err := maxcomputeService.DescribeMaxcomputeUser(d.Get("user_name").(string))
 	if err != nil {
 		if NotFoundError(err) {
@@ -137,7 +127,7 @@ func resourceAlibabacloudStackMaxcomputeUserRead(d *schema.ResourceData, meta in
 	d.Set("description", object.Data[0].Description)
 	return nil
 }
-func resourceAlibabacloudStackMaxcomputeUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	update := false
@@ -213,10 +203,10 @@ func resourceAlibabacloudStackMaxcomputeUserUpdate(d *schema.ResourceData, meta
 		}
 		addDebug("UpdateOdpsUser", raw, requestInfo, request)
 	}
-	return resourceAlibabacloudStackMaxcomputeUserRead(d, meta)
+	return resourceAlibabacloudStackMaxcomputeUserRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMaxcomputeUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMaxcomputeUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	//ASCM不支持删除
 	return nil
 	client := meta.(*connectivity.AlibabacloudStackClient)
@@ -236,10 +226,10 @@ func resourceAlibabacloudStackMaxcomputeUserDelete(d *schema.ResourceData, meta
 	}
 
 	wait := incrementalWait(3*time.Second, 10*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"500"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"500"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
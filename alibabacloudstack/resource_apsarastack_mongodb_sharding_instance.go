@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackMongoDBShardingInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackMongoDBShardingInstanceCreate,
-		Read:   resourceAlibabacloudStackMongoDBShardingInstanceRead,
-		Update: resourceAlibabacloudStackMongoDBShardingInstanceUpdate,
-		Delete: resourceAlibabacloudStackMongoDBShardingInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackMongoDBShardingInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -175,13 +176,13 @@ func resourceAlibabacloudStackMongoDBShardingInstance() *schema.Resource {
 	}
 }
 
-func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{}) (*dds.CreateShardingDBInstanceRequest, error) {
+func buildMongoDBShardingCreateRequest(ctx context.Context, d *schema.ResourceData, meta interface{}) (*dds.CreateShardingDBInstanceRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := dds.CreateCreateShardingDBInstanceRequest()
 
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.EngineVersion = Trim(d.Get("engine_version").(string))
 	request.Engine = "MongoDB"
 	request.DBInstanceDescription = d.Get("name").(string)
@@ -189,7 +190,7 @@ func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{})
 	request.AccountPassword = d.Get("account_password").(string)
 	if request.AccountPassword == "" {
 		if v := d.Get("kms_encrypted_password").(string); v != "" {
-			kmsService := KmsService{client}
+			kmsService := KmsService{client, ctx}
 			decryptResp, err := kmsService.Decrypt(v, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return request, WrapError(err)
@@ -229,7 +230,7 @@ func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{})
 	vswitchId := Trim(d.Get("vswitch_id").(string))
 	if vswitchId != "" {
 		// check vswitchId in zone
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, ctx}
 		vsw, err := vpcService.DescribeVSwitch(vswitchId)
 		if err != nil {
 			return nil, WrapError(err)
@@ -265,11 +266,11 @@ func buildMongoDBShardingCreateRequest(d *schema.ResourceData, meta interface{})
 	return request, nil
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, ctx}
 
-	request, err := buildMongoDBShardingCreateRequest(d, meta)
+	request, err := buildMongoDBShardingCreateRequest(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -291,13 +292,13 @@ func resourceAlibabacloudStackMongoDBShardingInstanceCreate(d *schema.ResourceDa
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackMongoDBShardingInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, ctx}
 
 	instance, err := ddsService.DescribeMongoDBInstance(d.Id())
 	if err != nil {
@@ -386,9 +387,9 @@ func resourceAlibabacloudStackMongoDBShardingInstanceRead(d *schema.ResourceData
 	return nil
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, ctx}
 	d.Partial(true)
 
 	if d.HasChange("backup_time") || d.HasChange("backup_period") {
@@ -402,7 +403,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		request := dds.CreateModifyDBInstanceTDERequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		request.TDEStatus = d.Get("tde_status").(string)
 		raw, err := client.WithDdsClient(func(client *dds.Client) (interface{}, error) {
@@ -419,7 +420,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		request := dds.CreateModifySecurityGroupConfigurationRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.DBInstanceId = d.Id()
 		request.SecurityGroupId = d.Get("security_group_id").(string)
@@ -436,7 +437,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackMongoDBShardingInstanceRead(d, meta)
+		return resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx, d, meta)
 	}
 
 	if d.HasChange("shard_list") {
@@ -461,7 +462,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		request := dds.CreateModifyDBInstanceDescriptionRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		request.DBInstanceId = d.Id()
 		request.DBInstanceDescription = d.Get("name").(string)
@@ -482,7 +483,7 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		if accountPassword = d.Get("account_password").(string); accountPassword != "" {
 			//d.SetPartial("account_password")
 		} else if kmsPassword := d.Get("kms_encrypted_password").(string); kmsPassword != "" {
-			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+			kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 			decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 			if err != nil {
 				return WrapError(err)
@@ -513,20 +514,20 @@ func resourceAlibabacloudStackMongoDBShardingInstanceUpdate(d *schema.ResourceDa
 		//d.SetPartial("security_ip_list")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackMongoDBShardingInstanceRead(d, meta)
+	return resourceAlibabacloudStackMongoDBShardingInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackMongoDBShardingInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackMongoDBShardingInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, ctx}
 
 	request := dds.CreateDeleteDBInstanceRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "dds", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 
-	err := resource.Retry(10*5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithDdsClient(func(ddsClient *dds.Client) (interface{}, error) {
 			return ddsClient.DeleteDBInstance(request)
 		})
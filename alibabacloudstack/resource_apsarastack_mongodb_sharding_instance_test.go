@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"testing"
@@ -64,8 +65,8 @@ func testSweepMongoDBShardingInstances(region string) error {
 	}
 
 	sweeped := false
-	service := VpcService{client}
-	ddsService := MongoDBService{client}
+	service := VpcService{client, context.Background()}
+	ddsService := MongoDBService{client, context.Background()}
 	for _, v := range insts {
 		name := v.DBInstanceDescription
 		id := v.DBInstanceId
@@ -117,7 +118,7 @@ func testSweepMongoDBShardingInstances(region string) error {
 
 func testAccCheckMongoDBShardingInstanceDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ddsService := MongoDBService{client}
+	ddsService := MongoDBService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_mongodb_sharding_instance" {
@@ -139,7 +140,7 @@ func TestAccAlibabacloudStackMongoDBShardingInstance_classic(t *testing.T) {
 	var v dds.DBInstance
 	resourceId := "alibabacloudstack_mongodb_sharding_instance.default"
 	serverFunc := func() interface{} {
-		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeMongoDBInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -252,7 +253,7 @@ func TestAccAlibabacloudStackMongoDBShardingInstance_classicVersion4(t *testing.
 	var v dds.DBInstance
 	resourceId := "alibabacloudstack_mongodb_sharding_instance.default"
 	serverFunc := func() interface{} {
-		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeMongoDBInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -314,7 +315,7 @@ func TestAccAlibabacloudStackMongoDBShardingInstance_vpc(t *testing.T) {
 	var v dds.DBInstance
 	resourceId := "alibabacloudstack_mongodb_sharding_instance.default"
 	serverFunc := func() interface{} {
-		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeMongoDBInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -429,7 +430,7 @@ func TestAccAlibabacloudStackMongoDBShardingInstance_multi_instance(t *testing.T
 	var v dds.DBInstance
 	resourceId := "alibabacloudstack_mongodb_sharding_instance.default.2"
 	serverFunc := func() interface{} {
-		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &MongoDBService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serverFunc, "DescribeMongoDBInstance")
 	ra := resourceAttrInit(resourceId, nil)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -98,7 +99,7 @@ func TestAccAlibabacloudStackNasAccessGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_nas_access_group.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNasAccessGroup0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNasAccessGroup")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
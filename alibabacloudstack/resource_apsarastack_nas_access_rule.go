@@ -1,12 +1,11 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -16,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasAccessRuleCreate,
-		Read:   resourceAlibabacloudStackNasAccessRuleRead,
-		Update: resourceAlibabacloudStackNasAccessRuleUpdate,
-		Delete: resourceAlibabacloudStackNasAccessRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasAccessRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasAccessRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -60,7 +59,7 @@ func resourceAlibabacloudStackNasAccessRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateAccessRule"
@@ -83,27 +82,16 @@ func resourceAlibabacloudStackNasAccessRuleCreate(d *schema.ResourceData, meta i
 		request["UserAccessType"] = v
 	}
 	request["Priority"] = d.Get("priority")
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_access_rule", action, AlibabacloudStackSdkGoERROR)
 	}
 	d.SetId(fmt.Sprint(request["AccessGroupName"], ":", response["AccessRuleId"]))
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -147,29 +135,18 @@ func resourceAlibabacloudStackNasAccessRuleUpdate(d *schema.ResourceData, meta i
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasAccessRuleRead(d, meta)
+	return resourceAlibabacloudStackNasAccessRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	object, err := nasService.DescribeNasAccessRule(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -193,7 +170,7 @@ func resourceAlibabacloudStackNasAccessRuleRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasAccessRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteAccessRule"
 	var response map[string]interface{}
@@ -215,19 +192,8 @@ func resourceAlibabacloudStackNasAccessRuleDelete(d *schema.ResourceData, meta i
 	request["OrganizationId"] = client.Department
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"Forbidden.NasNotFound"}) {
 			return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackNasAccessRule_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_nas_access_rule.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNasAccessRule0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNasAccessRule")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
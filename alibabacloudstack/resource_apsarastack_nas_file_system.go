@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackNasFileSystem() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasFileSystemCreate,
-		Read:   resourceAlibabacloudStackNasFileSystemRead,
-		Update: resourceAlibabacloudStackNasFileSystemUpdate,
-		Delete: resourceAlibabacloudStackNasFileSystemDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasFileSystemRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasFileSystemDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackNasFileSystem() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateFileSystem"
@@ -113,10 +114,10 @@ func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta i
 	}
 
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
-			if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
+			if IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -132,16 +133,16 @@ func resourceAlibabacloudStackNasFileSystemCreate(d *schema.ResourceData, meta i
 	d.SetId(fmt.Sprint(response["FileSystemId"]))
 	// Creating an extreme filesystem is asynchronous, so you need to block and wait until the creation is complete
 	//if d.Get("file_system_type") == "extreme" {
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	stateConf := BuildStateConf([]string{}, []string{"Running"}, d.Timeout(schema.TimeoutRead), 3*time.Second, nasService.DescribeNasFileSystemStateRefreshFunc(d.Id(), "Pending", []string{"Stopped", "Stopping", "Deleting"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	//}
-	return resourceAlibabacloudStackNasFileSystemUpdate(d, meta)
+	return resourceAlibabacloudStackNasFileSystemUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -160,10 +161,10 @@ func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta i
 			return WrapError(err)
 		}
 		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
+		err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
 			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 			if err != nil {
-				if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
+				if IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
 					wait()
 					return resource.RetryableError(err)
 				}
@@ -176,12 +177,12 @@ func resourceAlibabacloudStackNasFileSystemUpdate(d *schema.ResourceData, meta i
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasFileSystemRead(d, meta)
+	return resourceAlibabacloudStackNasFileSystemRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNasFileSystemRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	object, err := nasService.DescribeNasFileSystem(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -203,7 +204,7 @@ func resourceAlibabacloudStackNasFileSystemRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackNasFileSystemDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasFileSystemDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteFileSystem"
 	var response map[string]interface{}
@@ -220,10 +221,10 @@ func resourceAlibabacloudStackNasFileSystemDelete(d *schema.ResourceData, meta i
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
 	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
-			if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
+			if IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -1,12 +1,14 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strings"
 	"testing"
@@ -114,7 +116,7 @@ func TestAccAlibabacloudStackNasFileSystem_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_nas_file_system.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNasFileSystem0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNasFileSystem")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -177,7 +179,7 @@ func TestAccAlibabacloudStackNasFileSystemEncrypt(t *testing.T) {
 	resourceId := "alibabacloudstack_nas_file_system.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNasFileSystem0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNasFileSystem")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -262,3 +264,65 @@ data "alibabacloudstack_nas_zones" "default" {
 }
 `, name)
 }
+
+func TestUnitAlibabacloudStackNasFileSystem_mockTags(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("nas_file_system")
+	client := server.client()
+	client.Config.DefaultTags = map[string]string{"Environment": "test"}
+
+	r := Provider().ResourcesMap["alibabacloudstack_nas_file_system"]
+	config := map[string]interface{}{
+		"storage_type":  "Performance",
+		"protocol_type": "NFS",
+		"description":   "mock nas",
+		"tags": map[string]interface{}{
+			"Team": "storage",
+		},
+	}
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the nas file system got an error: %#v", err)
+	}
+	state, diags := r.Apply(context.Background(), nil, diff, client)
+	if diags.HasError() {
+		t.Fatalf("creating the nas file system got an error: %#v", diags)
+	}
+	call, ok := server.lastCall("TagResources")
+	if !ok {
+		t.Fatalf("expected TagResources to be called")
+	}
+	tags := make(map[string]string)
+	for i := 1; call.Params[fmt.Sprintf("Tag.%d.Key", i)] != ""; i++ {
+		tags[call.Params[fmt.Sprintf("Tag.%d.Key", i)]] = call.Params[fmt.Sprintf("Tag.%d.Value", i)]
+	}
+	if call.Params["ResourceType"] != "filesystem" || call.Params["ResourceId.1"] != "nas-mock0001" || call.Params["Product"] != "Nas" ||
+		len(tags) != 2 || tags["Team"] != "storage" || tags["Environment"] != "test" {
+		t.Errorf("TagResources was not called with the expected parameters: %v", call.Params)
+	}
+	for key, expected := range map[string]string{
+		"tags.%":               "1",
+		"tags.Team":            "storage",
+		"tags_all.%":           "2",
+		"tags_all.Environment": "test",
+	} {
+		if value := state.Attributes[key]; value != expected {
+			t.Errorf("expected the nas file system %s %q, got %q", key, expected, value)
+		}
+	}
+
+	delete(config, "tags")
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the nas file system got an error: %#v", err)
+	}
+	state, diags = r.Apply(context.Background(), state, diff, client)
+	if diags.HasError() {
+		t.Fatalf("updating the nas file system got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("UntagResources"); !ok || call.Params["TagKey.1"] != "Team" || call.Params["TagKey.2"] != "" {
+		t.Errorf("UntagResources was not called with the expected parameters: %v", call.Params)
+	}
+	if state.Attributes["tags.%"] != "0" || state.Attributes["tags_all.%"] != "1" {
+		t.Errorf("expected only the default tag to be left, got %v", state.Attributes)
+	}
+}
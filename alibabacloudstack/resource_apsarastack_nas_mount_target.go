@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,19 +9,18 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackNasMountTarget() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNasMountTargetCreate,
-		Read:   resourceAlibabacloudStackNasMountTargetRead,
-		Update: resourceAlibabacloudStackNasMountTargetUpdate,
-		Delete: resourceAlibabacloudStackNasMountTargetDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNasMountTargetRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNasMountTargetDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(5 * time.Minute),
@@ -55,9 +55,9 @@ func resourceAlibabacloudStackNasMountTarget() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	var response map[string]interface{}
 	action := "CreateMountTarget"
 	request := make(map[string]interface{})
@@ -81,7 +81,7 @@ func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta
 
 	vswitchId := Trim(d.Get("vswitch_id").(string))
 	if vswitchId != "" {
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, ctx}
 		vsw, err := vpcService.DescribeVSwitchWithTeadsl(vswitchId)
 		if err != nil {
 			return WrapError(err)
@@ -90,34 +90,23 @@ func resourceAlibabacloudStackNasMountTargetCreate(d *schema.ResourceData, meta
 		request["VpcId"] = vsw["VpcId"]
 		request["VSwitchId"] = vswitchId
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_nas_mount_target", action, AlibabacloudStackSdkGoERROR)
 	}
 
 	d.SetId(fmt.Sprint(request["FileSystemId"], ":", response["MountTargetDomain"]))
 	stateConf := BuildStateConf([]string{}, []string{"Active"}, d.Timeout(schema.TimeoutCreate), 10*time.Second, nasService.NasMountTargetStateRefreshFunc(d.Id(), []string{"Inactive"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackNasMountTargetUpdate(d, meta)
+	return resourceAlibabacloudStackNasMountTargetUpdate(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasMountTargetRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", strings.Split(d.Id(), "-")[0], d.Id()))
 	}
@@ -140,7 +129,7 @@ func resourceAlibabacloudStackNasMountTargetRead(d *schema.ResourceData, meta in
 	d.Set("vswitch_id", object["VswId"])
 	return nil
 }
-func resourceAlibabacloudStackNasMountTargetUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	if len(strings.Split(d.Id(), ":")) != 2 {
@@ -174,28 +163,17 @@ func resourceAlibabacloudStackNasMountTargetUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackNasMountTargetRead(d, meta)
+	return resourceAlibabacloudStackNasMountTargetRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackNasMountTargetDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNasMountTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	nasService := NasService{client}
+	nasService := NasService{client, ctx}
 	if len(strings.Split(d.Id(), ":")) != 2 {
 		d.SetId(fmt.Sprintf("%v:%v", strings.Split(d.Id(), "-")[0], d.Id()))
 	}
@@ -218,19 +196,8 @@ func resourceAlibabacloudStackNasMountTargetDelete(d *schema.ResourceData, meta
 	request["OrganizationId"] = client.Department
 	request["Department"] = client.Department
 	request["ResourceGroup"] = client.ResourceGroup
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-26"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"Forbidden.NasNotFound"}) {
 			return nil
@@ -238,7 +205,7 @@ func resourceAlibabacloudStackNasMountTargetDelete(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{"Active"}, []string{}, d.Timeout(schema.TimeoutDelete), 10*time.Second, nasService.NasMountTargetStateRefreshFunc(d.Id(), []string{"delete_failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
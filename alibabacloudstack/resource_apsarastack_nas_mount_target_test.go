@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackNasMountTarget_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_nas_mount_target.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNasMountTarget0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &NasService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNasMountTarget")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
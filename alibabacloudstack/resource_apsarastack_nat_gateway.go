@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -16,14 +17,15 @@ import (
 
 func resourceAlibabacloudStackNatGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNatGatewayCreate,
-		Read:   resourceAlibabacloudStackNatGatewayRead,
-		Update: resourceAlibabacloudStackNatGatewayUpdate,
-		Delete: resourceAlibabacloudStackNatGatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNatGatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNatGatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNatGatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNatGatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"vpc_id": {
 				Type:     schema.TypeString,
@@ -87,14 +89,15 @@ func resourceAlibabacloudStackNatGateway() *schema.Resource {
 				MaxItems: 4,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateNatGatewayRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -104,7 +107,7 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 	}
 	request.RegionId = string(client.Region)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VpcId = string(d.Get("vpc_id").(string))
 	request.Spec = string(d.Get("specification").(string))
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -131,7 +134,7 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 		request.Description = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateNatGateway(&args)
@@ -152,13 +155,13 @@ func resourceAlibabacloudStackNatGatewayCreate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpcService.DescribeNatGateway(d.Id())
 	if err != nil {
@@ -186,17 +189,19 @@ func resourceAlibabacloudStackNatGatewayRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", tagsToMap(listTagResourcesObject))
+	if err := setResourceTags(d, meta, tagsToMap(listTagResourcesObject)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := vpcService.SetResourceTags(d, "NATGATEWAY"); err != nil {
 			return WrapError(err)
 		}
@@ -218,7 +223,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 	modifyNatGatewayAttributeRequest.RegionId = natGateway.RegionId
 	modifyNatGatewayAttributeRequest.NatGatewayId = natGateway.NatGatewayId
 	modifyNatGatewayAttributeRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyNatGatewayAttributeRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyNatGatewayAttributeRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if d.HasChange("name") {
 		//d.SetPartial("name")
@@ -268,7 +273,7 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 		}
 		modifyNatGatewaySpecRequest.NatGatewayId = natGateway.NatGatewayId
 		modifyNatGatewaySpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		modifyNatGatewaySpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		modifyNatGatewaySpecRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		modifyNatGatewaySpecRequest.Spec = d.Get("specification").(string)
 
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -283,13 +288,13 @@ func resourceAlibabacloudStackNatGatewayUpdate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForNatGateway(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNatGatewayRead(d, meta)
+	return resourceAlibabacloudStackNatGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNatGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
-	err := deleteBandwidthPackages(d, meta)
+	vpcService := VpcService{client, ctx}
+	err := deleteBandwidthPackages(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -301,9 +306,9 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.NatGatewayId = d.Id()
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		request := vpc.CreateDeleteNatGatewayRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -312,7 +317,7 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 		}
 		request.RegionId = string(client.Region)
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.NatGatewayId = d.Id()
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteNatGateway(request)
@@ -335,7 +340,7 @@ func resourceAlibabacloudStackNatGatewayDelete(d *schema.ResourceData, meta inte
 	return WrapError(vpcService.WaitForNatGateway(d.Id(), Deleted, DefaultTimeoutMedium))
 }
 
-func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
+func deleteBandwidthPackages(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	packRequest := vpc.CreateDescribeBandwidthPackagesRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -345,9 +350,9 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 	}
 	packRequest.RegionId = string(client.Region)
 	packRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	packRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	packRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	packRequest.NatGatewayId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DescribeBandwidthPackages(packRequest)
 		})
@@ -368,7 +373,7 @@ func deleteBandwidthPackages(d *schema.ResourceData, meta interface{}) error {
 				}
 				request.BandwidthPackageId = pack.BandwidthPackageId
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				raw, e := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 					return vpcClient.DeleteBandwidthPackage(request)
 				})
@@ -435,26 +440,22 @@ func getPackage(packageId string, meta interface{}, d *schema.ResourceData) (pac
 	request.RegionId = client.RegionId
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.NatGatewayId = d.Id()
 	request.BandwidthPackageId = packageId
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeBandwidthPackages(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		packages, _ := raw.(*vpc.DescribeBandwidthPackagesResponse)
-		if len(packages.BandwidthPackages.BandwidthPackage) < 1 || packages.BandwidthPackages.BandwidthPackage[0].BandwidthPackageId != packageId {
-			return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
-		}
-		pack = packages.BandwidthPackages.BandwidthPackage[0]
-		return nil
+	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeBandwidthPackages(request)
 	})
+	if err != nil {
+		return pack, WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	packages, _ := raw.(*vpc.DescribeBandwidthPackagesResponse)
+	if len(packages.BandwidthPackages.BandwidthPackage) < 1 || packages.BandwidthPackages.BandwidthPackage[0].BandwidthPackageId != packageId {
+		return pack, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+	}
+	pack = packages.BandwidthPackages.BandwidthPackage[0]
 	return
 }
 func flattenPackPublicIp(publicIpAddressList []vpc.PublicIpAddresse) string {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -49,7 +50,7 @@ func testSweepNatGateways(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -75,7 +76,7 @@ func testSweepNatGateways(region string) error {
 		}
 		req.PageNumber = page
 	}
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, v := range gws {
 		name := v.Name
 		id := v.NatGatewayId
@@ -105,7 +106,7 @@ func testSweepNatGateways(region string) error {
 
 func testAccCheckNatGatewayDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_nat_gateway" {
@@ -130,7 +131,7 @@ func TestAccAlibabacloudStackNatGatewayBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_nat_gateway.default"
 	ra := resourceAttrInit(resourceId, testAccCheckNatGatewayBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
@@ -11,10 +12,13 @@ import (
 
 func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkAclAttachmentRead,
-		Update: resourceAlibabacloudStackNetworkAclAttachmentUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNetworkAclAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackNetworkAclAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 
@@ -43,17 +47,17 @@ func resourceAlibabacloudStackNetworkAclAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("network_acl_id").(string) + COLON_SEPARATED + resource.UniqueId())
 
-	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -81,9 +85,9 @@ func resourceAlibabacloudStackNetworkAclAttachmentRead(d *schema.ResourceData, m
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -179,12 +183,12 @@ func resourceAlibabacloudStackNetworkAclAttachmentUpdate(d *schema.ResourceData,
 		//d.SetPartial("resources")
 	}
 
-	return resourceAlibabacloudStackNetworkAclAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkAclAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -214,7 +218,7 @@ func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData,
 		})
 	}
 	request.Resource = &resources
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UnassociateNetworkAcl(request)
 		})
@@ -232,3 +236,35 @@ func resourceAlibabacloudStackNetworkAclAttachmentDelete(d *schema.ResourceData,
 	}
 	return vpcService.WaitForNetworkAclAttachment(networkAclId, vpcResource, Deleted, Timeout5Minute)
 }
+
+// resourceAlibabacloudStackNetworkAclAttachmentImport imports all of the resources the network acl is attached to
+// from the id of the network acl.
+func resourceAlibabacloudStackNetworkAclAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	vpcService := VpcService{client, ctx}
+	networkAclId := d.Id()
+	object, err := vpcService.DescribeNetworkAcl(networkAclId)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	var resources []map[string]interface{}
+	if resourceList, ok := object["Resources"].(map[string]interface{})["Resource"].([]interface{}); ok {
+		for _, v := range resourceList {
+			if item, ok := v.(map[string]interface{}); ok {
+				resources = append(resources, map[string]interface{}{
+					"resource_id":   item["ResourceId"],
+					"resource_type": item["ResourceType"],
+				})
+			}
+		}
+	}
+	if len(resources) == 0 {
+		return nil, WrapError(Error("the network acl %s is not attached to any resource", networkAclId))
+	}
+	d.Set("network_acl_id", networkAclId)
+	if err := d.Set("resources", resources); err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(networkAclId + COLON_SEPARATED + resource.UniqueId())
+	return []*schema.ResourceData{d}, nil
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -162,7 +163,7 @@ func testAccCheckNetworkAclAttachmentExists(n string) resource.TestCheckFunc {
 			return WrapError(Error("No Network Acl Attachment ID is set"))
 		}
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		parts, err := ParseResourceId(rs.Primary.ID, 2)
 		if err != nil {
@@ -192,7 +193,7 @@ func testAccCheckNetworkAclAttachmentExists(n string) resource.TestCheckFunc {
 func testAccCheckNetworkAclAttachmentDestroy(s *terraform.State) error {
 
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_network_acl_attachment" {
 			continue
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -13,12 +14,12 @@ import (
 
 func resourceAlibabacloudStackNetworkAclEntries() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkAclEntriesCreate,
-		Read:   resourceAlibabacloudStackNetworkAclEntriesRead,
-		Update: resourceAlibabacloudStackNetworkAclEntriesUpdate,
-		Delete: resourceAlibabacloudStackNetworkAclEntriesDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclEntriesCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNetworkAclEntriesRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackNetworkAclEntriesUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNetworkAclEntriesDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 
@@ -117,16 +118,16 @@ func resourceAlibabacloudStackNetworkAclEntries() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclEntriesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(d.Get("network_acl_id").(string) + COLON_SEPARATED + resource.UniqueId())
 
-	return resourceAlibabacloudStackNetworkAclEntriesUpdate(d, meta)
+	return resourceAlibabacloudStackNetworkAclEntriesUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclEntriesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -185,9 +186,9 @@ func resourceAlibabacloudStackNetworkAclEntriesRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclEntriesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -235,7 +236,7 @@ func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, me
 	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UpdateNetworkAclEntries(request)
 		})
@@ -254,9 +255,9 @@ func resourceAlibabacloudStackNetworkAclEntriesUpdate(d *schema.ResourceData, me
 	return vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout)
 }
 
-func resourceAlibabacloudStackNetworkAclEntriesDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkAclEntriesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -278,7 +279,7 @@ func resourceAlibabacloudStackNetworkAclEntriesDelete(d *schema.ResourceData, me
 	if err := vpcService.WaitForNetworkAcl(networkAclId, Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.UpdateNetworkAclEntries(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -114,7 +115,7 @@ func testAccCheckNetworkAclEntriesExists(n string) resource.TestCheckFunc {
 			return WrapError(Error("No Network Acl Entries ID is set"))
 		}
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		parts, err := ParseResourceId(rs.Primary.ID, 2)
 		if err != nil {
@@ -132,7 +133,7 @@ func testAccCheckNetworkAclEntriesExists(n string) resource.TestCheckFunc {
 func testAccCheckNetworkAclEntriesDestroy(s *terraform.State) error {
 
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_network_acl_entries" {
 			continue
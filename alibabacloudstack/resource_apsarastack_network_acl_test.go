@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -81,7 +82,7 @@ func testSweepNetworkAcl(region string) error {
 		request["PageNumber"] = request["PageNumber"].(int) + 1
 	}
 
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 	for _, id := range networkAclIds {
 		//	Delete attach resources
 		object, err := vpcService.DescribeNetworkAcl(id)
@@ -107,18 +108,7 @@ func testSweepNetworkAcl(region string) error {
 			action := "UnassociateNetworkAcl"
 			runtime := util.RuntimeOptions{}
 			runtime.SetAutoretry(true)
-			wait := incrementalWait(3*time.Second, 3*time.Second)
-			err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-				response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-				if err != nil {
-					if NeedRetry(err) {
-						wait()
-						return resource.RetryableError(err)
-					}
-					return resource.NonRetryableError(err)
-				}
-				return nil
-			})
+			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 			if err != nil {
 				log.Println("UnassociateNetworkAcl failed", err)
 			}
@@ -134,18 +124,7 @@ func testSweepNetworkAcl(region string) error {
 		}
 		action := "DeleteNetworkAcl"
 		request["RegionId"] = client.RegionId
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete Network Acl (%s): %s", id, err)
 		}
@@ -158,7 +137,7 @@ func TestAccAlibabacloudStackVpcNetworkAcl_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_network_acl.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackNetworkAclMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNetworkAcl")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -13,15 +14,16 @@ import (
 
 func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceNetworkInterfaceCreate,
-		Read:   resourceNetworkInterfaceRead,
-		Update: resourceNetworkInterfaceUpdate,
-		Delete: resourceNetworkInterfaceDelete,
+		CreateContext: withDiagnostics(resourceNetworkInterfaceCreate),
+		ReadContext:   withDiagnostics(resourceNetworkInterfaceRead),
+		UpdateContext: withDiagnostics(resourceNetworkInterfaceUpdate),
+		DeleteContext: withDiagnostics(resourceNetworkInterfaceDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -72,14 +74,15 @@ func resourceAlibabacloudStackNetworkInterface() *schema.Resource {
 				Optional: true,
 				Default:  "",
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	request := ecs.CreateCreateNetworkInterfaceRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -88,7 +91,7 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VSwitchId = d.Get("vswitch_id").(string)
 	groups := d.Get("security_groups").(*schema.Set).List()
 
@@ -119,12 +122,12 @@ func resourceNetworkInterfaceCreate(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	return resourceNetworkInterfaceUpdate(d, meta)
+	return resourceNetworkInterfaceUpdate(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	object, err := ecsService.DescribeNetworkInterface(d.Id())
 	if err != nil {
@@ -156,15 +159,17 @@ func resourceNetworkInterfaceRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if len(tags) > 0 {
-		d.Set("tags", ecsService.tagsToMap(tags))
+		if err := setResourceTags(d, meta, ecsService.tagsToMap(tags)); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
 }
 
-func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	d.Partial(true)
 
@@ -178,7 +183,7 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if !d.IsNewResource() && d.HasChange("description") {
 		request.Description = d.Get("description").(string)
 		attributeUpdate = true
@@ -226,10 +231,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				unAssignPrivateIpAddressesRequest.Scheme = "http"
 			}
 			unAssignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.UnassignPrivateIpAddresses(unAssignPrivateIpAddressesRequest)
 				})
@@ -258,10 +263,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 				assignPrivateIpAddressesRequest.Scheme = "http"
 			}
 			assignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-			assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			assignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 			assignPrivateIpAddressesRequest.PrivateIpAddress = &assignIpList
-			err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 				raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 					return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 				})
@@ -300,10 +305,10 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					assignPrivateIpAddressesRequest.Scheme = "http"
 				}
 				assignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-				assignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				assignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				assignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 				assignPrivateIpAddressesRequest.SecondaryPrivateIpAddressCount = requests.NewInteger(diff)
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 						return ecsClient.AssignPrivateIpAddresses(assignPrivateIpAddressesRequest)
 					})
@@ -333,8 +338,8 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 					unAssignPrivateIpAddressesRequest.Scheme = "http"
 				}
 				unAssignPrivateIpAddressesRequest.Headers = map[string]string{"RegionId": client.RegionId}
-				unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-				err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+				unAssignPrivateIpAddressesRequest.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 					unAssignPrivateIpAddressesRequest.NetworkInterfaceId = d.Id()
 					unAssignPrivateIpAddressesRequest.PrivateIpAddress = &unAssignIps
 					raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -363,18 +368,18 @@ func resourceNetworkInterfaceUpdate(d *schema.ResourceData, meta interface{}) er
 		}
 	}
 
-	if err := setTags(client, TagResourceEni, d); err != nil {
+	if err := setTags(ctx, client, TagResourceEni, d); err != nil {
 		return WrapError(err)
 	}
 
 	d.Partial(false)
 
-	return resourceNetworkInterfaceRead(d, meta)
+	return resourceNetworkInterfaceRead(ctx, d, meta)
 }
 
-func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDeleteNetworkInterfaceRequest()
 	request.RegionId = client.RegionId
@@ -385,9 +390,9 @@ func resourceNetworkInterfaceDelete(d *schema.ResourceData, meta interface{}) er
 	}
 	request.NetworkInterfaceId = d.Id()
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteNetworkInterface(request)
 		})
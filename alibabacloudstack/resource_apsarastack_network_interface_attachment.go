@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -13,12 +14,12 @@ import (
 
 func resourceNetworkInterfaceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackNetworkInterfaceAttachmentCreate,
-		Read:   resourceAlibabacloudStackNetworkInterfaceAttachmentRead,
-		Delete: resourceAlibabacloudStackNetworkInterfaceAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackNetworkInterfaceAttachmentDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -36,9 +37,9 @@ func resourceNetworkInterfaceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	eniId := d.Get("network_interface_id").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -51,11 +52,11 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceId = instanceId
 	request.NetworkInterfaceId = eniId
 
-	err := resource.Retry(DefaultTimeout*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachNetworkInterface(request)
 		})
@@ -75,13 +76,13 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentCreate(d *schema.Resourc
 	if err = ecsService.WaitForNetworkInterface(eniId, InUse, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d, meta)
+	return resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	object, err := ecsService.DescribeNetworkInterfaceAttachment(d.Id())
 	if err != nil {
@@ -98,9 +99,9 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentRead(d *schema.ResourceD
 	return nil
 }
 
-func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -125,9 +126,9 @@ func resourceAlibabacloudStackNetworkInterfaceAttachmentDelete(d *schema.Resourc
 	request.InstanceId = instanceId
 	request.NetworkInterfaceId = eniId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachNetworkInterface(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackNetworkInterfaceAttachmentBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_network_interface_attachment.default"
 	ra := resourceAttrInit(resourceId, testAccCheckNetworkInterfaceAttachmentCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -51,7 +52,7 @@ func TestAccAlibabacloudStackNetworkInterfaceAttachmentMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_network_interface_attachment.default.1"
 	ra := resourceAttrInit(resourceId, testAccCheckNetworkInterfaceAttachmentCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -87,7 +88,7 @@ func testAccCheckNetworkInterfaceAttachmentDestroy(s *terraform.State) error {
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 		_, err := ecsService.DescribeNetworkInterfaceAttachment(rs.Primary.ID)
 		if err != nil {
 			if NotFoundError(err) {
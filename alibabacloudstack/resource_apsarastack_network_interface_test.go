@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -30,7 +31,7 @@ func testAlibabacloudStackNetworkInterface(region string) error {
 		return fmt.Errorf("error getting AlibabacloudStack client: %#v", err)
 	}
 	client := rawClient.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, context.Background()}
 
 	req := ecs.CreateDescribeNetworkInterfacesRequest()
 	req.RegionId = client.RegionId
@@ -40,7 +41,7 @@ func testAlibabacloudStackNetworkInterface(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	var enis []ecs.NetworkInterfaceSet
@@ -76,7 +77,7 @@ func testAlibabacloudStackNetworkInterface(region string) error {
 	}
 
 	sweeped := false
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, eni := range enis {
 		name := eni.NetworkInterfaceName
 		skip := true
@@ -104,7 +105,7 @@ func testAlibabacloudStackNetworkInterface(region string) error {
 				req.Scheme = "http"
 			}
 			req.Headers = map[string]string{"RegionId": client.RegionId}
-			req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			req.InstanceId = eni.InstanceId
 			req.NetworkInterfaceId = eni.NetworkInterfaceId
 			_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -130,7 +131,7 @@ func testAlibabacloudStackNetworkInterface(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.NetworkInterfaceId = eni.NetworkInterfaceId
 		_, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteNetworkInterface(req)
@@ -160,7 +161,7 @@ func testAccCheckNetworkInterfaceDestroy(t *terraform.State) error {
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		ecsService := EcsService{client}
+		ecsService := EcsService{client, context.Background()}
 		_, err := ecsService.DescribeNetworkInterface(rs.Primary.ID)
 		if err != nil {
 			if NotFoundError(err) {
@@ -179,7 +180,7 @@ func TestAccAlibabacloudStackNetworkInterfaceBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_network_interface.default"
 	ra := resourceAttrInit(resourceId, testAccCheckNetworkInterfaceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -233,7 +234,7 @@ func TestAccAlibabacloudStackNetworkInterfaceMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_network_interface.default.2"
 	ra := resourceAttrInit(resourceId, testAccCheckNetworkInterfaceCheckMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -268,7 +269,7 @@ func TestAccAlibabacloudStackECSNetworkInterfaceBasicTag(t *testing.T) {
 	resourceId := "alibabacloudstack_network_interface.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackEcsNetworkInterfaceMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeNetworkInterface")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsGroupCreate,
-		Read:   resourceAlibabacloudStackOnsGroupRead,
-		Update: resourceAlibabacloudStackOnsGroupUpdate,
-		Delete: resourceAlibabacloudStackOnsGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -52,7 +53,7 @@ func resourceAlibabacloudStackOnsGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 
@@ -62,19 +63,18 @@ func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interf
 	request := requests.NewCommonRequest()
 
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleGroupCreate",
-		"Version":         "2018-02-05",
-		"ProductName":     "Ons-inner",
-		"PreventCache":    "",
-		"GroupId":         groupId,
-		"Remark":          remark,
-		"OnsRegionId":     client.RegionId,
-		"InstanceId":      instanceId,
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "Ons-inner",
+		"Action":        "ConsoleGroupCreate",
+		"Version":       "2018-02-05",
+		"ProductName":   "Ons-inner",
+		"PreventCache":  "",
+		"GroupId":       groupId,
+		"Remark":        remark,
+		"OnsRegionId":   client.RegionId,
+		"InstanceId":    instanceId,
 	}
 	request.Method = "POST"
 	request.Product = "Ons-inner"
@@ -116,13 +116,13 @@ func resourceAlibabacloudStackOnsGroupCreate(d *schema.ResourceData, meta interf
 	log.Printf("groupid and instanceid %s %s", groupId, instanceId)
 	d.SetId(groupId + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 
 	object, err := onsService.DescribeOnsGroup(d.Id())
 	if err != nil {
@@ -141,13 +141,13 @@ func resourceAlibabacloudStackOnsGroupRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsGroupUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsGroupRead(d, meta)
+func resourceAlibabacloudStackOnsGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackOnsGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := onsService.DescribeOnsGroup(d.Id())
 	parts, err := ParseResourceId(d.Id(), 2)
@@ -156,22 +156,21 @@ func resourceAlibabacloudStackOnsGroupDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, parts[0], "IsGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsGroupExist", check, requestInfo, map[string]string{"GroupId": parts[0]})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleGroupDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"GroupId":         parts[0],
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      parts[1],
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ons-inner",
+			"Action":        "ConsoleGroupDelete",
+			"Version":       "2018-02-05",
+			"ProductName":   "Ons-inner",
+			"PreventCache":  "",
+			"GroupId":       parts[0],
+			"OnsRegionId":   client.RegionId,
+			"InstanceId":    parts[1],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -52,7 +53,7 @@ func TestAccAlibabacloudStackOnsGroup_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ons_group.default"
 	ra := resourceAttrInit(resourceId, onsGroupBasicMap)
 	serviceFunc := func() interface{} {
-		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsInstanceCreate,
-		Read:   resourceAlibabacloudStackOnsInstanceRead,
-		Update: resourceAlibabacloudStackOnsInstanceUpdate,
-		Delete: resourceAlibabacloudStackOnsInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -82,7 +83,7 @@ func resourceAlibabacloudStackOnsInstance() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	maxrtps := d.Get("tps_receive_max").(int)
@@ -96,7 +97,6 @@ func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta int
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
 		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
 		"Department":        client.Department,
 		"ResourceGroup":     client.ResourceGroup,
 		"Product":           "Ons-inner",
@@ -143,13 +143,13 @@ func resourceAlibabacloudStackOnsInstanceCreate(d *schema.ResourceData, meta int
 	}
 	d.SetId(ins_resp.Data.InstanceID)
 
-	return resourceAlibabacloudStackOnsInstanceUpdate(d, meta)
+	return resourceAlibabacloudStackOnsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 
 	response, err := onsService.DescribeOnsInstance(d.Id())
 
@@ -170,9 +170,9 @@ func resourceAlibabacloudStackOnsInstanceRead(d *schema.ResourceData, meta inter
 	return nil
 }
 
-func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 	independentname := d.Get("independent_naming").(string)
 	cluster := d.Get("cluster").(string)
 	attributeUpdate := false
@@ -252,7 +252,6 @@ func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta int
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
 		"RegionId":          client.RegionId,
-		"AccessKeySecret":   client.SecretKey,
 		"Department":        client.Department,
 		"ResourceGroup":     client.ResourceGroup,
 		"Product":           "Ons-inner",
@@ -299,33 +298,32 @@ func resourceAlibabacloudStackOnsInstanceUpdate(d *schema.ResourceData, meta int
 
 	}
 
-	return resourceAlibabacloudStackOnsInstanceRead(d, meta)
+	return resourceAlibabacloudStackOnsInstanceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := onsService.DescribeOnsInstance(d.Id())
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsInstanceExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsInstanceExist", check, requestInfo, map[string]string{"InstanceId": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleInstanceDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      d.Id(),
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ons-inner",
+			"Action":        "ConsoleInstanceDelete",
+			"Version":       "2018-02-05",
+			"ProductName":   "Ons-inner",
+			"PreventCache":  "",
+			"OnsRegionId":   client.RegionId,
+			"InstanceId":    d.Id(),
 		}
 
 		request.Method = "POST"
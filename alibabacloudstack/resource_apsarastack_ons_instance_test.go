@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"strings"
@@ -17,7 +18,7 @@ func TestAccAlibabacloudStackOnsInstance_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ons_instance.default"
 	ra := resourceAttrInit(resourceId, onsInstanceBasicMap)
 	serviceFunc := func() interface{} {
-		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
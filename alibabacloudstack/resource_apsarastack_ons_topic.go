@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -19,12 +20,12 @@ import (
 
 func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOnsTopicCreate,
-		Read:   resourceAlibabacloudStackOnsTopicRead,
-		Update: resourceAlibabacloudStackOnsTopicUpdate,
-		Delete: resourceAlibabacloudStackOnsTopicDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOnsTopicCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOnsTopicRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOnsTopicUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOnsTopicDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -56,7 +57,7 @@ func resourceAlibabacloudStackOnsTopic() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	ordertype := d.Get("message_type").(string)
 	instanceId := d.Get("instance_id").(string)
@@ -64,20 +65,19 @@ func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interf
 	topic := d.Get("topic").(string)
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleTopicCreate",
-		"Version":         "2018-02-05",
-		"ProductName":     "Ons-inner",
-		"PreventCache":    "",
-		"OrderType":       ordertype,
-		"Topic":           topic,
-		"Remark":          remark,
-		"OnsRegionId":     client.RegionId,
-		"InstanceId":      instanceId,
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "Ons-inner",
+		"Action":        "ConsoleTopicCreate",
+		"Version":       "2018-02-05",
+		"ProductName":   "Ons-inner",
+		"PreventCache":  "",
+		"OrderType":     ordertype,
+		"Topic":         topic,
+		"Remark":        remark,
+		"OnsRegionId":   client.RegionId,
+		"InstanceId":    instanceId,
 	}
 	request.Method = "POST"
 	request.Product = "Ons-inner"
@@ -111,13 +111,13 @@ func resourceAlibabacloudStackOnsTopicCreate(d *schema.ResourceData, meta interf
 	}
 	d.SetId(topic + COLON_SEPARATED + instanceId)
 
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 
 	object, err := onsService.DescribeOnsTopic(d.Id())
 	if err != nil {
@@ -137,13 +137,13 @@ func resourceAlibabacloudStackOnsTopicRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackOnsTopicUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackOnsTopicRead(d, meta)
+func resourceAlibabacloudStackOnsTopicUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackOnsTopicRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOnsTopicDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	onsService := OnsService{client}
+	onsService := OnsService{client, ctx}
 	var requestInfo *ecs.Client
 
 	check, err := onsService.DescribeOnsTopic(d.Id())
@@ -153,22 +153,21 @@ func resourceAlibabacloudStackOnsTopicDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsTopicExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsTopicExist", check, requestInfo, map[string]string{"Topic": d.Id()})
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Department":      client.Department,
-			"ResourceGroup":   client.ResourceGroup,
-			"Product":         "Ons-inner",
-			"Action":          "ConsoleTopicDelete",
-			"Version":         "2018-02-05",
-			"ProductName":     "Ons-inner",
-			"PreventCache":    "",
-			"Topic":           parts[0],
-			"OnsRegionId":     client.RegionId,
-			"InstanceId":      parts[1],
+			"RegionId":      client.RegionId,
+			"Department":    client.Department,
+			"ResourceGroup": client.ResourceGroup,
+			"Product":       "Ons-inner",
+			"Action":        "ConsoleTopicDelete",
+			"Version":       "2018-02-05",
+			"ProductName":   "Ons-inner",
+			"PreventCache":  "",
+			"Topic":         parts[0],
+			"OnsRegionId":   client.RegionId,
+			"InstanceId":    parts[1],
 		}
 
 		request.Method = "POST"
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
@@ -53,7 +54,7 @@ func TestAccAlibabacloudStackOnsTopic_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ons_topic.default"
 	ra := resourceAttrInit(resourceId, onsTopicBasicMap)
 	serviceFunc := func() interface{} {
-		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OnsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,24 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackOosExecution() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOosExecutionCreate,
-		Read:   resourceAlibabacloudStackOosExecutionRead,
-		Delete: resourceAlibabacloudStackOosExecutionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOosExecutionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOosExecutionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOosExecutionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(11 * time.Minute),
@@ -130,9 +130,9 @@ func resourceAlibabacloudStackOosExecution() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOosExecutionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosExecutionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	oosService := OosService{client}
+	oosService := OosService{client, ctx}
 	var response map[string]interface{}
 	action := "StartExecution"
 	request := make(map[string]interface{})
@@ -177,34 +177,23 @@ func resourceAlibabacloudStackOosExecutionCreate(d *schema.ResourceData, meta in
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_execution", action, AlibabacloudStackSdkGoERROR)
 	}
 	responseExecution := response["Execution"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseExecution["ExecutionId"]))
 	stateConf := BuildStateConf([]string{}, []string{"Success"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, oosService.OosExecutionStateRefreshFunc(d.Id(), []string{"Failed"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackOosExecutionRead(d, meta)
+	return resourceAlibabacloudStackOosExecutionRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosExecutionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosExecutionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	oosService := OosService{client}
+	oosService := OosService{client, ctx}
 	object, err := oosService.DescribeOosExecution(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -233,7 +222,7 @@ func resourceAlibabacloudStackOosExecutionRead(d *schema.ResourceData, meta inte
 	d.Set("update_date", object["UpdateDate"])
 	return nil
 }
-func resourceAlibabacloudStackOosExecutionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosExecutionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteExecutions"
 	var response map[string]interface{}
@@ -248,19 +237,8 @@ func resourceAlibabacloudStackOosExecutionDelete(d *schema.ResourceData, meta in
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
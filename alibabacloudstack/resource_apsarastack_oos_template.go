@@ -1,26 +1,26 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackOosTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOosTemplateCreate,
-		Read:   resourceAlibabacloudStackOosTemplateRead,
-		Update: resourceAlibabacloudStackOosTemplateUpdate,
-		Delete: resourceAlibabacloudStackOosTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOosTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOosTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOosTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOosTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"auto_delete_executions": {
 				Type:     schema.TypeBool,
@@ -56,7 +56,8 @@ func resourceAlibabacloudStackOosTemplate() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"template_format": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,7 +95,7 @@ func resourceAlibabacloudStackOosTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateTemplate"
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta int
 	}
 	request["Content"] = d.Get("content")
 	request["RegionId"] = client.RegionId
-	if v, ok := d.GetOk("tags"); ok {
+	if v, ok := d.GetOk("tags_all"); ok {
 		respJson, err := convertMaptoJsonString(v.(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -118,30 +119,19 @@ func resourceAlibabacloudStackOosTemplateCreate(d *schema.ResourceData, meta int
 	}
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", action, AlibabacloudStackSdkGoERROR)
 	}
 	responseTemplate := response["Template"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseTemplate["TemplateName"]))
 
-	return resourceAlibabacloudStackOosTemplateRead(d, meta)
+	return resourceAlibabacloudStackOosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	oosService := OosService{client}
+	oosService := OosService{client, ctx}
 	object, err := oosService.DescribeOosTemplate(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -159,7 +149,9 @@ func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta inter
 	d.Set("has_trigger", object["HasTrigger"])
 	d.Set("share_type", object["ShareType"])
 	if v, ok := object["Tags"].(map[string]interface{}); ok {
-		d.Set("tags", tagsToMap(v))
+		if err := setResourceTags(d, meta, tagsToMap(v)); err != nil {
+			return WrapError(err)
+		}
 	}
 	d.Set("template_format", object["TemplateFormat"])
 	d.Set("template_id", object["TemplateId"])
@@ -169,7 +161,7 @@ func resourceAlibabacloudStackOosTemplateRead(d *schema.ResourceData, meta inter
 	d.Set("updated_date", object["UpdatedDate"])
 	return nil
 }
-func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -183,9 +175,9 @@ func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta int
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		update = true
-		respJson, err := convertMaptoJsonString(d.Get("tags").(map[string]interface{}))
+		respJson, err := convertMaptoJsonString(d.Get("tags_all").(map[string]interface{}))
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oos_template", "UpdateTemplate", AlibabacloudStackSdkGoERROR)
 		}
@@ -201,26 +193,15 @@ func resourceAlibabacloudStackOosTemplateUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackOosTemplateRead(d, meta)
+	return resourceAlibabacloudStackOosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackOosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOosTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteTemplate"
 	var response map[string]interface{}
@@ -238,19 +219,8 @@ func resourceAlibabacloudStackOosTemplateDelete(d *schema.ResourceData, meta int
 	request["RegionId"] = client.RegionId
 	request["Product"] = "Oos"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-06-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"EntityNotExists.Template"}) {
 			return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -96,7 +97,7 @@ func TestAccAlicloudOosTemplate_basic(t *testing.T) {
 	resourceId := "alicloud_oos_template.default"
 	ra := resourceAttrInit(resourceId, OosTemplateMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &OosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeOosTemplate")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
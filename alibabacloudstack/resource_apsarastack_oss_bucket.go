@@ -2,6 +2,7 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -23,14 +24,15 @@ import (
 
 func resourceAlibabacloudStackOssBucket() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketCreate,
-		Read:   resourceAlibabacloudStackOssBucketRead,
-		Update: resourceAlibabacloudStackOssBucketUpdate,
-		Delete: resourceAlibabacloudStackOssBucketDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"bucket": {
 				Type:         schema.TypeString,
@@ -275,7 +277,8 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 				MaxItems: 1,
 			},
 
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 
 			"force_destroy": {
 				Type:     schema.TypeBool,
@@ -309,9 +312,9 @@ func resourceAlibabacloudStackOssBucket() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	request := map[string]string{"bucketName": d.Get("bucket").(string), "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
@@ -352,7 +355,6 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 		}
 		request.QueryParams = map[string]string{
 
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -405,7 +407,7 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 
 	}
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		det, err := ossService.DescribeOssBucket(bucketName)
 		if err != nil {
 			return resource.NonRetryableError(err)
@@ -425,30 +427,30 @@ func resourceAlibabacloudStackOssBucketCreate(d *schema.ResourceData, meta inter
 	if v := d.Get("logging"); v != nil {
 		log.Printf("Enter for logging condition passed")
 
-		err = resourceAlibabacloudStackOssBucketLoggingCreate(client, d)
+		err = resourceAlibabacloudStackOssBucketLoggingCreate(ctx, client, d)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_oss_bucket", "Logging Failed", AlibabacloudStackOssGoSdk)
 		}
 	}
 	newlist := d.Get("vpclist").([]interface{})
 	bvclient := meta.(*connectivity.AlibabacloudStackClient)
-	bvserver := BucketVpcService{bvclient}
+	bvserver := BucketVpcService{bvclient, ctx}
 	vpclist, binderr := bvserver.BucketVpcList(bucketName)
 	if binderr != nil {
 		return WrapError(binderr)
 	}
 	oldlist := vpclist.VpcList
-	vpc_err := checkVpcListChange(oldlist, newlist, d, meta)
+	vpc_err := checkVpcListChange(ctx, oldlist, newlist, d, meta)
 	if vpc_err != nil {
 		return WrapError(vpc_err)
 	}
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	object, err := ossService.DescribeOssBucket(d.Id())
 	acl := d.Get("acl").(string)
 	if acl == "" {
@@ -483,7 +485,7 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 		return WrapError(err)
 	}
 	bvclient := meta.(*connectivity.AlibabacloudStackClient)
-	bvserver := BucketVpcService{bvclient}
+	bvserver := BucketVpcService{bvclient, ctx}
 	vpclist, binderr := bvserver.BucketVpcList(d.Get("bucket").(string))
 	if binderr != nil {
 		return WrapError(binderr)
@@ -754,7 +756,7 @@ func resourceAlibabacloudStackOssBucketRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -764,7 +766,7 @@ func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta inter
 		//}
 		////d.SetPartial("logging")
 		log.Print("changes in logging")
-		err := resourceAlibabacloudStackOssBucketLoggingCreate(client, d)
+		err := resourceAlibabacloudStackOssBucketLoggingCreate(ctx, client, d)
 		if err != nil {
 			return WrapError(err)
 		}
@@ -773,18 +775,18 @@ func resourceAlibabacloudStackOssBucketUpdate(d *schema.ResourceData, meta inter
 		o, n := d.GetChange("vpclist")
 		oldlist := o.([]interface{})
 		newlist := n.([]interface{})
-		vpc_err := checkVpcListChange(oldlist, newlist, d, meta)
+		vpc_err := checkVpcListChange(ctx, oldlist, newlist, d, meta)
 		if vpc_err != nil {
 			return WrapError(vpc_err)
 		}
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackOssBucketRead(d, meta)
+	return resourceAlibabacloudStackOssBucketRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	bvclient := meta.(*connectivity.AlibabacloudStackClient)
-	bvserver := BucketVpcService{bvclient}
+	bvserver := BucketVpcService{bvclient, ctx}
 	vpclist, binderr := bvserver.BucketVpcList(d.Id())
 	if binderr != nil {
 		return WrapError(binderr)
@@ -794,7 +796,7 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 		for _, v := range vpclist.VpcList {
 			vpc := v.(map[string]interface{})
 			client2 := meta.(*connectivity.AlibabacloudStackClient)
-			bvserver := BucketVpcService{client2}
+			bvserver := BucketVpcService{client2, ctx}
 			binderr := bvserver.UnBindBucket(vpc["vpcId"].(string), d.Id())
 			if binderr != nil {
 				return WrapError(binderr)
@@ -803,7 +805,7 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 	}
 	d.Set("vpclist", vlist)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	det, err := ossService.DescribeOssBucket(d.Id())
 	if err != nil {
@@ -814,14 +816,13 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 		return nil
 	}
 
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
 
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -871,7 +872,7 @@ func resourceAlibabacloudStackOssBucketDelete(d *schema.ResourceData, meta inter
 	return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
 }
 
-func checkVpcListChange(oldlist []interface{}, newlist []interface{}, d *schema.ResourceData, meta interface{}) error {
+func checkVpcListChange(ctx context.Context, oldlist []interface{}, newlist []interface{}, d *schema.ResourceData, meta interface{}) error {
 	vpclist := []string{}
 	for _, ovpcid := range oldlist {
 		isdelete := true
@@ -882,7 +883,7 @@ func checkVpcListChange(oldlist []interface{}, newlist []interface{}, d *schema.
 		}
 		if isdelete {
 			client2 := meta.(*connectivity.AlibabacloudStackClient)
-			bvserver := BucketVpcService{client2}
+			bvserver := BucketVpcService{client2, ctx}
 			binderr := bvserver.UnBindBucket(ovpcid.(string), d.Id())
 			if binderr != nil {
 				return WrapError(binderr)
@@ -899,13 +900,13 @@ func checkVpcListChange(oldlist []interface{}, newlist []interface{}, d *schema.
 		}
 		if iscreate {
 			client := meta.(*connectivity.AlibabacloudStackClient)
-			vpcServer := VpcService{client}
+			vpcServer := VpcService{client, ctx}
 			vpcdata, err := vpcServer.DescribeVpc(nvpcid.(string))
 			if err != nil {
 				return WrapError(err)
 			}
 			client2 := meta.(*connectivity.AlibabacloudStackClient)
-			bvserver := BucketVpcService{client2}
+			bvserver := BucketVpcService{client2, ctx}
 			binderr := bvserver.BindBucket(vpcdata.VpcId, vpcdata.VpcName, vpcdata.CidrBlock, d.Id())
 			if binderr != nil {
 				return WrapError(binderr)
@@ -942,7 +943,7 @@ func transitionsHash(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) error {
+func resourceAlibabacloudStackOssBucketLoggingCreate(ctx context.Context, client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) error {
 	describelogging, err := resourceAlibabacloudStackOssBucketLoggingDescribe(client, d)
 
 	if err != nil {
@@ -959,7 +960,6 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 			}
 			logrequest.QueryParams = map[string]string{
 
-				"AccessKeySecret":  client.SecretKey,
 				"Product":          "OneRouter",
 				"Department":       client.Department,
 				"ResourceGroup":    client.ResourceGroup,
@@ -1023,7 +1023,7 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 					//log.Printf("checking b %v",b)
 					//bucket:= bytes.NewBuffer(b).String()
 					//log.Printf("Checking buckets %v",bucket)
-					ossService := OssService{client}
+					ossService := OssService{client, ctx}
 					_, err := ossService.DescribeOssBucket(bucket)
 
 					if err != nil {
@@ -1035,7 +1035,6 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 					}
 					logrequest.QueryParams = map[string]string{
 
-						"AccessKeySecret":  client.SecretKey,
 						"Product":          "OneRouter",
 						"Department":       client.Department,
 						"ResourceGroup":    client.ResourceGroup,
@@ -1101,7 +1100,7 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 				//log.Printf("checking b %v",b)
 				//bucket:= bytes.NewBuffer(b).String()
 				//log.Printf("Checking buckets %v",bucket)
-				ossService := OssService{client}
+				ossService := OssService{client, ctx}
 				_, err := ossService.DescribeOssBucket(bucket)
 
 				if err != nil {
@@ -1113,7 +1112,6 @@ func resourceAlibabacloudStackOssBucketLoggingCreate(client *connectivity.Alibab
 				}
 				logrequest.QueryParams = map[string]string{
 
-					"AccessKeySecret":  client.SecretKey,
 					"Product":          "OneRouter",
 					"Department":       client.Department,
 					"ResourceGroup":    client.ResourceGroup,
@@ -1172,7 +1170,6 @@ func resourceAlibabacloudStackOssBucketLoggingDescribe(client *connectivity.Alib
 	describelogging := Logging{}
 	logdescribe.QueryParams = map[string]string{
 
-		"AccessKeySecret":   client.SecretKey,
 		"Product":           "OneRouter",
 		"Department":        client.Department,
 		"ResourceGroup":     client.ResourceGroup,
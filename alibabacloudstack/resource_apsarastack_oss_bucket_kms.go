@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -18,10 +19,13 @@ import (
 
 func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketKmsCreate,
-		Read:   resourceAlibabacloudStackOssBucketKmsRead,
-		Update: resourceAlibabacloudStackOssBucketKmsCreate,
-		Delete: resourceAlibabacloudStackOssBucketKmsDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketKmsRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsCreate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketKmsDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackOssBucketKmsImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -67,9 +71,9 @@ func resourceAlibabacloudStackOssBucketKms() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
@@ -90,7 +94,6 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -145,13 +148,13 @@ func resourceAlibabacloudStackOssBucketKmsCreate(d *schema.ResourceData, meta in
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
@@ -164,7 +167,6 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -218,9 +220,9 @@ func resourceAlibabacloudStackOssBucketKmsRead(d *schema.ResourceData, meta inte
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketKmsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	det, err := ossService.DescribeOssBucket(d.Id())
 	if err != nil {
@@ -231,14 +233,13 @@ func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta in
 		return nil
 	}
 
-	err = resource.Retry(3*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Second, func() *resource.RetryError {
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
 
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -280,3 +281,18 @@ func resourceAlibabacloudStackOssBucketKmsDelete(d *schema.ResourceData, meta in
 	//return WrapError(ossService.WaitForOssBucket(d.Id(), Deleted, DefaultTimeoutMedium))
 
 }
+
+// resourceAlibabacloudStackOssBucketKmsImport imports the encryption of a bucket, which the api does not return,
+// from an id formulated as <bucket>:<sse_algorithm>:<kms_master_key_id>. The kms_master_key_id may be empty.
+func resourceAlibabacloudStackOssBucketKmsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(parts[0])
+	d.Set("bucket", parts[0])
+	d.Set("sse_algorithm", parts[1])
+	d.Set("kms_master_key_id", parts[2])
+	d.Set("acl3", oss.ACLPrivate)
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,9 +2,11 @@ package alibabacloudstack
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -17,10 +19,13 @@ import (
 
 func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketObjectPut,
-		Read:   resourceAlibabacloudStackOssBucketObjectRead,
-		Update: resourceAlibabacloudStackOssBucketObjectPut,
-		Delete: resourceAlibabacloudStackOssBucketObjectDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectPut),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketObjectRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectPut),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketObjectDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackOssBucketObjectImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -110,7 +115,7 @@ func resourceAlibabacloudStackOssBucketObject() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectPut(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
@@ -154,6 +159,10 @@ func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta in
 	if err != nil {
 		return WrapError(err)
 	}
+	// An upload replaces the tags of the object, so the object is uploaded along with them
+	if tagging := ossObjectTagging(d); len(tagging.Tags) > 0 {
+		options = append(options, oss.SetTagging(tagging))
+	}
 	if filePath != "" {
 		err = bucket.PutObjectFromFile(key, filePath, options...)
 	}
@@ -167,10 +176,10 @@ func resourceAlibabacloudStackOssBucketObjectPut(d *schema.ResourceData, meta in
 	}
 
 	d.SetId(key)
-	return resourceAlibabacloudStackOssBucketObjectRead(d, meta)
+	return resourceAlibabacloudStackOssBucketObjectRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *oss.Client
@@ -207,13 +216,29 @@ func resourceAlibabacloudStackOssBucketObjectRead(d *schema.ResourceData, meta i
 	d.Set("content_encoding", object.Get("Content-Encoding"))
 	d.Set("expires", object.Get("Expires"))
 	d.Set("version_id", object.Get("x-oss-version-id"))
+	d.Set("server_side_encryption", object.Get(oss.HTTPHeaderOssServerSideEncryption))
+	if object.Get(oss.HTTPHeaderOssServerSideEncryption) == string(ServerSideEncryptionKMS) {
+		d.Set("kms_key_id", object.Get(oss.HTTPHeaderOssServerSideEncryptionKeyID))
+	}
+
+	acl, err := bucket.GetObjectACL(d.Get("key").(string))
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectACL", AlibabacloudStackOssGoSdk)
+	}
+	addDebug("GetObjectACL", acl, requestInfo, map[string]interface{}{
+		"objectKey": d.Get("key").(string),
+	})
+	// An object without an acl of its own has the acl of its bucket, which is not one of the acl of the resource
+	if acl.ACL != string(oss.ACLDefault) {
+		d.Set("acl", acl.ACL)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketObjectDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketObjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
 		requestInfo = ossClient
@@ -277,3 +302,38 @@ func buildObjectHeaderOptions(d *schema.ResourceData) (options []oss.Option, err
 	}
 	return options, nil
 }
+
+// ossObjectBucket returns the bucket of the object.
+func ossObjectBucket(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) (*oss.Bucket, error) {
+	raw, err := client.WithOssClientPutObject(func(ossClient *oss.Client) (interface{}, error) {
+		return ossClient.Bucket(d.Get("bucket").(string))
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), "Bucket", AlibabacloudStackOssGoSdk)
+	}
+	bucket, _ := raw.(*oss.Bucket)
+	return bucket, nil
+}
+
+// ossObjectTagging returns all of the tags the object is configured with.
+func ossObjectTagging(d *schema.ResourceData) oss.Tagging {
+	var tagging oss.Tagging
+	tags, _ := d.Get("tags_all").(map[string]interface{})
+	for key, value := range tags {
+		tagging.Tags = append(tagging.Tags, oss.Tag{Key: key, Value: value.(string)})
+	}
+	return tagging
+}
+
+// resourceAlibabacloudStackOssBucketObjectImport imports an object from an id formulated as <bucket>:<key>.
+// The key may contain colons itself, so the id is only split at the first one.
+func resourceAlibabacloudStackOssBucketObjectImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), COLON_SEPARATED, 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, WrapError(Error("Invalid Resource Id %s. Expected the format <bucket>:<key>", d.Id()))
+	}
+	d.SetId(parts[1])
+	d.Set("bucket", parts[0])
+	d.Set("key", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
@@ -4,10 +4,12 @@ package alibabacloudstack
 ALIBABACLOUDSTACK_OSSSERVICE_DOMAIN=oss-cn-qingdao-env66-d01-a.intra.env66.shuguang.com;
 */
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"strings"
 	"testing"
@@ -247,3 +249,51 @@ func testAccCheckOssBucketObjectDestroyWithProvider(s *terraform.State, provider
 /*
 ALIBABACLOUDSTACK_OSSSERVICE_DOMAIN=oss-cn-qingdao-env66-d01-a.intra.env66.shuguang.com;
 */
+
+func TestUnitAlibabacloudStackOssBucketObject_import(t *testing.T) {
+	var aclCalls int
+	ossServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/tf-mock-bucket/path/to:object.txt" {
+			t.Errorf("expected the requests to be sent to the object path/to:object.txt of tf-mock-bucket, got %s", r.URL.Path)
+		}
+		if _, ok := r.URL.Query()["acl"]; ok {
+			aclCalls++
+			w.Header().Set("Content-Type", "application/xml")
+			fmt.Fprint(w, `<AccessControlPolicy><AccessControlList><Grant>public-read</Grant></AccessControlList></AccessControlPolicy>`)
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		w.Header().Set("X-Oss-Server-Side-Encryption", "KMS")
+		w.Header().Set("X-Oss-Server-Side-Encryption-Key-Id", "key-mock0001")
+	}))
+	defer ossServer.Close()
+	client := newMockApiServer(t).client()
+	client.Config.OssServerEndpoint = ossServer.URL
+
+	r := resourceAlibabacloudStackOssBucketObject()
+	d := r.Data(&terraform.InstanceState{ID: "tf-mock-bucket:path/to:object.txt"})
+	imported, err := r.Importer.StateContext(context.Background(), d, client)
+	if err != nil {
+		t.Fatalf("importing the object got an error: %#v", err)
+	}
+	d = imported[0]
+	if diags := r.ReadContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("reading the imported object got an error: %#v", diags)
+	}
+	if d.Id() != "path/to:object.txt" || d.Get("bucket") != "tf-mock-bucket" {
+		t.Errorf("expected the object path/to:object.txt of tf-mock-bucket, got %s of %v", d.Id(), d.Get("bucket"))
+	}
+	if aclCalls != 1 {
+		t.Errorf("expected the acl of the object to be read once, it was read %d times", aclCalls)
+	}
+	for key, expected := range map[string]string{
+		"acl":                    "public-read",
+		"server_side_encryption": "KMS",
+		"kms_key_id":             "key-mock0001",
+		"content_type":           "text/plain",
+	} {
+		if value := d.Get(key); value != expected {
+			t.Errorf("expected the imported object to have the %s %s, got %v", key, expected, value)
+		}
+	}
+}
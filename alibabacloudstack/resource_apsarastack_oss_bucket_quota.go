@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,10 +18,13 @@ import (
 
 func resourceAlibabacloudStackOssBucketQuota() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackOssBucketQuotaCreate,
-		Read:   resourceAlibabacloudStackOssBucketQuotaRead,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackOssBucketQuotaCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackOssBucketQuotaRead),
 		//Update: resourceAlibabacloudStackOssBucketQuotaCreate,
-		Delete: resourceAlibabacloudStackOssBucketQuotaDelete,
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackOssBucketQuotaDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackOssBucketQuotaImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"bucket": {
@@ -36,9 +41,9 @@ func resourceAlibabacloudStackOssBucketQuota() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketQuotaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
@@ -53,7 +58,6 @@ func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -108,13 +112,13 @@ func resourceAlibabacloudStackOssBucketQuotaCreate(d *schema.ResourceData, meta
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketQuotaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
@@ -127,7 +131,6 @@ func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta in
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -181,9 +184,9 @@ func resourceAlibabacloudStackOssBucketQuotaRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackOssBucketQuotaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, ctx}
 	var requestInfo *oss.Client
 	bucketName := d.Get("bucket").(string)
 	det, err := ossService.DescribeOssBucket(bucketName)
@@ -198,7 +201,6 @@ func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret":  client.SecretKey,
 			"Product":          "OneRouter",
 			"Department":       client.Department,
 			"ResourceGroup":    client.ResourceGroup,
@@ -253,5 +255,22 @@ func resourceAlibabacloudStackOssBucketQuotaDelete(d *schema.ResourceData, meta
 	// Assign the bucket name as the resource ID
 	d.SetId(bucketName)
 
-	return resourceAlibabacloudStackOssBucketKmsRead(d, meta)
+	return resourceAlibabacloudStackOssBucketKmsRead(ctx, d, meta)
+}
+
+// resourceAlibabacloudStackOssBucketQuotaImport imports the quota of a bucket, which the api does not return,
+// from an id formulated as <bucket>:<quota>.
+func resourceAlibabacloudStackOssBucketQuotaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	quota, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return nil, WrapError(Error("the quota %q of the id %s is not a number", parts[1], d.Id()))
+	}
+	d.SetId(parts[0])
+	d.Set("bucket", parts[0])
+	d.Set("quota", quota)
+	return []*schema.ResourceData{d}, nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -101,7 +102,7 @@ func TestAccAlibabacloudStackOssBucketBasic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, ossBucketBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OssService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -169,7 +170,7 @@ func TestAccAlibabacloudStackOssBucketBasic(t *testing.T) {
 
 func testAccCheckOssBucketDestroy(s *terraform.State) error { //destroy function
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ossService := OssService{client}
+	ossService := OssService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_oss_bucket" || rs.Type != "alibabacloudstack_oss_bucket" {
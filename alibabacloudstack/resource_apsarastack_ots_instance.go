@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -13,14 +14,15 @@ import (
 
 func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceCreate,
-		Read:   resourceAliyunOtsInstanceRead,
-		Update: resourceAliyunOtsInstanceUpdate,
-		Delete: resourceAliyunOtsInstanceDelete,
+		CreateContext: withDiagnostics(resourceAliyunOtsInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAliyunOtsInstanceRead),
+		UpdateContext: withDiagnostics(resourceAliyunOtsInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAliyunOtsInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -55,14 +57,15 @@ func resourceAlibabacloudStackOtsInstance() *schema.Resource {
 					return d.Id() != ""
 				},
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 
 	instanceType := d.Get("instance_type").(string)
 	request := ots.CreateInsertInstanceRequest()
@@ -70,7 +73,7 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	request.ClusterType = convertInstanceType(OtsInstanceType(instanceType))
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	types, err := otsService.DescribeOtsInstanceTypes()
 	if err != nil {
@@ -103,12 +106,12 @@ func resourceAliyunOtsInstanceCreate(d *schema.ResourceData, meta interface{}) e
 	if err := otsService.WaitForOtsInstance(request.InstanceName, Running, DefaultTimeout/3); err != nil {
 		return WrapError(err)
 	}
-	return resourceAliyunOtsInstanceUpdate(d, meta)
+	return resourceAliyunOtsInstanceUpdate(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 	object, err := otsService.DescribeOtsInstance(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -122,13 +125,15 @@ func resourceAliyunOtsInstanceRead(d *schema.ResourceData, meta interface{}) err
 	d.Set("accessed_by", convertInstanceAccessedByRevert(object.Network))
 	d.Set("instance_type", convertInstanceTypeRevert(object.ClusterType))
 	d.Set("description", object.Description)
-	d.Set("tags", otsTagsToMap(object.TagInfos.TagInfo))
+	if err := setResourceTags(d, meta, otsTagsToMap(object.TagInfos.TagInfo)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
-func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 
 	d.Partial(true)
 
@@ -137,7 +142,7 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
 
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceName = d.Id()
 		request.Network = convertInstanceAccessedBy(InstanceAccessedByType(d.Get("accessed_by").(string)))
 		raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
@@ -150,8 +155,8 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		d.SetPartial("accessed_by")
 	}
 
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -182,7 +187,7 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 			request.RegionId = client.RegionId
 			request.Headers = map[string]string{"RegionId": client.RegionId}
 
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.InstanceName = d.Id()
 			var tags []ots.InsertTagsTagInfo
 			for _, t := range create {
@@ -206,20 +211,20 @@ func resourceAliyunOtsInstanceUpdate(d *schema.ResourceData, meta interface{}) e
 		return WrapError(err)
 	}
 	d.Partial(false)
-	return resourceAliyunOtsInstanceRead(d, meta)
+	return resourceAliyunOtsInstanceRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 	request := ots.CreateDeleteInstanceRequest()
 	request.RegionId = client.RegionId
 	//request.Headers["x-ascm-product-name"] = "Ots"
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.InstanceName = d.Id()
-	err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 			return otsClient.DeleteInstance(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ots"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -8,9 +9,12 @@ import (
 
 func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunOtsInstanceAttachmentCreate,
-		Read:   resourceAliyunOtsInstanceAttachmentRead,
-		Delete: resourceAliyunOtsInstanceAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAliyunOtsInstanceAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAliyunOtsInstanceAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAliyunOtsInstanceAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAliyunOtsInstanceAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"instance_name": {
@@ -37,9 +41,9 @@ func resourceAlibabacloudStackOtsInstanceAttachment() *schema.Resource {
 	}
 }
 
-func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := ots.CreateBindInstance2VpcRequest()
 	request.RegionId = client.RegionId
@@ -48,7 +52,7 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 	request.VirtualSwitchId = d.Get("vswitch_id").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if vsw, err := vpcService.DescribeVSwitch(d.Get("vswitch_id").(string)); err != nil {
 		return WrapError(err)
 	} else {
@@ -64,12 +68,12 @@ func resourceAliyunOtsInstanceAttachmentCreate(d *schema.ResourceData, meta inte
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
 	d.SetId(request.InstanceName)
-	return resourceAliyunOtsInstanceAttachmentRead(d, meta)
+	return resourceAliyunOtsInstanceAttachmentRead(ctx, d, meta)
 }
 
-func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -78,16 +82,17 @@ func resourceAliyunOtsInstanceAttachmentRead(d *schema.ResourceData, meta interf
 		}
 		return WrapError(err)
 	}
-	// There is a bug that inst does not contain instance name and vswitch ID, so this resource does not support import function.
-	//d.Set("instance_name", inst.InstanceName)
+	// The attachment does not contain the instance name and vswitch ID, the instance name is the id
+	// and the vswitch ID is given when importing.
+	d.Set("instance_name", d.Id())
 	d.Set("vpc_name", object.InstanceVpcName)
 	d.Set("vpc_id", object.VpcId)
 	return nil
 }
 
-func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunOtsInstanceAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	otsService := OtsService{client}
+	otsService := OtsService{client, ctx}
 	object, err := otsService.DescribeOtsInstanceAttachment(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -101,7 +106,7 @@ func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta inte
 	request.InstanceVpcName = object.InstanceVpcName
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	raw, err := client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.UnbindInstance2Vpc(request)
 	})
@@ -114,3 +119,15 @@ func resourceAliyunOtsInstanceAttachmentDelete(d *schema.ResourceData, meta inte
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	return WrapError(otsService.WaitForOtsInstanceVpc(d.Id(), Deleted, DefaultTimeout))
 }
+
+// resourceAliyunOtsInstanceAttachmentImport imports the attachment from an id formulated as <instance_name>:<vswitch_id>,
+// because the api does not return the vswitch.
+func resourceAliyunOtsInstanceAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(parts[0])
+	d.Set("vswitch_id", parts[1])
+	return []*schema.ResourceData{d}, nil
+}
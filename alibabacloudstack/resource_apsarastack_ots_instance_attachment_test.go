@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -17,7 +18,7 @@ func TestAccAlibabacloudStackOtsInstanceAttachmentBasic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, otsInstanceAttachmentBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -62,7 +63,7 @@ func TestAccAlibabacloudStackOtsInstanceAttachmentHighPerformance(t *testing.T)
 	ra := resourceAttrInit(resourceId, otsInstanceAttachmentBasicMap)
 
 	serviceFunc := func() interface{} {
-		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"testing"
@@ -18,7 +19,7 @@ func TestAccAlibabacloudStackOtsTable_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ots_table.default"
 	ra := resourceAttrInit(resourceId, otsTableBasicMap)
 	serviceFunc := func() interface{} {
-		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &OtsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -317,7 +318,7 @@ func testAccCheckOtsTableExist(n string, table *tablestore.DescribeTableResponse
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		otsService := OtsService{client}
+		otsService := OtsService{client, context.Background()}
 
 		response, err := otsService.DescribeOtsTable(rs.Primary.ID)
 
@@ -337,7 +338,7 @@ func testAccCheckOtsTableDestroy(s *terraform.State) error {
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		otsService := OtsService{client}
+		otsService := OtsService{client, context.Background()}
 
 		if _, err := otsService.DescribeOtsTable(rs.Primary.ID); err != nil {
 			if NotFoundError(err) {
@@ -0,0 +1,261 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackPolarDBAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackPolarDBAccountCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackPolarDBAccountRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackPolarDBAccountUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackPolarDBAccountDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"account_password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(8, 32),
+			},
+			"account_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "Normal",
+				ValidateFunc: validation.StringInSlice([]string{"Normal", "Super"}, false),
+			},
+			"account_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"database_privileges": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"account_privilege": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "ReadWrite",
+							ValidateFunc: validation.StringInSlice([]string{"ReadWrite", "ReadOnly", "DMLOnly", "DDLOnly"}, false),
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackPolarDBAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	clusterId := d.Get("db_cluster_id").(string)
+
+	request := polardb.CreateCreateAccountRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = clusterId
+	request.AccountName = d.Get("account_name").(string)
+	request.AccountPassword = d.Get("account_password").(string)
+	request.AccountType = d.Get("account_type").(string)
+	if v, ok := d.GetOk("account_description"); ok {
+		request.AccountDescription = v.(string)
+	}
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.CreateAccount(request)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_polardb_account", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, request.AccountName))
+
+	if err := polarDBService.WaitForPolarDBClusterRunning(clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackPolarDBAccountUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	object, err := polarDBService.DescribePolarDBAccount(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("account_name", object.AccountName)
+	d.Set("account_type", object.AccountType)
+	d.Set("account_description", object.AccountDescription)
+	d.Set("status", object.AccountStatus)
+
+	privileges := make([]map[string]interface{}, 0, len(object.DatabasePrivileges))
+	for _, privilege := range object.DatabasePrivileges {
+		privileges = append(privileges, map[string]interface{}{
+			"db_name":           privilege.DBName,
+			"account_privilege": privilege.AccountPrivilege,
+		})
+	}
+	if err := d.Set("database_privileges", privileges); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackPolarDBAccountUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+	d.Partial(true)
+
+	if !d.IsNewResource() && d.HasChange("account_password") {
+		request := polardb.CreateModifyAccountPasswordRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = parts[0]
+		request.AccountName = parts[1]
+		request.NewAccountPassword = d.Get("account_password").(string)
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyAccountPassword(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if !d.IsNewResource() && d.HasChange("account_description") {
+		request := polardb.CreateModifyAccountDescriptionRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = parts[0]
+		request.AccountName = parts[1]
+		request.AccountDescription = d.Get("account_description").(string)
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyAccountDescription(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if d.HasChange("database_privileges") {
+		o, n := d.GetChange("database_privileges")
+		oldPrivileges := polarDBAccountPrivileges(o.(*schema.Set))
+		newPrivileges := polarDBAccountPrivileges(n.(*schema.Set))
+
+		// A changed privilege on a database is revoked before the new privilege is granted.
+		for dbName, privilege := range oldPrivileges {
+			if newPrivileges[dbName] == privilege {
+				continue
+			}
+			request := polardb.CreateRevokeAccountPrivilegeRequest()
+			polarDBService.initRequest(request.RpcRequest)
+			request.DBClusterId = parts[0]
+			request.AccountName = parts[1]
+			request.DBName = dbName
+			if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+				return polarDBClient.RevokeAccountPrivilege(request)
+			}); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+		}
+		for dbName, privilege := range newPrivileges {
+			if oldPrivileges[dbName] == privilege {
+				continue
+			}
+			request := polardb.CreateGrantAccountPrivilegeRequest()
+			polarDBService.initRequest(request.RpcRequest)
+			request.DBClusterId = parts[0]
+			request.AccountName = parts[1]
+			request.DBName = dbName
+			request.AccountPrivilege = privilege
+			if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+				return polarDBClient.GrantAccountPrivilege(request)
+			}); err != nil {
+				return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+			}
+		}
+		if err := polarDBService.WaitForPolarDBClusterRunning(parts[0], d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackPolarDBAccountRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := polardb.CreateDeleteAccountRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = parts[0]
+	request.AccountName = parts[1]
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DeleteAccount(request)
+	}); err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidAccountName.NotFound")) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return WrapError(polarDBService.WaitForPolarDBClusterRunning(parts[0], d.Timeout(schema.TimeoutDelete)))
+}
+
+// polarDBAccountPrivileges maps the databases of the privileges to the privilege of the account on them.
+func polarDBAccountPrivileges(set *schema.Set) map[string]string {
+	privileges := make(map[string]string)
+	for _, v := range set.List() {
+		privilege := v.(map[string]interface{})
+		privileges[privilege["db_name"].(string)] = privilege["account_privilege"].(string)
+	}
+	return privileges
+}
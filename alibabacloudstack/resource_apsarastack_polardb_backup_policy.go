@@ -0,0 +1,168 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackPolarDBBackupPolicy() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackPolarDBBackupPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackPolarDBBackupPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackPolarDBBackupPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackPolarDBBackupPolicyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"preferred_backup_period": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice([]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}, false),
+				},
+			},
+			"preferred_backup_time": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(BACKUP_TIME, false),
+			},
+			"data_level1_backup_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(3, 14),
+			},
+			"data_level2_backup_retention_period": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"backup_retention_policy_on_cluster_deletion": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ALL", "LATEST", "NONE"}, false),
+			},
+			"backup_retention_period": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackPolarDBBackupPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+
+	d.SetId(d.Get("db_cluster_id").(string))
+
+	return resourceAlibabacloudStackPolarDBBackupPolicyUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBBackupPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	object, err := polarDBService.DescribePolarDBBackupPolicy(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("db_cluster_id", d.Id())
+	d.Set("preferred_backup_period", strings.Split(object.PreferredBackupPeriod, COMMA_SEPARATED))
+	d.Set("preferred_backup_time", object.PreferredBackupTime)
+	d.Set("backup_retention_period", strconv.Itoa(object.BackupRetentionPeriod))
+	d.Set("backup_retention_policy_on_cluster_deletion", object.BackupRetentionPolicyOnClusterDeletion)
+	if v, err := strconv.Atoi(object.DataLevel1BackupRetentionPeriod); err == nil {
+		d.Set("data_level1_backup_retention_period", v)
+	}
+	if v, err := strconv.Atoi(object.DataLevel2BackupRetentionPeriod); err == nil {
+		d.Set("data_level2_backup_retention_period", v)
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackPolarDBBackupPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	if d.HasChanges("preferred_backup_period", "preferred_backup_time", "data_level1_backup_retention_period", "data_level2_backup_retention_period", "backup_retention_policy_on_cluster_deletion") {
+		request := polardb.CreateModifyBackupPolicyRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		if v, ok := d.GetOk("preferred_backup_period"); ok {
+			request.PreferredBackupPeriod = strings.Join(expandStringList(v.(*schema.Set).List()), COMMA_SEPARATED)
+		}
+		if v, ok := d.GetOk("preferred_backup_time"); ok {
+			request.PreferredBackupTime = v.(string)
+		}
+		if v, ok := d.GetOk("data_level1_backup_retention_period"); ok {
+			request.DataLevel1BackupRetentionPeriod = strconv.Itoa(v.(int))
+		}
+		if v, ok := d.GetOk("data_level2_backup_retention_period"); ok {
+			request.DataLevel2BackupRetentionPeriod = strconv.Itoa(v.(int))
+		}
+		if v, ok := d.GetOk("backup_retention_policy_on_cluster_deletion"); ok {
+			request.BackupRetentionPolicyOnClusterDeletion = v.(string)
+		}
+
+		// wait cluster running before modifying
+		if err := polarDBService.WaitForPolarDBClusterRunning(d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyBackupPolicy(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	return resourceAlibabacloudStackPolarDBBackupPolicyRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBBackupPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	// In case of a delete we are resetting to default values which is Tuesday,Friday each 1am-2am
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	request := polardb.CreateModifyBackupPolicyRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = d.Id()
+	request.PreferredBackupTime = "01:00Z-02:00Z"
+	request.PreferredBackupPeriod = "Tuesday,Friday"
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.ModifyBackupPolicy(request)
+	}); err != nil {
+		if IsExpectedErrors(err, PolarDBClusterNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return nil
+}
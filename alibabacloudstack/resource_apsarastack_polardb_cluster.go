@@ -0,0 +1,411 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackPolarDBCluster() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackPolarDBClusterCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackPolarDBClusterRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackPolarDBClusterUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackPolarDBClusterDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(50 * time.Minute),
+			Update: schema.DefaultTimeout(50 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"MySQL", "PostgreSQL", "Oracle"}, false),
+			},
+			"db_version": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_node_class": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"modify_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Upgrade",
+				ValidateFunc: validation.StringInSlice([]string{"Upgrade", "Downgrade"}, false),
+			},
+			"db_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(2, 16),
+			},
+			"zone_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"vswitch_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(2, 256),
+			},
+			"maintain_time": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"security_ips": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"db_cluster_ip_array": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db_cluster_ip_array_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringNotInSlice([]string{PolarDBDefaultIPArrayName}, false),
+						},
+						"security_ips": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"vpc_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"db_node_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackPolarDBClusterCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	vpcService := VpcService{client, ctx}
+
+	request := polardb.CreateCreateDBClusterRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBType = d.Get("db_type").(string)
+	request.DBVersion = d.Get("db_version").(string)
+	request.DBNodeClass = d.Get("db_node_class").(string)
+	request.PayType = "Postpaid"
+	request.ClusterNetworkType = "VPC"
+	request.ClientToken = buildClientToken(request.GetActionName())
+	vswitch, err := vpcService.DescribeVSwitch(d.Get("vswitch_id").(string))
+	if err != nil {
+		return WrapError(err)
+	}
+	request.VSwitchId = vswitch.VSwitchId
+	request.VPCId = vswitch.VpcId
+	request.ZoneId = vswitch.ZoneId
+	if v, ok := d.GetOk("zone_id"); ok {
+		request.ZoneId = v.(string)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.DBClusterDescription = v.(string)
+	}
+	if v, ok := d.GetOk("security_ips"); ok {
+		request.SecurityIPList = strings.Join(expandStringList(v.(*schema.Set).List()), COMMA_SEPARATED)
+	}
+
+	raw, err := client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.CreateDBCluster(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_polardb_cluster", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.CreateDBClusterResponse)
+	d.SetId(response.DBClusterId)
+
+	if err := polarDBService.WaitForPolarDBClusterRunning(d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackPolarDBClusterUpdate(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBClusterRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	object, err := polarDBService.DescribePolarDBClusterAttribute(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+
+	d.Set("db_type", object.DBType)
+	d.Set("db_version", object.DBVersion)
+	d.Set("zone_id", strings.Split(object.ZoneIds, COMMA_SEPARATED)[0])
+	d.Set("vswitch_id", object.VSwitchId)
+	d.Set("vpc_id", object.VPCId)
+	d.Set("description", object.DBClusterDescription)
+	d.Set("maintain_time", object.MaintainTime)
+	d.Set("status", object.DBClusterStatus)
+
+	var nodeIds []string
+	for _, node := range object.DBNodes {
+		nodeIds = append(nodeIds, node.DBNodeId)
+		if node.DBNodeRole == "Writer" {
+			d.Set("db_node_class", node.DBNodeClass)
+		}
+	}
+	d.Set("db_node_count", len(object.DBNodes))
+	d.Set("db_node_ids", nodeIds)
+
+	ipArrays, err := polarDBService.DescribePolarDBClusterAccessWhitelist(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	var ipArrayGroups []map[string]interface{}
+	for _, ipArray := range ipArrays {
+		if ipArray.DBClusterIPArrayAttribute == "hidden" {
+			continue
+		}
+		securityIps := strings.Split(ipArray.SecurityIps, COMMA_SEPARATED)
+		if ipArray.DBClusterIPArrayName == PolarDBDefaultIPArrayName {
+			d.Set("security_ips", securityIps)
+			continue
+		}
+		ipArrayGroups = append(ipArrayGroups, map[string]interface{}{
+			"db_cluster_ip_array_name": ipArray.DBClusterIPArrayName,
+			"security_ips":             securityIps,
+		})
+	}
+	if err := d.Set("db_cluster_ip_array", ipArrayGroups); err != nil {
+		return WrapError(err)
+	}
+
+	endpoints, err := polarDBService.DescribePolarDBEndpoints(d.Id(), "")
+	if err != nil {
+		return WrapError(err)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.EndpointType != "Cluster" {
+			continue
+		}
+		for _, address := range endpoint.AddressItems {
+			if address.NetType == "Private" {
+				d.Set("connection_string", address.ConnectionString)
+				d.Set("port", address.Port)
+			}
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackPolarDBClusterUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	d.Partial(true)
+
+	if d.HasChange("description") && !d.IsNewResource() {
+		request := polardb.CreateModifyDBClusterDescriptionRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		request.DBClusterDescription = d.Get("description").(string)
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyDBClusterDescription(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if v, ok := d.GetOk("maintain_time"); ok && d.HasChange("maintain_time") {
+		request := polardb.CreateModifyDBClusterMaintainTimeRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		request.MaintainTime = v.(string)
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyDBClusterMaintainTime(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	if d.HasChange("security_ips") && !d.IsNewResource() {
+		securityIps := strings.Join(expandStringList(d.Get("security_ips").(*schema.Set).List()), COMMA_SEPARATED)
+		if err := polarDBService.ModifyPolarDBClusterAccessWhitelist(d.Id(), PolarDBDefaultIPArrayName, securityIps, "Cover", d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if d.HasChange("db_cluster_ip_array") {
+		o, n := d.GetChange("db_cluster_ip_array")
+		configured := make(map[string]bool)
+		for _, v := range n.(*schema.Set).List() {
+			group := v.(map[string]interface{})
+			name := group["db_cluster_ip_array_name"].(string)
+			configured[name] = true
+			securityIps := strings.Join(expandStringList(group["security_ips"].(*schema.Set).List()), COMMA_SEPARATED)
+			if err := polarDBService.ModifyPolarDBClusterAccessWhitelist(d.Id(), name, securityIps, "Cover", d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapError(err)
+			}
+		}
+		for _, v := range o.(*schema.Set).List() {
+			group := v.(map[string]interface{})
+			name := group["db_cluster_ip_array_name"].(string)
+			if configured[name] {
+				continue
+			}
+			securityIps := strings.Join(expandStringList(group["security_ips"].(*schema.Set).List()), COMMA_SEPARATED)
+			if err := polarDBService.ModifyPolarDBClusterAccessWhitelist(d.Id(), name, securityIps, "Delete", d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return WrapError(err)
+			}
+		}
+	}
+
+	if d.HasChange("db_node_class") && !d.IsNewResource() {
+		request := polardb.CreateModifyDBNodeClassRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		request.DBNodeTargetClass = d.Get("db_node_class").(string)
+		request.ModifyType = d.Get("modify_type").(string)
+		request.ClientToken = buildClientToken(request.GetActionName())
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyDBNodeClass(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		if err := polarDBService.WaitForPolarDBClusterRunning(d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	if v, ok := d.GetOk("db_node_count"); ok && d.HasChange("db_node_count") {
+		if err := resourceAlibabacloudStackPolarDBClusterModifyNodes(polarDBService, d, v.(int)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	d.Partial(false)
+	return resourceAlibabacloudStackPolarDBClusterRead(ctx, d, meta)
+}
+
+// resourceAlibabacloudStackPolarDBClusterModifyNodes adds read nodes of the class of the cluster or removes
+// the read nodes which were created last, until the cluster has the expected number of nodes.
+func resourceAlibabacloudStackPolarDBClusterModifyNodes(polarDBService PolarDBService, d *schema.ResourceData, expected int) error {
+	object, err := polarDBService.DescribePolarDBClusterAttribute(d.Id())
+	if err != nil {
+		return WrapError(err)
+	}
+	current := len(object.DBNodes)
+	if current == expected {
+		return nil
+	}
+
+	if expected > current {
+		request := polardb.CreateCreateDBNodesRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		request.ClientToken = buildClientToken(request.GetActionName())
+		var nodes []polardb.CreateDBNodesDBNode
+		for i := current; i < expected; i++ {
+			nodes = append(nodes, polardb.CreateDBNodesDBNode{TargetClass: d.Get("db_node_class").(string)})
+		}
+		request.DBNode = &nodes
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.CreateDBNodes(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	} else {
+		var readers []string
+		for _, node := range object.DBNodes {
+			if node.DBNodeRole == "Reader" {
+				readers = append(readers, node.DBNodeId)
+			}
+		}
+		if len(readers) < current-expected {
+			return WrapError(Error("[ERROR] The cluster %s has only %d read nodes to remove", d.Id(), len(readers)))
+		}
+		nodeIds := readers[len(readers)-(current-expected):]
+		request := polardb.CreateDeleteDBNodesRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = d.Id()
+		request.DBNodeId = &nodeIds
+		request.ClientToken = buildClientToken(request.GetActionName())
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.DeleteDBNodes(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+	return polarDBService.WaitForPolarDBClusterRunning(d.Id(), d.Timeout(schema.TimeoutUpdate))
+}
+
+func resourceAlibabacloudStackPolarDBClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	request := polardb.CreateDeleteDBClusterRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = d.Id()
+	err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DeleteDBCluster(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, PolarDBClusterNotFound) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	stateConf := BuildStateConf(append(PolarDBClusterPendingStatus, "Running", "Deleting"), []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, polarDBService.PolarDBClusterStateRefreshFunc(d.Id(), []string{}))
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return WrapErrorf(err, IdMsg, d.Id())
+	}
+	return nil
+}
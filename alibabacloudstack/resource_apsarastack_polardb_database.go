@@ -0,0 +1,154 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAlibabacloudStackPolarDBDatabase() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackPolarDBDatabaseCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackPolarDBDatabaseRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackPolarDBDatabaseUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackPolarDBDatabaseDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"character_set_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "utf8",
+			},
+			"db_description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackPolarDBDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	clusterId := d.Get("db_cluster_id").(string)
+
+	request := polardb.CreateCreateDatabaseRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = clusterId
+	request.DBName = d.Get("db_name").(string)
+	request.CharacterSetName = d.Get("character_set_name").(string)
+	if v, ok := d.GetOk("db_description"); ok {
+		request.DBDescription = v.(string)
+	}
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.CreateDatabase(request)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_polardb_database", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, request.DBName))
+
+	if err := polarDBService.WaitForPolarDBClusterRunning(clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+
+	return resourceAlibabacloudStackPolarDBDatabaseRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBDatabaseRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	object, err := polarDBService.DescribePolarDBDatabase(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("db_name", object.DBName)
+	d.Set("character_set_name", object.CharacterSetName)
+	d.Set("db_description", object.DBDescription)
+	d.Set("status", object.DBStatus)
+	return nil
+}
+
+func resourceAlibabacloudStackPolarDBDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if d.HasChange("db_description") {
+		request := polardb.CreateModifyDBDescriptionRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = parts[0]
+		request.DBName = parts[1]
+		request.DBDescription = d.Get("db_description").(string)
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyDBDescription(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+	}
+
+	return resourceAlibabacloudStackPolarDBDatabaseRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBDatabaseDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := polardb.CreateDeleteDatabaseRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = parts[0]
+	request.DBName = parts[1]
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DeleteDatabase(request)
+	}); err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidDBName.NotFound")) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return WrapError(polarDBService.WaitForPolarDBClusterRunning(parts[0], d.Timeout(schema.TimeoutDelete)))
+}
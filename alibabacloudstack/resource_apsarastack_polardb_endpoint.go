@@ -0,0 +1,261 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAlibabacloudStackPolarDBEndpoint() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: withDiagnostics(resourceAlibabacloudStackPolarDBEndpointCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackPolarDBEndpointRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackPolarDBEndpointUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackPolarDBEndpointDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"db_cluster_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"nodes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"read_write_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"ReadWrite", "ReadOnly"}, false),
+			},
+			"auto_add_new_nodes": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Enable", "Disable"}, false),
+			},
+			"endpoint_config": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"db_endpoint_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"connection_string": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"port": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAlibabacloudStackPolarDBEndpointCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	clusterId := d.Get("db_cluster_id").(string)
+
+	// The api does not return the id of the endpoint, it is the custom endpoint which did not exist before.
+	endpoints, err := polarDBService.DescribePolarDBEndpoints(clusterId, "")
+	if err != nil {
+		return WrapError(err)
+	}
+	existing := make(map[string]bool)
+	for _, endpoint := range endpoints {
+		existing[endpoint.DBEndpointId] = true
+	}
+
+	request := polardb.CreateCreateDBClusterEndpointRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = clusterId
+	request.EndpointType = "Custom"
+	request.ClientToken = buildClientToken(request.GetActionName())
+	if v, ok := d.GetOk("nodes"); ok {
+		request.Nodes = strings.Join(expandStringList(v.(*schema.Set).List()), COMMA_SEPARATED)
+	}
+	if v, ok := d.GetOk("read_write_mode"); ok {
+		request.ReadWriteMode = v.(string)
+	}
+	if v, ok := d.GetOk("auto_add_new_nodes"); ok {
+		request.AutoAddNewNodes = v.(string)
+	}
+	if v, ok := d.GetOk("endpoint_config"); ok {
+		config, err := json.Marshal(v)
+		if err != nil {
+			return WrapError(err)
+		}
+		request.EndpointConfig = string(config)
+	}
+	if v, ok := d.GetOk("description"); ok {
+		request.DBEndpointDescription = v.(string)
+	}
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.CreateDBClusterEndpoint(request)
+	}); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_polardb_endpoint", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	if err := polarDBService.WaitForPolarDBClusterRunning(clusterId, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return WrapError(err)
+	}
+	endpoints, err = polarDBService.DescribePolarDBEndpoints(clusterId, "")
+	if err != nil {
+		return WrapError(err)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.EndpointType == "Custom" && !existing[endpoint.DBEndpointId] {
+			d.SetId(fmt.Sprintf("%s%s%s", clusterId, COLON_SEPARATED, endpoint.DBEndpointId))
+			break
+		}
+	}
+	if d.Id() == "" {
+		return WrapErrorf(Error(GetNotFoundMessage("PolarDBEndpoint", clusterId)), NotFoundMsg, ProviderERROR)
+	}
+
+	return resourceAlibabacloudStackPolarDBEndpointRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+
+	object, err := polarDBService.DescribePolarDBEndpoint(d.Id())
+	if err != nil {
+		if NotFoundError(err) {
+			d.SetId("")
+			return nil
+		}
+		return WrapError(err)
+	}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	d.Set("db_cluster_id", parts[0])
+	d.Set("db_endpoint_id", object.DBEndpointId)
+	d.Set("nodes", strings.Split(object.Nodes, COMMA_SEPARATED))
+	d.Set("read_write_mode", object.ReadWriteMode)
+	d.Set("auto_add_new_nodes", object.AutoAddNewNodes)
+	d.Set("description", object.DBEndpointDescription)
+
+	config := make(map[string]interface{})
+	if object.EndpointConfig != "" {
+		if err := json.Unmarshal([]byte(object.EndpointConfig), &config); err != nil {
+			return WrapError(err)
+		}
+	}
+	endpointConfig := make(map[string]string)
+	for k, v := range config {
+		endpointConfig[k] = fmt.Sprint(v)
+	}
+	if err := d.Set("endpoint_config", endpointConfig); err != nil {
+		return WrapError(err)
+	}
+
+	for _, address := range object.AddressItems {
+		if address.NetType == "Private" {
+			d.Set("connection_string", address.ConnectionString)
+			d.Set("port", address.Port)
+		}
+	}
+	return nil
+}
+
+func resourceAlibabacloudStackPolarDBEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	if d.HasChanges("nodes", "read_write_mode", "auto_add_new_nodes", "endpoint_config", "description") {
+		request := polardb.CreateModifyDBClusterEndpointRequest()
+		polarDBService.initRequest(request.RpcRequest)
+		request.DBClusterId = parts[0]
+		request.DBEndpointId = parts[1]
+		if d.HasChange("nodes") {
+			request.Nodes = strings.Join(expandStringList(d.Get("nodes").(*schema.Set).List()), COMMA_SEPARATED)
+		}
+		if d.HasChange("read_write_mode") {
+			request.ReadWriteMode = d.Get("read_write_mode").(string)
+		}
+		if d.HasChange("auto_add_new_nodes") {
+			request.AutoAddNewNodes = d.Get("auto_add_new_nodes").(string)
+		}
+		if d.HasChange("endpoint_config") {
+			config, err := json.Marshal(d.Get("endpoint_config"))
+			if err != nil {
+				return WrapError(err)
+			}
+			request.EndpointConfig = string(config)
+		}
+		if d.HasChange("description") {
+			request.DBEndpointDescription = d.Get("description").(string)
+		}
+		if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.ModifyDBClusterEndpoint(request)
+		}); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		if err := polarDBService.WaitForPolarDBClusterRunning(parts[0], d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return WrapError(err)
+		}
+	}
+
+	return resourceAlibabacloudStackPolarDBEndpointRead(ctx, d, meta)
+}
+
+func resourceAlibabacloudStackPolarDBEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	polarDBService := PolarDBService{client, ctx}
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return WrapError(err)
+	}
+
+	request := polardb.CreateDeleteDBClusterEndpointRequest()
+	polarDBService.initRequest(request.RpcRequest)
+	request.DBClusterId = parts[0]
+	request.DBEndpointId = parts[1]
+	if err := polarDBService.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DeleteDBClusterEndpoint(request)
+	}); err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidDBEndpointId.NotFound")) {
+			return nil
+		}
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return WrapError(polarDBService.WaitForPolarDBClusterRunning(parts[0], d.Timeout(schema.TimeoutDelete)))
+}
@@ -0,0 +1,47 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+)
+
+func TestUnitAlibabacloudStackPolarDBEndpoint_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("polardb_endpoint")
+	client := server.client()
+
+	r := resourceAlibabacloudStackPolarDBEndpoint()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"db_cluster_id":      "pc-mock0001",
+		"nodes":              []interface{}{"pi-mock0002"},
+		"read_write_mode":    "ReadOnly",
+		"auto_add_new_nodes": "Disable",
+		"endpoint_config":    map[string]interface{}{"ConsistLevel": "1"},
+		"description":        "mock endpoint",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the endpoint got an error: %#v", diags)
+	}
+	if d.Id() != "pc-mock0001:pe-mockcustom" {
+		t.Fatalf("expected the endpoint id pc-mock0001:pe-mockcustom, got %q", d.Id())
+	}
+	call, ok := server.lastCall("CreateDBClusterEndpoint")
+	if !ok {
+		t.Fatalf("expected CreateDBClusterEndpoint to be called")
+	}
+	if call.Params["EndpointType"] != "Custom" || call.Params["Nodes"] != "pi-mock0002" || call.Params["EndpointConfig"] != `{"ConsistLevel":"1"}` {
+		t.Errorf("expected the custom endpoint of pi-mock0002 with the endpoint config, got %v", call.Params)
+	}
+	if value := d.Get("connection_string").(string); value != "pe-mockcustom.rwlb.polardb.mock" {
+		t.Errorf("expected the connection_string pe-mockcustom.rwlb.polardb.mock, got %q", value)
+	}
+	if value := d.Get("endpoint_config.ConsistLevel").(string); value != "1" {
+		t.Errorf("expected the endpoint_config ConsistLevel 1, got %q", value)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the endpoint got an error: %#v", diags)
+	}
+	if call, _ := server.lastCall("DeleteDBClusterEndpoint"); call.Params["DBEndpointId"] != "pe-mockcustom" {
+		t.Errorf("expected the endpoint pe-mockcustom to be deleted, got %v", call.Params)
+	}
+}
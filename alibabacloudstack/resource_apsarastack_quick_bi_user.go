@@ -1,26 +1,25 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserRead,
-		Update: resourceAlibabacloudStackQuickBiUserUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiUserRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -61,7 +60,7 @@ func resourceAlibabacloudStackQuickBiUser() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AddUser"
@@ -78,18 +77,7 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 	request["AuthAdminUser"] = d.Get("auth_admin_user")
 	request["NickName"] = d.Get("nick_name")
 	request["UserType"] = convertQuickBiUserUserTypeRequest(d.Get("user_type").(string))
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 
 	addDebug(action, response, request)
 	if err != nil {
@@ -98,11 +86,11 @@ func resourceAlibabacloudStackQuickBiUserCreate(d *schema.ResourceData, meta int
 	responseResult := response["Result"].(map[string]interface{})
 	d.SetId(fmt.Sprint(responseResult["UserId"]))
 
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	quickbiPublicService := QuickbiPublicService{client}
+	quickbiPublicService := QuickbiPublicService{client, ctx}
 	object, err := quickbiPublicService.DescribeQuickBiUser(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -121,7 +109,7 @@ func resourceAlibabacloudStackQuickBiUserRead(d *schema.ResourceData, meta inter
 	d.Set("user_type", convertQuickBiUserUserTypeResponse(formatInt(object["UserType"])))
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -148,26 +136,15 @@ func resourceAlibabacloudStackQuickBiUserUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
-	return resourceAlibabacloudStackQuickBiUserRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUser"
 	var response map[string]interface{}
@@ -179,18 +156,7 @@ func resourceAlibabacloudStackQuickBiUserDelete(d *schema.ResourceData, meta int
 		"UserId": d.Id(),
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"User.Not.In.Organization"}) {
@@ -1,22 +1,21 @@
 package alibabacloudstack
 
 import (
-	"time"
+	"context"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackQuickBiUserGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiUserGroupCreate,
-		Read:   resourceAlibabacloudStackQuickBiUserGroupRead,
-		Update: resourceAlibabacloudStackQuickBiUserGroupUpdate,
-		Delete: resourceAlibabacloudStackQuickBiUserGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiUserGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"user_group_id": {
@@ -46,7 +45,7 @@ var UserGroupDescription string
 var ParentUserGroupId string
 var UserGroupId string
 
-func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateUserGroup"
@@ -67,18 +66,7 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 	request["UserGroupDescription"] = UserGroupDescription
 	request["ParentUserGroupId"] = ParentUserGroupId
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 
 	addDebug(action, response, request)
 	if err != nil {
@@ -88,9 +76,9 @@ func resourceAlibabacloudStackQuickBiUserGroupCreate(d *schema.ResourceData, met
 	UserGroupId = responseResult
 	d.SetId(responseResult)
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("user_group_id", UserGroupId)
 	d.Set("user_group_name", UserGroupName)
@@ -99,7 +87,7 @@ func resourceAlibabacloudStackQuickBiUserGroupRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	update := false
@@ -123,27 +111,16 @@ func resourceAlibabacloudStackQuickBiUserGroupUpdate(d *schema.ResourceData, met
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 	}
 
-	return resourceAlibabacloudStackQuickBiUserGroupRead(d, meta)
+	return resourceAlibabacloudStackQuickBiUserGroupRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiUserGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteUserGroup"
 	var response map[string]interface{}
@@ -155,18 +132,7 @@ func resourceAlibabacloudStackQuickBiUserGroupDelete(d *schema.ResourceData, met
 		"UserGroupId": d.Id(),
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"User.Not.In.Organization"}) {
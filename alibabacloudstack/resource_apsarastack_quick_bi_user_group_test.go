@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -14,7 +15,7 @@ func TestAccAlicloudQuickBIUserGroup_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_quick_bi_user_group.default"
 	ra := resourceAttrInit(resourceId, AlicloudQuickBIUserGroupMap0)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &QuickbiPublicService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &QuickbiPublicService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
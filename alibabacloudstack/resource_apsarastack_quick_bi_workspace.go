@@ -1,23 +1,22 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 func resourceAlibabacloudStackQuickBiWorkspace() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackQuickBiWorkspaceCreate,
-		Read:   resourceAlibabacloudStackQuickBiWorkspaceRead,
-		Update: resourceAlibabacloudStackQuickBiWorkspaceUpdate,
-		Delete: resourceAlibabacloudStackQuickBiWorkspaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackQuickBiWorkspaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"workspace_id": {
@@ -55,7 +54,7 @@ var UseComment bool
 var AllowShare bool
 var AllowPublish bool
 
-func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateWorkSpace"
@@ -76,18 +75,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 	request["UseComment"] = UseComment
 	request["AllowShare"] = AllowShare
 	request["AllowPublish"] = AllowPublish
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 
 	addDebug(action, response, request)
 	if err != nil {
@@ -96,9 +84,9 @@ func resourceAlibabacloudStackQuickBiWorkspaceCreate(d *schema.ResourceData, met
 	WorkspaceId = response["Result"].(string)
 	d.SetId(fmt.Sprint(WorkspaceId))
 
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("workspace_id", WorkspaceId)
 	d.Set("workspace_name", WorkspaceName)
@@ -109,10 +97,10 @@ func resourceAlibabacloudStackQuickBiWorkspaceRead(d *schema.ResourceData, meta
 
 	return nil
 }
-func resourceAlibabacloudStackQuickBiWorkspaceUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudStackQuickBiWorkspaceRead(d, meta)
+func resourceAlibabacloudStackQuickBiWorkspaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudStackQuickBiWorkspaceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackQuickBiWorkspaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteWorkSpace"
 	var response map[string]interface{}
@@ -124,18 +112,7 @@ func resourceAlibabacloudStackQuickBiWorkspaceDelete(d *schema.ResourceData, met
 		"WorkspaceId": d.Id(),
 	}
 
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2022-03-01"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"Workspace.Not.In.Organization"}) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,10 +18,13 @@ import (
 
 func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudstackAscmAccessKeyCreate,
-		Read:   resourceAlibabacloudstackAscmAccessKeyRead,
-		Update: resourceAlibabacloudstackAscmAccessKeyUpdate,
-		Delete: resourceAlibabacloudstackAscmAccessKeyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudstackAscmAccessKeyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudstackAscmAccessKeyDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudstackAscmAccessKeyImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"user_name": {
@@ -61,7 +65,7 @@ func resourceAlibabacloudstackRamAccessKey() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var requestInfo *ecs.Client
 	//  userName:=d.Get("user_name")
@@ -84,14 +88,13 @@ func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta i
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"Department":      client.Department,
-		"ResourceGroup":   client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "RamCreateAccessKey",
-		"Version":         "2015-05-01",
-		"ProductName":     "ascm",
+		"RegionId":      client.RegionId,
+		"Department":    client.Department,
+		"ResourceGroup": client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "RamCreateAccessKey",
+		"Version":       "2015-05-01",
+		"ProductName":   "ascm",
 		//"": userName,
 	}
 	var response = AccessKeyInCreateAccessKey{}
@@ -114,17 +117,17 @@ func resourceAlibabacloudstackAscmAccessKeyCreate(d *schema.ResourceData, meta i
 
 	d.SetId(fmt.Sprint(response.AccessKeyId))
 
-	return resourceAlibabacloudstackAscmAccessKeyUpdate(d, meta)
+	return resourceAlibabacloudstackAscmAccessKeyUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return resourceAlibabacloudstackAscmAccessKeyRead(d, meta)
+func resourceAlibabacloudstackAscmAccessKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	return resourceAlibabacloudstackAscmAccessKeyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudstackAscmAccessKeyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	object, err := ascmService.DescribeAscmKeypolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -133,13 +136,15 @@ func resourceAlibabacloudstackAscmAccessKeyRead(d *schema.ResourceData, meta int
 		}
 		return WrapError(err)
 	}
-	d.Set("status", object) //add read data from the struct
+	if object.Status != "" {
+		d.Set("status", object.Status)
+	}
 	return nil
 }
 
-func resourceAlibabacloudstackAscmAccessKeyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudstackAscmAccessKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ascmService := AscmService{client}
+	ascmService := AscmService{client, ctx}
 	var requestInfo *ecs.Client
 	check, err := ascmService.DescribeAscmKeypolicy(d.Id())
 
@@ -147,20 +152,19 @@ func resourceAlibabacloudstackAscmAccessKeyDelete(d *schema.ResourceData, meta i
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "IsResourceGroupExist", AlibabacloudStackSdkGoERROR)
 	}
 	addDebug("IsKeyExist", check, requestInfo, map[string]string{"resourceGroupName": d.Id()})
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 1*time.Minute, func() *resource.RetryError {
 
 		request := requests.NewCommonRequest()
 		if client.Config.Insecure {
 			request.SetHTTPSInsecure(client.Config.Insecure)
 		}
 		request.QueryParams = map[string]string{
-			"RegionId":        client.RegionId,
-			"AccessKeySecret": client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "RamDeleteAccessKey",
-			"Version":         "2015-05-01",
-			"ProductName":     "ascm",
-			"id":              d.Id(),
+			"RegionId":    client.RegionId,
+			"Product":     "ascm",
+			"Action":      "RamDeleteAccessKey",
+			"Version":     "2015-05-01",
+			"ProductName": "ascm",
+			"id":          d.Id(),
 		}
 
 		request.Method = "POST"
@@ -203,14 +207,13 @@ func (s *AscmService) DescribeAscmKeypolicy(id string) (response *AccessKeyInCre
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "RamListAccessKeys",
-		"Version":         "2015-05-01",
-		"id":              id,
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "RamListAccessKeys",
+		"Version":       "2015-05-01",
+		"id":            id,
 	}
 	request.Method = "POST"
 	request.Product = "ascm"
@@ -257,3 +260,18 @@ type AccessKeyInCreateAccessKey struct {
 	Status          string `json:"Status" xml:"Status"`
 	CreateDate      string `json:"CreateDate" xml:"CreateDate"`
 }
+
+// resourceAlibabacloudstackAscmAccessKeyImport imports an access key from its id, or from an id formulated as
+// <user_name>:<access_key_id> for the access keys of a user. The secret is only returned when the key is created.
+func resourceAlibabacloudstackAscmAccessKeyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if strings.Contains(d.Id(), COLON_SEPARATED) {
+		parts, err := ParseResourceId(d.Id(), 2)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		d.SetId(parts[1])
+		d.Set("user_name", parts[0])
+	}
+	d.Set("status", Active)
+	return []*schema.ResourceData{d}, nil
+}
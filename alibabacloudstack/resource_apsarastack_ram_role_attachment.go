@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -13,9 +14,12 @@ import (
 
 func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackInstanceRoleAttachmentCreate,
-		Read:   resourceAlibabacloudStackInstanceRoleAttachmentRead,
-		Delete: resourceAlibabacloudStackInstanceRoleAttachmentDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackInstanceRoleAttachmentDelete),
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAlibabacloudStackInstanceRoleAttachmentImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"role_name": {
@@ -33,7 +37,7 @@ func resourceAlibabacloudStackRamRoleAttachment() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var instanceId string
 	var instanceIds []string
@@ -56,13 +60,12 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": client.SecretKey,
-		"Product":         "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup,
+		"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup,
 	}
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceId)
 	request.RamRoleName = d.Get("role_name").(string)
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachInstanceRamRole(request)
 		})
@@ -80,10 +83,10 @@ func resourceAlibabacloudStackInstanceRoleAttachmentCreate(d *schema.ResourceDat
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "ram_role_attachment", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackInstanceRoleAttachmentRead(d, meta)
+	return resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -91,7 +94,7 @@ func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData,
 	}
 	roleName := parts[0]
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ramService := RamService{client}
+	ramService := RamService{client, ctx}
 	object, err := ramService.DescribeRamRoleAttachment(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -107,15 +110,19 @@ func resourceAlibabacloudStackInstanceRoleAttachmentRead(d *schema.ResourceData,
 			instIds = append(instIds, item.InstanceId)
 		}
 	}
-	d.Set("role_name", object.InstanceRamRoleSets.InstanceRamRoleSet[0].RamRoleName)
+	if len(instIds) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("role_name", roleName)
 	d.Set("instance_ids", instIds)
 	return nil
 
 }
 
-func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackInstanceRoleAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ramService := RamService{client}
+	ramService := RamService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
@@ -131,11 +138,11 @@ func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceDat
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RamRoleName = roleName
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", instanceIds)
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DetachInstanceRamRole(request)
 		})
@@ -153,3 +160,14 @@ func resourceAlibabacloudStackInstanceRoleAttachmentDelete(d *schema.ResourceDat
 	}
 	return WrapError(ramService.WaitForRamRoleAttachment(d.Id(), Deleted, DefaultTimeout))
 }
+
+// resourceAlibabacloudStackInstanceRoleAttachmentImport imports the attachment from an id formulated as
+// <role_name>:<instance_id>,<instance_id>..., which it turns into the id the attachment is created with.
+func resourceAlibabacloudStackInstanceRoleAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts, err := ParseResourceId(d.Id(), 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	d.SetId(parts[0] + COLON_SEPARATED + strings.Join(strings.Split(parts[1], ","), "\",\""))
+	return []*schema.ResourceData{d}, nil
+}
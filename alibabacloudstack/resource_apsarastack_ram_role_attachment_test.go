@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackRamRoleAttachment_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ram_role_attachment.default"
 	ra := resourceAttrInit(resourceId, ramRoleAttachmentMap)
 	serviceFunc := func() interface{} {
-		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RamService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -43,7 +44,7 @@ func TestAccAlibabacloudStackRamRoleAttachment_basic(t *testing.T) {
 
 func testAccCheckRamRoleAttachmentDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ascmService := RamService{client}
+	ascmService := RamService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_ram_role_attachment" || rs.Type != "alibabacloudstack_ram_role_attachment" {
@@ -106,3 +107,19 @@ resource "alibabacloudstack_ram_role_attachment" "default" {
 var ramRoleAttachmentMap = map[string]string{
 	"role_name": CHECKSET,
 }
+
+func TestUnitAlibabacloudStackRamRoleAttachment_import(t *testing.T) {
+	d := resourceAlibabacloudStackRamRoleAttachment().TestResourceData()
+	d.SetId("tf-testrole:i-abc12345678,i-abc12345679")
+	if _, err := resourceAlibabacloudStackInstanceRoleAttachmentImport(context.Background(), d, nil); err != nil {
+		t.Fatalf("expected the import to succeed, got %v", err)
+	}
+	if expected := `tf-testrole:i-abc12345678","i-abc12345679`; d.Id() != expected {
+		t.Fatalf("expected the id %s, got %s", expected, d.Id())
+	}
+
+	d.SetId("i-abc12345678")
+	if _, err := resourceAlibabacloudStackInstanceRoleAttachmentImport(context.Background(), d, nil); err == nil {
+		t.Fatalf("expected the import without a role name to fail")
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackReservedInstanceCreate,
-		Read:   resourceAlibabacloudStackReservedInstanceRead,
-		Update: resourceAlibabacloudStackReservedInstanceUpdate,
-		Delete: resourceAlibabacloudStackReservedInstanceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackReservedInstanceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackReservedInstanceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"instance_type": {
@@ -87,9 +88,9 @@ func resourceAlibabacloudStackReservedInstance() *schema.Resource {
 		},
 	}
 }
-func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	request := ecs.CreatePurchaseReservedInstancesOfferingRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -97,7 +98,7 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if v, ok := d.GetOk("instance_type"); ok {
 		request.InstanceType = v.(string)
 	}
@@ -152,9 +153,9 @@ func resourceAlibabacloudStackReservedInstanceCreate(d *schema.ResourceData, met
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := ecs.CreateModifyReservedInstanceAttributeRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -163,7 +164,7 @@ func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.ReservedInstanceId = d.Id()
 	request.RegionId = client.RegionId
 	if d.HasChange("name") || d.HasChange("description") {
@@ -182,12 +183,12 @@ func resourceAlibabacloudStackReservedInstanceUpdate(d *schema.ResourceData, met
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackReservedInstanceRead(d, meta)
+	return resourceAlibabacloudStackReservedInstanceRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	reservedInstances, err := ecsService.DescribeReservedInstance(d.Id())
 	if err != nil {
 		return WrapError(err)
@@ -204,7 +205,7 @@ func resourceAlibabacloudStackReservedInstanceRead(d *schema.ResourceData, meta
 
 	return WrapError(err)
 }
-func resourceAlibabacloudStackReservedInstanceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackReservedInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	// PurchaseReservedInstancesOffering can not be release.
 	return nil
 }
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -8,25 +9,25 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackRosStack() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosStackCreate,
-		Read:   resourceAlibabacloudStackRosStackRead,
-		Update: resourceAlibabacloudStackRosStackUpdate,
-		Delete: resourceAlibabacloudStackRosStackDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosStackCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosStackRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosStackUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosStackDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(11 * time.Minute),
 			Delete: schema.DefaultTimeout(6 * time.Minute),
 			Update: schema.DefaultTimeout(11 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"create_option": {
 				Type:     schema.TypeString,
@@ -118,7 +119,8 @@ func resourceAlibabacloudStackRosStack() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"template_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -149,9 +151,9 @@ func resourceAlibabacloudStackRosStack() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rosService := RosService{client}
+	rosService := RosService{client, ctx}
 	var response map[string]interface{}
 	action := "CreateStack"
 	request := make(map[string]interface{})
@@ -229,15 +231,15 @@ func resourceAlibabacloudStackRosStackCreate(d *schema.ResourceData, meta interf
 
 	d.SetId(fmt.Sprint(response["StackId"]))
 	stateConf := BuildStateConf([]string{}, []string{"CREATE_COMPLETE"}, d.Timeout(schema.TimeoutCreate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"CREATE_FAILED", "CREATE_ROLLBACK_COMPLETE", "CREATE_ROLLBACK_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rosService := RosService{client}
+	rosService := RosService{client, ctx}
 	object, err := rosService.DescribeRosStack(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -286,13 +288,13 @@ func resourceAlibabacloudStackRosStackRead(d *schema.ResourceData, meta interfac
 	return nil
 }
 
-func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rosService := RosService{client}
+	rosService := RosService{client, ctx}
 	var response map[string]interface{}
 	d.Partial(true)
 
-	//if d.HasChange("tags") {
+	//if d.HasChange("tags_all") {
 	//	if err := rosService.SetResourceTags(d, "stack"); err != nil {
 	//		return WrapError(err)
 	//	}
@@ -375,7 +377,7 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"UPDATE_COMPLETE"}, d.Timeout(schema.TimeoutUpdate), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"UPDATE_FAILED", "ROLLBACK_FAILED"}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("disable_rollback")
@@ -385,11 +387,11 @@ func resourceAlibabacloudStackRosStackUpdate(d *schema.ResourceData, meta interf
 		//d.SetPartial("timeout_in_minutes")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosStackRead(d, meta)
+	return resourceAlibabacloudStackRosStackRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosStackDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rosService := RosService{client}
+	rosService := RosService{client, ctx}
 	action := "DeleteStack"
 	var response map[string]interface{}
 	conn, err := client.NewRosClient()
@@ -413,19 +415,8 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 	if v, ok := d.GetOk("retain_resources"); ok {
 		request["RetainResources"] = v.(*schema.Set).List()
 	}
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"StackNotFound"}) {
 			return nil
@@ -433,7 +424,7 @@ func resourceAlibabacloudStackRosStackDelete(d *schema.ResourceData, meta interf
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"DELETE_COMPLETE"}, d.Timeout(schema.TimeoutDelete), 100*time.Second, rosService.RosStackStateRefreshFunc(d.Id(), []string{"DELETE_FAILED"}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
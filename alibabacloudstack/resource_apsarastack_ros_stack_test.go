@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -100,7 +101,7 @@ func TestAccAlibabacloudStackRosStack_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ros_stack.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackRosStackMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeRosStack")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
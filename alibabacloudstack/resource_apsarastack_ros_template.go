@@ -1,32 +1,33 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRosTemplateCreate,
-		Read:   resourceAlibabacloudStackRosTemplateRead,
-		Update: resourceAlibabacloudStackRosTemplateUpdate,
-		Delete: resourceAlibabacloudStackRosTemplateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRosTemplateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRosTemplateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRosTemplateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRosTemplateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"template_body": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -48,7 +49,7 @@ func resourceAlibabacloudStackRosTemplate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateTemplate"
@@ -92,11 +93,11 @@ func resourceAlibabacloudStackRosTemplateCreate(d *schema.ResourceData, meta int
 
 	d.SetId(fmt.Sprint(response["TemplateId"]))
 
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	rosService := RosService{client}
+	rosService := RosService{client, ctx}
 	object, err := rosService.DescribeRosTemplate(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -117,13 +118,13 @@ func resourceAlibabacloudStackRosTemplateRead(d *schema.ResourceData, meta inter
 	//d.Set("tags", tagsToMap(listTagResourcesObject))
 	return nil
 }
-func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	//rosService := RosService{client}
 	var response map[string]interface{}
 	d.Partial(true)
 
-	//if d.HasChange("tags") {
+	//if d.HasChange("tags_all") {
 	//	if err := rosService.SetResourceTags(d, "template"); err != nil {
 	//		return WrapError(err)
 	//	}
@@ -158,19 +159,8 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
@@ -179,9 +169,9 @@ func resourceAlibabacloudStackRosTemplateUpdate(d *schema.ResourceData, meta int
 		//d.SetPartial("template_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackRosTemplateRead(d, meta)
+	return resourceAlibabacloudStackRosTemplateRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRosTemplateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteTemplate"
 	var response map[string]interface{}
@@ -196,19 +186,8 @@ func resourceAlibabacloudStackRosTemplateDelete(d *schema.ResourceData, meta int
 	request["Product"] = "ROS"
 	request["product"] = "ROS"
 	request["OrganizationId"] = client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		addDebug(action, response, request)
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-09-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"ChangeSetNotFound", "StackNotFound", "TemplateNotFound"}) {
 			return nil
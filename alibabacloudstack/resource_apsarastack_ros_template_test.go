@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -97,7 +98,7 @@ func TestAccAlibabacloudStackRosTemplate_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_ros_template.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackRosTemplateMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &RosService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeRosTemplate")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
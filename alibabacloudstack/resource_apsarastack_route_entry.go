@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,11 +15,11 @@ import (
 
 func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouteEntryCreate,
-		Read:   resourceAlibabacloudStackRouteEntryRead,
-		Delete: resourceAlibabacloudStackRouteEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouteEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouteEntryRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouteEntryDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -58,9 +59,9 @@ func resourceAlibabacloudStackRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	rtId := d.Get("route_table_id").(string)
 	cidr := d.Get("destination_cidrblock").(string)
@@ -80,14 +81,14 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = rtId
 	request.DestinationCidrBlock = cidr
 	request.NextHopType = nt
 	request.NextHopId = ni
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.RouteEntryName = d.Get("name").(string)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
 			return resource.NonRetryableError(err)
 		}
@@ -96,7 +97,7 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 			return vpcClient.CreateRouteEntry(&args)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"TaskConflict", "IncorrectRouteEntryStatus", Throttling, "IncorrectVpcStatus"}) {
+			if IsExpectedErrors(err, []string{"TaskConflict", "IncorrectRouteEntryStatus", "IncorrectVpcStatus"}) {
 				return resource.RetryableError(err)
 			}
 			return resource.NonRetryableError(err)
@@ -122,13 +123,13 @@ func resourceAlibabacloudStackRouteEntryCreate(d *schema.ResourceData, meta inte
 	if err := vpcService.WaitForRouteEntry(d.Id(), Available, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 5)
 	if err != nil {
 		return WrapError(err)
@@ -151,7 +152,7 @@ func resourceAlibabacloudStackRouteEntryRead(d *schema.ResourceData, meta interf
 	return nil
 }
 
-func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	request, err := buildAlibabacloudStackRouteEntryDeleteArgs(d, meta)
 	if err != nil {
 		return WrapError(err)
@@ -164,15 +165,15 @@ func resourceAlibabacloudStackRouteEntryDelete(d *schema.ResourceData, meta inte
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
-	vpcService := VpcService{client}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	vpcService := VpcService{client, ctx}
 	parts, err := ParseResourceId(d.Id(), 5)
 	rtId := parts[0]
 	if err := vpcService.WaitForAllRouteEntriesAvailable(rtId, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
 	retryTimes := 7
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouteEntry(request)
 		})
@@ -207,7 +208,7 @@ func buildAlibabacloudStackRouteEntryDeleteArgs(d *schema.ResourceData, meta int
 	}
 
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.RouteTableId = d.Get("route_table_id").(string)
 	request.DestinationCidrBlock = d.Get("destination_cidrblock").(string)
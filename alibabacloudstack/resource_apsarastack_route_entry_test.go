@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestAccAlibabacloudstackRouteEntryInstance(t *testing.T) {
 	resourceId := "alibabacloudstack_route_entry.default"
 	ra := resourceAttrInit(resourceId, testAccRouteEntryCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -57,7 +58,7 @@ func TestAccAlibabacloudstackRouteEntryInterface(t *testing.T) {
 	resourceId := "alibabacloudstack_route_entry.default"
 	ra := resourceAttrInit(resourceId, testAccRouteEntryCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -91,7 +92,7 @@ func TestAccAlibabacloudstackRouteEntryNatGateway(t *testing.T) {
 	resourceId := "alibabacloudstack_route_entry.default"
 	ra := resourceAttrInit(resourceId, testAccRouteEntryCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -125,7 +126,7 @@ func TestAccAlibabacloudstackRouteEntryMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_route_entry.default.2"
 	ra := resourceAttrInit(resourceId, testAccRouteEntryCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -156,7 +157,7 @@ func TestAccAlibabacloudstackRouteEntryMulti(t *testing.T) {
 
 func testAccCheckRouteEntryDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type == "alibabacloudstack_route_entry" || rs.Type != "alibabacloudstack_route_entry" {
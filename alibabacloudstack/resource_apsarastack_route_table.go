@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -10,14 +11,15 @@ import (
 
 func resourceAlibabacloudStackRouteTable() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAliyunRouteTableCreate,
-		Read:   resourceAliyunRouteTableRead,
-		Update: resourceAliyunRouteTableUpdate,
-		Delete: resourceAliyunRouteTableDelete,
+		CreateContext: withDiagnostics(resourceAliyunRouteTableCreate),
+		ReadContext:   withDiagnostics(resourceAliyunRouteTableRead),
+		UpdateContext: withDiagnostics(resourceAliyunRouteTableUpdate),
+		DeleteContext: withDiagnostics(resourceAliyunRouteTableDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"description": {
 				Type:         schema.TypeString,
@@ -35,14 +37,15 @@ func resourceAlibabacloudStackRouteTable() *schema.Resource {
 				Required: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateRouteTableRequest()
 	request.RegionId = client.RegionId
@@ -52,7 +55,7 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.VpcId = d.Get("vpc_id").(string)
 	request.RouteTableName = d.Get("name").(string)
@@ -73,14 +76,14 @@ func resourceAliyunRouteTableCreate(d *schema.ResourceData, meta interface{}) er
 		return WrapError(err)
 	}
 
-	return resourceAliyunRouteTableUpdate(d, meta)
+	return resourceAliyunRouteTableUpdate(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeRouteTable(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -92,19 +95,21 @@ func resourceAliyunRouteTableRead(d *schema.ResourceData, meta interface{}) erro
 	d.Set("vpc_id", object.VpcId)
 	d.Set("name", object.RouteTableName)
 	d.Set("description", object.Description)
-	d.Set("tags", vpcTagsToMap(object.Tags.Tag))
+	if err := setResourceTags(d, meta, vpcTagsToMap(object.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 	return nil
 }
 
-func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	if err := vpcService.setInstanceTags(d, TagResourceRouteTable); err != nil {
 		return WrapError(err)
 	}
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAliyunRouteTableRead(d, meta)
+		return resourceAliyunRouteTableRead(ctx, d, meta)
 	}
 	request := vpc.CreateModifyRouteTableAttributesRequest()
 	request.RegionId = client.RegionId
@@ -114,7 +119,7 @@ func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = d.Id()
 
 	if d.HasChange("description") {
@@ -133,12 +138,12 @@ func resourceAliyunRouteTableUpdate(d *schema.ResourceData, meta interface{}) er
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAliyunRouteTableRead(d, meta)
+	return resourceAliyunRouteTableRead(ctx, d, meta)
 }
 
-func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAliyunRouteTableDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	routeTableService := VpcService{client}
+	routeTableService := VpcService{client, ctx}
 	request := vpc.CreateDeleteRouteTableRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -147,7 +152,7 @@ func resourceAliyunRouteTableDelete(d *schema.ResourceData, meta interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouteTableId = d.Id()
 
 	raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
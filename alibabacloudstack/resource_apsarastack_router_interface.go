@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackRouterInterface() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouterInterfaceCreate,
-		Read:   resourceAlibabacloudStackRouterInterfaceRead,
-		Update: resourceAlibabacloudStackRouterInterfaceUpdate,
-		Delete: resourceAlibabacloudStackRouterInterfaceDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouterInterfaceRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -106,12 +107,12 @@ func resourceAlibabacloudStackRouterInterface() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouterInterfaceCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
-	request, err := buildAlibabacloudStackRouterInterfaceCreateArgs(d, meta)
+	vpcService := VpcService{client, ctx}
+	request, err := buildAlibabacloudStackRouterInterfaceCreateArgs(ctx, d, meta)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -135,10 +136,10 @@ func resourceAlibabacloudStackRouterInterfaceCreate(d *schema.ResourceData, meta
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackRouterInterfaceUpdate(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -154,7 +155,7 @@ func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if attributeUpdate {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ModifyRouterInterfaceAttribute(request)
@@ -176,7 +177,7 @@ func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta
 		}
 
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.RouterInterfaceId = d.Id()
 		request.Spec = d.Get("specification").(string)
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -189,13 +190,13 @@ func resourceAlibabacloudStackRouterInterfaceUpdate(d *schema.ResourceData, meta
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackRouterInterfaceRead(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpcService.DescribeRouterInterface(d.Id(), client.RegionId)
 	if err != nil {
@@ -223,9 +224,9 @@ func resourceAlibabacloudStackRouterInterfaceRead(d *schema.ResourceData, meta i
 
 }
 
-func resourceAlibabacloudStackRouterInterfaceDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	if object, err := vpcService.DescribeRouterInterface(d.Id(), client.RegionId); err != nil {
 		if NotFoundError(err) {
@@ -246,10 +247,10 @@ func resourceAlibabacloudStackRouterInterfaceDelete(d *schema.ResourceData, meta
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterInterfaceId = d.Id()
 	request.ClientToken = buildClientToken(request.GetActionName())
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouterInterface(&args)
@@ -273,9 +274,9 @@ func resourceAlibabacloudStackRouterInterfaceDelete(d *schema.ResourceData, meta
 	return WrapError(vpcService.WaitForRouterInterface(d.Id(), client.RegionId, Deleted, DefaultTimeoutMedium))
 }
 
-func buildAlibabacloudStackRouterInterfaceCreateArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateRouterInterfaceRequest, error) {
+func buildAlibabacloudStackRouterInterfaceCreateArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*vpc.CreateRouterInterfaceRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	oppositeRegion := d.Get("opposite_region").(string)
 	if err := ecsService.JudgeRegionValidation("opposite_region", oppositeRegion); err != nil {
@@ -290,7 +291,7 @@ func buildAlibabacloudStackRouterInterfaceCreateArgs(d *schema.ResourceData, met
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterType = d.Get("router_type").(string)
 	request.RouterId = d.Get("router_id").(string)
 	request.Role = d.Get("role").(string)
@@ -316,7 +317,7 @@ func buildAlibabacloudStackRouterInterfaceCreateArgs(d *schema.ResourceData, met
 			describeVirtualBorderRoutersRequest.Scheme = "http"
 		}
 		describeVirtualBorderRoutersRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		describeVirtualBorderRoutersRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		describeVirtualBorderRoutersRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		values := []string{request.RouterId}
 		filters := []vpc.DescribeVirtualBorderRoutersFilter{{
@@ -368,7 +369,7 @@ func buildAlibabacloudStackRouterInterfaceModifyAttrArgs(d *schema.ResourceData,
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterInterfaceId = d.Id()
 
 	attributeUpdate := false
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -15,11 +16,11 @@ import (
 
 func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackRouterInterfaceConnectionCreate,
-		Read:   resourceAlibabacloudStackRouterInterfaceConnectionRead,
-		Delete: resourceAlibabacloudStackRouterInterfaceConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackRouterInterfaceConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -63,9 +64,9 @@ func resourceAlibabacloudStackRouterInterfaceConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	oppositeId := d.Get("opposite_interface_id").(string)
 	interfaceId := d.Get("interface_id").(string)
@@ -88,7 +89,7 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 			if err = vpcService.WaitForRouterInterfaceConnection(d.Id(), client.RegionId, Active, DefaultTimeout); err != nil {
 				return WrapError(err)
 			}
-			return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+			return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 		}
 	}
 
@@ -101,7 +102,7 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RouterInterfaceId = interfaceId
 	request.OppositeInterfaceId = oppositeId
 
@@ -160,10 +161,10 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 			connectRequest.Scheme = "http"
 		}
 		connectRequest.Headers = map[string]string{"RegionId": client.RegionId}
-		connectRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		connectRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		connectRequest.RouterInterfaceId = interfaceId
-		if err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+		if err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.ConnectRouterInterface(connectRequest)
 			})
@@ -184,13 +185,13 @@ func resourceAlibabacloudStackRouterInterfaceConnectionCreate(d *schema.Resource
 		}
 	}
 
-	return resourceAlibabacloudStackRouterInterfaceConnectionRead(d, meta)
+	return resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeRouterInterfaceConnection(d.Id(), client.RegionId)
 
 	if err != nil {
@@ -219,9 +220,9 @@ func resourceAlibabacloudStackRouterInterfaceConnectionRead(d *schema.ResourceDa
 
 }
 
-func resourceAlibabacloudStackRouterInterfaceConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackRouterInterfaceConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeRouterInterfaceConnection(d.Id(), client.RegionId)
 	if err != nil {
 		if NotFoundError(err) {
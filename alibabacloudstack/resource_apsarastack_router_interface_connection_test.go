@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -23,7 +24,7 @@ func testAccCheckRouterInterfaceConnectionExists(n string) resource.TestCheckFun
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		response, err := vpcService.DescribeRouterInterfaceConnection(rs.Primary.ID, client.RegionId)
 		if err != nil {
@@ -46,7 +47,7 @@ func testAccCheckRouterInterfaceConnectionDestroy(s *terraform.State) error {
 
 		// Try to find the interface
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		ri, err := vpcService.DescribeRouterInterfaceConnection(rs.Primary.ID, client.RegionId)
 		if err != nil {
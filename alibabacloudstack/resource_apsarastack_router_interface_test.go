@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"os"
@@ -44,7 +45,7 @@ func testSweepRouterInterfaces(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -70,7 +71,7 @@ func testSweepRouterInterfaces(region string) error {
 		}
 		req.PageNumber = page
 	}
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, v := range ris {
 		name := v.Name
 		id := v.RouterInterfaceId
@@ -99,7 +100,7 @@ func testSweepRouterInterfaces(region string) error {
 			req.Scheme = "http"
 		}
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.RouterInterfaceId = id
 		_, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteRouterInterface(req)
@@ -123,7 +124,7 @@ func testAccCheckRouterInterfaceExists(n string, ri *vpc.RouterInterfaceType) re
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		response, err := vpcService.DescribeRouterInterface(rs.Primary.ID, client.RegionId)
 		if err != nil {
@@ -143,7 +144,7 @@ func testAccCheckRouterInterfaceDestroy(s *terraform.State) error {
 
 		// Try to find the interface
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 
 		ri, err := vpcService.DescribeRouterInterface(rs.Primary.ID, client.RegionId)
 		if err != nil {
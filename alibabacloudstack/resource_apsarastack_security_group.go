@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -14,14 +15,15 @@ import (
 
 func resourceAlibabacloudStackSecurityGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSecurityGroupCreate,
-		Read:   resourceAlibabacloudStackSecurityGroupRead,
-		Update: resourceAlibabacloudStackSecurityGroupUpdate,
-		Delete: resourceAlibabacloudStackSecurityGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSecurityGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSecurityGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -47,18 +49,19 @@ func resourceAlibabacloudStackSecurityGroup() *schema.Resource {
 				Computed:     true,
 				ValidateFunc: validation.StringInSlice([]string{"Accept", "Drop"}, false),
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackSecurityGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateSecurityGroupRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -86,13 +89,13 @@ func resourceAlibabacloudStackSecurityGroupCreate(d *schema.ResourceData, meta i
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	response, _ := raw.(*ecs.CreateSecurityGroupResponse)
 	d.SetId(response.SecurityGroupId)
-	return resourceAlibabacloudStackSecurityGroupUpdate(d, meta)
+	return resourceAlibabacloudStackSecurityGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	object, err := ecsService.DescribeSecurityGroup(d.Id())
 	if err != nil {
@@ -115,7 +118,7 @@ func resourceAlibabacloudStackSecurityGroupRead(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SecurityGroupId = d.Id()
 
 	raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -129,17 +132,19 @@ func resourceAlibabacloudStackSecurityGroupRead(d *schema.ResourceData, meta int
 	if len(response.SecurityGroups.SecurityGroup) < 1 {
 		return WrapErrorf(Error(GetNotFoundMessage("SecurityGroup", d.Id())), NotFoundMsg, ProviderERROR)
 	}
-	d.Set("tags", ecsService.tagsToMap(response.SecurityGroups.SecurityGroup[0].Tags.Tag))
+	if err := setResourceTags(d, meta, ecsService.tagsToMap(response.SecurityGroups.SecurityGroup[0].Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
 
-	if err := setTags(client, TagResourceSecurityGroup, d); err != nil {
+	if err := setTags(ctx, client, TagResourceSecurityGroup, d); err != nil {
 		return WrapError(err)
 	} else {
 		//d.SetPartial("tags")
@@ -153,7 +158,7 @@ func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta i
 		request := ecs.CreateModifySecurityGroupPolicyRequest()
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
 		} else {
@@ -175,14 +180,14 @@ func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta i
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSecurityGroupRead(d, meta)
+		return resourceAlibabacloudStackSecurityGroupRead(ctx, d, meta)
 	}
 
 	update := false
 	request := ecs.CreateModifySecurityGroupAttributeRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -212,23 +217,23 @@ func resourceAlibabacloudStackSecurityGroupUpdate(d *schema.ResourceData, meta i
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSecurityGroupRead(d, meta)
+	return resourceAlibabacloudStackSecurityGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSecurityGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSecurityGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	request := ecs.CreateDeleteSecurityGroupRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SecurityGroupId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
 		request.Scheme = "http"
 	}
-	err := resource.Retry(6*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteSecurityGroup(request)
 		})
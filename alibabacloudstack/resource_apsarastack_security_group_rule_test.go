@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"testing"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackSecurityGroupRuleBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_security_group_rule.default"
 	ra := resourceAttrInit(resourceId, testAccCheckSecurityGroupRuleBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -86,7 +87,7 @@ func TestAccAlibabacloudStackSecurityGroupEgressRule(t *testing.T) {
 		"cidr_ip":     "182.254.11.243/32",
 	})
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -128,7 +129,7 @@ func TestAccAlibabacloudStackSecurityGroupRuleMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_security_group_rule.default.2"
 	ra := resourceAttrInit(resourceId, testAccCheckSecurityGroupRuleBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -323,7 +324,7 @@ var testAccCheckSecurityGroupRuleBasicMap = map[string]string{
 
 func testAccCheckSecurityGroupRuleDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_security_group_rule" {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -47,7 +48,7 @@ func testSweepSecurityGroups(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.RegionId = client.RegionId
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
@@ -75,8 +76,8 @@ func testSweepSecurityGroups(region string) error {
 		req.PageNumber = page
 	}
 
-	vpcService := VpcService{client}
-	ecsService := EcsService{client}
+	vpcService := VpcService{client, context.Background()}
+	ecsService := EcsService{client, context.Background()}
 	for _, v := range groups {
 		name := v.SecurityGroupName
 		id := v.SecurityGroupId
@@ -107,7 +108,7 @@ func testSweepSecurityGroups(region string) error {
 
 func testAccCheckSecurityGroupDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_security_group" {
@@ -132,7 +133,7 @@ func TestAccAlibabacloudStackSecurityGroupBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_security_group.default"
 	ra := resourceAttrInit(resourceId, testAccCheckSecurityBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -206,7 +207,7 @@ func TestAccAlibabacloudStackSecurityGroupMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_security_group.default.9"
 	ra := resourceAttrInit(resourceId, testAccCheckSecurityBasicMap)
 	serviceFunc := func() interface{} {
-		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &EcsService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -382,3 +383,38 @@ var testAccCheckSecurityBasicMap = map[string]string{
 	//"tags.foo":            "foo",
 	//"tags.Test":           "Test",
 }
+
+func TestUnitAlibabacloudStackSecurityGroup_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("security_group")
+	client := server.client()
+
+	r := resourceAlibabacloudStackSecurityGroup()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"name":                "tf-testAccSecurityGroupMock",
+		"description":         "mock security group",
+		"vpc_id":              "vpc-mock0001",
+		"inner_access_policy": "Accept",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the security group got an error: %#v", diags)
+	}
+	if d.Id() != "sg-mock0001" {
+		t.Fatalf("expected the security group id sg-mock0001, got %q", d.Id())
+	}
+	if value := d.Get("inner_access_policy").(string); value != "Accept" {
+		t.Errorf("expected the inner_access_policy Accept, got %q", value)
+	}
+	if value := d.Get("tags.Created"); value != "TF" {
+		t.Errorf("expected the tag Created TF, got %v", value)
+	}
+	if call, ok := server.lastCall("CreateSecurityGroup"); !ok || call.Params["VpcId"] != "vpc-mock0001" || call.Params["SecurityGroupName"] != "tf-testAccSecurityGroupMock" {
+		t.Errorf("CreateSecurityGroup was not called with the expected parameters: %v", call.Params)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the security group got an error: %#v", diags)
+	}
+	if count := server.callCount("DeleteSecurityGroup"); count != 1 {
+		t.Errorf("expected DeleteSecurityGroup to be called once, got %d", count)
+	}
+}
@@ -1,8 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
@@ -14,14 +16,15 @@ import (
 
 func resourceAlibabacloudStackSlb() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCreate,
-		Read:   resourceAlibabacloudStackSlbRead,
-		Update: resourceAlibabacloudStackSlbUpdate,
-		Delete: resourceAlibabacloudStackSlbDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -54,6 +57,7 @@ func resourceAlibabacloudStackSlb() *schema.Resource {
 				Type:     schema.TypeMap,
 				Optional: true,
 			},
+			"tags_all": tagsAllSchema(),
 			"address": {
 				Type:         schema.TypeString,
 				Computed:     true,
@@ -65,9 +69,9 @@ func resourceAlibabacloudStackSlb() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	request := slb.CreateCreateLoadBalancerRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -76,7 +80,7 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerName = d.Get("name").(string)
 	request.AddressType = strings.ToLower(string(Intranet))
 	request.ClientToken = buildClientToken(request.GetActionName())
@@ -98,15 +102,19 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 
 	var raw interface{}
 
-	invoker := Invoker{}
-	invoker.AddCatcher(Catcher{"OperationFailed.TokenIsProcessing", 10, 5})
 	log.Printf("[DEBUG] slb request %v", request)
-	if err := invoker.Run(func() error {
+	if err := resource.RetryContext(ctx, time.Minute, func() *resource.RetryError {
 		resp, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateLoadBalancer(request)
 		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"OperationFailed.TokenIsProcessing"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
 		raw = resp
-		return err
+		return nil
 	}); err != nil {
 		if IsExpectedErrors(err, []string{"OrderFailed"}) {
 			return WrapError(err)
@@ -121,13 +129,13 @@ func resourceAlibabacloudStackSlbCreate(d *schema.ResourceData, meta interface{}
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSlbUpdate(d, meta)
+	return resourceAlibabacloudStackSlbUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	object, err := slbService.DescribeSlb(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -145,17 +153,17 @@ func resourceAlibabacloudStackSlbRead(d *schema.ResourceData, meta interface{})
 
 	tags, _ := slbService.DescribeTags(d.Id(), nil, TagResourceInstance)
 	if len(tags) > 0 {
-		if err := d.Set("tags", slbService.tagsToMap(tags)); err != nil {
+		if err := setResourceTags(d, meta, slbService.tagsToMap(tags)); err != nil {
 			return WrapError(err)
 		}
 	}
 	return nil
 }
 
-func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	d.Partial(true)
 
 	// set instance tags
@@ -166,7 +174,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSlbRead(d, meta)
+		return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 	}
 
 	if d.HasChange("specification") {
@@ -178,7 +186,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 		request.LoadBalancerSpec = d.Get("specification").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -199,7 +207,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		}
 		request.RegionId = client.RegionId
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 		request.LoadBalancerName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -219,7 +227,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		modifyLoadBalancerInternetSpecRequest.Scheme = "http"
 	}
 	modifyLoadBalancerInternetSpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	modifyLoadBalancerInternetSpecRequest.RegionId = client.RegionId
 	modifyLoadBalancerInternetSpecRequest.LoadBalancerId = d.Id()
 	if update {
@@ -241,7 +249,7 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 		modifyLoadBalancerPayTypeRequest.Scheme = "http"
 	}
 	modifyLoadBalancerInternetSpecRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	modifyLoadBalancerInternetSpecRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	modifyLoadBalancerPayTypeRequest.LoadBalancerId = d.Id()
 
 	if update {
@@ -255,12 +263,12 @@ func resourceAlibabacloudStackSlbUpdate(d *schema.ResourceData, meta interface{}
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbRead(d, meta)
+	return resourceAlibabacloudStackSlbRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	request := slb.CreateDeleteLoadBalancerRequest()
 	request.RegionId = client.RegionId
@@ -270,7 +278,7 @@ func resourceAlibabacloudStackSlbDelete(d *schema.ResourceData, meta interface{}
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Id()
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -15,14 +16,15 @@ import (
 
 func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbAclCreate,
-		Read:   resourceAlibabacloudStackSlbAclRead,
-		Update: resourceAlibabacloudStackSlbAclUpdate,
-		Delete: resourceAlibabacloudStackSlbAclDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbAclCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbAclRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbAclUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbAclDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -53,12 +55,13 @@ func resourceAlibabacloudStackSlbAcl() *schema.Resource {
 				MaxItems: 300,
 				MinItems: 0,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateCreateAccessControlListRequest()
@@ -69,12 +72,11 @@ func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interfac
 		request.Scheme = "http"
 	}
 	request.Headers["RegionId"] = client.RegionId
-	request.QueryParams["AccessKeySecret"] = client.SecretKey
 	request.QueryParams["Product"] = "Slb"
 	request.AclName = strings.TrimSpace(d.Get("name").(string))
 	request.AddressIPVersion = d.Get("ip_version").(string)
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.CreateAccessControlList(request)
@@ -86,19 +88,21 @@ func resourceAlibabacloudStackSlbAclCreate(d *schema.ResourceData, meta interfac
 	response, _ := raw.(*slb.CreateAccessControlListResponse)
 
 	d.SetId(response.AclId)
-	return resourceAlibabacloudStackSlbAclUpdate(d, meta)
+	return resourceAlibabacloudStackSlbAclUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	tags, err := slbService.DescribeTags(d.Id(), nil, TagResourceAcl)
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", slbService.tagsToMap(tags))
+	if err := setResourceTags(d, meta, slbService.tagsToMap(tags)); err != nil {
+		return WrapError(err)
+	}
 
 	object, err := slbService.DescribeSlbAcl(d.Id())
 	if err != nil {
@@ -117,9 +121,9 @@ func resourceAlibabacloudStackSlbAclRead(d *schema.ResourceData, meta interface{
 	return nil
 }
 
-func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	d.Partial(true)
 
@@ -136,7 +140,7 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.AclId = d.Id()
 		request.AclName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -172,12 +176,12 @@ func resourceAlibabacloudStackSlbAclUpdate(d *schema.ResourceData, meta interfac
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbAclRead(d, meta)
+	return resourceAlibabacloudStackSlbAclRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbAclDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	request := slb.CreateDeleteAccessControlListRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -186,9 +190,9 @@ func resourceAlibabacloudStackSlbAclDelete(d *schema.ResourceData, meta interfac
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AclId = d.Id()
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteAccessControlList(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbBackendServersCreate,
-		Read:   resourceAlibabacloudStackSlbBackendServersRead,
-		Update: resourceAlibabacloudStackSlbBackendServersUpdate,
-		Delete: resourceAlibabacloudStackSlbBackendServersDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbBackendServersRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbBackendServersDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -57,7 +58,7 @@ func resourceAlibabacloudStackSlbBackendServer() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateAddBackendServersRequest()
 	request.RegionId = client.RegionId
@@ -67,7 +68,7 @@ func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, me
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("backend_servers"); ok {
 		request.BackendServers = expandBackendServersInfoToString(v.(*schema.Set).List())
@@ -82,13 +83,13 @@ func resourceAlibabacloudStackSlbBackendServersCreate(d *schema.ResourceData, me
 	response, _ := raw.(*slb.AddBackendServersResponse)
 	d.SetId(response.LoadBalancerId)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	resource_id := d.Id()
 	object, err := slbService.DescribeSlb(resource_id)
 
@@ -119,7 +120,7 @@ func resourceAlibabacloudStackSlbBackendServersRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = d.Id()
 
 			segs := len(rmservers)/step + 1
@@ -202,7 +203,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.LoadBalancerId = d.Id()
 
 			segs := len(addservers)/step + 1
@@ -253,7 +254,7 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 					request.Scheme = "http"
 				}
 				request.Headers = map[string]string{"RegionId": client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 				request.LoadBalancerId = d.Id()
 				request.BackendServers = expandBackendServersInfoToString(servers[start:end])
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -269,16 +270,16 @@ func resourceAlibabacloudStackSlbBackendServersUpdate(d *schema.ResourceData, me
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbBackendServersRead(d, meta)
+	return resourceAlibabacloudStackSlbBackendServersRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbBackendServersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	instanceSet := d.Get("backend_servers").(*schema.Set)
 	step := 20
 	if len(instanceSet.List()) > 0 {
 
-		slbService := SlbService{client}
+		slbService := SlbService{client, ctx}
 		if d.Get("delete_protection_validation").(bool) {
 			lbInstance, err := slbService.DescribeSlb(d.Id())
 			if err != nil {
@@ -310,7 +311,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.LoadBalancerId = d.Id()
 
 		segs := len(servers)/step + 1
@@ -322,7 +323,7 @@ func resourceAlibabacloudStackSlbBackendServersDelete(d *schema.ResourceData, me
 			}
 
 			request.BackendServers = expandBackendServersWithoutTypeToString(servers[start:end])
-			err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 				raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 					return slbClient.RemoveBackendServers(request)
 				})
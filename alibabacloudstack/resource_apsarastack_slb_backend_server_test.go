@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -16,7 +17,7 @@ func TestAccAlibabacloudStackSlbBackendServers_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_backend_server.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -69,7 +70,7 @@ func TestAccAlibabacloudStackSlbBackendServers_multi_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_backend_server.default.1"
 	ra := resourceAttrInit(resourceId, slb_vpc)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -120,7 +121,7 @@ func TestAccAlibabacloudStackSlbBackendServers_classic(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_backend_server.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -12,12 +13,12 @@ import (
 
 func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbCACertificateCreate,
-		Read:   resourceAlibabacloudStackSlbCACertificateRead,
-		Update: resourceAlibabacloudStackSlbCACertificateUpdate,
-		Delete: resourceAlibabacloudStackSlbCACertificateDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbCACertificateRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbCACertificateDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -34,9 +35,9 @@ func resourceAlibabacloudStackSlbCACertificate() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	request := slb.CreateUploadCACertificateRequest()
 	request.RegionId = client.RegionId
@@ -46,7 +47,7 @@ func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	if val, ok := d.GetOk("name"); ok && val.(string) != "" {
 		request.CACertificateName = val.(string)
@@ -69,13 +70,13 @@ func resourceAlibabacloudStackSlbCACertificateCreate(d *schema.ResourceData, met
 
 	d.SetId(response.CACertificateId)
 
-	return resourceAlibabacloudStackSlbCACertificateUpdate(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	object, err := slbService.DescribeSlbCACertificate(d.Id())
 	if err != nil {
@@ -94,7 +95,7 @@ func resourceAlibabacloudStackSlbCACertificateRead(d *schema.ResourceData, meta
 	return nil
 }
 
-func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("name") {
 		request := slb.CreateSetCACertificateNameRequest()
@@ -105,7 +106,7 @@ func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, met
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.CACertificateId = d.Id()
 		request.CACertificateName = d.Get("name").(string)
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
@@ -117,12 +118,12 @@ func resourceAlibabacloudStackSlbCACertificateUpdate(d *schema.ResourceData, met
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbCACertificateRead(d, meta)
+	return resourceAlibabacloudStackSlbCACertificateRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbCACertificateDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	request := slb.CreateDeleteCACertificateRequest()
 	request.RegionId = client.RegionId
@@ -132,10 +133,10 @@ func resourceAlibabacloudStackSlbCACertificateDelete(d *schema.ResourceData, met
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.CACertificateId = d.Id()
 
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteCACertificate(request)
 		})
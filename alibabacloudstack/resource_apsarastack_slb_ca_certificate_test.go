@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -38,7 +39,7 @@ func testSweepSlbCACertificate(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 		return slbClient.DescribeCACertificates(req)
@@ -67,7 +68,7 @@ func testSweepSlbCACertificate(region string) error {
 		log.Printf("[INFO] Deleting Slb CA Certificate : %s (%s)", name, id)
 		req := slb.CreateDeleteCACertificateRequest()
 		req.Headers = map[string]string{"RegionId": client.RegionId}
-		req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		req.CACertificateId = id
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			req.Scheme = "https"
@@ -89,7 +90,7 @@ func TestAccAlibabacloudStackSlbCACertificate_basic(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_ca_certificate.default"
 	ra := resourceAttrInit(resourceId, ca_certificateMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &sc, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeSlbCACertificate")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -135,7 +136,7 @@ func TestAccAlibabacloudStackSlbCACertificate_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_ca_certificate.default.9"
 	ra := resourceAttrInit(resourceId, ca_certificateMap)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &sc, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeSlbCACertificate")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
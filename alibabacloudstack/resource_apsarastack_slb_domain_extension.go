@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -16,13 +17,13 @@ import (
 
 func resourceAlibabacloudStackSlbDomainExtension() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbDomainExtensionCreate,
-		Read:   resourceAlibabacloudStackSlbDomainExtensionRead,
-		Update: resourceAlibabacloudStackSlbDomainExtensionUpdate,
-		Delete: resourceAlibabacloudStackSlbDomainExtensionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbDomainExtensionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbDomainExtensionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbDomainExtensionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbDomainExtensionDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -54,7 +55,7 @@ func resourceAlibabacloudStackSlbDomainExtension() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDomainExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := slb.CreateCreateDomainExtensionRequest()
@@ -64,14 +65,14 @@ func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	request.ListenerPort = requests.NewInteger(d.Get("frontend_port").(int))
 	request.Domain = d.Get("domain").(string)
 	request.ServerCertificateId = d.Get("server_certificate_id").(string)
 
 	var response *slb.CreateDomainExtensionResponse
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateDomainExtension(request)
 		})
@@ -90,13 +91,13 @@ func resourceAlibabacloudStackSlbDomainExtensionCreate(d *schema.ResourceData, m
 	}
 
 	d.SetId(response.DomainExtensionId)
-	return resourceAlibabacloudStackSlbDomainExtensionRead(d, meta)
+	return resourceAlibabacloudStackSlbDomainExtensionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDomainExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	domainExtension, err := slbService.DescribeDomainExtensionAttribute(d.Id())
 	if err != nil {
@@ -116,12 +117,12 @@ func resourceAlibabacloudStackSlbDomainExtensionRead(d *schema.ResourceData, met
 	return nil
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDomainExtensionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	if d.HasChange("server_certificate_id") {
 		request := slb.CreateSetDomainExtensionAttributeRequest()
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.DomainExtensionId = d.Id()
 		if strings.ToLower(client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -130,7 +131,7 @@ func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, m
 		}
 		request.ServerCertificateId = d.Get("server_certificate_id").(string)
 		client := meta.(*connectivity.AlibabacloudStackClient)
-		err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 				return slbClient.SetDomainExtensionAttribute(request)
 			})
@@ -148,12 +149,12 @@ func resourceAlibabacloudStackSlbDomainExtensionUpdate(d *schema.ResourceData, m
 		}
 		//d.SetPartial("server_certificate_id")
 	}
-	return resourceAlibabacloudStackSlbDomainExtensionRead(d, meta)
+	return resourceAlibabacloudStackSlbDomainExtensionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbDomainExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	if d.Get("delete_protection_validation").(bool) {
 		lbId := d.Get("load_balancer_id").(string)
@@ -177,9 +178,9 @@ func resourceAlibabacloudStackSlbDomainExtensionDelete(d *schema.ResourceData, m
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteDomainExtension(request)
 		})
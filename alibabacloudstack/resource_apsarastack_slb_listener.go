@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbListenerCreate,
-		Read:   resourceAlibabacloudStackSlbListenerRead,
-		Update: resourceAlibabacloudStackSlbListenerUpdate,
-		Delete: resourceAlibabacloudStackSlbListenerDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbListenerCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbListenerRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbListenerUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbListenerDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -295,10 +296,10 @@ func resourceAlibabacloudStackSlbListener() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	httpForward := false
 	protocol := d.Get("protocol").(string)
 	lb_id := d.Get("load_balancer_id").(string)
@@ -306,7 +307,7 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 	if listenerForward, ok := d.GetOk("listener_forward"); ok && listenerForward.(string) == string(OnFlag) {
 		httpForward = true
 	}
-	request, err := buildListenerCommonArgs(d, meta)
+	request, err := buildListenerCommonArgs(ctx, d, meta)
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -365,12 +366,12 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		startLoadBalancerListenerRequest.Scheme = "http"
 	}
 	startLoadBalancerListenerRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	startLoadBalancerListenerRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	startLoadBalancerListenerRequest.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	startLoadBalancerListenerRequest.LoadBalancerId = lb_id
 	startLoadBalancerListenerRequest.ListenerPort = requests.NewInteger(frontend)
 	startLoadBalancerListenerRequest.ListenerProtocol = protocol
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.StartLoadBalancerListener(startLoadBalancerListenerRequest)
 		})
@@ -392,17 +393,17 @@ func resourceAlibabacloudStackSlbListenerCreate(d *schema.ResourceData, meta int
 		return WrapError(err)
 	}
 	if httpForward {
-		return resourceAlibabacloudStackSlbListenerRead(d, meta)
+		return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 	}
-	return resourceAlibabacloudStackSlbListenerUpdate(d, meta)
+	return resourceAlibabacloudStackSlbListenerUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
-	lb_id, protocol, port, err := parseListenerId(d, meta)
+	lb_id, protocol, port, err := parseListenerId(ctx, d, meta)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -415,7 +416,7 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 	d.Set("load_balancer_id", lb_id)
 	d.Set("frontend_port", port)
 	d.SetId(lb_id + ":" + protocol + ":" + strconv.Itoa(port))
-	return resource.Retry(5*time.Minute, func() *resource.RetryError {
+	return resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		object, err := slbService.DescribeSlbListener(d.Id())
 		if err != nil {
 			if NotFoundError(err) {
@@ -437,7 +438,7 @@ func resourceAlibabacloudStackSlbListenerRead(d *schema.ResourceData, meta inter
 	})
 }
 
-func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	proto := d.Get("protocol").(string)
 	lb_id := d.Get("load_balancer_id").(string)
 	frontend := d.Get("frontend_port").(int)
@@ -445,7 +446,7 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	protocol := Protocol(d.Get("protocol").(string))
-	commonRequest, err := buildListenerCommonArgs(d, meta)
+	commonRequest, err := buildListenerCommonArgs(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
@@ -656,14 +657,14 @@ func resourceAlibabacloudStackSlbListenerUpdate(d *schema.ResourceData, meta int
 
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbListenerRead(d, meta)
+	return resourceAlibabacloudStackSlbListenerRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
-	lbId, protocol, port, err := parseListenerId(d, meta)
+	lbId, protocol, port, err := parseListenerId(ctx, d, meta)
 	if err != nil {
 		if NotFoundError(err) {
 			d.SetId("")
@@ -691,11 +692,11 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = lbId
 	request.ListenerPort = requests.NewInteger(port)
 	request.ListenerProtocol = protocol
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteLoadBalancerListener(request)
 		})
@@ -715,9 +716,9 @@ func resourceAlibabacloudStackSlbListenerDelete(d *schema.ResourceData, meta int
 	return WrapError(slbService.WaitForSlbListener(d.Id(), Deleted, DefaultTimeoutMedium))
 }
 
-func buildListenerCommonArgs(d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
+func buildListenerCommonArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*requests.CommonRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	request, err := slbService.BuildSlbCommonRequest()
 	if err != nil {
@@ -725,7 +726,7 @@ func buildListenerCommonArgs(d *schema.ResourceData, meta interface{}) (*request
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.QueryParams["LoadBalancerId"] = d.Get("load_balancer_id").(string)
 	request.QueryParams["ListenerPort"] = string(requests.NewInteger(d.Get("frontend_port").(int)))
@@ -833,9 +834,9 @@ func buildHttpForwardArgs(d *schema.ResourceData, req *requests.CommonRequest) (
 	return req, nil
 }
 
-func parseListenerId(d *schema.ResourceData, meta interface{}) (string, string, int, error) {
+func parseListenerId(ctx context.Context, d *schema.ResourceData, meta interface{}) (string, string, int, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	parts, err := ParseSlbListenerId(d.Id())
 	if err != nil {
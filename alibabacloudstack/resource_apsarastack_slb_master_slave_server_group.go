@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbMasterSlaveServerGroupRead,
-		Update: resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -75,7 +76,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateCreateMasterSlaveServerGroupRequest()
 	request.RegionId = client.RegionId
@@ -85,7 +86,7 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.Resource
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("name"); ok {
 		request.MasterSlaveServerGroupName = v.(string)
@@ -103,13 +104,13 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupCreate(d *schema.Resource
 	response, _ := raw.(*slb.CreateMasterSlaveServerGroupResponse)
 	d.SetId(response.MasterSlaveServerGroupId)
 
-	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	object, err := slbService.DescribeSlbMasterSlaveServerGroup(d.Id())
 
 	if err != nil {
@@ -142,13 +143,13 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupRead(d *schema.ResourceDa
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	if d.Get("delete_protection_validation").(bool) {
 		lbId := d.Get("load_balancer_id").(string)
@@ -172,10 +173,10 @@ func resourceAlibabacloudStackSlbMasterSlaveServerGroupDelete(d *schema.Resource
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.MasterSlaveServerGroupId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteMasterSlaveServerGroup(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -18,12 +19,12 @@ import (
 
 func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbRuleCreate,
-		Read:   resourceAlibabacloudStackSlbRuleRead,
-		Update: resourceAlibabacloudStackSlbRuleUpdate,
-		Delete: resourceAlibabacloudStackSlbRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbRuleRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbRuleUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -177,7 +178,7 @@ func resourceAlibabacloudStackSlbRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	slb_id := d.Get("load_balancer_id").(string)
@@ -211,13 +212,13 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = slb_id
 	request.ListenerPort = requests.NewInteger(port)
 	request.RuleList = rule
 	var raw interface{}
 	var err error
-	if err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err = client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.CreateRules(request)
 		})
@@ -236,13 +237,13 @@ func resourceAlibabacloudStackSlbRuleCreate(d *schema.ResourceData, meta interfa
 	response, _ := raw.(*slb.CreateRulesResponse)
 	d.SetId(response.Rules.Rule[0].RuleId)
 
-	return resourceAlibabacloudStackSlbRuleUpdate(d, meta)
+	return resourceAlibabacloudStackSlbRuleUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	object, err := slbService.DescribeSlbRule(d.Id())
 
 	if err != nil {
@@ -281,7 +282,7 @@ func resourceAlibabacloudStackSlbRuleRead(d *schema.ResourceData, meta interface
 	return nil
 }
 
-func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	update := false
 	fullUpdate := false
@@ -292,7 +293,7 @@ func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interfa
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RuleId = d.Id()
 	if listenerSync, ok := d.GetOk("listener_sync"); ok && listenerSync == string(OffFlag) {
 		if stickySession := d.Get("sticky_session"); stickySession == string(OnFlag) {
@@ -369,12 +370,12 @@ func resourceAlibabacloudStackSlbRuleUpdate(d *schema.ResourceData, meta interfa
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackSlbRuleRead(d, meta)
+	return resourceAlibabacloudStackSlbRuleRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	if d.Get("delete_protection_validation").(bool) {
 		lbId := d.Get("load_balancer_id").(string)
@@ -398,10 +399,10 @@ func resourceAlibabacloudStackSlbRuleDelete(d *schema.ResourceData, meta interfa
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.RuleIds = fmt.Sprintf("['%s']", d.Id())
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteRules(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -16,12 +17,12 @@ import (
 
 func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSlbServerGroupCreate,
-		Read:   resourceAlibabacloudStackSlbServerGroupRead,
-		Update: resourceAlibabacloudStackSlbServerGroupUpdate,
-		Delete: resourceAlibabacloudStackSlbServerGroupDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSlbServerGroupRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSlbServerGroupDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"load_balancer_id": {
@@ -76,7 +77,7 @@ func resourceAlibabacloudStackSlbServerGroup() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	request := slb.CreateCreateVServerGroupRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -86,7 +87,7 @@ func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.LoadBalancerId = d.Get("load_balancer_id").(string)
 	if v, ok := d.GetOk("name"); ok {
 		request.VServerGroupName = v.(string)
@@ -101,13 +102,13 @@ func resourceAlibabacloudStackSlbServerGroupCreate(d *schema.ResourceData, meta
 	response, _ := raw.(*slb.CreateVServerGroupResponse)
 	d.SetId(response.VServerGroupId)
 	d.Set("load_balancer_id", d.Get("load_balancer_id").(string))
-	return resourceAlibabacloudStackSlbServerGroupUpdate(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 	object, err := slbService.DescribeSlbServerGroup(d.Id())
 
 	if err != nil {
@@ -159,7 +160,7 @@ func resourceAlibabacloudStackSlbServerGroupRead(d *schema.ResourceData, meta in
 	return nil
 }
 
-func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	d.Partial(true)
@@ -205,7 +206,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.VServerGroupId = d.Id()
 			segs := len(rmservers)/step + 1
 			for i := 0; i < segs; i++ {
@@ -253,7 +254,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 				request.Scheme = "http"
 			}
 			request.Headers = map[string]string{"RegionId": client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 			request.VServerGroupId = d.Id()
 			segs := len(addservers)/step + 1
 			for i := 0; i < segs; i++ {
@@ -292,7 +293,7 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.VServerGroupId = d.Id()
 		request.VServerGroupName = name
 		if serverUpdate {
@@ -346,12 +347,12 @@ func resourceAlibabacloudStackSlbServerGroupUpdate(d *schema.ResourceData, meta
 	}
 	d.Partial(false)
 
-	return resourceAlibabacloudStackSlbServerGroupRead(d, meta)
+	return resourceAlibabacloudStackSlbServerGroupRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSlbServerGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	slbService := SlbService{client}
+	slbService := SlbService{client, ctx}
 
 	if d.Get("delete_protection_validation").(bool) {
 		lbId := d.Get("load_balancer_id").(string)
@@ -375,9 +376,9 @@ func resourceAlibabacloudStackSlbServerGroupDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VServerGroupId = d.Id()
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithSlbClient(func(slbClient *slb.Client) (interface{}, error) {
 			return slbClient.DeleteVServerGroup(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackSlbServerGroup_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_server_group.default"
 	ra := resourceAttrInit(resourceId, serverGroupMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -115,7 +116,7 @@ func TestAccAlibabacloudStackSlbServerGroup_multi_vpc(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_server_group.default.1"
 	ra := resourceAttrInit(resourceId, serverGroupMultiClassicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -162,7 +163,7 @@ func TestAccAlibabacloudStackSlbServerGroup_classic(t *testing.T) {
 	resourceId := "alibabacloudstack_slb_server_group.default"
 	ra := resourceAttrInit(resourceId, serverGroupMultiClassicMap)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"testing"
@@ -48,7 +49,7 @@ func testSweepSLBs(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "slb", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	req.PageSize = requests.NewInteger(PageSizeLarge)
 	req.PageNumber = requests.NewInteger(1)
 	for {
@@ -75,9 +76,9 @@ func testSweepSLBs(region string) error {
 		req.PageNumber = page
 	}
 
-	service := SlbService{client}
-	vpcService := VpcService{client}
-	csService := CsService{client}
+	service := SlbService{client, context.Background()}
+	vpcService := VpcService{client, context.Background()}
+	csService := CsService{client, context.Background()}
 	for _, loadBalancer := range slbs {
 		name := loadBalancer.LoadBalancerName
 		id := loadBalancer.LoadBalancerId
@@ -126,7 +127,7 @@ func TestAccAlibabacloudStackSlb_classictest(t *testing.T) {
 	resourceId := "alibabacloudstack_slb.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -224,7 +225,7 @@ func TestAccAlibabacloudStackSlb_vpctest(t *testing.T) {
 	resourceId := "alibabacloudstack_slb.default"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -287,7 +288,7 @@ func TestAccAlibabacloudStackSlb_vpcmulti(t *testing.T) {
 	resourceId := "alibabacloudstack_slb.default.2"
 	ra := resourceAttrInit(resourceId, nil)
 	rc := resourceCheckInit(resourceId, &v, func() interface{} {
-		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &SlbService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	})
 	rac := resourceAttrCheckInit(rc, ra)
 
@@ -345,3 +346,35 @@ provider "alibabacloudstack" {
 	}
 	`, name)
 }
+
+func TestUnitAlibabacloudStackSlb_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("slb")
+	client := server.client()
+
+	r := resourceAlibabacloudStackSlb()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"name":          "tf-testAccSlbMock",
+		"address_type":  "intranet",
+		"vswitch_id":    "vsw-mock0001",
+		"specification": "slb.s2.small",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the slb got an error: %#v", diags)
+	}
+	if d.Id() != "lb-mock0001" {
+		t.Fatalf("expected the slb id lb-mock0001, got %q", d.Id())
+	}
+	if value := d.Get("address").(string); value != "192.168.0.10" {
+		t.Errorf("expected the slb address 192.168.0.10, got %q", value)
+	}
+	if call, ok := server.lastCall("CreateLoadBalancer"); !ok || call.Params["LoadBalancerSpec"] != "slb.s2.small" || call.Params["VSwitchId"] != "vsw-mock0001" {
+		t.Errorf("CreateLoadBalancer was not called with the expected parameters: %v", call.Params)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the slb got an error: %#v", diags)
+	}
+	if count := server.callCount("DeleteLoadBalancer"); count != 1 {
+		t.Errorf("expected DeleteLoadBalancer to be called once, got %d", count)
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 	"time"
@@ -13,13 +14,13 @@ import (
 
 func resourceAlibabacloudStackSnapshot() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnapshotCreate,
-		Read:   resourceAlibabacloudStackSnapshotRead,
-		Update: resourceAlibabacloudStackSnapshotUpdate,
-		Delete: resourceAlibabacloudStackSnapshotDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnapshotCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnapshotRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSnapshotUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnapshotDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -27,6 +28,7 @@ func resourceAlibabacloudStackSnapshot() *schema.Resource {
 			Delete: schema.DefaultTimeout(DefaultTimeout * time.Second),
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"disk_id": {
 				Type:     schema.TypeString,
@@ -44,18 +46,19 @@ func resourceAlibabacloudStackSnapshot() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateSnapshotRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.DiskId = d.Get("disk_id").(string)
 	request.ClientToken = buildClientToken(request.GetActionName())
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -80,22 +83,22 @@ func resourceAlibabacloudStackSnapshotCreate(d *schema.ResourceData, meta interf
 	response := raw.(*ecs.CreateSnapshotResponse)
 	d.SetId(response.SnapshotId)
 
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	stateConf := BuildStateConf([]string{}, []string{string(SnapshotCreatingAccomplished)}, d.Timeout(schema.TimeoutCreate), 2*time.Minute,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackSnapshotUpdate(d, meta)
+	return resourceAlibabacloudStackSnapshotUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	snapshot, err := ecsService.DescribeSnapshot(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -113,29 +116,31 @@ func resourceAlibabacloudStackSnapshotRead(d *schema.ResourceData, meta interfac
 		return WrapError(err)
 	}
 	if len(tags) > 0 {
-		d.Set("tags", tagsToMap(tags))
+		if err := setResourceTags(d, meta, tagsToMap(tags)); err != nil {
+			return WrapError(err)
+		}
 	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	if err := setTags(client, TagResourceSnapshot, d); err != nil {
+	if err := setTags(ctx, client, TagResourceSnapshot, d); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackSnapshotRead(d, meta)
+	return resourceAlibabacloudStackSnapshotRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDeleteSnapshotRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnapshotId = d.Id()
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -144,7 +149,7 @@ func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interf
 	}
 	var raw interface{}
 	var err error
-	err = resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err = client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteSnapshot(request)
 		})
@@ -168,7 +173,7 @@ func resourceAlibabacloudStackSnapshotDelete(d *schema.ResourceData, meta interf
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 0,
 		ecsService.SnapshotStateRefreshFunc(d.Id(), []string{string(SnapshotCreatingFailed)}))
 
-	if _, err = stateConf.WaitForState(); err != nil {
+	if _, err = stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"strings"
 	"time"
@@ -14,15 +15,16 @@ import (
 
 func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnapshotPolicyCreate,
-		Read:   resourceAlibabacloudStackSnapshotPolicyRead,
-		Update: resourceAlibabacloudStackSnapshotPolicyUpdate,
-		Delete: resourceAlibabacloudStackSnapshotPolicyDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnapshotPolicyRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnapshotPolicyDelete),
 
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -38,7 +40,8 @@ func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 				Type:     schema.TypeInt,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"time_points": {
 				Type:     schema.TypeSet,
 				Required: true,
@@ -48,7 +51,7 @@ func resourceAlibabacloudStackSnapshotPolicy() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
 	request := ecs.CreateCreateAutoSnapshotPolicyRequest()
@@ -59,7 +62,7 @@ func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyName = d.Get("name").(string)
 	request.RepeatWeekdays = convertListToJsonString(d.Get("repeat_weekdays").(*schema.Set).List())
 	request.RetentionDays = requests.NewInteger(d.Get("retention_days").(int))
@@ -75,18 +78,18 @@ func resourceAlibabacloudStackSnapshotPolicyCreate(d *schema.ResourceData, meta
 	response := raw.(*ecs.CreateAutoSnapshotPolicyResponse)
 	d.SetId(response.AutoSnapshotPolicyId)
 
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	if err := ecsService.WaitForSnapshotPolicy(d.Id(), SnapshotPolicyNormal, DefaultTimeout); err != nil {
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 	object, err := ecsService.DescribeSnapshotPolicy(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -107,17 +110,19 @@ func resourceAlibabacloudStackSnapshotPolicyRead(d *schema.ResourceData, meta in
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", ecsService.tagsToMap(object.Tags.Tag))
+	if err := setResourceTags(d, meta, ecsService.tagsToMap(object.Tags.Tag)); err != nil {
+		return WrapError(err)
+	}
 	d.Set("time_points", timePoints)
 
 	return nil
 }
 
-func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 
-	ecsService := EcsService{client}
-	if d.HasChange("tags") {
+	ecsService := EcsService{client, ctx}
+	if d.HasChange("tags_all") {
 		if err := ecsService.SetResourceTagsNew(d, "auto_snapshot_policy"); err != nil {
 			return WrapError(err)
 		}
@@ -126,7 +131,7 @@ func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta
 	request := ecs.CreateModifyAutoSnapshotPolicyExRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyId = d.Id()
 	if d.HasChange("name") {
 		request.AutoSnapshotPolicyName = d.Get("name").(string)
@@ -152,12 +157,12 @@ func resourceAlibabacloudStackSnapshotPolicyUpdate(d *schema.ResourceData, meta
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-	return resourceAlibabacloudStackSnapshotPolicyRead(d, meta)
+	return resourceAlibabacloudStackSnapshotPolicyRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnapshotPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	ecsService := EcsService{client}
+	ecsService := EcsService{client, ctx}
 
 	request := ecs.CreateDeleteAutoSnapshotPolicyRequest()
 	request.RegionId = client.RegionId
@@ -167,9 +172,9 @@ func resourceAlibabacloudStackSnapshotPolicyDelete(d *schema.ResourceData, meta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.AutoSnapshotPolicyId = d.Id()
-	err := resource.Retry(DefaultTimeout*time.Second, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, DefaultTimeout*time.Second, func() *resource.RetryError {
 		raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DeleteAutoSnapshotPolicy(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"time"
@@ -15,12 +16,12 @@ import (
 
 func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSnatEntryCreate,
-		Read:   resourceAlibabacloudStackSnatEntryRead,
-		Update: resourceAlibabacloudStackSnatEntryUpdate,
-		Delete: resourceAlibabacloudStackSnatEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSnatEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSnatEntryRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSnatEntryUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSnatEntryDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -53,9 +54,9 @@ func resourceAlibabacloudStackSnatEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateSnatEntryRequest()
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -65,7 +66,7 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 	}
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = d.Get("snat_table_id").(string)
 	request.SourceVSwitchId = d.Get("source_vswitch_id").(string)
 	request.SnatIp = d.Get("snat_ip").(string)
@@ -73,7 +74,7 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		request.SourceCIDR = v.(string)
 	}
 
-	if err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		ar := request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateSnatEntry(ar)
@@ -96,13 +97,13 @@ func resourceAlibabacloudStackSnatEntryCreate(d *schema.ResourceData, meta inter
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
 	}
@@ -129,13 +130,13 @@ func resourceAlibabacloudStackSnatEntryRead(d *schema.ResourceData, meta interfa
 	return nil
 }
 
-func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
 	}
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
@@ -150,7 +151,7 @@ func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta inter
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = parts[0]
 	request.SnatEntryId = parts[1]
 	update := false
@@ -171,12 +172,12 @@ func resourceAlibabacloudStackSnatEntryUpdate(d *schema.ResourceData, meta inter
 			return WrapError(err)
 		}
 	}
-	return resourceAlibabacloudStackSnatEntryRead(d, meta)
+	return resourceAlibabacloudStackSnatEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSnatEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	if strings.HasPrefix(d.Id(), "snat-") {
 		d.SetId(fmt.Sprintf("%s%s%s", d.Get("snat_table_id").(string), COLON_SEPARATED, d.Id()))
@@ -193,10 +194,10 @@ func resourceAlibabacloudStackSnatEntryDelete(d *schema.ResourceData, meta inter
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.SnatTableId = parts[0]
 	request.SnatEntryId = parts[1]
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteSnatEntry(request)
 		})
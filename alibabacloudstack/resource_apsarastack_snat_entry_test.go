@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ import (
 
 func testAccCheckSnatEntryDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_snat_entry" {
@@ -41,7 +42,7 @@ func TestAccAlibabacloudStackSnatEntryBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_snat_entry.default"
 	ra := resourceAttrInit(resourceId, testAccCheckSnatEntryBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -86,7 +87,7 @@ func TestAccAlibabacloudStackSnatEntryMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_snat_entry.default.9"
 	ra := resourceAttrInit(resourceId, testAccCheckSnatEntryBasicMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -16,17 +17,18 @@ import (
 
 func resourceAlibabacloudStackVpc() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcCreate,
-		Read:   resourceAlibabacloudStackVpcRead,
-		Update: resourceAlibabacloudStackVpcUpdate,
-		Delete: resourceAlibabacloudStackVpcDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpcUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"cidr_block": {
 				Type:          schema.TypeString,
@@ -91,7 +93,8 @@ func resourceAlibabacloudStackVpc() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 			"user_cidrs": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -111,9 +114,9 @@ func resourceAlibabacloudStackVpc() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	var response *vpc.CreateVpcResponse
 	request := buildAlibabacloudStackVpcArgs(d, meta)
@@ -124,15 +127,15 @@ func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}
 	}
 	request.RegionId = string(client.Region)
 	//request.Headers = map[string]string{"RegionId": client.RegionId}
-	//request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	//request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
-	err := resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpc(&args)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"TaskConflict", "UnknownError", Throttling}) {
+			if IsExpectedErrors(err, []string{"TaskConflict", "UnknownError"}) {
 				time.Sleep(5 * time.Second)
 				return resource.RetryableError(err)
 			}
@@ -149,17 +152,17 @@ func resourceAlibabacloudStackVpcCreate(d *schema.ResourceData, meta interface{}
 	d.SetId(response.VpcId)
 
 	stateConf := BuildStateConf([]string{"Pending"}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, vpcService.VpcStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackVpcUpdate(d, meta)
+	return resourceAlibabacloudStackVpcUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpcService.DescribeVpc(d.Id())
 	if err != nil {
@@ -179,7 +182,9 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	d.Set("secondary_cidr_blocks", object.SecondaryCidrBlocks.SecondaryCidrBlock)
 	d.Set("status", object.Status)
 	if tag := object.Tags.Tag; tag != nil {
-		d.Set("tags", vpcService.tagToMap(tag))
+		if err := setResourceTags(d, meta, vpcService.tagToMap(tag)); err != nil {
+			return WrapError(err)
+		}
 	}
 	d.Set("user_cidrs", object.UserCidrs.UserCidr)
 	d.Set("vpc_name", object.VpcName)
@@ -188,7 +193,7 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	request := vpc.CreateDescribeRouteTablesRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -201,17 +206,12 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	var routeTabls []vpc.RouteTable
 	for {
 		total := 0
-		err = resource.Retry(6*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(ctx, 6*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 				return vpcClient.DescribeRouteTables(request)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{Throttling}) {
-					time.Sleep(10 * time.Second)
-					return resource.RetryableError(err)
-				} else {
-					return resource.NonRetryableError(err)
-				}
+				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 			response, _ := raw.(*vpc.DescribeRouteTablesResponse)
@@ -244,14 +244,14 @@ func resourceAlibabacloudStackVpcRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	if err := vpcService.setInstanceSecondaryCidrBlocks(d); err != nil {
 		return WrapError(err)
 	}
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := vpcService.SetResourceTags(d, "vpc"); err != nil {
 			return WrapError(err)
 		}
@@ -259,14 +259,14 @@ func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackVpcRead(d, meta)
+		return resourceAlibabacloudStackVpcRead(ctx, d, meta)
 	}
 
 	groupRequestUpdate := false
 	groupRequest := vpc.CreateMoveResourceGroupRequest()
 	groupRequest.RegionId = client.RegionId
 	groupRequest.Headers = map[string]string{"RegionId": client.RegionId}
-	groupRequest.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	groupRequest.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		groupRequest.Scheme = "https"
 	} else {
@@ -293,7 +293,7 @@ func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}
 	request := vpc.CreateModifyVpcAttributeRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -334,23 +334,23 @@ func resourceAlibabacloudStackVpcUpdate(d *schema.ResourceData, meta interface{}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
 
-	return resourceAlibabacloudStackVpcRead(d, meta)
+	return resourceAlibabacloudStackVpcRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpcDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := vpc.CreateDeleteVpcRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
 		request.Scheme = "http"
 	}
 	request.VpcId = d.Id()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpc(request)
 		})
@@ -367,7 +367,7 @@ func resourceAlibabacloudStackVpcDelete(d *schema.ResourceData, meta interface{}
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{"Pending"}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, vpcService.VpcStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -378,7 +378,7 @@ func buildAlibabacloudStackVpcArgs(d *schema.ResourceData, meta interface{}) *vp
 	request := vpc.CreateCreateVpcRequest()
 	request.RegionId = client.RegionId
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	if strings.ToLower(client.Config.Protocol) == "https" {
 		request.Scheme = "https"
 	} else {
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,18 +9,17 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6EgressRuleCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6EgressRuleRead,
-		Delete: resourceAlibabacloudStackVpcIpv6EgressRuleDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleRead),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6EgressRuleDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -63,7 +63,7 @@ func resourceAlibabacloudStackVpcIpv6EgressRule() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateIpv6EgressOnlyRule"
@@ -86,38 +86,27 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleCreate(d *schema.ResourceData, me
 	request["RegionId"] = client.RegionId
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		request["ClientToken"] = buildClientToken("CreateIpv6EgressOnlyRule")
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["ClientToken"] = buildClientToken("CreateIpv6EgressOnlyRule")
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alicloud_vpc_ipv6_egress_rule", action, AlibabacloudStackSdkGoERROR)
 	}
 
 	d.SetId(fmt.Sprint(request["Ipv6GatewayId"], ":", response["Ipv6EgressRuleId"]))
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeVpcIpv6EgressRule(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -139,13 +128,13 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleRead(d *schema.ResourceData, meta
 	d.Set("status", object["Status"])
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	parts, err := ParseResourceId(d.Id(), 2)
 	if err != nil {
 		return WrapError(err)
 	}
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	action := "DeleteIpv6EgressOnlyRule"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
@@ -159,27 +148,16 @@ func resourceAlibabacloudStackVpcIpv6EgressRuleDelete(d *schema.ResourceData, me
 	request["RegionId"] = client.RegionId
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		request["ClientToken"] = buildClientToken("DeleteIpv6EgressOnlyRule")
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["ClientToken"] = buildClientToken("DeleteIpv6EgressOnlyRule")
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.VpcIpv6EgressRuleStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -8,19 +9,18 @@ import (
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackVpcIpv6Gateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6GatewayCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6GatewayRead,
-		Update: resourceAlibabacloudStackVpcIpv6GatewayUpdate,
-		Delete: resourceAlibabacloudStackVpcIpv6GatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6GatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcIpv6GatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6GatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6GatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(1 * time.Minute),
@@ -57,7 +57,7 @@ func resourceAlibabacloudStackVpcIpv6Gateway() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6GatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6GatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "CreateIpv6Gateway"
@@ -79,38 +79,27 @@ func resourceAlibabacloudStackVpcIpv6GatewayCreate(d *schema.ResourceData, meta
 	request["VpcId"] = d.Get("vpc_id")
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		request["ClientToken"] = buildClientToken("CreateIpv6Gateway")
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	request["ClientToken"] = buildClientToken("CreateIpv6Gateway")
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_gateway", action, AlibabacloudStackSdkGoERROR)
 	}
 
 	d.SetId(fmt.Sprint(response["Ipv6GatewayId"]))
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackVpcIpv6GatewayRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6GatewayRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6GatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6GatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeVpcIpv6Gateway(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -127,9 +116,9 @@ func resourceAlibabacloudStackVpcIpv6GatewayRead(d *schema.ResourceData, meta in
 	d.Set("vpc_id", object["VpcId"])
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6GatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	var response map[string]interface{}
 	d.Partial(true)
 
@@ -154,28 +143,17 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		}
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			request["ClientToken"] = buildClientToken("ModifyIpv6GatewaySpec")
-			request["Product"] = "Vpc"
-			request["product"] = "Vpc"
-			request["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		request["ClientToken"] = buildClientToken("ModifyIpv6GatewaySpec")
+		request["Product"] = "Vpc"
+		request["product"] = "Vpc"
+		request["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("spec")
@@ -203,37 +181,26 @@ func resourceAlibabacloudStackVpcIpv6GatewayUpdate(d *schema.ResourceData, meta
 		if err != nil {
 			return WrapError(err)
 		}
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-			modifyIpv6GatewayAttributeReq["Product"] = "Vpc"
-			modifyIpv6GatewayAttributeReq["OrganizationId"] = client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, modifyIpv6GatewayAttributeReq, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		modifyIpv6GatewayAttributeReq["Product"] = "Vpc"
+		modifyIpv6GatewayAttributeReq["OrganizationId"] = client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, modifyIpv6GatewayAttributeReq, &util.RuntimeOptions{})
 		addDebug(action, response, modifyIpv6GatewayAttributeReq)
 		if err != nil {
 			return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 		}
 		stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutUpdate), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-		if _, err := stateConf.WaitForState(); err != nil {
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 			return WrapErrorf(err, IdMsg, d.Id())
 		}
 		//d.SetPartial("description")
 		//d.SetPartial("ipv6_gateway_name")
 	}
 	d.Partial(false)
-	return resourceAlibabacloudStackVpcIpv6GatewayRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6GatewayRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6GatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6GatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	action := "DeleteIpv6Gateway"
 	var response map[string]interface{}
 	conn, err := client.NewVpcClient()
@@ -245,26 +212,15 @@ func resourceAlibabacloudStackVpcIpv6GatewayDelete(d *schema.ResourceData, meta
 	}
 
 	request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 5*time.Second, vpcService.VpcIpv6GatewayStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
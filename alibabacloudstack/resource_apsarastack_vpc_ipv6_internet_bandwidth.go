@@ -1,25 +1,24 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
-	"time"
 
 	util "github.com/alibabacloud-go/tea-utils/service"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate,
-		Read:   resourceAlibabacloudStackVpcIpv6InternetBandwidthRead,
-		Update: resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate,
-		Delete: resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Schema: map[string]*schema.Schema{
 			"bandwidth": {
@@ -52,7 +51,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidth() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	action := "AllocateIpv6InternetBandwidth"
@@ -70,21 +69,10 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 	request["RegionId"] = client.RegionId
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-		request["ClientToken"] = buildClientToken("AllocateIpv6InternetBandwidth")
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["ClientToken"] = buildClientToken("AllocateIpv6InternetBandwidth")
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_vpc_ipv6_internet_bandwidth", action, AlibabacloudStackSdkGoERROR)
@@ -92,11 +80,11 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthCreate(d *schema.ResourceD
 
 	d.SetId(fmt.Sprint(response["InternetBandwidthId"]))
 
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	object, err := vpcService.DescribeVpcIpv6InternetBandwidth(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -118,7 +106,7 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d *schema.ResourceDat
 	}
 	return nil
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	var response map[string]interface{}
 	request := map[string]interface{}{
@@ -135,28 +123,17 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthUpdate(d *schema.ResourceD
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutUpdate), func() *resource.RetryError {
-		request["ClientToken"] = buildClientToken("ModifyIpv6InternetBandwidth")
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["ClientToken"] = buildClientToken("ModifyIpv6InternetBandwidth")
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
 	}
-	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(d, meta)
+	return resourceAlibabacloudStackVpcIpv6InternetBandwidthRead(ctx, d, meta)
 }
-func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "DeleteIpv6InternetBandwidth"
 	var response map[string]interface{}
@@ -170,20 +147,9 @@ func resourceAlibabacloudStackVpcIpv6InternetBandwidthDelete(d *schema.ResourceD
 
 	request["Ipv6AddressId"] = d.Get("ipv6_address_id")
 	request["RegionId"] = client.RegionId
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), action, AlibabacloudStackSdkGoERROR)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -14,7 +15,7 @@ func TestAccAlibabacloudStackVPCIpv6InternetBandwidth_basic0(t *testing.T) {
 	resourceId := "alibabacloudstack_vpc_ipv6_internet_bandwidth.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudStackVPCIpv6InternetBandwidthMap0)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeVpcIpv6InternetBandwidth")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -1,10 +1,15 @@
 package alibabacloudstack
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"log"
+	"net/http"
+	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 
@@ -12,6 +17,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -55,19 +61,16 @@ func testSweepVpcs(region string) error {
 	} else {
 		request.Scheme = "http"
 	}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
-	invoker := NewInvoker()
 	for {
 		var raw interface{}
-		if err := invoker.Run(func() error {
-			raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeVpcs(request)
-			})
-			return err
-		}); err != nil {
+		raw, err = client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVpcs(request)
+		})
+		if err != nil {
 			log.Printf("[ERROR] Error retrieving VPCs: %s", WrapError(err))
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -103,7 +106,7 @@ func testSweepVpcs(region string) error {
 			continue
 		}
 		log.Printf("[INFO] Deleting VPC: %s (%s)", name, id)
-		service := VpcService{client}
+		service := VpcService{client, context.Background()}
 		err := service.sweepVpc(id)
 		if err != nil {
 			log.Printf("[ERROR] Failed to delete VPC (%s (%s)): %s", name, id, err)
@@ -114,7 +117,7 @@ func testSweepVpcs(region string) error {
 
 func testAccCheckVpcDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_vpc" {
@@ -142,7 +145,7 @@ func TestAccAlibabacloudStackVpcBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_vpc.default"
 	ra := resourceAttrInit(resourceId, testAccCheckVpcCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeVpc")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -272,7 +275,7 @@ func TestAccAlibabacloudstackVpc_enableIpv6(t *testing.T) {
 	resourceId := "alibabacloudstack_vpc.default"
 	ra := resourceAttrInit(resourceId, AlibabacloudstackVpcMap1)
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}, "DescribeVpc")
 	rac := resourceAttrCheckInit(rc, ra)
 	testAccCheck := rac.resourceAttrMapUpdateSet()
@@ -391,7 +394,7 @@ func TestAccAlibabacloudStackVpcMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_vpc.default.9"
 	ra := resourceAttrInit(resourceId, testAccCheckVpcCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -469,3 +472,233 @@ provider "alibabacloudstack" {
 
 `)
 }
+
+func TestUnitAlibabacloudStackVpc_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("vpc")
+	client := server.client()
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block":            "172.16.0.0/12",
+		"vpc_name":              "tf-testAccVpcMock",
+		"description":           "mock vpc",
+		"secondary_cidr_blocks": []interface{}{"192.168.0.0/16"},
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the vpc got an error: %#v", diags)
+	}
+	if d.Id() != "vpc-mock0001" {
+		t.Fatalf("expected the vpc id vpc-mock0001, got %q", d.Id())
+	}
+	for key, expected := range map[string]string{
+		"status":         "Available",
+		"router_id":      "vrt-mock0001",
+		"route_table_id": "vtb-mock0001",
+		"vpc_name":       "tf-testAccVpcMock",
+	} {
+		if value := d.Get(key).(string); value != expected {
+			t.Errorf("expected the vpc %s %q, got %q", key, expected, value)
+		}
+	}
+	if call, ok := server.lastCall("CreateVpc"); !ok || call.Params["CidrBlock"] != "172.16.0.0/12" || call.Params["Department"] != mockApiDepartment {
+		t.Errorf("CreateVpc was not called with the expected parameters: %v", call.Params)
+	}
+	if call, ok := server.lastCall("AssociateVpcCidrBlock"); !ok || call.Params["SecondaryCidrBlock"] != "192.168.0.0/16" {
+		t.Errorf("AssociateVpcCidrBlock was not called with the expected parameters: %v", call.Params)
+	}
+
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the vpc got an error: %#v", diags)
+	}
+	if count := server.callCount("DeleteVpc"); count != 1 {
+		t.Errorf("expected DeleteVpc to be called once, got %d", count)
+	}
+}
+
+func TestUnitAlibabacloudStackVpc_mockWithTerraform(t *testing.T) {
+	testAccPreCheckWithTerraform(t)
+	server := newMockApiServer(t).loadFixture("vpc")
+	unsetMockApiEnvironments(t)
+
+	resource.UnitTest(t, resource.TestCase{
+		ProviderFactories: map[string]func() (*schema.Provider, error){
+			"alibabacloudstack": func() (*schema.Provider, error) {
+				return Provider(), nil
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: server.providerBlock() + `
+resource "alibabacloudstack_vpc" "default" {
+  cidr_block            = "172.16.0.0/12"
+  vpc_name              = "tf-testAccVpcMock"
+  description           = "mock vpc"
+  secondary_cidr_blocks = ["192.168.0.0/16"]
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("alibabacloudstack_vpc.default", "id", "vpc-mock0001"),
+					resource.TestCheckResourceAttr("alibabacloudstack_vpc.default", "status", "Available"),
+					resource.TestCheckResourceAttr("alibabacloudstack_vpc.default", "route_table_id", "vtb-mock0001"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAlibabacloudStackVpc_mockDiagnostics(t *testing.T) {
+	server := newMockApiServer(t).onError("CreateVpc", http.StatusBadRequest, "InvalidVpcName.Malformed")
+	client := server.client()
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	diags := r.CreateContext(context.Background(), d, client)
+	if !diags.HasError() || len(diags) != 1 {
+		t.Fatalf("expected a single error diagnostic, got %#v", diags)
+	}
+	for _, expected := range []string{
+		"Action: CreateVpc",
+		"RequestId: 00000000-0000-0000-0000-000000000000",
+		"ErrorCode: InvalidVpcName.Malformed",
+		"ResourceId: alibabacloudstack_vpc",
+	} {
+		if !strings.Contains(diags[0].Detail, expected) {
+			t.Errorf("expected the diagnostic detail to contain %q, got %q", expected, diags[0].Detail)
+		}
+	}
+}
+
+func TestUnitAlibabacloudStackVpc_mockCancel(t *testing.T) {
+	server := newMockApiServer(t).onError("CreateVpc", http.StatusBadRequest, "TaskConflict")
+	client := server.client()
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	start := time.Now()
+	if diags := r.CreateContext(ctx, d, client); !diags.HasError() {
+		t.Fatalf("expected the cancelled create to return an error")
+	}
+	if elapsed := time.Since(start); elapsed > 30*time.Second {
+		t.Errorf("expected the create to stop once the context was cancelled, it took %s", elapsed)
+	}
+}
+
+func TestUnitAlibabacloudStackVpc_mockDebugRedaction(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("vpc")
+	debug, ok := os.LookupEnv("DEBUG")
+	os.Setenv("DEBUG", "terraform")
+	output := log.Writer()
+	var buffer bytes.Buffer
+	log.SetOutput(&buffer)
+	defer func() {
+		log.SetOutput(output)
+		if ok {
+			os.Setenv("DEBUG", debug)
+		} else {
+			os.Unsetenv("DEBUG")
+		}
+	}()
+	client := server.client()
+
+	r := resourceAlibabacloudStackVpc()
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"vpc_name":   "tf-testAccVpcMock",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the vpc got an error: %#v", diags)
+	}
+	addDebug("DescribeUsers", map[string]interface{}{"Password": "Passw0rd-mock", "kms_encrypted_password": "mock-ciphertext"},
+		map[string]string{"AccessKeyId": mockApiAccessKey, "SecurityToken": "mock-security-token"})
+
+	trace := buffer.String()
+	if !strings.Contains(trace, "CreateVpc") {
+		t.Fatalf("expected the debug trace of CreateVpc, got %q", trace)
+	}
+	for _, secret := range []string{mockApiAccessKey, mockApiSecretKey, "Passw0rd-mock", "mock-ciphertext", "mock-security-token"} {
+		if strings.Contains(trace, secret) {
+			t.Errorf("expected %q to be redacted from the debug trace, got %q", secret, trace)
+		}
+	}
+}
+
+func TestUnitAlibabacloudStackVpc_defaultTags(t *testing.T) {
+	client := &connectivity.AlibabacloudStackClient{Config: &connectivity.Config{
+		DefaultTags:           map[string]string{"Environment": "test", "Team": "default"},
+		IgnoreTagsKeys:        []string{"Owner"},
+		IgnoreTagsKeyPrefixes: []string{"acs:"},
+	}}
+	r := resourceAlibabacloudStackVpc()
+	config := map[string]interface{}{
+		"cidr_block": "172.16.0.0/12",
+		"tags": map[string]interface{}{
+			"Team":  "network",
+			"Owner": "someone",
+		},
+	}
+	diff, err := r.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the vpc got an error: %#v", err)
+	}
+	for key, expected := range map[string]string{
+		"tags_all.%":           "2",
+		"tags_all.Environment": "test",
+		"tags_all.Team":        "network",
+	} {
+		if attr := diff.Attributes[key]; attr == nil || attr.New != expected {
+			t.Errorf("expected the planned %s %q, got %#v", key, expected, attr)
+		}
+	}
+
+	state := &terraform.InstanceState{
+		ID: "vpc-mock0001",
+		Attributes: map[string]string{
+			"id":                   "vpc-mock0001",
+			"cidr_block":           "172.16.0.0/12",
+			"tags.%":               "2",
+			"tags.Team":            "network",
+			"tags.Owner":           "someone",
+			"tags_all.%":           "2",
+			"tags_all.Environment": "test",
+			"tags_all.Team":        "network",
+		},
+	}
+	if diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client); err != nil || diff != nil && len(diff.Attributes) > 0 {
+		t.Errorf("expected no changes once the default tags have been applied, got %#v, %v", diff, err)
+	}
+	client.Config.DefaultTags["Environment"] = "prod"
+	diff, err = r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(config), client)
+	if err != nil {
+		t.Fatalf("planning the vpc got an error: %#v", err)
+	}
+	if attr := diff.Attributes["tags_all.Environment"]; attr == nil || attr.Old != "test" || attr.New != "prod" {
+		t.Errorf("expected the changed default tag to update tags_all, got %#v", attr)
+	}
+
+	d := schema.TestResourceDataRaw(t, r.Schema, config)
+	if err := setResourceTags(d, client, map[string]string{
+		"Environment": "prod",
+		"Team":        "network",
+		"Owner":       "someone",
+		"acs:creator": "ros",
+		"Project":     "unmanaged",
+	}); err != nil {
+		t.Fatalf("setting the tags got an error: %#v", err)
+	}
+	for key, expected := range map[string]map[string]interface{}{
+		"tags":     {"Team": "network", "Project": "unmanaged"},
+		"tags_all": {"Environment": "prod", "Team": "network", "Project": "unmanaged"},
+	} {
+		if value := d.Get(key).(map[string]interface{}); fmt.Sprint(value) != fmt.Sprint(expected) {
+			t.Errorf("expected the vpc %s %v, got %v", key, expected, value)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -17,12 +18,12 @@ import (
 
 func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnConnectionCreate,
-		Read:   resourceAlibabacloudStackVpnConnectionRead,
-		Update: resourceAlibabacloudStackVpnConnectionUpdate,
-		Delete: resourceAlibabacloudStackVpnConnectionDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnConnectionRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnConnectionDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -174,15 +175,15 @@ func resourceAlibabacloudStackVpnConnection() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
-	request, err := buildAlibabacloudStackVpnConnectionArgs(d, meta)
+	vpnGatewayService := VpnGatewayService{client, ctx}
+	request, err := buildAlibabacloudStackVpnConnectionArgs(ctx, d, meta)
 	if err != nil {
 		return WrapError(err)
 	}
 	var response *vpc.CreateVpnConnectionResponse
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 3*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnConnection(&args)
@@ -208,13 +209,13 @@ func resourceAlibabacloudStackVpnConnectionCreate(d *schema.ResourceData, meta i
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 	response, err := vpnGatewayService.DescribeVpnConnection(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -247,9 +248,9 @@ func resourceAlibabacloudStackVpnConnectionRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 	request := vpc.CreateModifyVpnConnectionAttributeRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
@@ -294,12 +295,12 @@ func resourceAlibabacloudStackVpnConnectionUpdate(d *schema.ResourceData, meta i
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 
-	return resourceAlibabacloudStackVpnConnectionRead(d, meta)
+	return resourceAlibabacloudStackVpnConnectionRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnConnectionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 	request := vpc.CreateDeleteVpnConnectionRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
@@ -308,7 +309,7 @@ func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta i
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.VpnConnectionId = d.Id()
 
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnConnection(&args)
@@ -334,9 +335,9 @@ func resourceAlibabacloudStackVpnConnectionDelete(d *schema.ResourceData, meta i
 	return WrapError(vpnGatewayService.WaitForVpnConnection(d.Id(), Deleted, DefaultTimeout))
 }
 
-func buildAlibabacloudStackVpnConnectionArgs(d *schema.ResourceData, meta interface{}) (*vpc.CreateVpnConnectionRequest, error) {
+func buildAlibabacloudStackVpnConnectionArgs(ctx context.Context, d *schema.ResourceData, meta interface{}) (*vpc.CreateVpnConnectionRequest, error) {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 
 	request := vpc.CreateCreateVpnConnectionRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
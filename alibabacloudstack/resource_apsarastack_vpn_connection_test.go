@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -49,7 +50,7 @@ func TestAccAlibabacloudstackVpnConnectionBasic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, testAccVpnConnectionCheckMap)
 
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -223,7 +224,7 @@ func TestAccAlibabacloudstackVpnConnectionMulti(t *testing.T) {
 	ra := resourceAttrInit(resourceId, testAccVpnConnectionCheckMap)
 
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
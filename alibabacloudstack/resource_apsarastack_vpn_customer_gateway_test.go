@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -104,7 +105,7 @@ func testSweepVPNCustomerGateways(region string) error {
 
 func testAccCheckVpnCustomerGatewayDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_vpn_customer_gateway" {
@@ -130,7 +131,7 @@ func TestAccAlibabacloudStackVpnCustomerGatewayBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_vpn_customer_gateway.default"
 	ra := resourceAttrInit(resourceId, nil)
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -197,7 +198,7 @@ func TestAccAlibabacloudStackVpnCustomerGatewayMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_vpn_customer_gateway.default.4"
 	ra := resourceAttrInit(resourceId, nil)
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
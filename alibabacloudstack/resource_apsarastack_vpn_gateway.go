@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -19,14 +20,15 @@ import (
 
 func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnGatewayCreate,
-		Read:   resourceAlibabacloudStackVpnGatewayRead,
-		Update: resourceAlibabacloudStackVpnGatewayUpdate,
-		Delete: resourceAlibabacloudStackVpnGatewayDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnGatewayRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnGatewayDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -108,14 +110,15 @@ func resourceAlibabacloudStackVpnGateway() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 	request := vpc.CreateCreateVpnGatewayRequest()
 	request.RegionId = client.RegionId
 
@@ -177,14 +180,14 @@ func resourceAlibabacloudStackVpnGatewayCreate(d *schema.ResourceData, meta inte
 		return WrapError(err)
 	}
 
-	return resourceAlibabacloudStackVpnGatewayUpdate(d, meta)
+	return resourceAlibabacloudStackVpnGatewayUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
-	vpcService := VpcService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
+	vpcService := VpcService{client, ctx}
 
 	object, err := vpnGatewayService.DescribeVpnGateway(d.Id())
 	if err != nil {
@@ -224,14 +227,16 @@ func resourceAlibabacloudStackVpnGatewayRead(d *schema.ResourceData, meta interf
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", tagsToMap(listTagResourcesObject))
+	if err := setResourceTags(d, meta, tagsToMap(listTagResourcesObject)); err != nil {
+		return WrapError(err)
+	}
 
 	return nil
 }
 
-func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateModifyVpnGatewayAttributeRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -239,7 +244,7 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 	request.RegionId = client.RegionId
 	request.VpnGatewayId = d.Id()
 	update := false
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := vpcService.SetResourceTags(d, "VpnGateWay"); err != nil {
 			return WrapError(err)
 		}
@@ -270,7 +275,7 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+		return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 	}
 
 	if d.HasChange("bandwidth") {
@@ -282,12 +287,12 @@ func resourceAlibabacloudStackVpnGatewayUpdate(d *schema.ResourceData, meta inte
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackVpnGatewayRead(d, meta)
+	return resourceAlibabacloudStackVpnGatewayRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnGatewayDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, ctx}
 
 	request := vpc.CreateDeleteVpnGatewayRequest()
 	request.RegionId = client.RegionId
@@ -295,7 +300,7 @@ func resourceAlibabacloudStackVpnGatewayDelete(d *schema.ResourceData, meta inte
 	request.ClientToken = buildClientToken(request.GetActionName())
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnGateway(&args)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -107,7 +108,7 @@ func testSweepVPNGateways(region string) error {
 
 func testAccCheckVpnGatewayDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpnGatewayService := VpnGatewayService{client}
+	vpnGatewayService := VpnGatewayService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_vpn" {
@@ -139,7 +140,7 @@ func TestAccAlibabacloudStackVpnGatewayBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_vpn_gateway.default"
 	ra := resourceAttrInit(resourceId, testAccVpnGatewayCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
@@ -208,7 +209,7 @@ func TestAccAlibabacloudStackVpnGatewayMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_vpn_gateway.default.4"
 	ra := resourceAttrInit(resourceId, map[string]string{})
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
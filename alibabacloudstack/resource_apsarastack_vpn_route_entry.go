@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -14,12 +15,12 @@ import (
 
 func resourceAlibabacloudStackVpnRouteEntry() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackVpnRouteEntryCreate,
-		Read:   resourceAlibabacloudStackVpnRouteEntryRead,
-		Update: resourceAlibabacloudStackVpnRouteEntryUpdate,
-		Delete: resourceAlibabacloudStackVpnRouteEntryDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackVpnRouteEntryCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackVpnRouteEntryRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackVpnRouteEntryUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackVpnRouteEntryDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 
 		Schema: map[string]*schema.Schema{
@@ -55,9 +56,9 @@ func resourceAlibabacloudStackVpnRouteEntry() *schema.Resource {
 	}
 }
 
-func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnRouteEntryCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnRouteEntryService := VpnGatewayService{client}
+	vpnRouteEntryService := VpnGatewayService{client, ctx}
 	request := vpc.CreateCreateVpnRouteEntryRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
 	request.Headers["x-acs-organizationId"] = client.Department
@@ -72,7 +73,7 @@ func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta i
 
 	var raw interface{}
 	wait := incrementalWait(5*time.Second, 5*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw1, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVpnRouteEntry(request)
 		})
@@ -99,12 +100,12 @@ func resourceAlibabacloudStackVpnRouteEntryCreate(d *schema.ResourceData, meta i
 	if err := vpnRouteEntryService.WaitForVpnRouteEntry(d.Id(), Active, 2*DefaultTimeoutMedium); err != nil {
 		return WrapError(err)
 	}
-	return resourceAlibabacloudStackVpnRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackVpnRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnRouteEntryRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnRouteEntryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnRouteEntryService := VpnGatewayService{client}
+	vpnRouteEntryService := VpnGatewayService{client, ctx}
 
 	object, err := vpnRouteEntryService.DescribeVpnRouteEntry(d.Id())
 	if err != nil {
@@ -130,7 +131,7 @@ func resourceAlibabacloudStackVpnRouteEntryRead(d *schema.ResourceData, meta int
 	return nil
 }
 
-func resourceAlibabacloudStackVpnRouteEntryUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnRouteEntryUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	d.Partial(true)
 
@@ -178,12 +179,12 @@ func resourceAlibabacloudStackVpnRouteEntryUpdate(d *schema.ResourceData, meta i
 	}
 
 	d.Partial(false)
-	return resourceAlibabacloudStackVpnRouteEntryRead(d, meta)
+	return resourceAlibabacloudStackVpnRouteEntryRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackVpnRouteEntryDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackVpnRouteEntryDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpnRouteEntryService := VpnGatewayService{client}
+	vpnRouteEntryService := VpnGatewayService{client, ctx}
 
 	request := vpc.CreateDeleteVpnRouteEntryRequest()
 	request.Headers["x-ascm-product-name"] = "Vpc"
@@ -196,7 +197,7 @@ func resourceAlibabacloudStackVpnRouteEntryDelete(d *schema.ResourceData, meta i
 	request.ClientToken = buildClientToken(request.GetActionName())
 
 	wait := incrementalWait(5*time.Second, 5*time.Second)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVpnRouteEntry(request)
 		})
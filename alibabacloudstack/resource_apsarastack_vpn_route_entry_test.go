@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -18,7 +19,7 @@ func TestAccAlibabacloudStackVpnRouteEntry_basic(t *testing.T) {
 	ra := resourceAttrInit(resourceId, vpnRouteEntrybasicMap)
 
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 
@@ -100,7 +101,7 @@ func TestAccAlibabacloudStackVpnRouteEntry_multi(t *testing.T) {
 	resourceId := "alibabacloudstack_vpn_route_entry.default.1"
 	ra := resourceAttrInit(resourceId, vpnRouteEntrybasicMap)
 	serviceFunc := func() interface{} {
-		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpnGatewayService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInit(resourceId, &v, serviceFunc)
 	rac := resourceAttrCheckInit(rc, ra)
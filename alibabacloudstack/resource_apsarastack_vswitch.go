@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"log"
 	"strings"
 	"time"
@@ -13,17 +14,18 @@ import (
 
 func resourceAlibabacloudStackSwitch() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceAlibabacloudStackSwitchCreate,
-		Read:   resourceAlibabacloudStackSwitchRead,
-		Update: resourceAlibabacloudStackSwitchUpdate,
-		Delete: resourceAlibabacloudStackSwitchDelete,
+		CreateContext: withDiagnostics(resourceAlibabacloudStackSwitchCreate),
+		ReadContext:   withDiagnostics(resourceAlibabacloudStackSwitchRead),
+		UpdateContext: withDiagnostics(resourceAlibabacloudStackSwitchUpdate),
+		DeleteContext: withDiagnostics(resourceAlibabacloudStackSwitchDelete),
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			StateContext: schema.ImportStatePassthroughContext,
 		},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(10 * time.Minute),
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
+		CustomizeDiff: setTagsAllDiff,
 		Schema: map[string]*schema.Schema{
 			"availability_zone": {
 				Type:     schema.TypeString,
@@ -49,14 +51,15 @@ func resourceAlibabacloudStackSwitch() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"tags": tagsSchema(),
+			"tags":     tagsSchema(),
+			"tags_all": tagsAllSchema(),
 		},
 	}
 }
 
-func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
 	request := vpc.CreateCreateVSwitchRequest()
 	request.RegionId = client.RegionId
@@ -66,7 +69,7 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.VpcId = Trim(d.Get("vpc_id").(string))
 	request.ZoneId = d.Get("availability_zone").(string)
@@ -81,14 +84,14 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	}
 	request.ClientToken = buildClientToken(request.GetActionName())
 
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		args := *request
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.CreateVSwitch(&args)
 		})
 		if err != nil {
 			if IsExpectedErrors(err, []string{"TaskConflict", "UnknownError", "InvalidStatus.RouteEntry",
-				"InvalidCidrBlock.Overlapped", Throttling, "OperationFailed.IdempotentTokenProcessing"}) {
+				"InvalidCidrBlock.Overlapped", "OperationFailed.IdempotentTokenProcessing"}) {
 				time.Sleep(5 * time.Second)
 				return resource.RetryableError(err)
 			}
@@ -104,17 +107,17 @@ func resourceAlibabacloudStackSwitchCreate(d *schema.ResourceData, meta interfac
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, d.Timeout(schema.TimeoutCreate), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
-	return resourceAlibabacloudStackSwitchUpdate(d, meta)
+	return resourceAlibabacloudStackSwitchUpdate(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	wiatSecondsIfWithTest(1)
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	vswitch, err := vpcService.DescribeVSwitch(d.Id())
 	if err != nil {
 		if NotFoundError(err) {
@@ -132,16 +135,18 @@ func resourceAlibabacloudStackSwitchRead(d *schema.ResourceData, meta interface{
 	if err != nil {
 		return WrapError(err)
 	}
-	d.Set("tags", tagsToMap(listTagResourcesObject))
+	if err := setResourceTags(d, meta, tagsToMap(listTagResourcesObject)); err != nil {
+		return WrapError(err)
+	}
 	d.Set("description", vswitch.Description)
 	return nil
 }
 
-func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		if err := vpcService.SetResourceTags(d, "VSWITCH"); err != nil {
 			return WrapError(err)
 		}
@@ -149,7 +154,7 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 
 	if d.IsNewResource() {
 		d.Partial(false)
-		return resourceAlibabacloudStackSwitchRead(d, meta)
+		return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 	}
 	update := false
 	request := vpc.CreateModifyVSwitchAttributeRequest()
@@ -161,7 +166,7 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	request.VSwitchId = d.Id()
 
@@ -183,12 +188,12 @@ func resourceAlibabacloudStackSwitchUpdate(d *schema.ResourceData, meta interfac
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	}
-	return resourceAlibabacloudStackSwitchRead(d, meta)
+	return resourceAlibabacloudStackSwitchRead(ctx, d, meta)
 }
 
-func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interface{}) error {
+func resourceAlibabacloudStackSwitchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, ctx}
 	request := vpc.CreateDeleteVSwitchRequest()
 	request.RegionId = client.RegionId
 	if strings.ToLower(client.Config.Protocol) == "https" {
@@ -198,9 +203,9 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 	}
 	request.Headers = map[string]string{"RegionId": client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 	request.VSwitchId = d.Id()
-	err := resource.Retry(d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+	err := resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
 		raw, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.DeleteVSwitch(request)
 		})
@@ -222,7 +227,7 @@ func resourceAlibabacloudStackSwitchDelete(d *schema.ResourceData, meta interfac
 	}
 
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutDelete), 3*time.Second, vpcService.VSwitchStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -68,22 +69,19 @@ func testSweepVSwitches(region string) error {
 		req.Scheme = "http"
 	}
 	req.Headers = map[string]string{"RegionId": client.RegionId}
-	req.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "vpc", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 	// API DescribeVSwitches has some limitations
 	// If there is no vpc_id, setting PageSizeSmall can avoid ServiceUnavailable Error
 	req.PageSize = requests.NewInteger(PageSizeSmall)
 	req.PageNumber = requests.NewInteger(1)
-	invoker := NewInvoker()
 	for {
 		var raw interface{}
-		if err := invoker.Run(func() error {
-			rsp, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeVSwitches(req)
-			})
-			raw = rsp
-			return err
-		}); err != nil {
+		rsp, err := client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeVSwitches(req)
+		})
+		raw = rsp
+		if err != nil {
 			log.Printf("[ERROR] Error retrieving VSwitches: %s", WrapError(err))
 		}
 		resp, _ := raw.(*vpc.DescribeVSwitchesResponse)
@@ -103,7 +101,7 @@ func testSweepVSwitches(region string) error {
 		req.PageNumber = page
 	}
 	sweeped := false
-	service := VpcService{client}
+	service := VpcService{client, context.Background()}
 	for _, vsw := range vswitches {
 		name := vsw.VSwitchName
 		id := vsw.VSwitchId
@@ -149,7 +147,7 @@ func testAccCheckVSwitchExists(n string, vsw *vpc.DescribeVSwitchAttributesRespo
 		}
 
 		client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-		vpcService := VpcService{client}
+		vpcService := VpcService{client, context.Background()}
 		instance, err := vpcService.DescribeVSwitch(rs.Primary.ID)
 
 		if err != nil {
@@ -163,7 +161,7 @@ func testAccCheckVSwitchExists(n string, vsw *vpc.DescribeVSwitchAttributesRespo
 
 func testAccCheckVSwitchDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)
-	vpcService := VpcService{client}
+	vpcService := VpcService{client, context.Background()}
 
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "alibabacloudstack_vswitch" {
@@ -189,7 +187,7 @@ func TestAccAlibabacloudstackVSwitchBasic(t *testing.T) {
 	resourceId := "alibabacloudstack_vswitch.default"
 	ra := resourceAttrInit(resourceId, testAccCheckVSwitchCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeVSwitch")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -257,7 +255,7 @@ func TestAccAlibabacloudStackVSwitchMulti(t *testing.T) {
 	resourceId := "alibabacloudstack_vswitch.default.2"
 	ra := resourceAttrInit(resourceId, testAccCheckVSwitchCheckMap)
 	serviceFunc := func() interface{} {
-		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient)}
+		return &VpcService{testAccProvider.Meta().(*connectivity.AlibabacloudStackClient), context.Background()}
 	}
 	rc := resourceCheckInitWithDescribeMethod(resourceId, &v, serviceFunc, "DescribeVSwitch")
 	rac := resourceAttrCheckInit(rc, ra)
@@ -0,0 +1,180 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceScope sends the requests of a resource to the department and resource group of its department_id
+// and resource_group_id instead of the ones of the provider, so that a single provider manages several
+// organizations. The resources which declare a resource_group_id of their own keep it, and only get the department_id.
+// A resource exists in a single department and resource group, so moving it to another scope replaces it.
+type resourceScope struct {
+	department    bool
+	resourceGroup bool
+}
+
+// scopedResources are the resources and data sources whose APIs accept the organization and resource group
+// of a request, by name without the alibabacloudstack_ prefix. An entry ending with _ stands for every name it prefixes.
+// The resources of the ascm and ram products, which manage the organizations themselves, and the ones of the
+// products which only exist at the level of the account are left out.
+var scopedResources = []string{
+	// ecs
+	"instance", "instances", "instance_types", "instance_type_families", "disk", "disks", "disk_attachment",
+	"image", "images", "image_copy", "image_export", "image_import", "image_share_permission",
+	"key_pair", "key_pairs", "key_pair_attachment", "launch_template", "reserved_instance",
+	"security_group", "security_groups", "security_group_rule", "security_group_rules",
+	"snapshot", "snapshots", "snapshot_policy", "network_interface", "network_interfaces",
+	"network_interface_attachment", "ecs_", "ess_",
+	// vpc
+	"vpc", "vpcs", "vpc_", "vswitch", "vswitches", "route_", "router_", "nat_gateway", "nat_gateways",
+	"snat_", "forward_", "eip", "eips", "eip_association", "common_bandwidth_package",
+	"common_bandwidth_packages", "common_bandwidth_package_attachment", "network_acl", "network_acls",
+	"network_acl_", "vpn_", "express_connect_", "cen_",
+	// slb
+	"slb", "slbs", "slb_",
+	// databases
+	"db_", "adb_", "polardb_", "kvstore_", "mongodb_", "gpdb_", "hbase_", "drds_", "graph_database_",
+	// storage
+	"oss_bucket", "oss_buckets", "oss_bucket_kms", "oss_bucket_quota", "ots_", "nas_",
+	// others
+	"alikafka_", "api_gateway_", "application_deployment", "arms_", "cloud_firewall_", "cr_", "cs_", "csb_",
+	"data_works_", "datahub_", "dts_", "edas_", "elasticsearch_", "kms_", "log_", "logtail_", "maxcompute_",
+	"ons_", "oos_", "ros_",
+}
+
+func isScopedResource(name string) bool {
+	name = strings.TrimPrefix(name, "alibabacloudstack_")
+	for _, scoped := range scopedResources {
+		if name == scoped || strings.HasSuffix(scoped, "_") && strings.HasPrefix(name, scoped) {
+			return true
+		}
+	}
+	return false
+}
+
+// withResourceScope adds the department_id and resource_group_id to the scopedResources of the provider.
+func withResourceScope(provider *schema.Provider) *schema.Provider {
+	for name, r := range provider.ResourcesMap {
+		if isScopedResource(name) {
+			newResourceScope(r).apply(r, false)
+		}
+	}
+	for name, r := range provider.DataSourcesMap {
+		if isScopedResource(name) {
+			newResourceScope(r).apply(r, true)
+		}
+	}
+	return provider
+}
+
+func newResourceScope(r *schema.Resource) resourceScope {
+	_, department := r.Schema["department_id"]
+	_, resourceGroup := r.Schema["resource_group_id"]
+	return resourceScope{department: !department, resourceGroup: !resourceGroup}
+}
+
+func (s resourceScope) apply(r *schema.Resource, isDataSource bool) {
+	if s.department {
+		r.Schema["department_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !isDataSource,
+			Description: "The id of the department the requests are sent to instead of the one of the provider, or a department of the provider name_mapping.",
+		}
+	}
+	if s.resourceGroup {
+		r.Schema["resource_group_id"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    !isDataSource,
+			Description: "The id of the resource group the requests are sent to instead of the one of the provider, or a resource group of the provider name_mapping.",
+		}
+	}
+	if !s.department && !s.resourceGroup {
+		return
+	}
+
+	r.ReadContext = s.scoped(r.ReadContext)
+	if isDataSource {
+		return
+	}
+	r.UpdateContext = s.scoped(r.UpdateContext)
+	r.CreateContext = s.scoped(r.CreateContext)
+	r.DeleteContext = s.scoped(r.DeleteContext)
+	// The scope is resolved at plan time as well, so that an unknown name or a department_id which is not
+	// the department of the resource_group_id fails the plan instead of the apply
+	customizeDiff := r.CustomizeDiff
+	r.CustomizeDiff = func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		scoped, err := s.meta(diff.Get, meta)
+		if err != nil {
+			return WrapError(err)
+		}
+		if customizeDiff == nil {
+			return nil
+		}
+		return customizeDiff(ctx, diff, scoped)
+	}
+}
+
+func (s resourceScope) scoped(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	if operation == nil {
+		return nil
+	}
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		scoped, err := s.meta(d.Get, meta)
+		if err != nil {
+			return errorToDiagnostics(WrapError(err), d.Id())
+		}
+		return operation(ctx, d, scoped)
+	}
+}
+
+// meta returns the client scoped to the department_id and resource_group_id of the resource.
+func (s resourceScope) meta(get func(string) interface{}, meta interface{}) (interface{}, error) {
+	client, ok := meta.(*connectivity.AlibabacloudStackClient)
+	if !ok {
+		return meta, nil
+	}
+	var department, resourceGroup string
+	if s.department {
+		department, _ = get("department_id").(string)
+	}
+	if s.resourceGroup {
+		resourceGroup, _ = get("resource_group_id").(string)
+	}
+	if department == "" && resourceGroup == "" {
+		return client, nil
+	}
+	return client.WithScope(department, resourceGroup)
+}
+
+// resolveNameMapping looks up the ids of the departments and resource groups of the provider name_mapping
+// through the ascm apis, so that the resources can be scoped by their names.
+func resolveNameMapping(client *connectivity.AlibabacloudStackClient, mapping map[string]interface{}) error {
+	ascmService := AscmService{client, context.Background()}
+	config := client.Config
+	config.DepartmentIds = make(map[string]string)
+	config.ResourceGroupIds = make(map[string]string)
+	config.ResourceGroupDepartments = make(map[string]string)
+	for _, name := range expandStringList(mapping["departments"].(*schema.Set).List()) {
+		id, err := ascmService.DescribeAscmOrganizationIdByName(name)
+		if err != nil {
+			return WrapError(err)
+		}
+		config.DepartmentIds[name] = id
+	}
+	for _, name := range expandStringList(mapping["resource_groups"].(*schema.Set).List()) {
+		id, departmentId, err := ascmService.DescribeAscmResourceGroupIdByName(name)
+		if err != nil {
+			return WrapError(err)
+		}
+		config.ResourceGroupIds[name] = id
+		config.ResourceGroupDepartments[id] = departmentId
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackResourceScope_mock(t *testing.T) {
+	server := newMockApiServer(t).loadFixture("security_group").on("GetOrganizationList", map[string]interface{}{
+		"code": "200",
+		"data": []interface{}{
+			map[string]interface{}{"id": 34, "name": "Finance-Archive"},
+			map[string]interface{}{"id": 35, "name": "Finance"},
+		},
+	}).on("ListResourceGroup", map[string]interface{}{
+		"code": "200",
+		"data": []interface{}{
+			map[string]interface{}{"id": 120, "organizationID": 36, "resourceGroupName": "finance-rg"},
+		},
+	})
+	unsetMockApiEnvironments(t)
+	config := server.providerConfig()
+	config["name_mapping"] = []interface{}{
+		map[string]interface{}{
+			"departments":     []interface{}{"Finance"},
+			"resource_groups": []interface{}{"finance-rg"},
+		},
+	}
+	p := Provider()
+	if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		t.Fatalf("configuring the provider with the name_mapping got an error: %#v", diags)
+	}
+	client := p.Meta().(*connectivity.AlibabacloudStackClient)
+
+	for _, c := range []struct {
+		department, resourceGroup                 string
+		expectedDepartment, expectedResourceGroup string
+	}{
+		{"", "", mockApiDepartment, mockApiResourceGroup},
+		{"12", "", "12", mockApiResourceGroup},
+		{"Finance", "", "35", mockApiResourceGroup},
+		{"", "finance-rg", "36", "120"},
+		{"36", "finance-rg", "36", "120"},
+	} {
+		scoped, err := client.WithScope(c.department, c.resourceGroup)
+		if err != nil {
+			t.Fatalf("scoping the client to %q and %q got an error: %#v", c.department, c.resourceGroup, err)
+		}
+		if scoped.Department != c.expectedDepartment || scoped.ResourceGroup != c.expectedResourceGroup {
+			t.Errorf("expected the client scoped to %q and %q to send the requests to %s and %s, got %s and %s",
+				c.department, c.resourceGroup, c.expectedDepartment, c.expectedResourceGroup, scoped.Department, scoped.ResourceGroup)
+		}
+	}
+	if scoped, _ := client.WithScope("12", ""); scoped == client {
+		t.Errorf("expected the client scoped to another department not to be the client of the provider")
+	} else if again, _ := scoped.WithScope("12", ""); again != scoped {
+		t.Errorf("expected the scoped clients to be cached")
+	}
+	if _, err := client.WithScope("Marketing", ""); err == nil {
+		t.Errorf("expected a department which is neither an id nor mapped to fail")
+	}
+	if _, err := client.WithScope("Finance", "finance-rg"); err == nil {
+		t.Errorf("expected a department which is not the one of the resource group to fail")
+	}
+
+	for _, name := range []string{"alibabacloudstack_ascm_user", "alibabacloudstack_ram_role_attachment", "alibabacloudstack_cms_alarm", "alibabacloudstack_oss_bucket_object"} {
+		if _, ok := p.ResourcesMap[name].Schema["department_id"]; ok {
+			t.Errorf("expected %s, whose APIs do not accept the organization of the request, not to get a department_id", name)
+		}
+	}
+	if _, ok := p.DataSourcesMap["alibabacloudstack_ascm_users"].Schema["department_id"]; ok {
+		t.Errorf("expected alibabacloudstack_ascm_users not to get a department_id")
+	}
+
+	r := p.ResourcesMap["alibabacloudstack_security_group"]
+	if !r.Schema["department_id"].ForceNew || !r.Schema["resource_group_id"].ForceNew {
+		t.Errorf("expected changing the department_id or resource_group_id of a resource to replace it")
+	}
+	d := newMockApiResourceData(t, r, map[string]interface{}{
+		"name":              "tf-testAccSecurityGroupMock",
+		"vpc_id":            "vpc-mock0001",
+		"resource_group_id": "finance-rg",
+	})
+	if diags := r.CreateContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("creating the security group got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("CreateSecurityGroup"); !ok || call.Params["Department"] != "36" || call.Params["ResourceGroup"] != "120" {
+		t.Errorf("CreateSecurityGroup was not sent to the resource group of the resource: %v", call.Params)
+	}
+	if diags := r.DeleteContext(context.Background(), d, client); diags.HasError() {
+		t.Fatalf("deleting the security group got an error: %#v", diags)
+	}
+	if call, ok := server.lastCall("DeleteSecurityGroup"); !ok || call.Params["Department"] != "36" || call.Params["ResourceGroup"] != "120" {
+		t.Errorf("DeleteSecurityGroup was not sent to the resource group of the resource: %v", call.Params)
+	}
+}
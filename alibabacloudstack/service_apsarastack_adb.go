@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -20,6 +21,7 @@ import (
 
 type AdbService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *AdbService) DescribeAdbCluster(id string) (instance *adb.DBClusterInDescribeDBClusters, err error) {
@@ -115,7 +117,9 @@ func (s *AdbService) WaitForAdbConnection(id string, status Status, timeout int)
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.ConnectionString, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -224,7 +228,9 @@ func (s *AdbService) WaitForAdbAccount(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.AccountStatus, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -241,15 +247,16 @@ func (s *AdbService) DescribeAdbAccount(id string) (ds *adb.DBAccount, err error
 	request.AccountName = parts[1]
 	request.Headers["x-ascm-product-name"] = "adb"
 	request.Headers["x-acs-organizationId"] = s.client.Department
-	invoker := NewInvoker()
-	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *adb.DescribeAccountsResponse
-	if err := invoker.Run(func() error {
+	if err := resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithAdbClient(func(adbClient *adb.Client) (interface{}, error) {
 			return adbClient.DescribeAccounts(request)
 		})
 		if err != nil {
-			return err
+			if IsExpectedErrors(err, []string{"OperationDenied.DBInstanceStatus"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -289,14 +296,16 @@ func (s *AdbService) WaitForAdbInstance(id string, status Status, timeout int) e
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBClusterStatus, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
 
 func (s *AdbService) setClusterTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -434,7 +443,9 @@ func (s *AdbService) WaitForCluster(id string, status Status, timeout int) error
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBClusterStatus, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -689,7 +700,7 @@ func (s *AdbService) DescribeDBClusterAccessWhiteList(id string) (object map[str
 
 func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		added, removed := parsingTags(d)
 		conn, err := s.client.NewAdsClient()
 		if err != nil {
@@ -716,15 +727,9 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 			}
 			runtime := util.RuntimeOptions{}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -748,17 +753,11 @@ func (s *AdbService) SetResourceTags(d *schema.ResourceData, resourceType string
 				count++
 			}
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
 			runtime := util.RuntimeOptions{}
 			runtime.SetIgnoreSSL(s.client.Config.Insecure)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-03-15"), StringPointer("AK"), nil, request, &runtime)
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,25 +16,21 @@ import (
 
 type AlikafkaService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaInstance(instanceId string) (*alikafka.InstanceVO, error) {
 	alikafkaInstance := &alikafka.InstanceVO{}
 	instanceListReq := alikafka.CreateGetInstanceListRequest()
 	instanceListReq.RegionId = alikafkaService.client.RegionId
-        instanceListReq.QueryParams["Product"] = "alikafka"
-	wait := incrementalWait(2*time.Second, 1*time.Second)
+	instanceListReq.QueryParams["Product"] = "alikafka"
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetInstanceList(instanceListReq)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(instanceListReq.GetActionName(), raw, instanceListReq.RpcRequest, instanceListReq)
@@ -61,19 +59,14 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaNodeStatus(instanceId st
 	describeNodeStatusReq := alikafka.CreateDescribeNodeStatusRequest()
 	describeNodeStatusReq.RegionId = alikafkaService.client.RegionId
 	describeNodeStatusReq.InstanceId = instanceId
-        describeNodeStatusReq.QueryParams["Product"] = "alikafka"
-	wait := incrementalWait(2*time.Second, 1*time.Second)
+	describeNodeStatusReq.QueryParams["Product"] = "alikafka"
 	var raw interface{}
 	var err error
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.DescribeNodeStatus(describeNodeStatusReq)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(describeNodeStatusReq.GetActionName(), raw, describeNodeStatusReq.RpcRequest, describeNodeStatusReq)
@@ -95,22 +88,17 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaInstanceByOrderId(orderI
 	instanceListReq := alikafka.CreateGetInstanceListRequest()
 	instanceListReq.RegionId = alikafkaService.client.RegionId
 	instanceListReq.OrderId = orderId
-        instanceListReq.QueryParams["Product"] = "alikafka"
+	instanceListReq.QueryParams["Product"] = "alikafka"
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
 
-		wait := incrementalWait(2*time.Second, 1*time.Second)
 		var raw interface{}
 		var err error
-		err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err = resource.RetryContext(alikafkaService.ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 				return client.GetInstanceList(instanceListReq)
 			})
 			if err != nil {
-				if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-					wait()
-					return resource.RetryableError(err)
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(instanceListReq.GetActionName(), raw, instanceListReq.RpcRequest, instanceListReq)
@@ -142,42 +130,152 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroup(id string)
 		return alikafkaConsumerGroup, WrapError(err)
 	}
 	instanceId := parts[0]
-	//consumerId := parts[1]
+	consumerId := parts[1]
+
+	consumerGroups, err := alikafkaService.DescribeAlikafkaConsumerGroups(instanceId)
+	if err != nil {
+		return alikafkaConsumerGroup, WrapError(err)
+	}
+	for _, v := range consumerGroups {
+		if v.ConsumerId == consumerId {
+			return &v, nil
+		}
+	}
+	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+}
 
+// DescribeAlikafkaConsumerGroups returns all of the consumer groups of the instance.
+func (alikafkaService *AlikafkaService) DescribeAlikafkaConsumerGroups(instanceId string) ([]alikafka.ConsumerVO, error) {
 	request := alikafka.CreateGetConsumerListRequest()
 	request.InstanceId = instanceId
 	request.RegionId = alikafkaService.client.RegionId
-        request.QueryParams["Product"] = "alikafka"
-	wait := incrementalWait(2*time.Second, 1*time.Second)
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
 	var raw interface{}
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(alikafkaService.ctx, 10*time.Minute, func() *resource.RetryError {
+		var err error
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 			return client.GetConsumerList(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		return nil
 	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
 
+	consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
+	return consumerListResp.ConsumerList.ConsumerVO, nil
+}
+
+// DescribeAlikafkaInstances returns all of the alikafka instances but the released ones.
+func (alikafkaService *AlikafkaService) DescribeAlikafkaInstances() ([]alikafka.InstanceVO, error) {
+	request := alikafka.CreateGetInstanceListRequest()
+	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	var raw interface{}
+	err := resource.RetryContext(alikafkaService.ctx, 10*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
+			return client.GetInstanceList(request)
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
 	if err != nil {
-		return alikafkaConsumerGroup, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_alikafka_instances", request.GetActionName(), AlibabacloudStackSdkGoERROR)
 	}
 
-	//consumerListResp, _ := raw.(*alikafka.GetConsumerListResponse)
-	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	instanceListResp, _ := raw.(*alikafka.GetInstanceListResponse)
+	var instances []alikafka.InstanceVO
+	for _, v := range instanceListResp.InstanceList.InstanceVO {
+		// ServiceStatus equals 10 means the instance is released
+		if v.ServiceStatus != 10 {
+			instances = append(instances, v)
+		}
+	}
+	return instances, nil
+}
 
-	//for _, v := range consumerListResp.ConsumerList {
-	//	if v.ConsumerId == consumerId {
-	//		return &v, nil
-	//	}
-	//}
-	return alikafkaConsumerGroup, WrapErrorf(Error(GetNotFoundMessage("AlikafkaConsumerGroup", id)), NotFoundMsg, ProviderERROR)
+// DescribeAlikafkaTopics returns all of the topics of the instance.
+func (alikafkaService *AlikafkaService) DescribeAlikafkaTopics(instanceId string) ([]alikafka.TopicList, error) {
+	request := alikafka.CreateGetTopicListRequest()
+	request.InstanceId = instanceId
+	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	request.PageSize = "100"
+	var topics []alikafka.TopicList
+	for page := 1; ; page++ {
+		request.CurrentPage = strconv.Itoa(page)
+		var raw interface{}
+		err := resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
+			var err error
+			raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+				return alikafkaClient.GetTopicList(request)
+			})
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+			return nil
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		topicListResp, _ := raw.(*alikafka.GetTopicListResponse)
+		topics = append(topics, topicListResp.TopicList...)
+		if len(topicListResp.TopicList) < 100 || len(topics) >= topicListResp.Total {
+			return topics, nil
+		}
+	}
+}
+
+// DescribeAlikafkaSaslUsers returns all of the sasl users of the instance.
+func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUsers(instanceId string) ([]alikafka.SaslUserList, error) {
+	request := alikafka.CreateDescribeSaslUsersRequest()
+	request.InstanceId = instanceId
+	request.RegionId = alikafkaService.client.RegionId
+	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
+	request.QueryParams = alikafkaService.queryParams(request.GetActionName())
+	var raw interface{}
+	err := resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
+		var err error
+		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
+			return alikafkaClient.DescribeSaslUsers(request)
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		return nil
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+
+	userListResp, _ := raw.(*alikafka.DescribeSaslUsersResponse)
+	return userListResp.SaslUserList, nil
+}
+
+// queryParams returns the query parameters which the alikafka api expects along with the action.
+func (alikafkaService *AlikafkaService) queryParams(action string) map[string]string {
+	return map[string]string{
+		"AccessKeyId":   alikafkaService.client.AccessKey,
+		"Product":       "alikafka",
+		"RegionId":      alikafkaService.client.RegionId,
+		"Department":    alikafkaService.client.Department,
+		"ResourceGroup": alikafkaService.client.ResourceGroup,
+		"Action":        action,
+		"Version":       "2019-09-16",
+	}
 }
 
 func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (*alikafka.TopicStatus, error) {
@@ -196,18 +294,13 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopicStatus(id string) (
 	request.Topic = topic
 	request.QueryParams["Product"] = "alikafka"
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicStatus(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -243,32 +336,23 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaTopic(id string) (*alika
 	request.RegionId = alikafkaService.client.RegionId
 	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 
-	
-
-
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": alikafkaService.client.SecretKey,
-		"AccessKeyId":     alikafkaService.client.AccessKey,
-		"Product":         "alikafka",
-		"RegionId":        alikafkaService.client.RegionId,
-		"Department":      alikafkaService.client.Department,
-		"ResourceGroup":   alikafkaService.client.ResourceGroup,
-		"Action":          "GetTopicList",
-		"Version":         "2019-09-16",
+		"AccessKeyId":   alikafkaService.client.AccessKey,
+		"Product":       "alikafka",
+		"RegionId":      alikafkaService.client.RegionId,
+		"Department":    alikafkaService.client.Department,
+		"ResourceGroup": alikafkaService.client.ResourceGroup,
+		"Action":        "GetTopicList",
+		"Version":       "2019-09-16",
 	}
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.GetTopicList(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -304,18 +388,13 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslUser(id string) (*al
 	request.RegionId = alikafkaService.client.RegionId
 	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 	request.QueryParams["Product"] = "alikafka"
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeSaslUsers(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -360,17 +439,12 @@ func (alikafkaService *AlikafkaService) DescribeAlikafkaSaslAcl(id string) (*ali
 	request.AclResourcePatternType = aclResourcePatternType
 	request.Domain = alikafkaService.client.Config.AlikafkaOpenAPIEndpoint
 	request.QueryParams["Product"] = "alikafka"
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(alikafkaService.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = alikafkaService.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.DescribeAcls(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -412,7 +486,9 @@ func (s *AlikafkaService) WaitForAlikafkaInstanceUpdated(id string, topicQuota i
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -454,7 +530,9 @@ func (s *AlikafkaService) WaitForAlikafkaInstance(id string, status Status, time
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -484,7 +562,9 @@ func (s *AlikafkaService) WaitForAllAlikafkaNodeRelease(id string, status string
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalMedium * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalMedium*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -509,7 +589,9 @@ func (s *AlikafkaService) WaitForAlikafkaConsumerGroup(id string, status Status,
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId+":"+object.ConsumerId, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -564,7 +646,9 @@ func (s *AlikafkaService) WaitForAlikafkaTopic(id string, status Status, timeout
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.InstanceId+":"+object.Topic, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -594,7 +678,9 @@ func (s *AlikafkaService) WaitForAlikafkaSaslUser(id string, status Status, time
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, instanceId+":"+object.Username, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -625,7 +711,9 @@ func (s *AlikafkaService) WaitForAlikafkaSaslAcl(id string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, instanceId+":"+object.Username, id, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -647,18 +735,13 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 		request.Tag = &reqTags
 	}
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithAlikafkaClient(func(alikafkaClient *alikafka.Client) (interface{}, error) {
 			return alikafkaClient.ListTagResources(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling, ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -674,8 +757,8 @@ func (s *AlikafkaService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -692,17 +775,11 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.RegionId = s.client.RegionId
 			request.QueryParams["Product"] = "alikafka"
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.UntagResources(request)
 				})
 				if err != nil {
-					if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -721,17 +798,11 @@ func (s *AlikafkaService) setInstanceTags(d *schema.ResourceData, resourceType T
 			request.RegionId = s.client.RegionId
 			request.QueryParams["Product"] = "alikafka"
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithAlikafkaClient(func(client *alikafka.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
 				if err != nil {
-					if IsExpectedErrors(err, []string{ThrottlingUser, "ONS_SYSTEM_FLOW_CONTROL"}) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
@@ -16,6 +17,7 @@ import (
 
 type CloudApiService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *CloudApiService) DescribeApiGatewayGroup(id string) (*cloudapi.DescribeApiGroupResponse, error) {
@@ -30,14 +32,13 @@ func (s *CloudApiService) DescribeApiGatewayGroup(id string) (*cloudapi.Describe
 		"x-acs-organizationid":  s.client.Department,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "DescribeApiGroup",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        "DescribeApiGroup",
+		"Version":       "2016-07-14",
 	}
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -88,14 +89,13 @@ func (s *CloudApiService) DescribeApiGatewayApp(id string) (*cloudapi.DescribeAp
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeApp",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DescribeApp",
+		"Version":       "2016-07-14",
 	}
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -149,14 +149,13 @@ func (s *CloudApiService) DescribeApiGatewayApi(id string) (*cloudapi.DescribeAp
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DescribeApi",
+		"Version":       "2016-07-14",
 	}
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -213,14 +212,13 @@ func (s *CloudApiService) DescribeApiGatewayAppAttachment(id string) (*cloudapi.
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeAuthorizedApps",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DescribeAuthorizedApps",
+		"Version":       "2016-07-14",
 	}
 	appId, _ := strconv.ParseInt(parts[2], 10, 64)
 
@@ -274,14 +272,13 @@ func (s *CloudApiService) DescribeApiGatewayVpcAccess(id string) (*cloudapi.VpcA
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeVpcAccesses",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DescribeVpcAccesses",
+		"Version":       "2016-07-14",
 	}
 	parts, err := ParseResourceId(id, 4)
 	if err != nil {
@@ -350,7 +347,9 @@ func (s *CloudApiService) WaitForApiGatewayAppAttachment(id string, status Statu
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, strconv.FormatInt(object.AppId, 10), appIds, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -369,14 +368,13 @@ func (s *CloudApiService) DescribeDeployedApi(id string, stageName string) (*clo
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DescribeDeployedApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DescribeDeployedApi",
+		"Version":       "2016-07-14",
 	}
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -408,14 +406,13 @@ func (s *CloudApiService) DeployedApi(id string, stageName string) (err error) {
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "DeployApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "DeployApi",
+		"Version":       "2016-07-14",
 	}
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
 		return cloudApiClient.DeployApi(request)
@@ -441,14 +438,13 @@ func (s *CloudApiService) AbolishApi(id string, stageName string) (err error) {
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "AbolishApi",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "AbolishApi",
+		"Version":       "2016-07-14",
 	}
 
 	raw, err := s.client.WithCloudApiClient(func(cloudApiClient *cloudapi.Client) (interface{}, error) {
@@ -474,14 +470,13 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 		"RegionId": s.client.RegionId,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudAPI",
-		"RegionId":        s.client.RegionId,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          "ListTagResources",
-		"Version":         "2016-07-14",
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "CloudAPI",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        "ListTagResources",
+		"Version":       "2016-07-14",
 	}
 	if resourceTags != nil && len(resourceTags) > 0 {
 		var reqTags []cloudapi.ListTagResourcesTag
@@ -507,7 +502,7 @@ func (s *CloudApiService) DescribeTags(resourceId string, resourceTags map[strin
 }
 
 func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -526,14 +521,13 @@ func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType T
 			"RegionId": s.client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": s.client.SecretKey,
-			"AccessKeyId":     s.client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        s.client.RegionId,
-			"Department":      s.client.Department,
-			"ResourceGroup":   s.client.ResourceGroup,
-			"Action":          "UntagResources",
-			"Version":         "2016-07-14",
+			"AccessKeyId":   s.client.AccessKey,
+			"Product":       "CloudAPI",
+			"RegionId":      s.client.RegionId,
+			"Department":    s.client.Department,
+			"ResourceGroup": s.client.ResourceGroup,
+			"Action":        "UntagResources",
+			"Version":       "2016-07-14",
 		}
 		raw, err := s.client.WithCloudApiClient(func(client *cloudapi.Client) (interface{}, error) {
 			return client.UntagResources(request)
@@ -554,14 +548,13 @@ func (s *CloudApiService) setInstanceTags(d *schema.ResourceData, resourceType T
 			"RegionId": s.client.RegionId,
 		}
 		request.QueryParams = map[string]string{
-			"AccessKeySecret": s.client.SecretKey,
-			"AccessKeyId":     s.client.AccessKey,
-			"Product":         "CloudAPI",
-			"RegionId":        s.client.RegionId,
-			"Department":      s.client.Department,
-			"ResourceGroup":   s.client.ResourceGroup,
-			"Action":          "TagResources",
-			"Version":         "2016-07-14",
+			"AccessKeyId":   s.client.AccessKey,
+			"Product":       "CloudAPI",
+			"RegionId":      s.client.RegionId,
+			"Department":    s.client.Department,
+			"ResourceGroup": s.client.ResourceGroup,
+			"Action":        "TagResources",
+			"Version":       "2016-07-14",
 		}
 		raw, err := s.client.WithCloudApiClient(func(client *cloudapi.Client) (interface{}, error) {
 			return client.TagResources(request)
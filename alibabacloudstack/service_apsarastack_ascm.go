@@ -1,7 +1,9 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -12,6 +14,7 @@ import (
 
 type AscmService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *AscmService) DescribeAscmLogonPolicy(id string) (response *LoginPolicy, err error) {
@@ -31,14 +34,13 @@ func (s *AscmService) DescribeAscmLogonPolicy(id string) (response *LoginPolicy,
 	request.ApiName = "ListLoginPolicies"
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "ListLoginPolicies",
-		"Version":         "2019-05-10",
-		"Name":            id,
+		"Product":       "ascm",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        "ListLoginPolicies",
+		"Version":       "2019-05-10",
+		"Name":          id,
 	}
 	var resp = &LoginPolicy{}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -75,7 +77,6 @@ func (s *AscmService) DescribeAscmResourceGroup(id string) (response *ResourceGr
 	}
 	request.QueryParams = map[string]string{
 		"RegionId":          s.client.RegionId,
-		"AccessKeySecret":   s.client.SecretKey,
 		"Product":           "ascm",
 		"Action":            "ListResourceGroup",
 		"Version":           "2019-05-10",
@@ -128,13 +129,12 @@ func (s *AscmService) DescribeAscmCustomRole(id string) (response *AscmCustomRol
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListRoles",
-		"Version":         "2019-05-10",
-		"roleName":        did[0],
-		"roleType":        "ROLETYPE_ASCM",
+		"RegionId": s.client.RegionId,
+		"Product":  "ascm",
+		"Action":   "ListRoles",
+		"Version":  "2019-05-10",
+		"roleName": did[0],
+		"roleType": "ROLETYPE_ASCM",
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -184,15 +184,14 @@ func (s *AscmService) DescribeAscmRamRole(id string) (response *AscmRoles, err e
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRoles",
-		"Version":         "2019-05-10",
-		"roleName":        did[0],
-		"roleType":        "ROLETYPE_RAM",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "ListRoles",
+		"Version":       "2019-05-10",
+		"roleName":      did[0],
+		"roleType":      "ROLETYPE_RAM",
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -242,15 +241,14 @@ func (s *AscmService) DescribeAscmRamServiceRole(id string) (response *RamRole,
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"id":              id,
-		"Action":          "GetRAMServiceRole",
-		"Version":         "2019-05-10",
-		"roleType":        "ROLETYPE_RAM",
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"id":            id,
+		"Action":        "GetRAMServiceRole",
+		"Version":       "2019-05-10",
+		"roleType":      "ROLETYPE_RAM",
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -312,7 +310,6 @@ func (s *AscmService) DescribeAscmResourceGroupUserAttachment(id string) (respon
 	}
 	request.QueryParams = map[string]string{
 		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
 		"Product":         "ascm",
 		"Action":          "ListAscmUsersInsideResourceGroup",
 		"Version":         "2019-05-10",
@@ -368,17 +365,15 @@ func (s *AscmService) DescribeAscmUserGroupResourceSet(id string) (response *Lis
 	}
 	if id == "" {
 		request.QueryParams = map[string]string{
-			"RegionId":        s.client.RegionId,
-			"AccessKeySecret": s.client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ListResourceGroup",
-			"Version":         "2019-05-10",
-			"pageSize":        "1000",
+			"RegionId": s.client.RegionId,
+			"Product":  "ascm",
+			"Action":   "ListResourceGroup",
+			"Version":  "2019-05-10",
+			"pageSize": "1000",
 		}
 	} else {
 		request.QueryParams = map[string]string{
 			"RegionId":          s.client.RegionId,
-			"AccessKeySecret":   s.client.SecretKey,
 			"Product":           "ascm",
 			"Action":            "ListResourceGroup",
 			"Version":           "2019-05-10",
@@ -432,12 +427,11 @@ func (s *AscmService) DescribeAscmUserGroupResourceSetBinding(id string) (respon
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListResourceGroup",
-		"Version":         "2019-05-10",
-		"pageSize":        "1000",
+		"RegionId": s.client.RegionId,
+		"Product":  "ascm",
+		"Action":   "ListResourceGroup",
+		"Version":  "2019-05-10",
+		"pageSize": "1000",
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -494,12 +488,11 @@ func (s *AscmService) DescribeAscmUser(id string) (response *User, err error) {
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
+		"RegionId":  s.client.RegionId,
+		"Product":   "ascm",
+		"Action":    "ListUsers",
+		"Version":   "2019-05-10",
+		"loginName": id,
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -548,20 +541,18 @@ func (s *AscmService) DescribeAscmUserGroup(id string) (response *UserGroup, err
 	}
 	if id == "" {
 		request.QueryParams = map[string]string{
-			"RegionId":        s.client.RegionId,
-			"AccessKeySecret": s.client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ListUserGroups",
-			"Version":         "2019-05-10",
+			"RegionId": s.client.RegionId,
+			"Product":  "ascm",
+			"Action":   "ListUserGroups",
+			"Version":  "2019-05-10",
 		}
 	} else {
 		request.QueryParams = map[string]string{
-			"RegionId":        s.client.RegionId,
-			"AccessKeySecret": s.client.SecretKey,
-			"Product":         "ascm",
-			"Action":          "ListUserGroups",
-			"Version":         "2019-05-10",
-			"userGroupName":   id,
+			"RegionId":      s.client.RegionId,
+			"Product":       "ascm",
+			"Action":        "ListUserGroups",
+			"Version":       "2019-05-10",
+			"userGroupName": id,
 		}
 	}
 
@@ -611,12 +602,11 @@ func (s *AscmService) DescribeAscmUserGroupRoleBinding(id string) (response *Use
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListUserGroups",
-		"Version":         "2019-05-10",
-		"pageSize":        "1000",
+		"RegionId": s.client.RegionId,
+		"Product":  "ascm",
+		"Action":   "ListUserGroups",
+		"Version":  "2019-05-10",
+		"pageSize": "1000",
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -672,12 +662,11 @@ func (s *AscmService) DescribeAscmUserRoleBinding(id string) (response *User, er
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
+		"RegionId":  s.client.RegionId,
+		"Product":   "ascm",
+		"Action":    "ListUsers",
+		"Version":   "2019-05-10",
+		"loginName": id,
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -724,12 +713,11 @@ func (s *AscmService) DescribeAscmDeletedUser(id string) (response *DeletedUser,
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ascm",
-		"Action":          "ListDeletedUsers",
-		"Version":         "2019-05-10",
-		"loginName":       id,
+		"RegionId":  s.client.RegionId,
+		"Product":   "ascm",
+		"Action":    "ListDeletedUsers",
+		"Version":   "2019-05-10",
+		"loginName": id,
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -777,14 +765,13 @@ func (s *AscmService) DescribeAscmOrganization(id string) (response *Organizatio
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetOrganizationList",
-		"Version":         "2019-05-10",
-		"name":            did[0],
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "GetOrganizationList",
+		"Version":       "2019-05-10",
+		"name":          did[0],
 	}
 	request.Method = "POST"
 	request.Product = "ascm"
@@ -832,15 +819,14 @@ func (s *AscmService) DescribeAscmRamPolicy(id string) (response *RamPolicies, e
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRAMPolicies",
-		"Version":         "2019-05-10",
-		"policyName":      did[0],
+		"RegionId":      s.client.RegionId,
+		"AccessKeyId":   s.client.AccessKey,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "ListRAMPolicies",
+		"Version":       "2019-05-10",
+		"policyName":    did[0],
 	}
 	request.Method = "POST"
 	request.Product = "ascm"
@@ -889,15 +875,14 @@ func (s *AscmService) DescribeAscmRamPolicyForRole(id string) (response *RamPoli
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "ListRAMPolicies",
-		"Version":         "2019-05-10",
-		"RamPolicyId":     did[0],
+		"RegionId":      s.client.RegionId,
+		"AccessKeyId":   s.client.AccessKey,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "ListRAMPolicies",
+		"Version":       "2019-05-10",
+		"RamPolicyId":   did[0],
 		//"roleId":     did[1],
 	}
 	request.Method = "POST"
@@ -956,18 +941,17 @@ func (s *AscmService) DescribeAscmQuota(id string) (response *AscmQuota, err err
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"regionName ":     s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetQuota",
-		"Version":         "2019-05-10",
-		"productName":     did[0],
-		"quotaType":       did[1],
-		"quotaTypeId":     did[2],
-		"targetType":      targetType,
+		"RegionId":      s.client.RegionId,
+		"regionName ":   s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "GetQuota",
+		"Version":       "2019-05-10",
+		"productName":   did[0],
+		"quotaType":     did[1],
+		"quotaTypeId":   did[2],
+		"targetType":    targetType,
 	}
 	request.Method = "GET"
 	request.Product = "Ascm"
@@ -1016,14 +1000,13 @@ func (s *AscmService) DescribeAscmPasswordPolicy(id string) (response *PasswordP
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "ascm",
-		"Action":          "GetPasswordPolicy",
-		"Version":         "2019-05-10",
-		"id":              id,
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "ascm",
+		"Action":        "GetPasswordPolicy",
+		"Version":       "2019-05-10",
+		"id":            id,
 	}
 	request.Method = "POST"
 	request.Product = "ascm"
@@ -1071,15 +1054,14 @@ func (s *AscmService) DescribeAscmUsergroupUser(id string) (response *User, err
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Product":         "ascm",
-		"Action":          "ListUsersInUserGroup",
-		"Version":         "2019-05-10",
-		"userGroupId":     id,
+		"AccessKeyId":   s.client.AccessKey,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Product":       "ascm",
+		"Action":        "ListUsersInUserGroup",
+		"Version":       "2019-05-10",
+		"userGroupId":   id,
 	}
 	request.Method = "POST"
 	request.Product = "Ascm"
@@ -1119,3 +1101,57 @@ func (s *AscmService) DescribeAscmUsergroupUser(id string) (response *User, err
 
 	return resp, nil
 }
+
+// DescribeAscmOrganizationIdByName returns the id of the organization with exactly the name.
+func (s *AscmService) DescribeAscmOrganizationIdByName(name string) (string, error) {
+	response := &Organization{}
+	if err := s.doAscmNameRequest("GetOrganizationList", map[string]string{"name": name}, name, response); err != nil {
+		return "", err
+	}
+	for _, organization := range response.Data {
+		if organization.Name == name {
+			return fmt.Sprint(organization.ID), nil
+		}
+	}
+	return "", WrapErrorf(Error(GetNotFoundMessage("Organization", name)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeAscmResourceGroupIdByName returns the ids of the resource group with exactly the name and of its organization.
+func (s *AscmService) DescribeAscmResourceGroupIdByName(name string) (string, string, error) {
+	response := &ResourceGroup{}
+	if err := s.doAscmNameRequest("ListResourceGroup", map[string]string{"resourceGroupName": name}, name, response); err != nil {
+		return "", "", err
+	}
+	for _, group := range response.Data {
+		if group.ResourceGroupName == name {
+			return fmt.Sprint(group.ID), fmt.Sprint(group.OrganizationID), nil
+		}
+	}
+	return "", "", WrapErrorf(Error(GetNotFoundMessage("ResourceGroup", name)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *AscmService) doAscmNameRequest(action string, params map[string]string, name string, response interface{}) error {
+	request, err := s.client.NewCommonRequest("ASCM", "ascm", s.client.Config.Protocol, connectivity.ApiVersion20190510)
+	if err != nil {
+		return WrapError(err)
+	}
+	request.Method = "POST"
+	request.ApiName = action
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams["RegionId"] = s.client.RegionId
+	request.QueryParams["Action"] = action
+	for key, value := range params {
+		request.QueryParams[key] = value
+	}
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, name, action, AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(action, raw, request)
+	if err := json.Unmarshal(raw.(*responses.CommonResponse).GetHttpContentBytes(), response); err != nil {
+		return WrapError(err)
+	}
+	return nil
+}
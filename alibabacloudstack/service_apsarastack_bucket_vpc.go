@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"strings"
@@ -14,6 +15,7 @@ import (
 
 type BucketVpcService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 type VpcListResult struct {
@@ -92,7 +94,6 @@ func (s *BucketVpcService) BindBucket(vpcId string, vpcName string, vLan string,
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
 		"AccessKeyId":      s.client.AccessKey,
 		"Product":          "ascm",
 		"Department":       s.client.Department,
@@ -149,7 +150,6 @@ func (s *BucketVpcService) UnBindBucket(vpcId string, bucket string) error {
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
 		"Product":          "Ascm",
 		"Department":       s.client.Department,
 		"ResourceGroup":    s.client.ResourceGroup,
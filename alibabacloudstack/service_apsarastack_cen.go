@@ -1,5 +1,343 @@
 package alibabacloudstack
 
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/PaesslerAG/jsonpath"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
 const ChildInstanceTypeVpc = "VPC"
 const ChildInstanceTypeVbr = "VBR"
 const ChildInstanceTypeCcn = "CCN"
+
+type CenService struct {
+	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
+}
+
+// CenOperationBlocking are the errors of a request which hit the CEN or one of its children while another change is in progress.
+var CenOperationBlocking = []string{"Operation.Blocking", "InvalidOperation.CenInstanceStatus", "InvalidOperation.ChildInstanceStatus", "InvalidOperation.BwpInstanceStatus", "InvalidStatus.Resource", "IncorrectStatus.VpcOrVbrStatus", "OperationFailed.LastTokenProcessing"}
+
+var CenNotFound = []string{"ParameterCenInstanceId", "InvalidCenInstanceId.NotFound", "ParameterInstanceId", "ParameterBwpInstanceId", "InvalidRouteMapId.NotFound"}
+
+// doRequest sends the request of the action to the Cbn api, and retries it while the CEN is busy with another change.
+func (s *CenService) doRequest(action string, request map[string]interface{}, timeout time.Duration) (response map[string]interface{}, err error) {
+	conn, err := s.client.NewCbnClient()
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request["RegionId"] = s.client.RegionId
+	request["Product"] = "Cbn"
+	request["OrganizationId"] = s.client.Department
+	runtime := util.RuntimeOptions{}
+	runtime.SetAutoretry(true)
+	wait := incrementalWait(3*time.Second, 3*time.Second)
+	err = resource.RetryContext(s.ctx, timeout, func() *resource.RetryError {
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-09-12"), StringPointer("AK"), nil, request, &runtime)
+		if err != nil {
+			if IsExpectedErrors(err, CenOperationBlocking) {
+				wait()
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	addDebug(action, response, request)
+	return response, err
+}
+
+// describeAll pages through the items at the path of the responses of the describe action.
+func (s *CenService) describeAll(action string, request map[string]interface{}, path string) ([]interface{}, error) {
+	var items []interface{}
+	request["PageSize"] = PageSizeLarge
+	request["PageNumber"] = 1
+	for {
+		response, err := s.doRequest(action, request, 5*time.Minute)
+		if err != nil {
+			return nil, err
+		}
+		v, err := jsonpath.Get(path, response)
+		if err != nil {
+			return nil, WrapErrorf(err, FailedGetAttributeMsg, action, path, response)
+		}
+		result, _ := v.([]interface{})
+		items = append(items, result...)
+		if len(result) < PageSizeLarge {
+			break
+		}
+		request["PageNumber"] = request["PageNumber"].(int) + 1
+	}
+	return items, nil
+}
+
+func (s *CenService) DescribeCenInstance(id string) (object map[string]interface{}, err error) {
+	action := "DescribeCens"
+	request := map[string]interface{}{
+		"Filter.1.Key":     "CenId",
+		"Filter.1.Value.1": id,
+	}
+	cens, err := s.describeAll(action, request, "$.Cens.Cen")
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range cens {
+		if fmt.Sprint(v.(map[string]interface{})["CenId"]) == id {
+			return v.(map[string]interface{}), nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Instance", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenInstanceStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenInstance(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// DescribeCenInstanceAttachment describes the child instance of the id <cen id>:<child id>:<child type>:<child region id>.
+func (s *CenService) DescribeCenInstanceAttachment(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 4)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeCenAttachedChildInstanceAttribute"
+	request := map[string]interface{}{
+		"CenId":                 parts[0],
+		"ChildInstanceId":       parts[1],
+		"ChildInstanceType":     parts[2],
+		"ChildInstanceRegionId": parts[3],
+	}
+	response, err := s.doRequest(action, request, 5*time.Minute)
+	if err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	if fmt.Sprint(response["ChildInstanceId"]) != parts[1] {
+		return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Instance Attachment", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response, nil
+}
+
+func (s *CenService) CenInstanceAttachmentStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenInstanceAttachment(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+func (s *CenService) DescribeCenBandwidthPackage(id string) (object map[string]interface{}, err error) {
+	action := "DescribeCenBandwidthPackages"
+	request := map[string]interface{}{
+		"Filter.1.Key":     "CenBandwidthPackageId",
+		"Filter.1.Value.1": id,
+	}
+	packages, err := s.describeAll(action, request, "$.CenBandwidthPackages.CenBandwidthPackage")
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range packages {
+		if fmt.Sprint(v.(map[string]interface{})["CenBandwidthPackageId"]) == id {
+			return v.(map[string]interface{}), nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Bandwidth Package", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenBandwidthPackageStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenBandwidthPackage(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// cenStringList returns the strings of the list at the path of the object, e.g. the CENs a bandwidth package is associated with.
+func cenStringList(object map[string]interface{}, path string) []string {
+	var items []string
+	if v, err := jsonpath.Get(path, object); err == nil {
+		if list, ok := v.([]interface{}); ok {
+			for _, item := range list {
+				items = append(items, fmt.Sprint(item))
+			}
+		}
+	}
+	return items
+}
+
+// DescribeCenBandwidthPackageAttachment describes the bandwidth package of the id <cen id>:<bandwidth package id>
+// when it is associated with the CEN.
+func (s *CenService) DescribeCenBandwidthPackageAttachment(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	object, err = s.DescribeCenBandwidthPackage(parts[1])
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	for _, cenId := range cenStringList(object, "$.CenIds.CenId") {
+		if cenId == parts[0] {
+			return object, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Bandwidth Package Attachment", id)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeCenRouteMap describes the route map of the id <cen id>:<route map id>.
+func (s *CenService) DescribeCenRouteMap(id string) (object map[string]interface{}, err error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribeCenRouteMaps"
+	request := map[string]interface{}{
+		"CenId":      parts[0],
+		"RouteMapId": parts[1],
+	}
+	routeMaps, err := s.describeAll(action, request, "$.RouteMaps.RouteMap")
+	if err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range routeMaps {
+		if fmt.Sprint(v.(map[string]interface{})["RouteMapId"]) == parts[1] {
+			return v.(map[string]interface{}), nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Route Map", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenRouteMapStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenRouteMap(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["Status"]) == failState {
+				return object, fmt.Sprint(object["Status"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["Status"])))
+			}
+		}
+		return object, fmt.Sprint(object["Status"]), nil
+	}
+}
+
+// parseCenRouteEntryId parses the id <cen id>:<child id>:<route table id>:<cidr block>, the cidr block of an
+// IPv6 route contains the separator itself.
+func parseCenRouteEntryId(id string) ([]string, error) {
+	parts := strings.SplitN(id, COLON_SEPARATED, 4)
+	if len(parts) != 4 {
+		return nil, WrapError(fmt.Errorf("invalid resource id %s, expected <instance_id>:<child_instance_id>:<route_table_id>:<cidr_block>", id))
+	}
+	return parts, nil
+}
+
+// DescribeCenRouteEntry describes the route entry of the child instance which is published to the CEN.
+func (s *CenService) DescribeCenRouteEntry(id string) (object map[string]interface{}, err error) {
+	parts, err := parseCenRouteEntryId(id)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	childType, err := GetCenChildInstanceType(parts[1])
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	action := "DescribePublishedRouteEntries"
+	request := map[string]interface{}{
+		"CenId":                     parts[0],
+		"ChildInstanceId":           parts[1],
+		"ChildInstanceType":         childType,
+		"ChildInstanceRegionId":     s.client.RegionId,
+		"ChildInstanceRouteTableId": parts[2],
+		"DestinationCidrBlock":      parts[3],
+	}
+	entries, err := s.describeAll(action, request, "$.PublishedRouteEntries.PublishedRouteEntry")
+	if err != nil {
+		if IsExpectedErrors(err, CenNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	for _, v := range entries {
+		entry := v.(map[string]interface{})
+		if fmt.Sprint(entry["DestinationCidrBlock"]) == parts[3] && fmt.Sprint(entry["PublishStatus"]) != "NonPublished" {
+			return entry, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("CEN Route Entry", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *CenService) CenRouteEntryStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribeCenRouteEntry(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if fmt.Sprint(object["PublishStatus"]) == failState {
+				return object, fmt.Sprint(object["PublishStatus"]), WrapError(Error(FailedToReachTargetStatus, fmt.Sprint(object["PublishStatus"])))
+			}
+		}
+		return object, fmt.Sprint(object["PublishStatus"]), nil
+	}
+}
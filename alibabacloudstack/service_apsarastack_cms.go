@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -15,6 +16,7 @@ import (
 
 type CmsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 type IspCities []map[string]string
@@ -47,23 +49,14 @@ func (s *CmsService) DescribeCmsAlarm(id string) (alarm cms.Alarm, err error) {
 		request.RuleName = parts[1]
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms"}
+	request.QueryParams = map[string]string{"Product": "cms"}
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var response *cms.DescribeMetricRuleListResponse
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithCmsClient(func(cmsClient *cms.Client) (interface{}, error) {
 			return cmsClient.DescribeMetricRuleList(request)
 		})
-		if err != nil && IsExpectedErrors(err, []string{Throttling}) {
-			time.Sleep(10 * time.Second)
-			return resource.RetryableError(err)
-		}
 		if err != nil {
-			if IsExpectedErrors(err, []string{ThrottlingUser}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -97,7 +90,9 @@ func (s *CmsService) WaitForCmsAlarm(id string, enabled bool, timeout int) error
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("Alarm", strconv.FormatBool(enabled)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -121,7 +116,7 @@ func (s *CmsService) ExtractWebhookFromJson(webhookJson string) (string, error)
 func (s *CmsService) DescribeSiteMonitor(id, keyword string) (siteMonitor cms.SiteMonitor, err error) {
 	listRequest := cms.CreateDescribeSiteMonitorListRequest()
 	listRequest.Headers = map[string]string{"RegionId": s.client.RegionId}
-	listRequest.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	listRequest.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	listRequest.Keyword = keyword
 	listRequest.TaskId = id
@@ -147,7 +142,7 @@ func (s *CmsService) DescribeSiteMonitor(id, keyword string) (siteMonitor cms.Si
 func (s *CmsService) GetIspCities(id string) (ispCities IspCities, err error) {
 	request := cms.CreateDescribeSiteMonitorAttributeRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.TaskId = id
 
@@ -174,7 +169,7 @@ func (s *CmsService) DescribeCmsAlarmContact(id string) (object cms.Contact, err
 	request := cms.CreateDescribeContactListRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.ContactName = id
 
@@ -207,7 +202,7 @@ func (s *CmsService) DescribeCmsAlarmContactGroup(id string) (object cms.Contact
 	request := cms.CreateDescribeContactGroupListRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "cms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -12,6 +13,7 @@ import (
 
 type CrService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 type crCreateNamespaceRequestPayload struct {
@@ -253,15 +255,14 @@ func (c *CrService) DescribeCrNamespace(id string) (*crDescribeNamespaceResponse
 	request.ApiName = "GetNamespace"
 	request.Headers = map[string]string{"RegionId": c.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": c.client.SecretKey,
-		"AccessKeyId":     c.client.AccessKey,
-		"Product":         "cr",
-		"Department":      c.client.Department,
-		"ResourceGroup":   c.client.ResourceGroup,
-		"RegionId":        c.client.RegionId,
-		"Action":          "GetNamespace",
-		"Version":         "2016-06-07",
-		"Namespace":       id,
+		"AccessKeyId":   c.client.AccessKey,
+		"Product":       "cr",
+		"Department":    c.client.Department,
+		"ResourceGroup": c.client.ResourceGroup,
+		"RegionId":      c.client.RegionId,
+		"Action":        "GetNamespace",
+		"Version":       "2016-06-07",
+		"Namespace":     id,
 	}
 	raw, err := c.client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -296,16 +297,15 @@ func (c *CrService) DescribeCrRepo(id string) (GetRepoResponse, error) {
 	request.ApiName = "GetRepo"
 	request.Headers = map[string]string{"RegionId": c.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": c.client.SecretKey,
-		"AccessKeyId":     c.client.AccessKey,
-		"Product":         "cr",
-		"Department":      c.client.Department,
-		"ResourceGroup":   c.client.ResourceGroup,
-		"RegionId":        c.client.RegionId,
-		"Action":          "GetRepo",
-		"Version":         "2016-06-07",
-		"RepoName":        repoName,
-		"RepoNamespace":   repoNamespace,
+		"AccessKeyId":   c.client.AccessKey,
+		"Product":       "cr",
+		"Department":    c.client.Department,
+		"ResourceGroup": c.client.ResourceGroup,
+		"RegionId":      c.client.RegionId,
+		"Action":        "GetRepo",
+		"Version":       "2016-06-07",
+		"RepoName":      repoName,
+		"RepoNamespace": repoNamespace,
 	}
 	raw, err := c.client.WithEcsClient(func(crClient *ecs.Client) (interface{}, error) {
 		return crClient.ProcessCommonRequest(request)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -18,6 +19,7 @@ import (
 
 type CsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 const (
@@ -41,7 +43,6 @@ const (
 )
 
 func (s *CsService) DescribeCsKubernetes(id string) (cl *cs.KubernetesClusterDetail, err error) {
-	invoker := NewInvoker()
 	cluster := &cs.KubernetesClusterDetail{}
 	cluster.ClusterId = ""
 	var requestInfo *cs.Client
@@ -51,9 +52,8 @@ func (s *CsService) DescribeCsKubernetes(id string) (cl *cs.KubernetesClusterDet
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "CS",
+		"RegionId": s.client.RegionId,
+		"Product":  "CS",
 		//"Department":       s.client.Department,
 		//"ResourceGroup":    s.client.ResourceGroup,
 		"Action": "DescribeClustersV1",
@@ -74,13 +74,11 @@ func (s *CsService) DescribeCsKubernetes(id string) (cl *cs.KubernetesClusterDet
 	} // Set request scheme. Default: http
 	request.ApiName = "DescribeClustersV1"
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	if err := invoker.Run(func() error {
-		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ProcessCommonRequest(request)
-		})
-		response = raw
-		return err
-	}); err != nil {
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	response = raw
+	if err != nil {
 		if IsExpectedErrors(err, []string{"ErrorClusterNotFound"}) {
 			return cluster, WrapErrorf(err, NotFoundMsg, DenverdinoAlibabacloudStackgo)
 		}
@@ -134,7 +132,6 @@ func (s *CsService) DescribeCsKubernetes(id string) (cl *cs.KubernetesClusterDet
 	return cluster, nil
 }
 func (s *CsService) DescribeClusterNodes(id, nodepoolid string) (pools *NodePools, err error) {
-	invoker := NewInvoker()
 	//var requestInfo *cs.Client
 	var response interface{}
 	request := requests.NewCommonRequest()
@@ -143,7 +140,6 @@ func (s *CsService) DescribeClusterNodes(id, nodepoolid string) (pools *NodePool
 	}
 	request.QueryParams = map[string]string{
 		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
 		"Product":          "CS",
 		"Department":       s.client.Department,
 		"ResourceGroup":    s.client.ResourceGroup,
@@ -167,13 +163,11 @@ func (s *CsService) DescribeClusterNodes(id, nodepoolid string) (pools *NodePool
 	request.ApiName = "DescribeClusterNodes"
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	var clusternodepools *NodePools
-	if err := invoker.Run(func() error {
-		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
-			return ecsClient.ProcessCommonRequest(request)
-		})
-		response = raw
-		return err
-	}); err != nil {
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.ProcessCommonRequest(request)
+	})
+	response = raw
+	if err != nil {
 		if IsExpectedErrors(err, []string{"ErrorClusterNodePoolNotFound"}) {
 			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
 		}
@@ -194,7 +188,6 @@ func (s *CsService) DescribeClusterNodePools(id string) (*NodePool, error) {
 	}
 	req.QueryParams = map[string]string{
 		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
 		"Product":          "CS",
 		"Department":       s.client.Department,
 		"ResourceGroup":    s.client.ResourceGroup,
@@ -286,7 +279,6 @@ func (s *CsService) DescribeCsKubernetesNodePool(id, clusterid string) (*NodePoo
 	}
 	req.QueryParams = map[string]string{
 		"RegionId":         s.client.RegionId,
-		"AccessKeySecret":  s.client.SecretKey,
 		"Product":          "CS",
 		"Department":       s.client.Department,
 		"ResourceGroup":    s.client.ResourceGroup,
@@ -336,17 +328,9 @@ func (s *CsService) DescribeCsKubernetesNodePool(id, clusterid string) (*NodePoo
 	return node, nil
 }
 func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs) error {
-	invoker := NewInvoker()
-	err := invoker.Run(func() error {
-		_, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.UpgradeCluster(clusterId, args)
-		})
-		if e != nil {
-			return e
-		}
-		return nil
+	_, err := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+		return nil, csClient.UpgradeCluster(clusterId, args)
 	})
-
 	if err != nil {
 		return WrapError(err)
 	}
@@ -357,14 +341,8 @@ func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs
 	}
 
 	// if upgrade failed cancel the task
-	err = invoker.Run(func() error {
-		_, e := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
-			return nil, csClient.CancelUpgradeCluster(clusterId)
-		})
-		if e != nil {
-			return e
-		}
-		return nil
+	_, err = s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
+		return nil, csClient.CancelUpgradeCluster(clusterId)
 	})
 	if err != nil {
 		return WrapError(upgradeError)
@@ -378,7 +356,7 @@ func (s *CsService) UpgradeCluster(clusterId string, args *cs.UpgradeClusterArgs
 }
 
 func (s *CsService) WaitForUpgradeCluster(clusterId string, action string) (string, error) {
-	err := resource.Retry(UpgradeClusterTimeout, func() *resource.RetryError {
+	err := resource.RetryContext(s.ctx, UpgradeClusterTimeout, func() *resource.RetryError {
 		resp, err := s.client.WithCsClient(func(csClient *cs.Client) (interface{}, error) {
 			return csClient.QueryUpgradeClusterResult(clusterId)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
 	"strings"
@@ -16,6 +17,7 @@ import (
 
 type DatahubService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *DatahubService) DescribeDatahubProject(id string) (*datahub.GetProjectResult, error) {
@@ -39,15 +41,14 @@ func (s *DatahubService) DescribeDatahubProject(id string) (*datahub.GetProjectR
 		"x-acs-organizationid":  s.client.Department,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "datahub",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetProject",
-		"Version":         "2019-11-20",
-		"ProjectName":     id,
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "datahub",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        "GetProject",
+		"Version":       "2019-11-20",
+		"ProjectName":   id,
 	}
 
 	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
@@ -122,7 +123,6 @@ func (s *DatahubService) DescribeDatahubSubscription(id string) (*datahub.GetSub
 		"x-acs-organizationid":  s.client.Department,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret":  s.client.SecretKey,
 		"AccessKeyId":      s.client.AccessKey,
 		"Product":          "datahub",
 		"Department":       s.client.Department,
@@ -216,16 +216,15 @@ func (s *DatahubService) DescribeDatahubTopic(id string) (*GetTopicResult, error
 		"x-acs-organizationid":  s.client.Department,
 	}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "datahub",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetTopic",
-		"Version":         "2019-11-20",
-		"ProjectName":     projectName,
-		"TopicName":       topicName,
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "datahub",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        "GetTopic",
+		"Version":       "2019-11-20",
+		"ProjectName":   projectName,
+		"TopicName":     topicName,
 	}
 
 	raw, err := s.client.WithEcsClient(func(dataHubClient *ecs.Client) (interface{}, error) {
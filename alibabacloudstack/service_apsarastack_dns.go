@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -16,6 +17,7 @@ import (
 
 type DnsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *DnsService) DescribeDnsRecord(id string) (response *DnsRecord, err error) {
@@ -30,12 +32,11 @@ func (s *DnsService) DescribeDnsRecord(id string) (response *DnsRecord, err erro
 		request.SetHTTPSInsecure(s.client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "CloudDns",
-		"Action":          "DescribeGlobalZoneRecords",
-		"Version":         "2021-06-24",
-		"ZoneId":          ZoneId,
+		"RegionId": s.client.RegionId,
+		"Product":  "CloudDns",
+		"Action":   "DescribeGlobalZoneRecords",
+		"Version":  "2021-06-24",
+		"ZoneId":   ZoneId,
 	}
 	request.Method = "POST"
 	request.Product = "CloudDns"
@@ -82,7 +83,7 @@ func (s *DnsService) DescribeDnsGroup(id string) (alidns.DomainGroup, error) {
 	var group alidns.DomainGroup
 	request := alidns.CreateDescribeDomainGroupsRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 	request.RegionId = s.client.RegionId
@@ -120,7 +121,7 @@ func (s *DnsService) ListTagResources(id string) (object alidns.ListTagResources
 	request := alidns.CreateListTagResourcesRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -142,7 +143,7 @@ func (s *DnsService) DescribeDnsDomainAttachment(id string) (object alidns.Descr
 	request := alidns.CreateDescribeInstanceDomainsRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+	request.QueryParams = map[string]string{"Product": "alidns"}
 	request.QueryParams["Department"] = s.client.Department
 	request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -200,11 +201,13 @@ func (s *DnsService) WaitForAlidnsDomainAttachment(id string, expected map[strin
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, "", expected, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := d.GetChange("tags_all")
 	added := make([]alidns.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, alidns.TagResourcesTag{
@@ -220,7 +223,7 @@ func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string
 		request := alidns.CreateUntagResourcesRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = s.client.Department
 		request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -239,7 +242,7 @@ func (s *DnsService) SetResourceTags(d *schema.ResourceData, resourceType string
 		request := alidns.CreateTagResourcesRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "alidns"}
+		request.QueryParams = map[string]string{"Product": "alidns"}
 		request.QueryParams["Department"] = s.client.Department
 		request.QueryParams["ResourceGroup"] = s.client.ResourceGroup
 
@@ -276,12 +279,11 @@ func (s *DnsService) DescribeDnsDomain(id string) (response *DnsDomains, err err
 	request.ApiName = "DescribeGlobalZones"
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "CloudDns",
-		"RegionId":        s.client.RegionId,
-		"Action":          "DescribeGlobalZones",
-		"Version":         "2022-06-24",
+		"AccessKeyId": s.client.AccessKey,
+		"Product":     "CloudDns",
+		"RegionId":    s.client.RegionId,
+		"Action":      "DescribeGlobalZones",
+		"Version":     "2022-06-24",
 		//"Id":              did[1],
 		"Name":              did[0],
 		"Forwardedregionid": s.client.RegionId,
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,7 @@ import (
 
 type EcsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *EcsService) JudgeRegionValidation(key, region string) error {
@@ -36,7 +38,7 @@ func (s *EcsService) JudgeRegionValidation(key, region string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeRegions(request)
 	})
@@ -69,7 +71,7 @@ func (s *EcsService) DescribeZone(id string) (zone ecs.Zone, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeZones(request)
 	})
@@ -102,7 +104,7 @@ func (s *EcsService) DescribeZones(d *schema.ResourceData) (zones []ecs.Zone, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	//request.SpotStrategy = d.Get("spot_strategy").(string)
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeZones(request)
@@ -139,21 +141,15 @@ func (s *EcsService) DescribeInstance(id string) (instance ecs.Instance, err err
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.InstanceIds = convertListToJsonString([]interface{}{id})
 
 	var response *ecs.DescribeInstancesResponse
-	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstances(request)
 		})
 		if err != nil {
-			if IsThrottling(err) {
-				wait()
-				return resource.RetryableError(err)
-
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -182,7 +178,7 @@ func (s *EcsService) DescribeInstanceAttribute(id string) (instance ecs.Describe
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeInstanceAttribute(request)
 	})
@@ -209,18 +205,13 @@ func (s *EcsService) DescribeInstanceSystemDisk(id, rg string) (disk ecs.Disk, e
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var response *ecs.DescribeDisksResponse
-	wait := incrementalWait(1*time.Second, 1*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeDisks(request)
 		})
 		if err != nil {
-			if IsThrottling(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -276,7 +267,7 @@ func (s *EcsService) JoinSecurityGroups(instanceId string, securityGroupIds []st
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for _, sid := range securityGroupIds {
 		request.SecurityGroupId = sid
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -301,7 +292,7 @@ func (s *EcsService) LeaveSecurityGroups(instanceId string, securityGroupIds []s
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for _, sid := range securityGroupIds {
 		request.SecurityGroupId = sid
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -326,7 +317,7 @@ func (s *EcsService) DescribeSecurityGroup(id string) (group ecs.DescribeSecurit
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeSecurityGroupAttribute(request)
 	})
@@ -367,7 +358,7 @@ func (s *EcsService) DescribeSecurityGroupRule(id string) (rule ecs.Permission,
 	request.NicType = nicType
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeSecurityGroupAttribute(request)
 	})
@@ -416,14 +407,14 @@ func (s *EcsService) DescribeAvailableResources(d *schema.ResourceData, meta int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DestinationResource = string(destination)
 	request.IoOptimized = string(IOOptimized)
 
 	if v, ok := d.GetOk("availability_zone"); ok && strings.TrimSpace(v.(string)) != "" {
 		zoneId = strings.TrimSpace(v.(string))
 	} else if v, ok := d.GetOk("vswitch_id"); ok && strings.TrimSpace(v.(string)) != "" {
-		vpcService := VpcService{s.client}
+		vpcService := VpcService{s.client, s.ctx}
 		if vsw, err := vpcService.DescribeVSwitch(strings.TrimSpace(v.(string))); err == nil {
 			zoneId = vsw.ZoneId
 		}
@@ -525,7 +516,7 @@ func (s *EcsService) QueryInstancesWithKeyPair(instanceIdsStr, keyPair string) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.PageNumber = requests.NewInteger(1)
 	request.InstanceIds = instanceIdsStr
@@ -570,12 +561,11 @@ func (s *EcsService) DescribeKeyPair(id string) (keyPair ecs.KeyPair, err error)
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeyId":     s.client.AccessKey,
-		"AccessKeySecret": s.client.SecretKey,
-		"SecurityToken":   s.client.Config.SecurityToken,
-		"Product":         "ecs",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup}
+		"AccessKeyId":   s.client.AccessKey,
+		"SecurityToken": s.client.Config.SecurityToken,
+		"Product":       "ecs",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup}
 	request.KeyPairName = id
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeKeyPairs(request)
@@ -622,7 +612,7 @@ func (s *EcsService) DescribeDisk(id string) (disk ecs.Disk, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeDisks(request)
 	})
@@ -663,7 +653,7 @@ func (s *EcsService) DescribeDisksByType(instanceId string, diskType DiskType) (
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if instanceId != "" {
 		request.InstanceId = instanceId
 	}
@@ -692,7 +682,7 @@ func (s *EcsService) DescribeTags(resourceId string, resourceType TagResourceTyp
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ResourceType = string(resourceType)
 	request.ResourceId = resourceId
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -721,7 +711,7 @@ func (s *EcsService) DescribeImageById(id string) (image ecs.Image, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = id
 	request.ImageOwnerAlias = "self"
 	request.Status = fmt.Sprintf("%s,%s,%s,%s,%s", "Creating", "Waiting", "Available", "UnAvailable", "CreateFailed")
@@ -761,7 +751,7 @@ func (s *EcsService) deleteImage(d *schema.ResourceData) error {
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = object.ImageId
 
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -773,7 +763,7 @@ func (s *EcsService) deleteImage(d *schema.ResourceData) error {
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -784,7 +774,7 @@ func (s *EcsService) updateImage(d *schema.ResourceData) error {
 
 	d.Partial(true)
 
-	err := setTags(s.client, TagResourceImage, d)
+	err := setTags(s.ctx, s.client, TagResourceImage, d)
 	if err != nil {
 		return WrapError(err)
 	} else {
@@ -799,7 +789,7 @@ func (s *EcsService) updateImage(d *schema.ResourceData) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ImageId = d.Id()
 
 	if d.HasChange("description") || d.HasChange("name") || d.HasChange("image_name") {
@@ -838,7 +828,7 @@ func (s *EcsService) DescribeNetworkInterface(id string) (networkInterface ecs.N
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	eniIds := []string{id}
 	request.NetworkInterfaceId = &eniIds
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -913,7 +903,7 @@ func (s *EcsService) DescribeNetworkInterfaceAttachment(id string) (networkInter
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.InstanceId = instanceId
 	eniIds := []string{eniId}
 	request.NetworkInterfaceId = &eniIds
@@ -986,14 +976,18 @@ func (s *EcsService) WaitForEcsInstance(instanceId string, status Status, timeou
 		}
 		if instance.Status == string(status) {
 			//Sleep one more time for timing issues
-			time.Sleep(DefaultIntervalMedium * time.Second)
+			if err := sleepContext(s.ctx, DefaultIntervalMedium*time.Second); err != nil {
+				return WrapError(err)
+			}
 			break
 		}
 		timeout = timeout - DefaultIntervalShort
 		if timeout <= 0 {
 			return GetTimeErrorFromString(GetTimeoutMessage("ECS Instance", string(status)))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 	}
 	return nil
@@ -1032,7 +1026,7 @@ func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) e
 	}
 	request := ecs.CreateDeleteImageRequest()
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if force, ok := d.GetOk("force"); ok {
 		request.Force = requests.NewBoolean(force.(bool))
 	}
@@ -1053,7 +1047,7 @@ func (s *EcsService) deleteImageforDest(d *schema.ResourceData, region string) e
 	}
 	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 	stateConf := BuildStateConf([]string{}, []string{}, d.Timeout(schema.TimeoutCreate), 3*time.Second, s.ImageStateRefreshFunc(d.Id(), []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
@@ -1069,7 +1063,7 @@ func (s *EcsService) DescribeImage(id, region string) (image ecs.Image, err erro
 	}
 	request.ImageId = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs"}
+	request.QueryParams = map[string]string{"Product": "ecs"}
 	request.Status = fmt.Sprintf("%s,%s,%s,%s,%s", "Creating", "Waiting", "Available", "UnAvailable", "CreateFailed")
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeImages(request)
@@ -1240,7 +1234,9 @@ func (s *EcsService) WaitForVpcAttributesChanged(instanceId, vswitchId, privateI
 		if time.Now().After(deadline) {
 			return WrapError(Error("Wait for VPC attributes changed timeout"))
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		instance, err := s.DescribeInstance(instanceId)
 		if err != nil {
@@ -1265,7 +1261,9 @@ func (s *EcsService) WaitForPrivateIpsCountChanged(eniId string, count int) erro
 		if time.Now().After(deadline) {
 			return fmt.Errorf("Wait for private IP addrsses count changed timeout")
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		ips, err := s.QueryPrivateIps(eniId)
 		if err != nil {
@@ -1283,7 +1281,9 @@ func (s *EcsService) WaitForPrivateIpsListChanged(eniId string, ipList []string)
 		if time.Now().After(deadline) {
 			return fmt.Errorf("Wait for private IP addrsses list changed timeout")
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		ips, err := s.QueryPrivateIps(eniId)
 		if err != nil {
@@ -1315,6 +1315,70 @@ func (s *EcsService) WaitForPrivateIpsListChanged(eniId string, ipList []string)
 	}
 }
 
+// DescribeInstancePrimaryNetworkInterface returns the primary ENI of a VPC instance,
+// which carries its secondary private IPs and IPv6 addresses.
+func (s *EcsService) DescribeInstancePrimaryNetworkInterface(instanceId string) (networkInterface ecs.NetworkInterfaceSet, err error) {
+	request := ecs.CreateDescribeNetworkInterfacesRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.InstanceId = instanceId
+	request.Type = "Primary"
+	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
+		return ecsClient.DescribeNetworkInterfaces(request)
+	})
+	if err != nil {
+		err = WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		return
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response := raw.(*ecs.DescribeNetworkInterfacesResponse)
+	for _, k := range response.NetworkInterfaceSets.NetworkInterfaceSet {
+		if k.InstanceId == instanceId && k.Type == "Primary" {
+			return k, nil
+		}
+	}
+	err = WrapErrorf(Error(GetNotFoundMessage("PrimaryNetworkInterface", instanceId)), NotFoundMsg, ProviderERROR, response.RequestId)
+	return
+}
+
+func (s *EcsService) QueryIpv6Addresses(eniId string) ([]string, error) {
+	eni, err := s.DescribeNetworkInterface(eniId)
+	if err != nil {
+		return nil, fmt.Errorf("Describe NetworkInterface(%s) failed, %s", eniId, err)
+	}
+	ips := make([]string, 0, len(eni.Ipv6Sets.Ipv6Set))
+	for _, ip := range eni.Ipv6Sets.Ipv6Set {
+		ips = append(ips, ip.Ipv6Address)
+	}
+	return ips, nil
+}
+
+func (s *EcsService) WaitForIpv6AddressesCountChanged(eniId string, count int) error {
+	deadline := time.Now().Add(DefaultTimeout * time.Second)
+	for {
+		if time.Now().After(deadline) {
+			return fmt.Errorf("Wait for IPv6 addresses count changed timeout")
+		}
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
+
+		ips, err := s.QueryIpv6Addresses(eniId)
+		if err != nil {
+			return fmt.Errorf("Query IPv6 addresses failed, %s", err)
+		}
+		if len(ips) == count {
+			return nil
+		}
+	}
+}
+
 func (s *EcsService) WaitForModifySecurityGroupPolicy(id, target string, timeout int) error {
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
 	for {
@@ -1340,17 +1404,14 @@ func (s *EcsService) AttachKeyPair(keyName string, instanceIds []interface{}) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.KeyPairName = keyName
 	request.InstanceIds = convertListToJsonString(instanceIds)
-	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.AttachKeyPair(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ServiceUnavailable"}) {
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1430,7 +1491,7 @@ func (s *EcsService) DescribeTaskById(id string) (task *ecs.DescribeTaskAttribut
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeTaskAttribute(request)
 	})
@@ -1456,7 +1517,7 @@ func (s *EcsService) DescribeSnapshot(id string) (*ecs.Snapshot, error) {
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.SnapshotIds = fmt.Sprintf("[\"%s\"]", id)
 	request.QueryParams["SnapshotId"] = id
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1489,7 +1550,7 @@ func (s *EcsService) DescribeSnapshotPolicy(id string) (*ecs.AutoSnapshotPolicy,
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeAutoSnapshotPolicyEx(request)
 	})
@@ -1518,7 +1579,7 @@ func (s *EcsService) DescribeReservedInstance(id string) (reservedInstance ecs.R
 	request.ReservedInstanceId = balance
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeReservedInstances(request)
 	})
@@ -1556,7 +1617,9 @@ func (s *EcsService) WaitForReservedInstance(id string, status Status, timeout i
 		if time.Now().After(deadLine) {
 			return WrapErrorf(GetTimeErrorFromString("ECS WaitForSnapshotPolicy"), WaitTimeoutMsg, id, GetFunc(1), timeout, reservedInstance.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1580,7 +1643,9 @@ func (s *EcsService) WaitForSnapshotPolicy(id string, status Status, timeout int
 		if time.Now().After(deadLine) {
 			return WrapErrorf(GetTimeErrorFromString("ECS WaitForSnapshotPolicy"), WaitTimeoutMsg, id, GetFunc(1), timeout, snapshotPolicy.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1594,7 +1659,7 @@ func (s *EcsService) DescribeLaunchTemplate(id string) (set ecs.LaunchTemplateSe
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LaunchTemplateId = &[]string{id}
 
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1626,7 +1691,7 @@ func (s *EcsService) DescribeLaunchTemplateVersion(id string, version int) (set
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.LaunchTemplateId = id
 	request.LaunchTemplateVersion = &[]string{strconv.FormatInt(int64(version), 10)}
 	raw, err := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
@@ -1672,7 +1737,9 @@ func (s *EcsService) WaitForLaunchTemplate(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1685,7 +1752,7 @@ func (s *EcsService) DescribeImageShareByImageId(id string) (imageShare *ecs.Des
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	parts, err := ParseResourceId(id, 2)
 	if err != nil {
 		return imageShare, WrapError(err)
@@ -1725,7 +1792,9 @@ func (s *EcsService) WaitForAutoProvisioningGroup(id string, status Status, time
 		if object.Status == string(status) {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
@@ -1743,7 +1812,7 @@ func (s *EcsService) DescribeAutoProvisioningGroup(id string) (group ecs.AutoPro
 	request.AutoProvisioningGroupId = &ids
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, e := s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 		return ecsClient.DescribeAutoProvisioningGroups(request)
 	})
@@ -1787,7 +1856,7 @@ func (s *EcsService) ecsTagIgnored(t ecs.Tag) bool {
 }
 
 func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := d.GetChange("tags_all")
 	added := make([]ecs.TagResourcesTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, ecs.TagResourcesTag{
@@ -1808,7 +1877,7 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.ResourceId = &[]string{d.Id()}
 		request.ResourceType = resourceType
 		request.TagKey = &removed
@@ -1832,7 +1901,7 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.ResourceId = &[]string{d.Id()}
 		request.ResourceType = resourceType
 		request.Tag = &added
@@ -1852,7 +1921,7 @@ func (s *EcsService) SetResourceTags(d *schema.ResourceData, resourceType string
 
 func (s *EcsService) SetResourceTagsNew(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		added, removed := parsingTags(d)
 		conn, err := s.client.NewEcsClient()
 		if err != nil {
@@ -1875,17 +1944,11 @@ func (s *EcsService) SetResourceTagsNew(d *schema.ResourceData, resourceType str
 			for i, key := range removedTagKeys {
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "ascm"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -1909,17 +1972,11 @@ func (s *EcsService) SetResourceTagsNew(d *schema.ResourceData, resourceType str
 				count++
 			}
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "ascm"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-05-10"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -1942,7 +1999,7 @@ func (s *EcsService) DescribeEcsDedicatedHost(id string) (object ecs.DedicatedHo
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -2026,15 +2083,14 @@ func (s *EcsService) DescribeEcsEbsStorageSet(id string) (result *datahub.EcsDes
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"PageNumber":      "1",
+		"AccessKeyId":   s.client.AccessKey,
+		"RegionId":      s.client.RegionId,
+		"Product":       "Ecs",
+		"Version":       "2014-05-26",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        action,
+		"PageNumber":    "1",
 	}
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -2092,15 +2148,14 @@ func (s *EcsService) DescribeEcsCommand(id string) (result *datahub.EcsDescribeE
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Version":         "2014-05-26",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"CommandId":       id,
+		"AccessKeyId":   s.client.AccessKey,
+		"RegionId":      s.client.RegionId,
+		"Product":       "Ecs",
+		"Version":       "2014-05-26",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        action,
+		"CommandId":     id,
 	}
 	request.PageNumber = requests.NewInteger(1)
 	request.PageSize = requests.NewInteger(20)
@@ -2166,15 +2221,14 @@ func (s *EcsService) DescribeEcsHpcCluster(id string) (result *datahub.EcsDescri
 	request.ApiName = action
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"RegionId":        s.client.RegionId,
-		"Product":         "Ecs",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Action":          action,
-		"HpcClusterIds":   string(ids),
-		"ClientToken":     ClientToken,
+		"AccessKeyId":   s.client.AccessKey,
+		"RegionId":      s.client.RegionId,
+		"Product":       "Ecs",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Action":        action,
+		"HpcClusterIds": string(ids),
+		"ClientToken":   ClientToken,
 	}
 
 	raw, err := s.client.WithEcsClient(func(EcsClient *ecs.Client) (interface{}, error) {
@@ -2225,18 +2279,7 @@ func (s *EcsService) DescribeEcsDeploymentSet(id string) (object map[string]inte
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2014-05-26"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"reflect"
 	"strconv"
@@ -16,6 +17,7 @@ import (
 
 type EdasService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 type Hook struct {
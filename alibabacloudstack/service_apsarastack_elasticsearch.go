@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
@@ -19,6 +20,7 @@ import (
 
 type ElasticsearchService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *ElasticsearchService) DescribeElasticsearchInstance(id string) (object map[string]interface{}, err error) {
@@ -122,7 +124,7 @@ func (s *ElasticsearchService) ElasticsearchRetryFunc(wait func(), errorCodeList
 	var raw interface{}
 	var err error
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithElasticsearchClient(do)
 
 		if err != nil {
@@ -167,7 +169,7 @@ func (s *ElasticsearchService) TriggerNetwork(d *schema.ResourceData, content ma
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, s.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -192,10 +194,10 @@ func (s *ElasticsearchService) ModifyWhiteIps(d *schema.ResourceData, content ma
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction", "InternalServerError"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction", "InternalServerError"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -211,7 +213,7 @@ func (s *ElasticsearchService) ModifyWhiteIps(d *schema.ResourceData, content ma
 
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, s.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -232,7 +234,7 @@ func (s *ElasticsearchService) DescribeElasticsearchTags(id string) (tags map[st
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "elasticsearch", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ResourceIds = string(resourceIds)
 	request.ResourceType = strings.ToUpper(string(TagResourceInstance))
 	raw, err := s.client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
@@ -283,7 +285,7 @@ func (s *ElasticsearchService) getActionType(actionType bool) string {
 	}
 }
 
-func updateDescription(d *schema.ResourceData, meta interface{}) error {
+func updateDescription(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var response map[string]interface{}
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	action := "UpdateDescription"
@@ -302,10 +304,10 @@ func updateDescription(d *schema.ResourceData, meta interface{}) error {
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	//response, err = elasticsearchClient.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = elasticsearchClient.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, request, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"GetCustomerLabelFail"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"GetCustomerLabelFail"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -320,11 +322,11 @@ func updateDescription(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
+func updateInstanceTags(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	remove, add := elasticsearchService.diffElasticsearchTags(o, n)
@@ -358,7 +360,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.ResourceIds = string(resourceIds)
 		raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.UntagResources(request)
@@ -391,7 +393,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "elasticsearch", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		raw, err := client.WithElasticsearchClient(func(elasticsearchClient *elasticsearch.Client) (interface{}, error) {
 			return elasticsearchClient.TagResources(request)
@@ -406,7 +408,7 @@ func updateInstanceTags(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
-func updateInstanceChargeType(d *schema.ResourceData, meta interface{}) error {
+func updateInstanceChargeType(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var response map[string]interface{}
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchClient, err := client.NewElasticsearchClient()
@@ -433,15 +435,14 @@ func updateInstanceChargeType(d *schema.ResourceData, meta interface{}) error {
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	response, err = elasticsearchClient.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
-	time.Sleep(10 * time.Second)
 	addDebug(action, response, content)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), response, AlibabacloudStackSdkGoERROR)
 	}
-	return nil
+	return WrapError(sleepContext(ctx, 10*time.Second))
 }
 
-func renewInstance(d *schema.ResourceData, meta interface{}) error {
+func renewInstance(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	var response map[string]interface{}
 	client := meta.(*connectivity.AlibabacloudStackClient)
 	elasticsearchClient, err := client.NewElasticsearchClient()
@@ -463,18 +464,16 @@ func renewInstance(d *schema.ResourceData, meta interface{}) error {
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
 	response, err = elasticsearchClient.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
-	time.Sleep(10 * time.Second)
-
 	addDebug(action, response, content)
 	if err != nil {
 		return WrapErrorf(err, DefaultErrorMsg, d.Id(), response, AlibabacloudStackSdkGoERROR)
 	}
-	return nil
+	return WrapError(sleepContext(ctx, 10*time.Second))
 }
 
-func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
+func updateDataNodeAmount(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -493,10 +492,10 @@ func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -514,16 +513,16 @@ func updateDataNodeAmount(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
+func updateDataNodeSpec(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -547,10 +546,10 @@ func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -568,16 +567,16 @@ func updateDataNodeSpec(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
+func updateMasterNode(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -607,10 +606,10 @@ func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -628,15 +627,15 @@ func updateMasterNode(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func updatePassword(d *schema.ResourceData, meta interface{}) error {
+func updatePassword(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -657,7 +656,7 @@ func updatePassword(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("password")
 		content["esAdminPassword"] = password
 	} else {
-		kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient)}
+		kmsService := KmsService{meta.(*connectivity.AlibabacloudStackClient), ctx}
 		decryptResp, err := kmsService.Decrypt(kmsPassword, d.Get("kms_encryption_context").(map[string]interface{}))
 		if err != nil {
 			return WrapError(err)
@@ -673,10 +672,10 @@ func updatePassword(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -694,7 +693,7 @@ func updatePassword(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -721,9 +720,9 @@ func filterWhitelist(destIPs []string, localIPs *schema.Set) []string {
 	return whitelist
 }
 
-func updateClientNode(d *schema.ResourceData, meta interface{}) error {
+func updateClientNode(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -755,10 +754,10 @@ func updateClientNode(d *schema.ResourceData, meta interface{}) error {
 
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -776,16 +775,16 @@ func updateClientNode(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 
 	return nil
 }
 
-func openHttps(d *schema.ResourceData, meta interface{}) error {
+func openHttps(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -805,10 +804,10 @@ func openHttps(d *schema.ResourceData, meta interface{}) error {
 
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -826,15 +825,15 @@ func openHttps(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
 }
 
-func closeHttps(d *schema.ResourceData, meta interface{}) error {
+func closeHttps(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*connectivity.AlibabacloudStackClient)
-	elasticsearchService := ElasticsearchService{client}
+	elasticsearchService := ElasticsearchService{client, ctx}
 	conn, err := client.NewElasticsearchClient()
 	if err != nil {
 		return WrapError(err)
@@ -853,10 +852,10 @@ func closeHttps(d *schema.ResourceData, meta interface{}) error {
 	runtime.SetAutoretry(true)
 	// retry
 	wait := incrementalWait(3*time.Second, 5*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(ctx, 5*time.Minute, func() *resource.RetryError {
 		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2017-06-13"), StringPointer("AK"), nil, content, &runtime)
 		if err != nil {
-			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) || NeedRetry(err) {
+			if IsExpectedErrors(err, []string{"ConcurrencyUpdateInstanceConflict", "InstanceStatusNotSupportCurrentAction"}) {
 				wait()
 				return resource.RetryableError(err)
 			}
@@ -874,7 +873,7 @@ func closeHttps(d *schema.ResourceData, meta interface{}) error {
 	stateConf := BuildStateConf([]string{"activating"}, []string{"active"}, d.Timeout(schema.TimeoutUpdate), 5*time.Minute, elasticsearchService.ElasticsearchStateRefreshFunc(d.Id(), []string{"inactive"}))
 	stateConf.PollInterval = 5 * time.Second
 
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
 		return WrapErrorf(err, IdMsg, d.Id())
 	}
 	return nil
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -14,13 +15,14 @@ import (
 
 type EssService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *EssService) DescribeEssAlarm(id string) (alarm ess.Alarm, err error) {
 	request := ess.CreateDescribeAlarmsRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.AlarmTaskId = id
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -45,7 +47,7 @@ func (s *EssService) DescribeEssAlarm(id string) (alarm ess.Alarm, err error) {
 	AlarmsRequest := ess.CreateDescribeAlarmsRequest()
 	AlarmsRequest.RegionId = s.client.RegionId
 	AlarmsRequest.Headers = map[string]string{"RegionId": s.client.RegionId}
-	AlarmsRequest.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	AlarmsRequest.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		AlarmsRequest.Scheme = "https"
 	} else {
@@ -79,7 +81,7 @@ func (s *EssService) DescribeEssLifecycleHook(id string) (hook ess.LifecycleHook
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeLifecycleHooks(request)
 	})
@@ -132,7 +134,7 @@ func (s *EssService) DescribeEssNotification(id string) (notification ess.Notifi
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ScalingGroupId = scalingGroupId
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeNotificationConfigurations(request)
@@ -188,7 +190,7 @@ func (s *EssService) DescribeEssScalingGroup(id string) (group ess.ScalingGroup,
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, e := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingGroups(request)
 	})
@@ -217,7 +219,7 @@ func (s *EssService) DescribeEssScalingConfiguration(id string) (config ess.Scal
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingConfigurations(request)
 	})
@@ -248,7 +250,7 @@ func (s *EssService) ActiveEssScalingConfiguration(sgId, id string) error {
 	request.ActiveScalingConfigurationId = id
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.ModifyScalingGroup(request)
 	})
@@ -337,7 +339,7 @@ func (s *EssService) DescribeEssScalingRule(id string) (rule ess.ScalingRule, er
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScalingRules(request)
 	})
@@ -391,7 +393,7 @@ func (s *EssService) DescribeEssScheduledTask(id string) (task ess.ScheduledTask
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 		return essClient.DescribeScheduledTasks(request)
 	})
@@ -429,7 +431,9 @@ func (s *EssService) WaitForEssScheduledTask(id string, status Status, timeout i
 			return nil
 		}
 
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.ScheduledTaskId, id, ProviderERROR)
 		}
@@ -445,7 +449,7 @@ func (srv *EssService) DescribeEssAttachment(id string, instanceIds []string) (i
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": srv.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": srv.client.SecretKey, "Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
 
 	request.ScalingGroupId = id
 	s := reflect.ValueOf(request).Elem()
@@ -488,7 +492,7 @@ func (s *EssService) DescribeEssScalingConfifurations(id string) (configs []ess.
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	for {
 		raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
 			return essClient.DescribeScalingConfigurations(request)
@@ -543,7 +547,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 	}
 
 	removed := instanceIds
-	if err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+	if err := resource.RetryContext(srv.ctx, 5*time.Minute, func() *resource.RetryError {
 		request := ess.CreateRemoveInstancesRequest()
 		request.ScalingGroupId = id
 		request.RegionId = srv.client.RegionId
@@ -553,7 +557,7 @@ func (srv *EssService) EssRemoveInstances(id string, instanceIds []string) error
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": srv.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": srv.client.SecretKey, "Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ess", "Department": srv.client.Department, "ResourceGroup": srv.client.ResourceGroup}
 
 		if len(removed) > 0 {
 			request.InstanceId = &removed
@@ -624,13 +628,96 @@ func (s *EssService) WaitForEssScalingGroup(id string, status Status, timeout in
 		if object.LifecycleState == string(status) {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.LifecycleState, string(status), ProviderERROR)
 		}
 	}
 }
 
+// DescribeEssScalingInstances returns all of the ECS instances in the scaling group in the lifecycle state,
+// or all of them when the state is empty.
+func (s *EssService) DescribeEssScalingInstances(id string, lifecycleState string) (instances []ess.ScalingInstance, err error) {
+	request := ess.CreateDescribeScalingInstancesRequest()
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.ScalingGroupId = id
+	request.LifecycleState = lifecycleState
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	for {
+		raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+			return essClient.DescribeScalingInstances(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidScalingGroupId.NotFound"}) {
+				return instances, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+			}
+			return instances, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*ess.DescribeScalingInstancesResponse)
+		instances = append(instances, response.ScalingInstances.ScalingInstance...)
+		if len(response.ScalingInstances.ScalingInstance) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return instances, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return instances, nil
+}
+
+// EssScalingInstancesRefreshFunc reaches InService once at least count instances of the scaling group are in service.
+func (s *EssService) EssScalingInstancesRefreshFunc(id string, count int) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		instances, err := s.DescribeEssScalingInstances(id, string(InService))
+		if err != nil {
+			return nil, "", WrapError(err)
+		}
+		if len(instances) < count {
+			return instances, string(Pending), nil
+		}
+		return instances, string(InService), nil
+	}
+}
+
+// ModifyEssScalingGroupCapacity sets the minimum size or, when the scaling group works with it, the desired capacity.
+func (s *EssService) ModifyEssScalingGroupCapacity(id string, minSize, desiredCapacity int) error {
+	request := ess.CreateModifyScalingGroupRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "ess", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.ScalingGroupId = id
+	request.MinSize = requests.NewInteger(minSize)
+	if desiredCapacity > 0 {
+		request.DesiredCapacity = requests.NewInteger(desiredCapacity)
+	}
+	raw, err := s.client.WithEssClient(func(essClient *ess.Client) (interface{}, error) {
+		return essClient.ModifyScalingGroup(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return nil
+}
+
 // ess dimensions to map
 func (s *EssService) flattenDimensionsToMap(dimensions []ess.Dimension) map[string]string {
 	result := make(map[string]string)
@@ -660,7 +747,9 @@ func (s *EssService) WaitForEssAttachment(id string, status Status, timeout int)
 		if len(object) > 0 && status != Deleted {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, Null, string(status), ProviderERROR)
 		}
@@ -683,7 +772,9 @@ func (s *EssService) WaitForEssAlarm(id string, status Status, timeout int) erro
 		if object.AlarmTaskId == id && status != Deleted {
 			return nil
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.AlarmTaskId, id, ProviderERROR)
 		}
@@ -0,0 +1,349 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/crc64"
+	"io/ioutil"
+	"strconv"
+
+	"github.com/alibabacloud-go/tea/tea"
+	"github.com/aliyun/fc-go-sdk"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/mitchellh/go-homedir"
+)
+
+type FcService struct {
+	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
+}
+
+func (s *FcService) DescribeFcService(id string) (*fc.GetServiceOutput, error) {
+	request := fc.NewGetServiceInput(id)
+	var requestInfo *fc.Client
+	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.GetService(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackFcGoSdk)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetService", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("GetService", raw, requestInfo, request)
+	return raw.(*fc.GetServiceOutput), nil
+}
+
+func (s *FcService) DescribeFcFunction(id string) (*fc.GetFunctionOutput, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := fc.NewGetFunctionInput(parts[0], parts[1])
+	var requestInfo *fc.Client
+	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.GetFunction(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackFcGoSdk)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetFunction", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("GetFunction", raw, requestInfo, request)
+	return raw.(*fc.GetFunctionOutput), nil
+}
+
+func (s *FcService) DescribeFcTrigger(id string) (*fc.GetTriggerOutput, error) {
+	parts, err := ParseResourceId(id, 3)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := fc.NewGetTriggerInput(parts[0], parts[1], parts[2])
+	var requestInfo *fc.Client
+	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.GetTrigger(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackFcGoSdk)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetTrigger", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("GetTrigger", raw, requestInfo, request)
+	return raw.(*fc.GetTriggerOutput), nil
+}
+
+func (s *FcService) DescribeFcAlias(id string) (*fc.GetAliasOutput, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := fc.NewGetAliasInput(parts[0], parts[1])
+	var requestInfo *fc.Client
+	raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+		requestInfo = fcClient
+		return fcClient.GetAlias(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, FcNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackFcGoSdk)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, "GetAlias", AlibabacloudStackFcGoSdk)
+	}
+	addDebug("GetAlias", raw, requestInfo, request)
+	return raw.(*fc.GetAliasOutput), nil
+}
+
+// DescribeFcVersion looks the version up in the versions of the service, the api does not get a single version.
+func (s *FcService) DescribeFcVersion(id string) (map[string]interface{}, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	versions, err := s.ListFcVersions(parts[0])
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	for _, version := range versions {
+		if fmt.Sprint(version["version_id"]) == parts[1] {
+			return version, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("FcVersion", id)), NotFoundMsg, ProviderERROR)
+}
+
+// ListFcVersions lists all of the published versions of the service.
+func (s *FcService) ListFcVersions(serviceName string) ([]map[string]interface{}, error) {
+	var versions []map[string]interface{}
+	request := fc.NewListServiceVersionsInput(serviceName).WithLimit(100)
+	for {
+		var requestInfo *fc.Client
+		raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.ListServiceVersions(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, FcNotFound) {
+				return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackFcGoSdk)
+			}
+			return nil, WrapErrorf(err, DefaultErrorMsg, serviceName, "ListServiceVersions", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("ListServiceVersions", raw, requestInfo, request)
+		response := raw.(*fc.ListServiceVersionsOutput)
+		for _, version := range response.Versions {
+			versions = append(versions, map[string]interface{}{
+				"version_id":    tea.StringValue(version.VersionID),
+				"description":   tea.StringValue(version.Description),
+				"created_time":  tea.StringValue(version.CreatedTime),
+				"last_modified": tea.StringValue(version.LastModifiedTime),
+			})
+		}
+		if response.NextToken == nil || *response.NextToken == "" {
+			break
+		}
+		request.WithNextToken(*response.NextToken)
+	}
+	return versions, nil
+}
+
+// fcTriggerConfigString returns the raw trigger config of the trigger as a normalized json string.
+func fcTriggerConfigString(config json.RawMessage) (string, error) {
+	if len(config) == 0 {
+		return "", nil
+	}
+	return normalizeJsonString(string(config))
+}
+
+// fcFunctionCodeChecksum returns the crc64 checksum of the local code package, in the format
+// of the code checksum which Function Compute reports for the code of a function.
+func fcFunctionCodeChecksum(filename string) (string, error) {
+	path, err := homedir.Expand(filename)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	return strconv.FormatUint(crc64.Checksum(content, crc64.MakeTable(crc64.ECMA)), 10), nil
+}
+
+// fcFunctionCode builds the code of the function from the local code package or the oss object.
+func fcFunctionCode(filename, ossBucket, ossKey string) (*fc.Code, error) {
+	code := fc.NewCode()
+	if filename != "" {
+		path, err := homedir.Expand(filename)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		return code.WithZipFile(content), nil
+	}
+	if ossBucket == "" || ossKey == "" {
+		return nil, WrapError(Error("[ERROR] Must specify \"filename\" or both of \"oss_bucket\" and \"oss_key\" for the code of the function"))
+	}
+	return code.WithOSSBucketName(ossBucket).WithOSSObjectName(ossKey), nil
+}
+
+// ListFcServices lists all of the services with their attributes in the schema of the services data source.
+func (s *FcService) ListFcServices() ([]map[string]interface{}, error) {
+	var services []map[string]interface{}
+	request := fc.NewListServicesInput().WithLimit(100)
+	for {
+		var requestInfo *fc.Client
+		raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.ListServices(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_fc_services", "ListServices", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("ListServices", raw, requestInfo, request)
+		response := raw.(*fc.ListServicesOutput)
+		for _, service := range response.Services {
+			mapping := map[string]interface{}{
+				"id":              tea.StringValue(service.ServiceID),
+				"name":            tea.StringValue(service.ServiceName),
+				"description":     tea.StringValue(service.Description),
+				"role":            tea.StringValue(service.Role),
+				"internet_access": tea.BoolValue(service.InternetAccess),
+				"creation_time":   tea.StringValue(service.CreatedTime),
+				"last_modified":   tea.StringValue(service.LastModifiedTime),
+			}
+			if logConfig := service.LogConfig; logConfig != nil {
+				mapping["log_project"] = tea.StringValue(logConfig.Project)
+				mapping["log_store"] = tea.StringValue(logConfig.Logstore)
+			}
+			if vpcConfig := service.VPCConfig; vpcConfig != nil {
+				mapping["vpc_id"] = tea.StringValue(vpcConfig.VPCID)
+				mapping["vswitch_ids"] = vpcConfig.VSwitchIDs
+				mapping["security_group_id"] = tea.StringValue(vpcConfig.SecurityGroupID)
+			}
+			services = append(services, mapping)
+		}
+		if response.NextToken == nil || *response.NextToken == "" {
+			break
+		}
+		request.WithNextToken(*response.NextToken)
+	}
+	return services, nil
+}
+
+// ListFcFunctions lists all of the functions of the service with their attributes in the schema of the functions data source.
+func (s *FcService) ListFcFunctions(serviceName string) ([]map[string]interface{}, error) {
+	var functions []map[string]interface{}
+	request := fc.NewListFunctionsInput(serviceName).WithLimit(100)
+	for {
+		var requestInfo *fc.Client
+		raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.ListFunctions(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_fc_functions", "ListFunctions", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("ListFunctions", raw, requestInfo, request)
+		response := raw.(*fc.ListFunctionsOutput)
+		for _, function := range response.Functions {
+			functions = append(functions, map[string]interface{}{
+				"id":                    tea.StringValue(function.FunctionID),
+				"name":                  tea.StringValue(function.FunctionName),
+				"description":           tea.StringValue(function.Description),
+				"runtime":               tea.StringValue(function.Runtime),
+				"handler":               tea.StringValue(function.Handler),
+				"timeout":               int(tea.Int32Value(function.Timeout)),
+				"memory_size":           int(tea.Int32Value(function.MemorySize)),
+				"code_size":             int(tea.Int64Value(function.CodeSize)),
+				"code_checksum":         tea.StringValue(function.CodeChecksum),
+				"environment_variables": function.EnvironmentVariables,
+				"creation_time":         tea.StringValue(function.CreatedTime),
+				"last_modified":         tea.StringValue(function.LastModifiedTime),
+			})
+		}
+		if response.NextToken == nil || *response.NextToken == "" {
+			break
+		}
+		request.WithNextToken(*response.NextToken)
+	}
+	return functions, nil
+}
+
+// ListFcTriggers lists all of the triggers of the function with their attributes in the schema of the triggers data source.
+func (s *FcService) ListFcTriggers(serviceName, functionName string) ([]map[string]interface{}, error) {
+	var triggers []map[string]interface{}
+	request := fc.NewListTriggersInput(serviceName, functionName).WithLimit(100)
+	for {
+		var requestInfo *fc.Client
+		raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.ListTriggers(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_fc_triggers", "ListTriggers", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("ListTriggers", raw, requestInfo, request)
+		response := raw.(*fc.ListTriggersOutput)
+		for _, trigger := range response.Triggers {
+			config, err := fcTriggerConfigString(trigger.RawTriggerConfig)
+			if err != nil {
+				return nil, WrapError(err)
+			}
+			triggers = append(triggers, map[string]interface{}{
+				"id":            tea.StringValue(trigger.TriggerID),
+				"name":          tea.StringValue(trigger.TriggerName),
+				"type":          tea.StringValue(trigger.TriggerType),
+				"description":   tea.StringValue(trigger.Description),
+				"role":          tea.StringValue(trigger.InvocationRole),
+				"source_arn":    tea.StringValue(trigger.SourceARN),
+				"qualifier":     tea.StringValue(trigger.Qualifier),
+				"config":        config,
+				"creation_time": tea.StringValue(trigger.CreatedTime),
+				"last_modified": tea.StringValue(trigger.LastModifiedTime),
+			})
+		}
+		if response.NextToken == nil || *response.NextToken == "" {
+			break
+		}
+		request.WithNextToken(*response.NextToken)
+	}
+	return triggers, nil
+}
+
+// ListFcAliases lists all of the aliases of the service with their attributes in the schema of the aliases data source.
+func (s *FcService) ListFcAliases(serviceName string) ([]map[string]interface{}, error) {
+	var aliases []map[string]interface{}
+	request := fc.NewListAliasesInput(serviceName).WithLimit(100)
+	for {
+		var requestInfo *fc.Client
+		raw, err := s.client.WithFcClient(func(fcClient *fc.Client) (interface{}, error) {
+			requestInfo = fcClient
+			return fcClient.ListAliases(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_fc_aliases", "ListAliases", AlibabacloudStackFcGoSdk)
+		}
+		addDebug("ListAliases", raw, requestInfo, request)
+		response := raw.(*fc.ListAliasesOutput)
+		for _, alias := range response.Aliases {
+			aliases = append(aliases, map[string]interface{}{
+				"name":                       tea.StringValue(alias.AliasName),
+				"version_id":                 tea.StringValue(alias.VersionID),
+				"description":                tea.StringValue(alias.Description),
+				"additional_version_weights": alias.AdditionalVersionWeight,
+			})
+		}
+		if response.NextToken == nil || *response.NextToken == "" {
+			break
+		}
+		request.WithNextToken(*response.NextToken)
+	}
+	return aliases, nil
+}
@@ -1,10 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
-	"time"
 
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -33,10 +33,11 @@ const (
 
 type HBaseService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *HBaseService) setInstanceTags(d *schema.ResourceData) error {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 
@@ -150,18 +151,7 @@ func (s *HBaseService) DescribeHBaseInstance(id string) (object map[string]inter
 
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-01"), StringPointer("AK"), request, nil, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2019-01-01"), StringPointer("AK"), request, nil, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"Instance.NotFound"}) {
@@ -177,7 +167,7 @@ func (s *HBaseService) DescribeHBaseInstance(id string) (object map[string]inter
 	return object, nil
 }
 
-//pop has limit, support next.
+// pop has limit, support next.
 func (s *HBaseService) DescribeIpWhitelist(id string) (instance hbase.DescribeIpWhitelistResponse, err error) {
 	request := hbase.CreateDescribeIpWhitelistRequest()
 	request.RegionId = s.client.RegionId
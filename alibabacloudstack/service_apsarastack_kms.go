@@ -1,13 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"log"
-	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/kms"
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
@@ -16,13 +15,14 @@ import (
 
 type KmsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *KmsService) DescribeKmsKey(id string) (object kms.KeyMetadata, err error) {
 	request := kms.CreateDescribeKeyRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = id
 
@@ -55,7 +55,7 @@ func (s *KmsService) Decrypt(ciphertextBlob string, encryptionContext map[string
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.CiphertextBlob = ciphertextBlob
 	request.EncryptionContext = string(context[:])
@@ -87,18 +87,7 @@ func (s *KmsService) Decrypt2(ciphertextBlob string, encryptionContext map[strin
 	}
 	request["Product"] = "Kms"
 	request["OrganizationId"] = s.client.Department
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-01-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-01-20"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 	addDebug(action, response, request)
 	if err != nil {
 		return plaintext, WrapErrorf(err, DefaultErrorMsg, context, action, AlibabacloudStackSdkGoERROR)
@@ -115,7 +104,7 @@ func (s *KmsService) DescribeKmsSecret(id string) (object kms.DescribeSecretResp
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.SecretName = id
 	request.FetchTags = "true"
@@ -141,7 +130,7 @@ func (s *KmsService) GetSecretValue(id string) (object kms.GetSecretValueRespons
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.SecretName = id
 
@@ -162,7 +151,7 @@ func (s *KmsService) GetSecretValue(id string) (object kms.GetSecretValueRespons
 }
 
 func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string) error {
-	oldItems, newItems := d.GetChange("tags")
+	oldItems, newItems := d.GetChange("tags_all")
 	added := make([]JsonTag, 0)
 	for key, value := range newItems.(map[string]interface{}) {
 		added = append(added, JsonTag{
@@ -179,7 +168,7 @@ func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string
 		request.RegionId = s.client.RegionId
 
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 		if resourceType == "key" {
 			request.KeyId = d.Id()
@@ -205,7 +194,7 @@ func (s *KmsService) setResourceTags(d *schema.ResourceData, resourceType string
 		request.RegionId = s.client.RegionId
 
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 		if resourceType == "key" {
 			request.KeyId = d.Id()
@@ -234,7 +223,7 @@ func (s *KmsService) DescribeKmsAlias(id string) (object kms.KeyMetadata, err er
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = id
 
@@ -264,7 +253,7 @@ func (s *KmsService) DescribeKmsKeyVersion(id string) (object kms.DescribeKeyVer
 	request.RegionId = s.client.RegionId
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "kms", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.KeyId = parts[0]
 	request.KeyVersionId = parts[1]
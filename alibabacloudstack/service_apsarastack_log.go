@@ -1,10 +1,12 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,10 +16,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 )
 
-var SlsClientTimeoutCatcher = Catcher{LogClientTimeout, 15, 5}
-
 type LogService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 type LogProject struct {
@@ -58,18 +59,17 @@ func (s *LogService) DescribeLogProject(id string) (*LogProject, error) {
 	request.ApiName = "GetProject"
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"AccessKeyId":     s.client.AccessKey,
-		"Product":         "SLS",
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"RegionId":        s.client.RegionId,
-		"Action":          "GetProject",
-		"Version":         "2020-03-31",
-		"projectName":     id,
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "SLS",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        "GetProject",
+		"Version":       "2020-03-31",
+		"projectName":   id,
 	}
 	var logProject *LogProject
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
 			return slsClient.ProcessCommonRequest(request)
 		})
@@ -139,7 +139,7 @@ func (s *LogService) DescribeLogStore(id string) (*sls.LogStore, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetLogStore(projectName, name)
@@ -206,7 +206,7 @@ func (s *LogService) DescribeLogStoreIndex(id string) (*sls.Index, error) {
 	}
 	projectName, name := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetIndex(projectName, name)
@@ -248,7 +248,7 @@ func (s *LogService) DescribeLogMachineGroup(id string) (*sls.MachineGroup, erro
 	}
 	projectName, groupName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetMachineGroup(projectName, groupName)
@@ -317,7 +317,7 @@ func (s *LogService) DescribeLogtailConfig(id string) (*sls.LogConfig, error) {
 	}
 	projectName, configName := parts[0], parts[2]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetConfig(projectName, configName)
@@ -384,7 +384,7 @@ func (s *LogService) DescribeLogtailAttachment(id string) (groupName string, err
 	projectName, configName, name := parts[0], parts[1], parts[2]
 	var groupNames []string
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
@@ -457,7 +457,7 @@ func (s *LogService) DescribeLogAlert(id string) (*sls.Alert, error) {
 	}
 	projectName, alertName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetAlert(projectName, alertName)
@@ -523,7 +523,7 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			return nil, slsClient.CreateDashboard(project, dashboard)
 		})
@@ -551,12 +551,12 @@ func (s *LogService) CreateLogDashboard(project, name string) error {
 	return nil
 }
 
-func CreateDashboard(project, name string, client *sls.Client) error {
+func CreateDashboard(ctx context.Context, project, name string, client *sls.Client) error {
 	dashboard := sls.Dashboard{
 		DashboardName: name,
 		ChartList:     []sls.Chart{},
 	}
-	err := resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err := resource.RetryContext(ctx, 2*time.Minute, func() *resource.RetryError {
 		err := client.CreateDashboard(project, dashboard)
 		if err != nil {
 			if err.(*sls.Error).Message == "specified dashboard already exists" {
@@ -621,7 +621,7 @@ func (s *LogService) DescribeLogDashboard(id string) (*sls.Dashboard, error) {
 	}
 	projectName, dashboardName := parts[0], parts[1]
 	var requestInfo *sls.Client
-	err = resource.Retry(2*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
 			requestInfo = slsClient
 			return slsClient.GetDashboard(projectName, dashboardName)
@@ -681,3 +681,241 @@ func (s *LogService) WaitForLogDashboard(id string, status Status, timeout int)
 		}
 	}
 }
+
+func (s *LogService) DescribeLogSavedSearch(id string) (*sls.SavedSearch, error) {
+	savedSearch := &sls.SavedSearch{}
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return savedSearch, WrapError(err)
+	}
+	projectName, searchName := parts[0], parts[1]
+	var requestInfo *sls.Client
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return slsClient.GetSavedSearch(projectName, searchName)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("GetSavedSearch", raw, requestInfo, map[string]string{
+				"project":     projectName,
+				"search_name": searchName,
+			})
+		}
+		savedSearch, _ = raw.(*sls.SavedSearch)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "SavedSearchNotExist"}) {
+			return savedSearch, WrapErrorf(err, NotFoundMsg, AlibabacloudStackLogGoSdkERROR)
+		}
+		return savedSearch, WrapErrorf(err, DefaultErrorMsg, id, "GetSavedSearch", AlibabacloudStackLogGoSdkERROR)
+	}
+	if savedSearch == nil || savedSearch.SavedSearchName == "" {
+		return savedSearch, WrapErrorf(Error(GetNotFoundMessage("LogSavedSearch", id)), NotFoundMsg, ProviderERROR)
+	}
+	return savedSearch, nil
+}
+
+// newLogProject returns the project of the log client, the ETL jobs are only managed through a project.
+func newLogProject(slsClient *sls.Client, name string) *sls.LogProject {
+	project, _ := sls.NewLogProject(name, slsClient.Endpoint, slsClient.AccessKeyID, slsClient.AccessKeySecret)
+	project.SecurityToken = slsClient.SecurityToken
+	project.UserAgent = slsClient.UserAgent
+	return project
+}
+
+func (s *LogService) DescribeLogEtl(id string) (*sls.ETLJob, error) {
+	job := &sls.ETLJob{}
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return job, WrapError(err)
+	}
+	projectName, etlName := parts[0], parts[1]
+	var requestInfo *sls.Client
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			requestInfo = slsClient
+			return newLogProject(slsClient, projectName).GetETLJob(etlName)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("GetETLJob", raw, requestInfo, map[string]string{
+				"project":  projectName,
+				"etl_name": etlName,
+			})
+		}
+		job, _ = raw.(*sls.ETLJob)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "JobNotExist", "ETLJobNotExist"}) {
+			return job, WrapErrorf(err, NotFoundMsg, AlibabacloudStackLogGoSdkERROR)
+		}
+		return job, WrapErrorf(err, DefaultErrorMsg, id, "GetETLJob", AlibabacloudStackLogGoSdkERROR)
+	}
+	if job == nil || job.JobName == "" {
+		return job, WrapErrorf(Error(GetNotFoundMessage("LogEtl", id)), NotFoundMsg, ProviderERROR)
+	}
+	return job, nil
+}
+
+func (s *LogService) DescribeLogOssShipper(id string) (*sls.Shipper, error) {
+	shipper := &sls.Shipper{}
+	parts, err := ParseResourceId(id, 3)
+	if err != nil {
+		return shipper, WrapError(err)
+	}
+	store, err := s.DescribeLogStore(parts[0] + COLON_SEPARATED + parts[1])
+	if err != nil {
+		return shipper, WrapError(err)
+	}
+	shipperName := parts[2]
+	err = resource.RetryContext(s.ctx, 2*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			return store.GetShipper(shipperName)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InternalServerError", LogClientTimeout}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		if debugOn() {
+			addDebug("GetShipper", raw, map[string]string{
+				"project":      parts[0],
+				"logstore":     parts[1],
+				"shipper_name": shipperName,
+			})
+		}
+		shipper, _ = raw.(*sls.Shipper)
+		return nil
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist", "LogStoreNotExist", "ShipperNotExist"}) {
+			return shipper, WrapErrorf(err, NotFoundMsg, AlibabacloudStackLogGoSdkERROR)
+		}
+		return shipper, WrapErrorf(err, DefaultErrorMsg, id, "GetShipper", AlibabacloudStackLogGoSdkERROR)
+	}
+	if shipper == nil || shipper.ShipperName == "" {
+		return shipper, WrapErrorf(Error(GetNotFoundMessage("LogOssShipper", id)), NotFoundMsg, ProviderERROR)
+	}
+	return shipper, nil
+}
+
+// DescribeLogProjectTags returns the tags of the project but the ones which Alibaba Cloud adds itself.
+func (s *LogService) DescribeLogProjectTags(project string) (map[string]interface{}, error) {
+	tags := make(map[string]interface{})
+	nextToken := ""
+	for {
+		var respTags []*sls.ResourceTagResponse
+		raw, err := s.client.WithLogClient(func(slsClient *sls.Client) (interface{}, error) {
+			var err error
+			respTags, nextToken, err = slsClient.ListTagResources(project, "project", []string{project}, []sls.ResourceFilterTag{}, nextToken)
+			return respTags, err
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, project, "ListTagResources", AlibabacloudStackLogGoSdkERROR)
+		}
+		addDebug("ListTagResources", raw, map[string]string{"project": project})
+		for _, tag := range respTags {
+			if !ignoredTags(tag.TagKey, tag.TagValue) {
+				tags[tag.TagKey] = tag.TagValue
+			}
+		}
+		if nextToken == "" {
+			return tags, nil
+		}
+	}
+}
+
+// doLogProjectRequest calls the project api of the log service with the parameters.
+func (s *LogService) doLogProjectRequest(action string, params map[string]string) (*responses.CommonResponse, error) {
+	request := requests.NewCommonRequest()
+	request.Method = "POST"
+	request.Product = "SLS"
+	request.Domain = s.client.Domain
+	request.Version = "2020-03-31"
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.ApiName = action
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{
+		"AccessKeyId":   s.client.AccessKey,
+		"Product":       "SLS",
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"RegionId":      s.client.RegionId,
+		"Action":        action,
+		"Version":       "2020-03-31",
+	}
+	for key, value := range params {
+		request.QueryParams[key] = value
+	}
+	raw, err := s.client.WithEcsClient(func(slsClient *ecs.Client) (interface{}, error) {
+		return slsClient.ProcessCommonRequest(request)
+	})
+	addDebug(action, raw, request, params)
+	if err != nil {
+		return nil, err
+	}
+	return raw.(*responses.CommonResponse), nil
+}
+
+func (s *LogService) DescribeLogProjectPolicy(project string) (string, error) {
+	response, err := s.doLogProjectRequest("GetProjectPolicy", map[string]string{"projectName": project})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ProjectNotExist"}) {
+			return "", WrapErrorf(err, NotFoundMsg, AlibabacloudStackLogGoSdkERROR)
+		}
+		return "", WrapErrorf(err, DefaultErrorMsg, project, "GetProjectPolicy", AlibabacloudStackLogGoSdkERROR)
+	}
+	return normalizeJsonString(response.GetHttpContentString())
+}
+
+// DescribeLogProjects returns the projects of the log service.
+func (s *LogService) DescribeLogProjects() ([]LogProject, error) {
+	var projects []LogProject
+	offset := 0
+	for {
+		response, err := s.doLogProjectRequest("ListProject", map[string]string{
+			"offset": strconv.Itoa(offset),
+			"size":   strconv.Itoa(PageSizeXLarge),
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DefaultErrorMsg, "alibabacloudstack_log_projects", "ListProject", AlibabacloudStackLogGoSdkERROR)
+		}
+		var page LogProject
+		if err := json.Unmarshal(response.GetHttpContentBytes(), &page); err != nil {
+			return nil, WrapError(err)
+		}
+		for _, p := range page.Projects {
+			projects = append(projects, LogProject{
+				ProjectName:    p.ProjectName,
+				Status:         p.Status,
+				Owner:          p.Owner,
+				Description:    p.Description,
+				Region:         p.Region,
+				CreateTime:     p.CreateTime,
+				LastModifyTime: p.LastModifyTime,
+			})
+		}
+		offset += len(page.Projects)
+		if len(page.Projects) < PageSizeXLarge || offset >= page.Total {
+			return projects, nil
+		}
+	}
+}
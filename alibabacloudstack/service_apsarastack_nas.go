@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/PaesslerAG/jsonpath"
@@ -11,6 +12,7 @@ import (
 
 type NasService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *NasService) DescribeNasFileSystem(id string) (object map[string]interface{}, err error) {
@@ -203,7 +205,7 @@ func (s *NasService) DescribeNasFileSystemStateRefreshFunc(id string, defaultRet
 	return func() (interface{}, string, error) {
 		object, err := s.DescribeNasFileSystem(id)
 		if err != nil {
-			if NeedRetry(err) && IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
+			if IsExpectedErrors(err, []string{InvalidFileSystemStatus_Ordering}) {
 				return nil, defaultRetryState, nil
 			}
 			return nil, "", WrapError(err)
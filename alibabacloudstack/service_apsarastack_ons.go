@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/responses"
@@ -11,6 +12,7 @@ import (
 
 type OnsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *OnsService) DescribeOnsInstance(instanceid string) (response *OnsInstance, err error) {
@@ -18,16 +20,15 @@ func (s *OnsService) DescribeOnsInstance(instanceid string) (response *OnsInstan
 
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleInstanceBaseInfo",
-		"Version":         "2018-02-05",
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      instanceid,
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "Ons-inner",
+		"Action":        "ConsoleInstanceBaseInfo",
+		"Version":       "2018-02-05",
+		"OnsRegionId":   s.client.RegionId,
+		"PreventCache":  "",
+		"InstanceId":    instanceid,
 	}
 	request.Method = "POST"
 	request.Product = "Ons-inner"
@@ -85,17 +86,16 @@ func (s *OnsService) DescribeOnsTopic(id string) (response *Topic, err error) {
 	InstanceId := did[1]
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleTopicList",
-		"Version":         "2018-02-05",
-		"topic":           TopicId,
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      InstanceId,
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "Ons-inner",
+		"Action":        "ConsoleTopicList",
+		"Version":       "2018-02-05",
+		"topic":         TopicId,
+		"OnsRegionId":   s.client.RegionId,
+		"PreventCache":  "",
+		"InstanceId":    InstanceId,
 	}
 	request.Method = "POST"
 	request.Product = "Ons-inner"
@@ -144,17 +144,16 @@ func (s *OnsService) DescribeOnsGroup(id string) (response *OnsGroup, err error)
 	InstanceId := did[1]
 	request := requests.NewCommonRequest()
 	request.QueryParams = map[string]string{
-		"RegionId":        s.client.RegionId,
-		"AccessKeySecret": s.client.SecretKey,
-		"Department":      s.client.Department,
-		"ResourceGroup":   s.client.ResourceGroup,
-		"Product":         "Ons-inner",
-		"Action":          "ConsoleGroupList",
-		"Version":         "2018-02-05",
-		"GroupId":         GroupId,
-		"OnsRegionId":     s.client.RegionId,
-		"PreventCache":    "",
-		"InstanceId":      InstanceId,
+		"RegionId":      s.client.RegionId,
+		"Department":    s.client.Department,
+		"ResourceGroup": s.client.ResourceGroup,
+		"Product":       "Ons-inner",
+		"Action":        "ConsoleGroupList",
+		"Version":       "2018-02-05",
+		"GroupId":       GroupId,
+		"OnsRegionId":   s.client.RegionId,
+		"PreventCache":  "",
+		"InstanceId":    InstanceId,
 	}
 	request.Method = "POST"
 	request.Product = "Ons-inner"
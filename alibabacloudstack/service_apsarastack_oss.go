@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
@@ -17,6 +18,7 @@ import (
 // OssService *connectivity.AlibabacloudStackClient
 type OssService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *OssService) DescribeOssBucket(id string) (response oss.GetBucketInfoResult, err error) {
@@ -29,7 +31,6 @@ func (s *OssService) DescribeOssBucket(id string) (response oss.GetBucketInfoRes
 	}
 	request.QueryParams = map[string]string{
 
-		"AccessKeySecret":  s.client.SecretKey,
 		"Product":          "OneRouter",
 		"Department":       s.client.Department,
 		"ResourceGroup":    s.client.ResourceGroup,
@@ -159,16 +160,15 @@ func (s *OssService) HeadOssBucketObject(bucketName string, objectName string) e
 		request.SetHTTPSInsecure(client.Config.Insecure)
 	}
 	request.QueryParams = map[string]string{
-		"RegionId":        client.RegionId,
-		"AccessKeySecret": client.SecretKey,
-		"AccessKeyId":     client.AccessKey,
-		"Product":         "OneRouter",
-		"Action":          "DoApi",
-		"AppAction":       "HeadObject",
-		"AppName":         "one-console-app-oss",
-		"Version":         "2018-12-12",
-		"Params":          "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + bucketName + "\",\"objectName\":\"" + objectName + "\"}}",
-		"AccountInfo":     "",
+		"RegionId":    client.RegionId,
+		"AccessKeyId": client.AccessKey,
+		"Product":     "OneRouter",
+		"Action":      "DoApi",
+		"AppAction":   "HeadObject",
+		"AppName":     "one-console-app-oss",
+		"Version":     "2018-12-12",
+		"Params":      "{\"region\":\"" + client.RegionId + "\",\"params\":{\"bucketName\":\"" + bucketName + "\",\"objectName\":\"" + objectName + "\"}}",
+		"AccountInfo": "",
 	}
 	request.Method = "POST"
 	request.Product = "OneRouter"
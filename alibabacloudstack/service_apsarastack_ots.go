@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"strings"
 
 	"time"
@@ -16,6 +17,7 @@ import (
 
 type OtsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *OtsService) getPrimaryKeyType(primaryKeyType string) tablestore.PrimaryKeyType {
@@ -38,7 +40,7 @@ func (s *OtsService) ListOtsTable(instanceName string) (table *tablestore.ListTa
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.ListTable()
@@ -80,7 +82,7 @@ func (s *OtsService) DescribeOtsTable(id string) (*tablestore.DescribeTableRespo
 	}
 	var raw interface{}
 	var requestInfo *tablestore.TableStoreClient
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithTableStoreClient(instanceName, func(tableStoreClient *tablestore.TableStoreClient) (interface{}, error) {
 			requestInfo = tableStoreClient
 			return tableStoreClient.DescribeTable(request)
@@ -154,7 +156,7 @@ func (s *OtsService) ListOtsInstance(pageSize int, pageNum int) ([]string, error
 	req.PageNum = requests.NewInteger(pageNum)
 	req.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	req.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	req.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	var allInstanceNames []string
 
 	for {
@@ -199,7 +201,7 @@ func (s *OtsService) DescribeOtsInstance(id string) (inst ots.InstanceInfo, err
 	//request.Headers["x-acs-resourcegroupid"] = ""
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.GetInstance(request)
 	})
@@ -226,7 +228,7 @@ func (s *OtsService) DescribeOtsInstanceAttachment(id string) (inst ots.VpcInfo,
 	request.InstanceName = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListVpcInfoByInstance(request)
 	})
@@ -275,7 +277,7 @@ func (s *OtsService) ListOtsInstanceVpc(id string) (inst []ots.VpcInfo, err erro
 	request.InstanceName = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListVpcInfoByInstance(request)
 	})
@@ -316,7 +318,9 @@ func (s *OtsService) WaitForOtsInstance(id string, status Status, timeout int) e
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, fmt.Sprint(object.Status), status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -326,7 +330,7 @@ func (s *OtsService) DescribeOtsInstanceTypes() (types []string, err error) {
 	request.Method = requests.GET
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "Ots", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithOtsClient(func(otsClient *ots.Client) (interface{}, error) {
 		return otsClient.ListClusterType(request)
 	})
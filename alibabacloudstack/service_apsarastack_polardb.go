@@ -0,0 +1,274 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/polardb"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+type PolarDBService struct {
+	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
+}
+
+// PolarDBClusterPendingStatus are the statuses the cluster goes through until it is Running again after a change.
+var PolarDBClusterPendingStatus = []string{"Creating", "Rebooting", "DBNodeCreating", "DBNodeDeleting", "ClassChanging", "NetAddressCreating", "NetAddressDeleting", "NetAddressModifying", "MinorVersionUpgrading", "Maintaining", "Switching", "ConfigSwitching"}
+
+var PolarDBClusterNotFound = []string{"InvalidDBClusterId.NotFound", "InvalidDBClusterId.NotFoundError"}
+
+// The whitelist group which the security ips of the cluster belong to when no group is named.
+const PolarDBDefaultIPArrayName = "default"
+
+// initRequest sends the request to the region, department and resource group of the provider.
+func (s *PolarDBService) initRequest(request *requests.RpcRequest) {
+	request.RegionId = s.client.RegionId
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "polardb", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+}
+
+// invoke sends the request which changes the cluster, and retries it while the cluster is busy with another change.
+func (s *PolarDBService) invoke(request *requests.RpcRequest, do func(*polardb.Client) (interface{}, error)) error {
+	return resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
+		raw, err := s.client.WithPolarDBClient(do)
+		if err != nil {
+			if IsExpectedErrors(err, OperationDeniedDBStatus) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		addDebug(request.GetActionName(), raw, request)
+		return nil
+	})
+}
+
+func (s *PolarDBService) DescribePolarDBClusterAttribute(id string) (*polardb.DescribeDBClusterAttributeResponse, error) {
+	request := polardb.CreateDescribeDBClusterAttributeRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = id
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeDBClusterAttribute(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, PolarDBClusterNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeDBClusterAttributeResponse)
+	if response.DBClusterId != id {
+		return nil, WrapErrorf(Error(GetNotFoundMessage("PolarDBCluster", id)), NotFoundMsg, ProviderERROR)
+	}
+	return response, nil
+}
+
+func (s *PolarDBService) PolarDBClusterStateRefreshFunc(id string, failStates []string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		object, err := s.DescribePolarDBClusterAttribute(id)
+		if err != nil {
+			if NotFoundError(err) {
+				// Set this to nil as if we didn't find anything.
+				return nil, "", nil
+			}
+			return nil, "", WrapError(err)
+		}
+
+		for _, failState := range failStates {
+			if object.DBClusterStatus == failState {
+				return object, object.DBClusterStatus, WrapError(Error(FailedToReachTargetStatus, object.DBClusterStatus))
+			}
+		}
+		return object, object.DBClusterStatus, nil
+	}
+}
+
+// WaitForPolarDBClusterRunning waits for the change of the cluster to finish.
+func (s *PolarDBService) WaitForPolarDBClusterRunning(id string, timeout time.Duration) error {
+	stateConf := BuildStateConf(PolarDBClusterPendingStatus, []string{"Running"}, timeout, 5*time.Second, s.PolarDBClusterStateRefreshFunc(id, []string{"Deleting"}))
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
+		return WrapErrorf(err, IdMsg, id)
+	}
+	return nil
+}
+
+func (s *PolarDBService) DescribePolarDBClusters(request *polardb.DescribeDBClustersRequest) ([]polardb.DBCluster, error) {
+	var clusters []polardb.DBCluster
+	s.initRequest(request.RpcRequest)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.PageNumber = requests.NewInteger(1)
+	for {
+		raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+			return polarDBClient.DescribeDBClusters(request)
+		})
+		if err != nil {
+			return nil, WrapErrorf(err, DataDefaultErrorMsg, "alibabacloudstack_polardb_clusters", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*polardb.DescribeDBClustersResponse)
+		clusters = append(clusters, response.Items.DBCluster...)
+		if len(response.Items.DBCluster) < PageSizeLarge {
+			break
+		}
+		page, err := getNextpageNumber(request.PageNumber)
+		if err != nil {
+			return nil, WrapError(err)
+		}
+		request.PageNumber = page
+	}
+	return clusters, nil
+}
+
+func (s *PolarDBService) DescribePolarDBClusterAccessWhitelist(id string) ([]polardb.DBClusterIPArray, error) {
+	request := polardb.CreateDescribeDBClusterAccessWhitelistRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = id
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeDBClusterAccessWhitelist(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, PolarDBClusterNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeDBClusterAccessWhitelistResponse)
+	return response.Items.DBClusterIPArray, nil
+}
+
+// ModifyPolarDBClusterAccessWhitelist replaces the security ips of the whitelist group, the group is deleted with the Delete mode.
+func (s *PolarDBService) ModifyPolarDBClusterAccessWhitelist(id, groupName, securityIps, modifyMode string, timeout time.Duration) error {
+	request := polardb.CreateModifyDBClusterAccessWhitelistRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = id
+	request.DBClusterIPArrayName = groupName
+	request.SecurityIps = securityIps
+	request.ModifyMode = modifyMode
+	err := s.invoke(request.RpcRequest, func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.ModifyDBClusterAccessWhitelist(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	return s.WaitForPolarDBClusterRunning(id, timeout)
+}
+
+func (s *PolarDBService) DescribePolarDBEndpoints(clusterId, endpointId string) ([]polardb.DBEndpoint, error) {
+	request := polardb.CreateDescribeDBClusterEndpointsRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = clusterId
+	request.DBEndpointId = endpointId
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeDBClusterEndpoints(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidDBEndpointId.NotFound")) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, clusterId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeDBClusterEndpointsResponse)
+	return response.Items, nil
+}
+
+func (s *PolarDBService) DescribePolarDBEndpoint(id string) (*polardb.DBEndpoint, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	endpoints, err := s.DescribePolarDBEndpoints(parts[0], parts[1])
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	for _, endpoint := range endpoints {
+		if endpoint.DBEndpointId == parts[1] {
+			return &endpoint, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("PolarDBEndpoint", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *PolarDBService) DescribePolarDBAccount(id string) (*polardb.DBAccount, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := polardb.CreateDescribeAccountsRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = parts[0]
+	request.AccountName = parts[1]
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeAccounts(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidAccountName.NotFound")) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeAccountsResponse)
+	for _, account := range response.Accounts {
+		if account.AccountName == parts[1] {
+			return &account, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("PolarDBAccount", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *PolarDBService) DescribePolarDBDatabase(id string) (*polardb.Database, error) {
+	parts, err := ParseResourceId(id, 2)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	request := polardb.CreateDescribeDatabasesRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = parts[0]
+	request.DBName = parts[1]
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeDatabases(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, append(PolarDBClusterNotFound, "InvalidDBName.NotFound")) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeDatabasesResponse)
+	for _, database := range response.Databases.Database {
+		if database.DBName == parts[1] {
+			return &database, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("PolarDBDatabase", id)), NotFoundMsg, ProviderERROR)
+}
+
+func (s *PolarDBService) DescribePolarDBBackupPolicy(id string) (*polardb.DescribeBackupPolicyResponse, error) {
+	request := polardb.CreateDescribeBackupPolicyRequest()
+	s.initRequest(request.RpcRequest)
+	request.DBClusterId = id
+	raw, err := s.client.WithPolarDBClient(func(polarDBClient *polardb.Client) (interface{}, error) {
+		return polarDBClient.DescribeBackupPolicy(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, PolarDBClusterNotFound) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*polardb.DescribeBackupPolicyResponse)
+	return response, nil
+}
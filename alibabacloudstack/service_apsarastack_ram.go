@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"reflect"
@@ -52,6 +53,7 @@ type Policy struct {
 
 type RamService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *RamService) ParseRolePolicyDocument(policyDocument string) (RolePolicy, error) {
@@ -533,12 +535,11 @@ func (s *RamService) DescribeRamRoleAttachment(id string) (*ecs.DescribeInstance
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
 	request.QueryParams = map[string]string{
-		"AccessKeySecret": s.client.SecretKey,
-		"Product":         "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup,
+		"Product": "ecs", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup,
 	}
 	request.InstanceIds = fmt.Sprintf("[\"%s\"]", parts[1])
 	var raw interface{}
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 			return ecsClient.DescribeInstanceRamRole(request)
 		})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -19,23 +20,22 @@ import (
 
 type RdsService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
-//
-//       _______________                      _______________                       _______________
-//       |              | ______param______\  |              |  _____request_____\  |              |
-//       |   Business   |                     |    Service   |                      |    SDK/API   |
-//       |              | __________________  |              |  __________________  |              |
-//       |______________| \    (obj, err)     |______________|  \ (status, cont)    |______________|
-//                           |                                    |
-//                           |A. {instance, nil}                  |a. {200, content}
-//                           |B. {nil, error}                     |b. {200, nil}
-//                      					  |c. {4xx, nil}
+//	_______________                      _______________                       _______________
+//	|              | ______param______\  |              |  _____request_____\  |              |
+//	|   Business   |                     |    Service   |                      |    SDK/API   |
+//	|              | __________________  |              |  __________________  |              |
+//	|______________| \    (obj, err)     |______________|  \ (status, cont)    |______________|
+//	                    |                                    |
+//	                    |A. {instance, nil}                  |a. {200, content}
+//	                    |B. {nil, error}                     |b. {200, nil}
+//	               					  |c. {4xx, nil}
 //
 // The API return 200 for resource not found.
 // When getInstance is empty, then throw InstanceNotfound error.
 // That the business layer only need to check error.
-var DBInstanceStatusCatcher = Catcher{"OperationDenied.DBInstanceStatus", 60, 5}
 
 func (s *RdsService) DescribeDBInstance(id string) (*rds.DBInstanceAttribute, error) {
 	instance := &rds.DBInstanceAttribute{}
@@ -47,7 +47,7 @@ func (s *RdsService) DescribeDBInstance(id string) (*rds.DBInstanceAttribute, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeDBInstanceAttribute(request)
@@ -76,7 +76,7 @@ func (s *RdsService) DescribeTasks(id string) (task *rds.DescribeTasksResponse,
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeTasks(request)
@@ -103,7 +103,7 @@ func (s *RdsService) DescribeDBReadonlyInstance(id string) (*rds.DBInstanceAttri
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeDBInstanceAttribute(request)
@@ -137,18 +137,19 @@ func (s *RdsService) DescribeDBAccount(id string) (*rds.DBInstanceAccount, error
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
-	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
-	if err := invoker.Run(func() error {
+	if err := resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeAccounts(request)
 		})
 		if err != nil {
-			return err
+			if IsExpectedErrors(err, []string{"OperationDenied.DBInstanceStatus"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
 		}
 
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -182,18 +183,19 @@ func (s *RdsService) DescribeDBAccountPrivilege(id string) (*rds.DBInstanceAccou
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
-	invoker := NewInvoker()
-	invoker.AddCatcher(DBInstanceStatusCatcher)
 	var response *rds.DescribeAccountsResponse
-	if err := invoker.Run(func() error {
+	if err := resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeAccounts(request)
 		})
 		if err != nil {
-			return err
+			if IsExpectedErrors(err, []string{"OperationDenied.DBInstanceStatus"}) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
 		response, _ = raw.(*rds.DescribeAccountsResponse)
@@ -231,11 +233,11 @@ func (s *RdsService) DescribeDBDatabase(id string) (*rds.Database, error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.DBName = dbName
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.DescribeDatabases(request)
 		})
@@ -266,7 +268,7 @@ func (s *RdsService) DescribeParameters(id string) (*rds.DescribeParametersRespo
 	request := rds.CreateDescribeParametersRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
 		request.Scheme = "https"
@@ -289,6 +291,26 @@ func (s *RdsService) DescribeParameters(id string) (*rds.DescribeParametersRespo
 	return response, err
 }
 
+// DescribeDBInstanceParameterGroupId returns the id of the parameter group applied to the instance, read from the
+// ParamGroupInfo of DescribeParameters which the response type of the sdk does not declare.
+func (s *RdsService) DescribeDBInstanceParameterGroupId(id string) (string, error) {
+	response, err := s.DescribeParameters(id)
+	if err != nil {
+		return "", WrapError(err)
+	}
+	var parameters struct {
+		ParamGroupInfo struct {
+			ParamGroupId string
+		}
+	}
+	if content := response.GetHttpContentBytes(); len(content) > 0 {
+		if err := json.Unmarshal(content, &parameters); err != nil {
+			return "", WrapError(err)
+		}
+	}
+	return parameters.ParamGroupInfo.ParamGroupId, nil
+}
+
 func (s *RdsService) RefreshParameters(d *schema.ResourceData, attribute string) error {
 	var param []map[string]interface{}
 	documented, ok := d.GetOk(attribute)
@@ -341,7 +363,7 @@ func (s *RdsService) ModifyParameters(d *schema.ResourceData, attribute string)
 	request := rds.CreateModifyParameterRequest()
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = d.Id()
 	request.Forcerestart = requests.NewBoolean(d.Get("force_restart").(bool))
 	if strings.ToLower(s.client.Config.Protocol) == "https" {
@@ -376,7 +398,7 @@ func (s *RdsService) ModifyParameters(d *schema.ResourceData, attribute string)
 				req.Scheme = "http"
 			}
 			req.Headers = map[string]string{"RegionId": s.client.RegionId}
-			req.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			req.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 			req.DBInstanceId = d.Id()
 			req.Engine = d.Get("engine").(string)
 			req.EngineVersion = d.Get("engine_version").(string)
@@ -435,7 +457,7 @@ func (s *RdsService) DescribeDBInstanceNetInfo(id string) ([]rds.DBInstanceNetIn
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeDBInstanceNetInfo(request)
 	})
@@ -520,13 +542,13 @@ func (s *RdsService) GrantAccountPrivilege(id, dbName string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
 	request.DBName = dbName
 	request.AccountPrivilege = parts[2]
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.GrantAccountPrivilege(request)
 		})
@@ -566,12 +588,12 @@ func (s *RdsService) RevokeAccountPrivilege(id, dbName string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = parts[0]
 	request.AccountName = parts[1]
 	request.DBName = dbName
 
-	err = resource.Retry(3*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 3*time.Minute, func() *resource.RetryError {
 		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 			return rdsClient.RevokeAccountPrivilege(request)
 		})
@@ -608,7 +630,7 @@ func (s *RdsService) ReleaseDBPublicConnection(instanceId, connection string) er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.CurrentConnectionString = connection
 
@@ -702,7 +724,7 @@ func (s *RdsService) ModifyDBBackupPolicy(d *schema.ResourceData, updateForData,
 		request := rds.CreateModifyBackupPolicyRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		if strings.ToLower(s.client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -742,7 +764,7 @@ func (s *RdsService) ModifyDBBackupPolicy(d *schema.ResourceData, updateForData,
 		request := rds.CreateModifyBackupPolicyRequest()
 		request.RegionId = s.client.RegionId
 		request.Headers = map[string]string{"RegionId": s.client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 		request.DBInstanceId = d.Id()
 		if strings.ToLower(s.client.Config.Protocol) == "https" {
 			request.Scheme = "https"
@@ -783,7 +805,7 @@ func (s *RdsService) ModifyDBSecurityIps(instanceId, ips string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = instanceId
 	request.SecurityIps = ips
 
@@ -812,7 +834,7 @@ func (s *RdsService) DescribeDBSecurityIps(instanceId string) (ips []rds.DBInsta
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = instanceId
 
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
@@ -867,7 +889,7 @@ func (s *RdsService) DescribeSecurityGroupConfiguration(id string) ([]string, er
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeSecurityGroupConfiguration(request)
@@ -896,7 +918,7 @@ func (s *RdsService) DescribeDBInstanceSSL(id string) (*rds.DescribeDBInstanceSS
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeDBInstanceSSL(request)
@@ -920,7 +942,7 @@ func (s *RdsService) DescribeRdsTDEInfo(id string) (*rds.DescribeDBInstanceTDERe
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	statErr := s.WaitForDBInstance(id, Running, DefaultLongTimeout)
 	if statErr != nil {
@@ -946,7 +968,7 @@ func (s *RdsService) ModifySecurityGroupConfiguration(id string, groupid string)
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.DBInstanceId = id
 	//openapi required that input "Empty" if groupid is ""
 	if len(groupid) == 0 {
@@ -974,7 +996,7 @@ func (s *RdsService) DescribeMultiIZByRegion() (izs []string, err error) {
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeRegions(request)
 	})
@@ -1007,7 +1029,7 @@ func (s *RdsService) DescribeBackupPolicy(id string) (*rds.DescribeBackupPolicyR
 	}
 	request.DBInstanceId = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RegionId = s.client.RegionId
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeBackupPolicy(request)
@@ -1024,6 +1046,76 @@ func (s *RdsService) DescribeBackupPolicy(id string) (*rds.DescribeBackupPolicyR
 	return raw.(*rds.DescribeBackupPolicyResponse), nil
 }
 
+// DescribeDBBackups returns the backups of the instance which have started within the time range,
+// or all of its backups when the range is not given.
+func (s *RdsService) DescribeDBBackups(id, startTime, endTime string) (backups []rds.Backup, err error) {
+	request := rds.CreateDescribeBackupsRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = id
+	request.StartTime = startTime
+	request.EndTime = endTime
+	request.PageNumber = requests.NewInteger(1)
+	request.PageSize = requests.NewInteger(PageSizeLarge)
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.RegionId = s.client.RegionId
+	for {
+		raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+			return rdsClient.DescribeBackups(request)
+		})
+		if err != nil {
+			if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+				return backups, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+			}
+			return backups, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+		}
+		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+		response, _ := raw.(*rds.DescribeBackupsResponse)
+		backups = append(backups, response.Items.Backup...)
+		if len(response.Items.Backup) < PageSizeLarge {
+			break
+		}
+		if page, err := getNextpageNumber(request.PageNumber); err != nil {
+			return backups, WrapError(err)
+		} else {
+			request.PageNumber = page
+		}
+	}
+	return backups, nil
+}
+
+// DescribeDBRecoveryTime returns the time range the instance can be restored to a point in time of.
+func (s *RdsService) DescribeDBRecoveryTime(id string) (*rds.DescribeLocalAvailableRecoveryTimeResponse, error) {
+	recovery := &rds.DescribeLocalAvailableRecoveryTimeResponse{}
+	request := rds.CreateDescribeLocalAvailableRecoveryTimeRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = id
+	request.Region = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.RegionId = s.client.RegionId
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeLocalAvailableRecoveryTime(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+			return recovery, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return recovery, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+
+	return raw.(*rds.DescribeLocalAvailableRecoveryTimeResponse), nil
+}
+
 func (s *RdsService) DescribeDbInstanceMonitor(id string) (monitoringPeriod int, err error) {
 
 	request := rds.CreateDescribeDBInstanceMonitorRequest()
@@ -1034,7 +1126,7 @@ func (s *RdsService) DescribeDbInstanceMonitor(id string) (monitoringPeriod int,
 	}
 	request.DBInstanceId = id
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RegionId = s.client.RegionId
 	raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 		return client.DescribeDBInstanceMonitor(request)
@@ -1063,7 +1155,7 @@ func (s *RdsService) DescribeSQLCollectorPolicy(id string) (collectorPolicy *rds
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeSQLCollectorPolicy(request)
 	})
@@ -1089,7 +1181,7 @@ func (s *RdsService) DescribeSQLCollectorRetention(id string) (collectorRetentio
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
 		return rdsClient.DescribeSQLCollectorRetention(request)
 	})
@@ -1105,6 +1197,191 @@ func (s *RdsService) DescribeSQLCollectorRetention(id string) (collectorRetentio
 	return response, nil
 }
 
+func (s *RdsService) DescribeDBParameterGroup(id string) (*rds.ParameterGroup, error) {
+	request := rds.CreateDescribeParameterGroupRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.ParameterGroupId = id
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeParameterGroup(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"ParamGroupsNotExist", "InvalidParameterGroupId.NotFound"}) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*rds.DescribeParameterGroupResponse)
+	for _, group := range response.ParamGroup.ParameterGroup {
+		if group.ParameterGroupId == id {
+			return &group, nil
+		}
+	}
+	return nil, WrapErrorf(Error(GetNotFoundMessage("DBParameterGroup", id)), NotFoundMsg, ProviderERROR)
+}
+
+// DescribeDBParameterTemplates returns the parameters of the engine with their default and valid values,
+// the ones of the instance when its id is given.
+func (s *RdsService) DescribeDBParameterTemplates(instanceId, engine, engineVersion string) ([]rds.TemplateRecord, error) {
+	request := rds.CreateDescribeParameterTemplatesRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = instanceId
+	request.Engine = engine
+	request.EngineVersion = engineVersion
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeParameterTemplates(request)
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*rds.DescribeParameterTemplatesResponse)
+	return response.Parameters.TemplateRecord, nil
+}
+
+// ModifyDBInstanceParameterGroup applies the parameters of the parameter group to the instance.
+func (s *RdsService) ModifyDBInstanceParameterGroup(instanceId, groupId string, forceRestart bool) error {
+	request := rds.CreateModifyParameterRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = instanceId
+	request.ParameterGroupId = groupId
+	request.Forcerestart = requests.NewBoolean(forceRestart)
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	if err := s.WaitForDBInstance(instanceId, Running, DefaultLongTimeout); err != nil {
+		return WrapError(err)
+	}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.ModifyParameter(request)
+	})
+	if err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, instanceId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return WrapError(s.WaitForDBInstance(instanceId, Running, DefaultLongTimeout))
+}
+
+func (s *RdsService) DescribeDasInstanceConfig(id string) (*rds.DescribeDasInstanceConfigResponse, error) {
+	request := rds.CreateDescribeDasInstanceConfigRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = id
+	request.ClientToken = buildClientToken(request.GetActionName())
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeDasInstanceConfig(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return raw.(*rds.DescribeDasInstanceConfigResponse), nil
+}
+
+func (s *RdsService) DescribeHASwitchConfig(id string) (*rds.DescribeHASwitchConfigResponse, error) {
+	request := rds.CreateDescribeHASwitchConfigRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = id
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeHASwitchConfig(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+			return nil, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	return raw.(*rds.DescribeHASwitchConfigResponse), nil
+}
+
+// DescribeDBInstancePrimaryNode returns the id of the node of the instance which currently is the primary one.
+func (s *RdsService) DescribeDBInstancePrimaryNode(id string) (string, error) {
+	request := rds.CreateDescribeDBInstanceHAConfigRequest()
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.DBInstanceId = id
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeDBInstanceHAConfig(request)
+	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidDBInstanceId.NotFound"}) {
+			return "", WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return "", WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*rds.DescribeDBInstanceHAConfigResponse)
+	for _, node := range response.HostInstanceInfos.NodeInfo {
+		if node.NodeType == "Master" {
+			return node.NodeId, nil
+		}
+	}
+	return "", nil
+}
+
+func (s *RdsService) DescribeAvailableDBInstanceClasses(request *rds.DescribeAvailableClassesRequest) ([]rds.DBInstanceClass, error) {
+	if strings.ToLower(s.client.Config.Protocol) == "https" {
+		request.Scheme = "https"
+	} else {
+		request.Scheme = "http"
+	}
+	request.RegionId = s.client.RegionId
+	request.Headers = map[string]string{"RegionId": s.client.RegionId}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	raw, err := s.client.WithRdsClient(func(rdsClient *rds.Client) (interface{}, error) {
+		return rdsClient.DescribeAvailableClasses(request)
+	})
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, request.ZoneId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*rds.DescribeAvailableClassesResponse)
+	return response.DBInstanceClasses, nil
+}
+
 // WaitForInstance waits for instance to given status
 func (s *RdsService) WaitForDBInstance(id string, status Status, timeout int) error {
 	deadline := time.Now().Add(time.Duration(timeout) * time.Second)
@@ -1122,7 +1399,9 @@ func (s *RdsService) WaitForDBInstance(id string, status Status, timeout int) er
 		if object != nil && strings.ToLower(object.DBInstanceStatus) == strings.ToLower(string(status)) {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBInstanceStatus, status, ProviderERROR)
 		}
@@ -1214,7 +1493,9 @@ func (s *RdsService) WaitForDBParameter(instanceId string, timeout int, expects
 			break
 		}
 
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, instanceId, GetFunc(1), timeout, got_value, expected_value, ProviderERROR)
@@ -1261,7 +1542,9 @@ func (s *RdsService) WaitForDBReadWriteSplitting(id string, status Status, timeo
 		if err == nil {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.ConnectionString, id, ProviderERROR)
 		}
@@ -1294,7 +1577,7 @@ func (s *RdsService) WaitForAccount(id string, status Status, timeout int) error
 				}
 				request.RegionId = s.client.RegionId
 				request.Headers = map[string]string{"RegionId": s.client.RegionId}
-				request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+				request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 				request.DBInstanceId = object.DBInstanceId
 				request.AccountName = object.AccountName
 
@@ -1408,7 +1691,9 @@ func (s *RdsService) WaitForDBDatabase(id string, status Status, timeout int) er
 		if object != nil && object.DBName == parts[1] {
 			break
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.DBName, parts[1], ProviderERROR)
 		}
@@ -1459,8 +1744,8 @@ func (s *RdsService) flattenDBSecurityIPs(list []rds.DBInstanceIPArray) []map[st
 }
 
 func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		remove, add := diffRdsTags(o, n)
@@ -1477,19 +1762,13 @@ func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
 			request.TagKey = &remove
 			request.RegionId = s.client.RegionId
 			request.Headers = map[string]string{"RegionId": s.client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
-			wait := incrementalWait(1*time.Second, 2*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 					return client.UntagResources(request)
 				})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1512,18 +1791,12 @@ func (s *RdsService) setInstanceTags(d *schema.ResourceData) error {
 			request.ResourceType = "INSTANCE"
 			request.RegionId = s.client.RegionId
 			request.Headers = map[string]string{"RegionId": s.client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
-			wait := incrementalWait(1*time.Second, 2*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1550,7 +1823,7 @@ func (s *RdsService) describeTags(d *schema.ResourceData) (tags []Tag, err error
 	}
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "rds", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	raw, err := s.client.WithRdsClient(func(client *rds.Client) (interface{}, error) {
 		return client.DescribeTags(request)
 	})
@@ -1,6 +1,7 @@
 package alibabacloudstack
 
 import (
+	"context"
 	"fmt"
 	"github.com/PaesslerAG/jsonpath"
 	util "github.com/alibabacloud-go/tea-utils/service"
@@ -20,6 +21,7 @@ import (
 
 type VpcService struct {
 	client *connectivity.AlibabacloudStackClient
+	ctx    context.Context
 }
 
 func (s *VpcService) DescribeEip(id string) (eip vpc.EipAddress, err error) {
@@ -32,7 +34,7 @@ func (s *VpcService) DescribeEip(id string) (eip vpc.EipAddress, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	request.AllocationId = id
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -77,28 +79,24 @@ func (s *VpcService) DescribeNatGateway(id string) (nat vpc.NatGateway, err erro
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.NatGatewayId = id
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeNatGateways(request)
-		})
-		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidNatGatewayId.NotFound"}) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
-			}
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeNatGatewaysResponse)
-		if len(response.NatGateways.NatGateway) <= 0 || response.NatGateways.NatGateway[0].NatGatewayId != id {
-			return WrapErrorf(Error(GetNotFoundMessage("NatGateway", id)), NotFoundMsg, ProviderERROR)
-		}
-		nat = response.NatGateways.NatGateway[0]
-		return nil
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeNatGateways(request)
 	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidNatGatewayId.NotFound"}) {
+			return nat, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return nat, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeNatGatewaysResponse)
+	if len(response.NatGateways.NatGateway) <= 0 || response.NatGateways.NatGateway[0].NatGatewayId != id {
+		return nat, WrapErrorf(Error(GetNotFoundMessage("NatGateway", id)), NotFoundMsg, ProviderERROR)
+	}
+	nat = response.NatGateways.NatGateway[0]
 	return
 }
 
@@ -111,28 +109,24 @@ func (s *VpcService) DescribeVpc(id string) (v vpc.Vpc, err error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.VpcId = id
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeVpcs(request)
-		})
-		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidVpcID.NotFound", "Forbidden.VpcNotFound"}) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
-			}
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeVpcsResponse)
-		if len(response.Vpcs.Vpc) < 1 || response.Vpcs.Vpc[0].VpcId != id {
-			return WrapErrorf(Error(GetNotFoundMessage("VPC", id)), NotFoundMsg, ProviderERROR)
-		}
-		v = response.Vpcs.Vpc[0]
-		return nil
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeVpcs(request)
 	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidVpcID.NotFound", "Forbidden.VpcNotFound"}) {
+			return v, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeVpcsResponse)
+	if len(response.Vpcs.Vpc) < 1 || response.Vpcs.Vpc[0].VpcId != id {
+		return v, WrapErrorf(Error(GetNotFoundMessage("VPC", id)), NotFoundMsg, ProviderERROR)
+	}
+	v = response.Vpcs.Vpc[0]
 	return
 }
 
@@ -166,28 +160,24 @@ func (s *VpcService) DescribeVSwitch(id string) (v vpc.DescribeVSwitchAttributes
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.VSwitchId = id
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeVSwitchAttributes(request)
-		})
-		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidVswitchID.NotFound"}) {
-				return WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
-			}
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeVSwitchAttributesResponse)
-		if response.VSwitchId != id {
-			return WrapErrorf(Error(GetNotFoundMessage("vswitch", id)), NotFoundMsg, ProviderERROR)
-		}
-		v = *response
-		return nil
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeVSwitchAttributes(request)
 	})
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidVswitchID.NotFound"}) {
+			return v, WrapErrorf(err, NotFoundMsg, AlibabacloudStackSdkGoERROR)
+		}
+		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeVSwitchAttributesResponse)
+	if response.VSwitchId != id {
+		return v, WrapErrorf(Error(GetNotFoundMessage("vswitch", id)), NotFoundMsg, ProviderERROR)
+	}
+	v = *response
 	return
 }
 
@@ -225,22 +215,16 @@ func (s *VpcService) DescribeSnatEntry(id string) (snat vpc.SnatTableEntry, err
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.SnatTableId = parts[0]
 
 	request.PageSize = requests.NewInteger(PageSizeLarge)
 
 	for {
-		invoker := NewInvoker()
-		var response *vpc.DescribeSnatTableEntriesResponse
-		var raw interface{}
-		err = invoker.Run(func() error {
-			raw, err = s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeSnatTableEntries(request)
-			})
-			response, _ = raw.(*vpc.DescribeSnatTableEntriesResponse)
-			return err
+		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeSnatTableEntries(request)
 		})
+		response, _ := raw.(*vpc.DescribeSnatTableEntriesResponse)
 
 		//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
 		//so judge the snatEntries length priority
@@ -289,34 +273,30 @@ func (s *VpcService) DescribeForwardEntry(id string) (entry vpc.ForwardTableEntr
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ForwardTableId = forwardTableId
 	request.ForwardEntryId = forwardEntryId
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeForwardTableEntries(request)
-		})
-		//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
-		//so judge the snatEntries length priority
-		if err != nil {
-			if IsExpectedErrors(err, []string{"InvalidForwardEntryId.NotFound", "InvalidForwardTableId.NotFound"}) {
-				return WrapErrorf(Error(GetNotFoundMessage("ForwardEntry", id)), NotFoundMsg, ProviderERROR)
-			}
-			return WrapErrorf(err, DefaultErrorMsg, "ForwardEntry", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeForwardTableEntries(request)
+	})
+	//this special deal cause the DescribeSnatEntry can't find the records would be throw "cant find the snatTable error"
+	//so judge the snatEntries length priority
+	if err != nil {
+		if IsExpectedErrors(err, []string{"InvalidForwardEntryId.NotFound", "InvalidForwardTableId.NotFound"}) {
+			return entry, WrapErrorf(Error(GetNotFoundMessage("ForwardEntry", id)), NotFoundMsg, ProviderERROR)
 		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeForwardTableEntriesResponse)
+		return entry, WrapErrorf(err, DefaultErrorMsg, "ForwardEntry", request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeForwardTableEntriesResponse)
 
-		if len(response.ForwardTableEntries.ForwardTableEntry) > 0 {
-			entry = response.ForwardTableEntries.ForwardTableEntry[0]
-			return nil
-		}
+	if len(response.ForwardTableEntries.ForwardTableEntry) > 0 {
+		entry = response.ForwardTableEntries.ForwardTableEntry[0]
+		return entry, nil
+	}
 
-		return WrapErrorf(Error(GetNotFoundMessage("ForwardEntry", id)), NotFoundMsg, ProviderERROR)
-	})
-	return
+	return entry, WrapErrorf(Error(GetNotFoundMessage("ForwardEntry", id)), NotFoundMsg, ProviderERROR)
 }
 
 func (s *VpcService) QueryRouteTableById(routeTableId string) (rt vpc.RouteTable, err error) {
@@ -328,26 +308,22 @@ func (s *VpcService) QueryRouteTableById(routeTableId string) (rt vpc.RouteTable
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RouteTableId = routeTableId
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeRouteTables(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, routeTableId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeRouteTablesResponse)
-		if len(response.RouteTables.RouteTable) == 0 ||
-			response.RouteTables.RouteTable[0].RouteTableId != routeTableId {
-			return WrapErrorf(Error(GetNotFoundMessage("RouteTable", routeTableId)), NotFoundMsg, ProviderERROR)
-		}
-		rt = response.RouteTables.RouteTable[0]
-		return nil
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeRouteTables(request)
 	})
+	if err != nil {
+		return rt, WrapErrorf(err, DefaultErrorMsg, routeTableId, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeRouteTablesResponse)
+	if len(response.RouteTables.RouteTable) == 0 ||
+		response.RouteTables.RouteTable[0].RouteTableId != routeTableId {
+		return rt, WrapErrorf(Error(GetNotFoundMessage("RouteTable", routeTableId)), NotFoundMsg, ProviderERROR)
+	}
+	rt = response.RouteTables.RouteTable[0]
 	return
 }
 
@@ -367,19 +343,14 @@ func (s *VpcService) DescribeRouteEntry(id string) (*vpc.RouteEntry, error) {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RouteTableId = rtId
 
-	invoker := NewInvoker()
 	for {
-		var raw interface{}
-		if err := invoker.Run(func() error {
-			response, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-				return vpcClient.DescribeRouteTables(request)
-			})
-			raw = response
-			return err
-		}); err != nil {
+		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+			return vpcClient.DescribeRouteTables(request)
+		})
+		if err != nil {
 			return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -417,7 +388,7 @@ func (s *VpcService) DescribeRouterInterface(id, regionId string) (ri vpc.Router
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
 	values := []string{id}
 	filter := []vpc.DescribeRouterInterfacesFilter{
@@ -427,23 +398,19 @@ func (s *VpcService) DescribeRouterInterface(id, regionId string) (ri vpc.Router
 		},
 	}
 	request.Filter = &filter
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeRouterInterfaces(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeRouterInterfacesResponse)
-		if len(response.RouterInterfaceSet.RouterInterfaceType) <= 0 ||
-			response.RouterInterfaceSet.RouterInterfaceType[0].RouterInterfaceId != id {
-			return WrapErrorf(Error(GetNotFoundMessage("RouterInterface", id)), NotFoundMsg, ProviderERROR)
-		}
-		ri = response.RouterInterfaceSet.RouterInterfaceType[0]
-		return nil
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeRouterInterfaces(request)
 	})
+	if err != nil {
+		return ri, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeRouterInterfacesResponse)
+	if len(response.RouterInterfaceSet.RouterInterfaceType) <= 0 ||
+		response.RouterInterfaceSet.RouterInterfaceType[0].RouterInterfaceId != id {
+		return ri, WrapErrorf(Error(GetNotFoundMessage("RouterInterface", id)), NotFoundMsg, ProviderERROR)
+	}
+	ri = response.RouterInterfaceSet.RouterInterfaceType[0]
 	return
 }
 
@@ -480,35 +447,31 @@ func (s *VpcService) DescribeCenInstanceGrant(id string) (rule vpc.CbnGrantRule,
 
 	request.RegionId = s.client.RegionId
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.InstanceId = instanceId
 	request.InstanceType = instanceType
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeGrantRulesToCen(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeGrantRulesToCenResponse)
-		ruleList := response.CenGrantRules.CbnGrantRule
-		if len(ruleList) <= 0 {
-			return WrapErrorf(Error(GetNotFoundMessage("GrantRules", id)), NotFoundMsg, ProviderERROR)
-		}
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeGrantRulesToCen(request)
+	})
+	if err != nil {
+		return rule, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeGrantRulesToCenResponse)
+	ruleList := response.CenGrantRules.CbnGrantRule
+	if len(ruleList) <= 0 {
+		return rule, WrapErrorf(Error(GetNotFoundMessage("GrantRules", id)), NotFoundMsg, ProviderERROR)
+	}
 
-		for ruleNum := 0; ruleNum <= len(response.CenGrantRules.CbnGrantRule)-1; ruleNum++ {
-			if ruleList[ruleNum].CenInstanceId == cenId {
-				rule = ruleList[ruleNum]
-				return nil
-			}
+	for ruleNum := 0; ruleNum <= len(response.CenGrantRules.CbnGrantRule)-1; ruleNum++ {
+		if ruleList[ruleNum].CenInstanceId == cenId {
+			rule = ruleList[ruleNum]
+			return rule, nil
 		}
+	}
 
-		return WrapErrorf(Error(GetNotFoundMessage("GrantRules", id)), NotFoundMsg, ProviderERROR)
-	})
-	return
+	return rule, WrapErrorf(Error(GetNotFoundMessage("GrantRules", id)), NotFoundMsg, ProviderERROR)
 }
 
 func (s *VpcService) WaitForCenInstanceGrant(id string, status Status, timeout int) error {
@@ -536,7 +499,9 @@ func (s *VpcService) WaitForCenInstanceGrant(id string, status Status, timeout i
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.CenInstanceId, instanceId, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -550,29 +515,25 @@ func (s *VpcService) DescribeCommonBandwidthPackage(id string) (v vpc.CommonBand
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.BandwidthPackageId = id
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeCommonBandwidthPackages(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeCommonBandwidthPackagesResponse)
-		//Finding the commonBandwidthPackageId
-		for _, bandPackage := range response.CommonBandwidthPackages.CommonBandwidthPackage {
-			if bandPackage.BandwidthPackageId == id {
-				v = bandPackage
-				return nil
-			}
-		}
-		return WrapErrorf(Error(GetNotFoundMessage("CommonBandWidthPackage", id)), NotFoundMsg, ProviderERROR)
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeCommonBandwidthPackages(request)
 	})
-	return
+	if err != nil {
+		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeCommonBandwidthPackagesResponse)
+	//Finding the commonBandwidthPackageId
+	for _, bandPackage := range response.CommonBandwidthPackages.CommonBandwidthPackage {
+		if bandPackage.BandwidthPackageId == id {
+			v = bandPackage
+			return v, nil
+		}
+	}
+	return v, WrapErrorf(Error(GetNotFoundMessage("CommonBandWidthPackage", id)), NotFoundMsg, ProviderERROR)
 }
 
 func (s *VpcService) DescribeCommonBandwidthPackageAttachment(id string) (v vpc.CommonBandwidthPackage, err error) {
@@ -605,29 +566,24 @@ func (s *VpcService) DescribeRouteTable(id string) (v vpc.RouterTableListType, e
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RouteTableId = id
 
-	invoker := NewInvoker()
-	err = invoker.Run(func() error {
-		raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
-			return vpcClient.DescribeRouteTableList(request)
-		})
-		if err != nil {
-			return WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
-		}
-		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
-		response, _ := raw.(*vpc.DescribeRouteTableListResponse)
-		//Finding the routeTableId
-		for _, routerTableType := range response.RouterTableList.RouterTableListType {
-			if routerTableType.RouteTableId == id {
-				v = routerTableType
-				return nil
-			}
-		}
-		return WrapErrorf(Error(GetNotFoundMessage("RouteTable", id)), NotFoundMsg, ProviderERROR)
+	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
+		return vpcClient.DescribeRouteTableList(request)
 	})
-	return v, WrapError(err)
+	if err != nil {
+		return v, WrapErrorf(err, DefaultErrorMsg, id, request.GetActionName(), AlibabacloudStackSdkGoERROR)
+	}
+	addDebug(request.GetActionName(), raw, request.RpcRequest, request)
+	response, _ := raw.(*vpc.DescribeRouteTableListResponse)
+	//Finding the routeTableId
+	for _, routerTableType := range response.RouterTableList.RouterTableListType {
+		if routerTableType.RouteTableId == id {
+			return routerTableType, nil
+		}
+	}
+	return v, WrapErrorf(Error(GetNotFoundMessage("RouteTable", id)), NotFoundMsg, ProviderERROR)
 }
 
 func (s *VpcService) DescribeRouteTableAttachment(id string) (v vpc.RouterTableListType, err error) {
@@ -635,25 +591,20 @@ func (s *VpcService) DescribeRouteTableAttachment(id string) (v vpc.RouterTableL
 	if err != nil {
 		return v, WrapError(err)
 	}
-	invoker := NewInvoker()
 	routeTableId := parts[0]
 	vSwitchId := parts[1]
 
-	err = invoker.Run(func() error {
-		object, err := s.DescribeRouteTable(routeTableId)
-		if err != nil {
-			return WrapError(err)
-		}
+	object, err := s.DescribeRouteTable(routeTableId)
+	if err != nil {
+		return v, WrapError(err)
+	}
 
-		for _, id := range object.VSwitchIds.VSwitchId {
-			if id == vSwitchId {
-				v = object
-				return nil
-			}
+	for _, id := range object.VSwitchIds.VSwitchId {
+		if id == vSwitchId {
+			return object, nil
 		}
-		return WrapErrorf(Error(GetNotFoundMessage("RouteTableAttachment", id)), NotFoundMsg, ProviderERROR)
-	})
-	return v, WrapError(err)
+	}
+	return v, WrapErrorf(Error(GetNotFoundMessage("RouteTableAttachment", id)), NotFoundMsg, ProviderERROR)
 }
 
 func (s *VpcService) WaitForVSwitch(id string, status Status, timeout int) error {
@@ -675,7 +626,9 @@ func (s *VpcService) WaitForVSwitch(id string, status Status, timeout int) error
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -698,7 +651,9 @@ func (s *VpcService) WaitForNatGateway(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -721,7 +676,9 @@ func (s *VpcService) WaitForRouteEntry(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, status, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -745,7 +702,9 @@ func (s *VpcService) WaitForAllRouteEntriesAvailable(routeTableId string, timeou
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, routeTableId, GetFunc(1), timeout, Available, Null, ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 	return nil
 }
@@ -769,7 +728,9 @@ func (s *VpcService) WaitForRouterInterface(id, regionId string, status Status,
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -792,7 +753,9 @@ func (s *VpcService) WaitForRouterInterfaceConnection(id, regionId string, statu
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -815,7 +778,9 @@ func (s *VpcService) WaitForEip(id string, status Status, timeout int) error {
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -838,7 +803,9 @@ func (s *VpcService) WaitForEipAssociation(id string, status Status, timeout int
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -851,7 +818,7 @@ func (s *VpcService) DeactivateRouterInterface(interfaceId string) error {
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RouterInterfaceId = interfaceId
 
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
@@ -874,7 +841,7 @@ func (s *VpcService) ActivateRouterInterface(interfaceId string) error {
 	}
 
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.RouterInterfaceId = interfaceId
 	raw, err := s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 		return vpcClient.ActivateRouterInterface(request)
@@ -905,7 +872,9 @@ func (s *VpcService) WaitForForwardEntry(id string, status Status, timeout int)
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1015,7 +984,9 @@ func (s *VpcService) WaitForRouteTable(id string, status Status, timeout int) er
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(s.ctx, 3*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1040,7 +1011,9 @@ func (s *VpcService) WaitForRouteTableAttachment(id string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, object.Status, string(status), ProviderERROR)
 		}
-		time.Sleep(3 * time.Second)
+		if err := sleepContext(s.ctx, 3*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1059,18 +1032,7 @@ func (s *VpcService) DescribeNetworkAcl(id string) (object map[string]interface{
 	request["OrganizationId"] = s.client.Department
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		if IsExpectedErrors(err, []string{"InvalidNetworkAcl.NotFound"}) {
@@ -1088,31 +1050,28 @@ func (s *VpcService) DescribeNetworkAcl(id string) (object map[string]interface{
 
 func (s *VpcService) DescribeNetworkAclAttachment(id string, resource []vpc.Resource) (err error) {
 
-	invoker := NewInvoker()
-	return invoker.Run(func() error {
-		object, err := s.DescribeNetworkAcl(id)
-		if err != nil {
-			return WrapError(err)
+	object, err := s.DescribeNetworkAcl(id)
+	if err != nil {
+		return WrapError(err)
+	}
+	resources, _ := object["Resources"].(map[string]interface{})["Resource"].([]interface{})
+	if len(resources) < 1 {
+		return WrapErrorf(Error(GetNotFoundMessage("Network Acl Attachment", id)), NotFoundMsg, ProviderERROR)
+	}
+	success := true
+	for _, source := range resources {
+		success = false
+		for _, res := range resource {
+			item := source.(map[string]interface{})
+			if fmt.Sprint(item["ResourceId"]) == res.ResourceId {
+				success = true
+			}
 		}
-		resources, _ := object["Resources"].(map[string]interface{})["Resource"].([]interface{})
-		if len(resources) < 1 {
+		if success == false {
 			return WrapErrorf(Error(GetNotFoundMessage("Network Acl Attachment", id)), NotFoundMsg, ProviderERROR)
 		}
-		success := true
-		for _, source := range resources {
-			success = false
-			for _, res := range resource {
-				item := source.(map[string]interface{})
-				if fmt.Sprint(item["ResourceId"]) == res.ResourceId {
-					success = true
-				}
-			}
-			if success == false {
-				return WrapErrorf(Error(GetNotFoundMessage("Network Acl Attachment", id)), NotFoundMsg, ProviderERROR)
-			}
-		}
-		return nil
-	})
+	}
+	return nil
 }
 
 func (s *VpcService) WaitForNetworkAcl(networkAclId string, status Status, timeout int) error {
@@ -1143,7 +1102,9 @@ func (s *VpcService) WaitForNetworkAcl(networkAclId string, status Status, timeo
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, networkAclId, GetFunc(1), timeout, fmt.Sprint(object["Status"]), string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1176,7 +1137,9 @@ func (s *VpcService) WaitForNetworkAclAttachment(id string, resource []vpc.Resou
 		if time.Now().After(deadline) {
 			return WrapErrorf(err, WaitTimeoutMsg, id, GetFunc(1), timeout, fmt.Sprint(object["Status"]), string(status), ProviderERROR)
 		}
-		time.Sleep(DefaultIntervalShort * time.Second)
+		if err := sleepContext(s.ctx, DefaultIntervalShort*time.Second); err != nil {
+			return WrapError(err)
+		}
 	}
 }
 
@@ -1189,7 +1152,7 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 		request.Scheme = "http"
 	}
 	request.Headers = map[string]string{"RegionId": s.client.RegionId}
-	request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+	request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 	request.ResourceType = string(resourceType)
 	request.ResourceId = &[]string{resourceId}
 	if resourceTags != nil && len(resourceTags) > 0 {
@@ -1203,18 +1166,13 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 		request.Tag = &reqTags
 	}
 
-	wait := incrementalWait(3*time.Second, 5*time.Second)
 	var raw interface{}
 
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+	err = resource.RetryContext(s.ctx, 5*time.Minute, func() *resource.RetryError {
 		raw, err = s.client.WithVpcClient(func(vpcClient *vpc.Client) (interface{}, error) {
 			return vpcClient.ListTagResources(request)
 		})
 		if err != nil {
-			if IsExpectedErrors(err, []string{Throttling}) {
-				wait()
-				return resource.RetryableError(err)
-			}
 			return resource.NonRetryableError(err)
 		}
 		addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1230,8 +1188,8 @@ func (s *VpcService) DescribeTags(resourceId string, resourceTags map[string]int
 }
 
 func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagResourceType) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		o := oraw.(map[string]interface{})
 		n := nraw.(map[string]interface{})
 		create, remove := s.diffTags(s.tagsFromMap(o), s.tagsFromMap(n))
@@ -1252,19 +1210,13 @@ func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 			request.TagKey = &tagKey
 			request.RegionId = s.client.RegionId
 			request.Headers = map[string]string{"RegionId": s.client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithVpcClient(func(client *vpc.Client) (interface{}, error) {
 					return client.UnTagResources(request)
 				})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1287,19 +1239,13 @@ func (s *VpcService) setInstanceTags(d *schema.ResourceData, resourceType TagRes
 			request.ResourceType = string(resourceType)
 			request.RegionId = s.client.RegionId
 			request.Headers = map[string]string{"RegionId": s.client.RegionId}
-			request.QueryParams = map[string]string{"AccessKeySecret": s.client.SecretKey, "Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
+			request.QueryParams = map[string]string{"Product": "vpc", "Department": s.client.Department, "ResourceGroup": s.client.ResourceGroup}
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				raw, err := s.client.WithVpcClient(func(client *vpc.Client) (interface{}, error) {
 					return client.TagResources(request)
 				})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -1479,24 +1425,13 @@ func (s *VpcService) DeleteAclResources(id string) (object map[string]interface{
 	request["ClientToken"] = buildClientToken("UnassociateNetworkAcl")
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(10*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return response, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
 	}
 	stateConf := BuildStateConf([]string{}, []string{"Available"}, 10*time.Minute, 5*time.Second, s.NetworkAclStateRefreshFunc(id, []string{}))
-	if _, err := stateConf.WaitForState(); err != nil {
+	if _, err := stateConf.WaitForStateContext(s.ctx); err != nil {
 		return response, WrapErrorf(err, IdMsg, id)
 	}
 	return object, nil
@@ -1524,20 +1459,9 @@ func (s *VpcService) DescribeVpcIpv6EgressRule(id string) (object map[string]int
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			request["Product"] = "Vpc"
-			request["OrganizationId"] = s.client.Department
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		request["Product"] = "Vpc"
+		request["OrganizationId"] = s.client.Department
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -1599,20 +1523,9 @@ func (s *VpcService) DescribeVpcIpv6Gateway(id string) (object map[string]interf
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = s.client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = s.client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -1661,20 +1574,9 @@ func (s *VpcService) DescribeVpcIpv6InternetBandwidth(id string) (object map[str
 	}
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		request["Product"] = "Vpc"
-		request["OrganizationId"] = s.client.Department
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	request["Product"] = "Vpc"
+	request["OrganizationId"] = s.client.Department
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -1745,7 +1647,7 @@ func (s *VpcService) setInstanceSecondaryCidrBlocks(d *schema.ResourceData) erro
 
 func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string) error {
 
-	if d.HasChange("tags") {
+	if d.HasChange("tags_all") {
 		added, removed := parsingTags(d)
 		conn, err := s.client.NewVpcClient()
 		if err != nil {
@@ -1768,17 +1670,11 @@ func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string
 			for i, key := range removedTagKeys {
 				request[fmt.Sprintf("TagKey.%d", i+1)] = key
 			}
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "Vpc"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -1802,17 +1698,11 @@ func (s *VpcService) SetResourceTags(d *schema.ResourceData, resourceType string
 				count++
 			}
 
-			wait := incrementalWait(2*time.Second, 1*time.Second)
-			err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+			err := resource.RetryContext(s.ctx, 10*time.Minute, func() *resource.RetryError {
 				request["Product"] = "Vpc"
 				request["OrganizationId"] = s.client.Department
 				response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
 				if err != nil {
-					if IsThrottling(err) {
-						wait()
-						return resource.RetryableError(err)
-
-					}
 					return resource.NonRetryableError(err)
 				}
 				addDebug(action, response, request)
@@ -1844,30 +1734,19 @@ func (s *VpcService) ListTagResources(id string, resourceType string) (object in
 	var response map[string]interface{}
 
 	for {
-		wait := incrementalWait(3*time.Second, 5*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err := conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			addDebug(action, response, request)
-			v, err := jsonpath.Get("$.TagResources.TagResource", response)
-			if err != nil {
-				return resource.NonRetryableError(WrapErrorf(err, FailedGetAttributeMsg, id, "$.TagResources.TagResource", response))
-			}
-			if v != nil {
-				tags = append(tags, v.([]interface{})...)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+		addDebug(action, response, request)
 		if err != nil {
 			err = WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
 			return
 		}
+		v, err := jsonpath.Get("$.TagResources.TagResource", response)
+		if err != nil {
+			return object, WrapErrorf(err, FailedGetAttributeMsg, id, "$.TagResources.TagResource", response)
+		}
+		if v != nil {
+			tags = append(tags, v.([]interface{})...)
+		}
 		if response["NextToken"] == nil {
 			break
 		}
@@ -1897,18 +1776,7 @@ func (s *VpcService) DescribeExpressConnectPhysicalConnection(id string) (object
 	request["Filter"] = filterMapList
 	runtime := util.RuntimeOptions{}
 	runtime.SetAutoretry(true)
-	wait := incrementalWait(3*time.Second, 3*time.Second)
-	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-		if err != nil {
-			if NeedRetry(err) {
-				wait()
-				return resource.RetryableError(err)
-			}
-			return resource.NonRetryableError(err)
-		}
-		return nil
-	})
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 	addDebug(action, response, request)
 	if err != nil {
 		return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -1966,18 +1834,7 @@ func (s *VpcService) DescribeExpressConnectVirtualBorderRouter(id string) (objec
 	for {
 		runtime := util.RuntimeOptions{}
 		runtime.SetAutoretry(true)
-		wait := incrementalWait(3*time.Second, 3*time.Second)
-		err = resource.Retry(5*time.Minute, func() *resource.RetryError {
-			response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
-			if err != nil {
-				if NeedRetry(err) {
-					wait()
-					return resource.RetryableError(err)
-				}
-				return resource.NonRetryableError(err)
-			}
-			return nil
-		})
+		response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer("2016-04-28"), StringPointer("AK"), nil, request, &runtime)
 		addDebug(action, response, request)
 		if err != nil {
 			return object, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
@@ -0,0 +1,324 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/PaesslerAG/jsonpath"
+	util "github.com/alibabacloud-go/tea-utils/service"
+	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack/connectivity"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceTagging declares how the tags of a resource are managed through the tag apis of its product.
+type resourceTagging struct {
+	Api          tagApi
+	ResourceType TagResourceType
+	// Product and Version are the product code and the api version the tag apis are called with
+	Product   string
+	Version   string
+	NewClient func(client *connectivity.AlibabacloudStackClient) (*connectivity.RpcClient, error)
+}
+
+// tagApi are the apis a product tags its resources with.
+type tagApi int
+
+const (
+	// tagResourcesApi are the TagResources, UntagResources and ListTagResources apis, which take the
+	// ResourceType and the ResourceId.N of the resources.
+	tagResourcesApi tagApi = iota
+	// kmsTagApi are the TagResource, UntagResource and ListResourceTags apis of kms, which take the KeyId
+	// of a single key and the tags and the tag keys as json.
+	kmsTagApi
+	// ossObjectTagApi are the PutObjectTagging, DeleteObjectTagging and GetObjectTagging apis of oss, which
+	// replace all of the tags of an object of the bucket at once.
+	ossObjectTagApi
+)
+
+// The slb_server_group and slb_listener are not declared: the SLB tag apis only tag the load balancer
+// itself, so they are tagged through the tags of their slb.
+var (
+	kvstoreInstanceTagging = resourceTagging{
+		ResourceType: TagResourceDBInstance,
+		Product:      "R-kvstore",
+		Version:      "2015-01-01",
+		NewClient:    (*connectivity.AlibabacloudStackClient).NewKVStoreClient,
+	}
+	mongodbShardingInstanceTagging = resourceTagging{
+		ResourceType: TagResourceDBInstance,
+		Product:      "Dds",
+		Version:      "2015-12-01",
+		NewClient:    (*connectivity.AlibabacloudStackClient).NewDdsClient,
+	}
+	essScalingGroupTagging = resourceTagging{
+		ResourceType: TagResourceScalingGroup,
+		Product:      "Ess",
+		Version:      "2014-08-28",
+		NewClient:    (*connectivity.AlibabacloudStackClient).NewEssClient,
+	}
+	nasFileSystemTagging = resourceTagging{
+		ResourceType: TagResourceFileSystem,
+		Product:      "Nas",
+		Version:      "2017-06-26",
+		NewClient:    (*connectivity.AlibabacloudStackClient).NewNasClient,
+	}
+	kmsKeyTagging = resourceTagging{
+		Api:       kmsTagApi,
+		Product:   "Kms",
+		Version:   "2016-01-20",
+		NewClient: (*connectivity.AlibabacloudStackClient).NewKmsClient,
+	}
+	ossBucketObjectTagging = resourceTagging{
+		Api: ossObjectTagApi,
+	}
+)
+
+// withTagging makes the resource taggable: it adds the tags and tags_all to the resource and applies
+// and reads them with the tag apis once the resource itself has been created, updated or read.
+// The resource opts in with a single declaration in the provider resources map.
+func withTagging(r *schema.Resource, tagging resourceTagging) *schema.Resource {
+	if _, ok := r.Schema["tags"]; !ok {
+		r.Schema["tags"] = tagsSchema()
+	}
+	r.Schema["tags_all"] = tagsAllSchema()
+	if r.CustomizeDiff == nil {
+		r.CustomizeDiff = setTagsAllDiff
+	} else {
+		r.CustomizeDiff = customizeDiffAll(r.CustomizeDiff, setTagsAllDiff)
+	}
+
+	r.CreateContext = tagging.after(r.CreateContext, tagging.update, tagging.read)
+	r.ReadContext = tagging.after(r.ReadContext, tagging.read)
+	// The resources without any updatable attribute have no update, the tags need one
+	r.UpdateContext = tagging.after(r.UpdateContext, tagging.update, tagging.read)
+	return r
+}
+
+// after runs the steps once the operation of the resource has succeeded and the resource still exists.
+func (t resourceTagging) after(operation func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics, steps ...func(context.Context, *schema.ResourceData, interface{}) error) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		if operation != nil {
+			diags = operation(ctx, d, meta)
+			if diags.HasError() || d.Id() == "" {
+				return diags
+			}
+		}
+		for _, step := range steps {
+			if err := step(ctx, d, meta); err != nil {
+				return append(diags, errorToDiagnostics(err, d.Id())...)
+			}
+		}
+		return diags
+	}
+}
+
+func (t resourceTagging) update(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if !d.HasChange("tags_all") {
+		return nil
+	}
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	if t.Api == ossObjectTagApi {
+		return t.putObjectTagging(client, d)
+	}
+	added, removed := parsingTags(d)
+	removedTagKeys := make([]string, 0)
+	for _, key := range removed {
+		if !ignoredTags(key, "") {
+			removedTagKeys = append(removedTagKeys, key)
+		}
+	}
+	if len(removedTagKeys) > 0 {
+		request := t.request(client, d.Id())
+		action := "UntagResources"
+		if t.Api == kmsTagApi {
+			action = "UntagResource"
+			request["TagKeys"] = convertListToJsonString(convertListStringToListInterface(removedTagKeys))
+		} else {
+			for i, key := range removedTagKeys {
+				request[fmt.Sprintf("TagKey.%d", i+1)] = key
+			}
+		}
+		if _, err := t.doRequest(ctx, client, action, d.Id(), request); err != nil {
+			return err
+		}
+	}
+	if len(added) > 0 {
+		request := t.request(client, d.Id())
+		action := "TagResources"
+		if t.Api == kmsTagApi {
+			action = "TagResource"
+			var tags []interface{}
+			for key, value := range added {
+				tags = append(tags, map[string]interface{}{"TagKey": key, "TagValue": value})
+			}
+			tagsJson, err := convertArrayObjectToJsonString(tags)
+			if err != nil {
+				return WrapError(err)
+			}
+			request["Tags"] = tagsJson
+		} else {
+			count := 1
+			for key, value := range added {
+				request[fmt.Sprintf("Tag.%d.Key", count)] = key
+				request[fmt.Sprintf("Tag.%d.Value", count)] = value
+				count++
+			}
+		}
+		if _, err := t.doRequest(ctx, client, action, d.Id(), request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// putObjectTagging replaces the tags of the oss object with all of the ones of the configuration.
+func (t resourceTagging) putObjectTagging(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) error {
+	bucket, err := ossObjectBucket(client, d)
+	if err != nil {
+		return err
+	}
+	tagging := ossObjectTagging(d)
+	if len(tagging.Tags) == 0 {
+		if err := bucket.DeleteObjectTagging(d.Id()); err != nil {
+			return WrapErrorf(err, DefaultErrorMsg, d.Id(), "DeleteObjectTagging", AlibabacloudStackOssGoSdk)
+		}
+		return nil
+	}
+	if err := bucket.PutObjectTagging(d.Id(), tagging); err != nil {
+		return WrapErrorf(err, DefaultErrorMsg, d.Id(), "PutObjectTagging", AlibabacloudStackOssGoSdk)
+	}
+	return nil
+}
+
+func (t resourceTagging) read(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*connectivity.AlibabacloudStackClient)
+	var tags map[string]interface{}
+	var err error
+	switch t.Api {
+	case kmsTagApi:
+		tags, err = t.listKeyTags(ctx, client, d.Id())
+	case ossObjectTagApi:
+		tags, err = t.getObjectTagging(client, d)
+	default:
+		tags, err = t.listTags(ctx, client, d.Id())
+	}
+	if err != nil {
+		return err
+	}
+	return setResourceTags(d, meta, tags)
+}
+
+// listKeyTags returns the tags of the kms key but the ones which Alibaba Cloud adds itself.
+func (t resourceTagging) listKeyTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, id string) (map[string]interface{}, error) {
+	response, err := t.doRequest(ctx, client, "ListResourceTags", id, t.request(client, id))
+	if err != nil {
+		return nil, err
+	}
+	tags := make(map[string]interface{})
+	v, err := jsonpath.Get("$.Tags.Tag", response)
+	if err != nil {
+		// A key without any tag has no Tag
+		return tags, nil
+	}
+	items, _ := v.([]interface{})
+	for _, item := range items {
+		tag, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		key, value := fmt.Sprint(tag["TagKey"]), fmt.Sprint(tag["TagValue"])
+		if !ignoredTags(key, value) {
+			tags[key] = value
+		}
+	}
+	return tags, nil
+}
+
+// getObjectTagging returns the tags of the oss object.
+func (t resourceTagging) getObjectTagging(client *connectivity.AlibabacloudStackClient, d *schema.ResourceData) (map[string]interface{}, error) {
+	bucket, err := ossObjectBucket(client, d)
+	if err != nil {
+		return nil, err
+	}
+	result, err := bucket.GetObjectTagging(d.Id())
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, d.Id(), "GetObjectTagging", AlibabacloudStackOssGoSdk)
+	}
+	addDebug("GetObjectTagging", result, map[string]string{"bucketName": d.Get("bucket").(string), "objectKey": d.Id()})
+	tags := make(map[string]interface{})
+	for _, tag := range result.Tags {
+		if !ignoredTags(tag.Key, tag.Value) {
+			tags[tag.Key] = tag.Value
+		}
+	}
+	return tags, nil
+}
+
+// listTags returns the tags of the resource but the ones which Alibaba Cloud adds itself.
+func (t resourceTagging) listTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, id string) (map[string]interface{}, error) {
+	request := t.request(client, id)
+	tags := make(map[string]interface{})
+	for {
+		response, err := t.doRequest(ctx, client, "ListTagResources", id, request)
+		if err != nil {
+			return nil, err
+		}
+		// Most of the products wrap the tags in TagResources.TagResource, some return them in TagResources
+		var items []interface{}
+		switch v := response["TagResources"].(type) {
+		case []interface{}:
+			items = v
+		case map[string]interface{}:
+			items, _ = v["TagResource"].([]interface{})
+		}
+		for _, item := range items {
+			tag, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			key, value := fmt.Sprint(tag["TagKey"]), fmt.Sprint(tag["TagValue"])
+			if !ignoredTags(key, value) {
+				tags[key] = value
+			}
+		}
+		if nextToken, ok := response["NextToken"].(string); ok && nextToken != "" {
+			request["NextToken"] = nextToken
+			continue
+		}
+		return tags, nil
+	}
+}
+
+func (t resourceTagging) request(client *connectivity.AlibabacloudStackClient, id string) map[string]interface{} {
+	if t.Api == kmsTagApi {
+		return map[string]interface{}{
+			"RegionId":       client.RegionId,
+			"KeyId":          id,
+			"Product":        t.Product,
+			"OrganizationId": client.Department,
+		}
+	}
+	return map[string]interface{}{
+		"RegionId":       client.RegionId,
+		"ResourceType":   string(t.ResourceType),
+		"ResourceId.1":   id,
+		"Product":        t.Product,
+		"OrganizationId": client.Department,
+	}
+}
+
+func (t resourceTagging) doRequest(ctx context.Context, client *connectivity.AlibabacloudStackClient, action, id string, request map[string]interface{}) (map[string]interface{}, error) {
+	conn, err := t.NewClient(client)
+	if err != nil {
+		return nil, WrapError(err)
+	}
+	var response map[string]interface{}
+	response, err = conn.DoRequest(StringPointer(action), nil, StringPointer("POST"), StringPointer(t.Version), StringPointer("AK"), nil, request, &util.RuntimeOptions{})
+	addDebug(action, response, request)
+	if err != nil {
+		return nil, WrapErrorf(err, DefaultErrorMsg, id, action, AlibabacloudStackSdkGoERROR)
+	}
+	return response, nil
+}
@@ -0,0 +1,84 @@
+package alibabacloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestUnitAlibabacloudStackTagging_kmsKey(t *testing.T) {
+	server := newMockApiServer(t).
+		on("UntagResource", map[string]interface{}{"RequestId": "mock"}).
+		on("TagResource", map[string]interface{}{"RequestId": "mock"}).
+		on("ListResourceTags", map[string]interface{}{
+			"Tags": map[string]interface{}{
+				"Tag": []interface{}{
+					map[string]interface{}{"KeyId": "key-mock0001", "TagKey": "Env", "TagValue": "test"},
+					map[string]interface{}{"KeyId": "key-mock0001", "TagKey": "acs:rm:rgId", "TagValue": mockApiResourceGroup},
+				},
+			},
+		})
+	client := server.client()
+
+	r := withTagging(resourceAlibabacloudStackKmsKey(), kmsKeyTagging)
+	state := &terraform.InstanceState{
+		ID: "key-mock0001",
+		Attributes: map[string]string{
+			"id":           "key-mock0001",
+			"tags.%":       "2",
+			"tags.Env":     "dev",
+			"tags.Old":     "removed",
+			"tags_all.%":   "2",
+			"tags_all.Env": "dev",
+			"tags_all.Old": "removed",
+		},
+	}
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"tags": map[string]interface{}{"Env": "test"},
+	}), client)
+	if err != nil {
+		t.Fatalf("planning the changed tags got an error: %#v", err)
+	}
+	d, err := schema.InternalMap(r.Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("building the resource data of the planned tags got an error: %#v", err)
+	}
+	if err := kmsKeyTagging.update(context.Background(), d, client); err != nil {
+		t.Fatalf("tagging the kms key got an error: %#v", err)
+	}
+	call, ok := server.lastCall("UntagResource")
+	if !ok || call.Params["KeyId"] != "key-mock0001" {
+		t.Errorf("UntagResource was not called with the KeyId of the key: %v", call.Params)
+	}
+	var tagKeys []string
+	if err := json.Unmarshal([]byte(call.Params["TagKeys"]), &tagKeys); err != nil {
+		t.Errorf("expected the changed and removed tag keys as json, got %q", call.Params["TagKeys"])
+	}
+	sort.Strings(tagKeys)
+	if len(tagKeys) != 2 || tagKeys[0] != "Env" || tagKeys[1] != "Old" {
+		t.Errorf("expected the changed tag key Env and the removed tag key Old to be untagged, got %v", tagKeys)
+	}
+	call, ok = server.lastCall("TagResource")
+	if !ok || call.Params["KeyId"] != "key-mock0001" || call.Params["Tags"] != `[{"TagKey":"Env","TagValue":"test"}]` {
+		t.Errorf("TagResource was not called with the KeyId and the tags as json: %v", call.Params)
+	}
+	for _, action := range []string{"TagResources", "UntagResources"} {
+		if count := server.callCount(action); count != 0 {
+			t.Errorf("expected the kms key not to be tagged through %s, it was called %d times", action, count)
+		}
+	}
+
+	if err := kmsKeyTagging.read(context.Background(), d, client); err != nil {
+		t.Fatalf("reading the tags of the kms key got an error: %#v", err)
+	}
+	if tags := d.Get("tags").(map[string]interface{}); len(tags) != 1 || tags["Env"] != "test" {
+		t.Errorf("expected the tags of the key without the ones of Alibaba Cloud, got %v", tags)
+	}
+	if call, ok := server.lastCall("ListResourceTags"); !ok || call.Params["KeyId"] != "key-mock0001" {
+		t.Errorf("ListResourceTags was not called with the KeyId of the key: %v", call.Params)
+	}
+}
@@ -1,7 +1,10 @@
 package alibabacloudstack
 
 import (
+	"context"
+	"fmt"
 	"log"
+	"reflect"
 	"strings"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -47,6 +50,109 @@ func tagsSchemaComputed() *schema.Schema {
 		},
 	}
 }
+
+// tagsAllSchema holds all of the tags of a resource, including the ones inherited from the provider default_tags.
+func tagsAllSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeMap,
+		Computed: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+// providerIgnoredTag reports whether the tag matches the provider ignore_tags.
+func providerIgnoredTag(config *connectivity.Config, tagKey string) bool {
+	for _, key := range config.IgnoreTagsKeys {
+		if tagKey == key {
+			return true
+		}
+	}
+	for _, prefix := range config.IgnoreTagsKeyPrefixes {
+		if strings.HasPrefix(tagKey, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// mergeDefaultTags returns the provider default_tags overridden by the tags of the resource,
+// without the tags matching the provider ignore_tags.
+func mergeDefaultTags(meta interface{}, tags map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{})
+	client, ok := meta.(*connectivity.AlibabacloudStackClient)
+	if !ok || client.Config == nil {
+		for key, value := range tags {
+			result[key] = value
+		}
+		return result
+	}
+	for key, value := range client.Config.DefaultTags {
+		result[key] = value
+	}
+	for key, value := range tags {
+		result[key] = value
+	}
+	for key := range result {
+		if providerIgnoredTag(client.Config, key) {
+			delete(result, key)
+		}
+	}
+	return result
+}
+
+// setTagsAllDiff plans the tags_all of a resource. The resources apply the tags_all instead of the tags,
+// so that a change of the provider default_tags updates every resource inheriting them.
+func setTagsAllDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("tags") {
+		return d.SetNewComputed("tags_all")
+	}
+	all := mergeDefaultTags(meta, d.Get("tags").(map[string]interface{}))
+	old, _ := d.Get("tags_all").(map[string]interface{})
+	if len(old) == 0 && len(all) == 0 || reflect.DeepEqual(old, all) {
+		return nil
+	}
+	return d.SetNew("tags_all", all)
+}
+
+// setResourceTags saves the tags read from the api. The tags_all gets all of them but the ignored ones,
+// and the tags leave out the default tags which the configuration does not set itself.
+func setResourceTags(d *schema.ResourceData, meta interface{}, tags interface{}) error {
+	all := make(map[string]interface{})
+	switch v := tags.(type) {
+	case map[string]string:
+		for key, value := range v {
+			all[key] = value
+		}
+	case map[string]interface{}:
+		for key, value := range v {
+			all[key] = value
+		}
+	default:
+		return fmt.Errorf("unsupported tags type %T", tags)
+	}
+	configured, _ := d.Get("tags").(map[string]interface{})
+	resourceTags := make(map[string]interface{})
+	client, ok := meta.(*connectivity.AlibabacloudStackClient)
+	for key, value := range all {
+		if ok && client.Config != nil {
+			if providerIgnoredTag(client.Config, key) {
+				delete(all, key)
+				continue
+			}
+			if defaultValue, isDefault := client.Config.DefaultTags[key]; isDefault && defaultValue == value && configured[key] != value {
+				continue
+			}
+		}
+		resourceTags[key] = value
+	}
+	if err := d.Set("tags", resourceTags); err != nil {
+		return err
+	}
+	return d.Set("tags_all", all)
+}
+
 func elasticsearchTagIgnored(tagKey, tagValue string) bool {
 	filter := []string{"^aliyun", "^acs:", "^http://", "^https://"}
 	for _, v := range filter {
@@ -61,27 +167,27 @@ func elasticsearchTagIgnored(tagKey, tagValue string) bool {
 }
 
 // setTags is a helper to set the tags for a resource. It expects the
-// tags field to be named "tags"
-func setTags(client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
-		return updateTags(client, []string{d.Id()}, resourceType, oraw, nraw)
+// tags field to be named "tags_all"
+func setTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
+		return updateTags(ctx, client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
 	return nil
 }
 
 func setCdnTags(client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	if d.HasChange("tags") {
-		oraw, nraw := d.GetChange("tags")
+	if d.HasChange("tags_all") {
+		oraw, nraw := d.GetChange("tags_all")
 		return updateCdnTags(client, []string{d.Id()}, resourceType, oraw, nraw)
 	}
 
 	return nil
 }
 
-func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
-	ecsService := EcsService{client}
+func setVolumeTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, resourceType TagResourceType, d *schema.ResourceData) error {
+	ecsService := EcsService{client, ctx}
 	if d.HasChange("volume_tags") {
 		request := ecs.CreateDescribeDisksRequest()
 		if strings.ToLower(client.Config.Protocol) == "https" {
@@ -90,20 +196,14 @@ func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType Ta
 			request.Scheme = "http"
 		}
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 		request.InstanceId = d.Id()
 		var response *ecs.DescribeDisksResponse
-		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.DescribeDisks(request)
 			})
 			if err != nil {
-				if IsThrottling(err) {
-					wait()
-					return resource.RetryableError(err)
-
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -135,13 +235,13 @@ func setVolumeTags(client *connectivity.AlibabacloudStackClient, resourceType Ta
 		if d.IsNewResource() {
 			oraw = systemDiskTag
 		}
-		return updateTags(client, ids, resourceType, oraw, nraw)
+		return updateTags(ctx, client, ids, resourceType, oraw, nraw)
 	}
 
 	return nil
 }
 
-func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, resourceType TagResourceType, oraw, nraw interface{}) error {
+func updateTags(ctx context.Context, client *connectivity.AlibabacloudStackClient, ids []string, resourceType TagResourceType, oraw, nraw interface{}) error {
 	o := oraw.(map[string]interface{})
 	n := nraw.(map[string]interface{})
 	create, remove := diffTags(tagsFromMap(o), tagsFromMap(n))
@@ -157,7 +257,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		}
 		request.ResourceId = &ids
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		var tagsKey []string
 		for _, t := range remove {
@@ -165,17 +265,11 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		}
 		request.TagKey = &tagsKey
 
-		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.UntagResources(request)
 			})
 			if err != nil {
-				if IsThrottling(err) {
-					wait()
-					return resource.RetryableError(err)
-
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -197,7 +291,7 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		request.ResourceType = string(resourceType)
 		request.ResourceId = &ids
 		request.Headers = map[string]string{"RegionId": client.RegionId}
-		request.QueryParams = map[string]string{"AccessKeySecret": client.SecretKey, "Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
+		request.QueryParams = map[string]string{"Product": "ecs", "Department": client.Department, "ResourceGroup": client.ResourceGroup}
 
 		var tags []ecs.TagResourcesTag
 		for _, t := range create {
@@ -208,17 +302,11 @@ func updateTags(client *connectivity.AlibabacloudStackClient, ids []string, reso
 		}
 		request.Tag = &tags
 
-		wait := incrementalWait(1*time.Second, 1*time.Second)
-		err := resource.Retry(10*time.Minute, func() *resource.RetryError {
+		err := resource.RetryContext(ctx, 10*time.Minute, func() *resource.RetryError {
 			raw, err := client.WithEcsClient(func(ecsClient *ecs.Client) (interface{}, error) {
 				return ecsClient.TagResources(request)
 			})
 			if err != nil {
-				if IsThrottling(err) {
-					wait()
-					return resource.RetryableError(err)
-
-				}
 				return resource.NonRetryableError(err)
 			}
 			addDebug(request.GetActionName(), raw, request.RpcRequest, request)
@@ -596,7 +684,7 @@ func ignoredTags(tagKey, tagValue string) bool {
 	return false
 }
 func parsingTags(d *schema.ResourceData) (map[string]interface{}, []string) {
-	oraw, nraw := d.GetChange("tags")
+	oraw, nraw := d.GetChange("tags_all")
 	removedTags := oraw.(map[string]interface{})
 	addedTags := nraw.(map[string]interface{})
 	// Build the list of what to remove
@@ -125,6 +125,23 @@ func validateStringConvertInt64() schema.SchemaValidateFunc {
 	}
 }
 
+func validateStringConvertFloat64() schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if value, ok := v.(string); ok {
+			_, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				errors = append(errors, fmt.Errorf(
+					"%q should be convert to float64, got %q", k, value))
+			}
+		} else {
+			errors = append(errors, fmt.Errorf(
+				"%q should be convert to string, got %q", k, value))
+		}
+
+		return
+	}
+}
+
 func validateOssBucketDateTimestamp(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	_, err := time.Parse("2006-01-02", value)
@@ -3,18 +3,35 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"log"
+	"os"
+	"strings"
 
 	"github.com/aliyun/terraform-provider-alibabacloudstack/alibabacloudstack"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/plugin"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func main() {
 	var debugMode bool
+	var generateConfig, resourceTypes, department, resourceGroup string
 
 	flag.BoolVar(&debugMode, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&generateConfig, "generate-config", "", "write the import blocks and the configuration of the existing resources to the file, \"-\" for stdout, instead of running the provider")
+	flag.StringVar(&resourceTypes, "resource-types", "", "comma separated resource types to generate the configuration of, all of the supported ones when empty: "+strings.Join(alibabacloudstack.ConfigGeneratorResourceTypes(), ", "))
+	flag.StringVar(&department, "department", "", "the department to generate the configuration of, defaults to ALIBABACLOUDSTACK_DEPARTMENT")
+	flag.StringVar(&resourceGroup, "resource-group", "", "the resource group to generate the configuration of, defaults to ALIBABACLOUDSTACK_RESOURCE_GROUP")
 	flag.Parse()
 
+	if generateConfig != "" {
+		if err := generate(generateConfig, resourceTypes, department, resourceGroup); err != nil {
+			log.Fatal(err.Error())
+		}
+		return
+	}
+
 	opts := &plugin.ServeOpts{ProviderFunc: alibabacloudstack.Provider}
 
 	if debugMode {
@@ -28,3 +45,41 @@ func main() {
 
 	plugin.Serve(opts)
 }
+
+// generate configures the provider from the ALIBABACLOUDSTACK_* environment variables and writes the
+// configuration of the existing resources of the department and resource group.
+func generate(output, resourceTypes, department, resourceGroup string) error {
+	ctx := context.Background()
+	config := map[string]interface{}{}
+	if department != "" {
+		config["department"] = department
+	}
+	if resourceGroup != "" {
+		config["resource_group"] = resourceGroup
+	}
+	provider := alibabacloudstack.Provider()
+	if diags := provider.Configure(ctx, terraform.NewResourceConfigRaw(config)); diags.HasError() {
+		for _, d := range diags {
+			if d.Severity == diag.Error {
+				return fmt.Errorf("configuring the provider failed: %s %s", d.Summary, d.Detail)
+			}
+		}
+	}
+
+	w := os.Stdout
+	if output != "-" {
+		file, err := os.Create(output)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		w = file
+	}
+	var types []string
+	for _, resourceType := range strings.Split(resourceTypes, ",") {
+		if resourceType = strings.TrimSpace(resourceType); resourceType != "" {
+			types = append(types, resourceType)
+		}
+	}
+	return alibabacloudstack.GenerateConfig(ctx, provider.Meta(), w, types)
+}